@@ -55,7 +55,10 @@ var allCmd = &cobra.Command{
 			panic(err)
 		}
 
-		pubSub := p2p.NewBlossomSub(NodeConfig.P2P, logger)
+		pubSub, err := p2p.NewBlossomSub(NodeConfig.P2P, logger, nil)
+		if err != nil {
+			panic(err)
+		}
 		logger.Info("connecting to network")
 		time.Sleep(5 * time.Second)
 
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+var faucetCmd = &cobra.Command{
+	Use:   "faucet",
+	Short: "Claims test tokens from the faucet of a test network",
+	Long: `Claims test tokens from the faucet of a test network:
+
+	faucet
+
+	The faucet credits the account of the node's peer ID, at most once every
+	few hundred frames. The claim is signed for the network of the node config
+	and the latest frame of the node, and cannot carry an expiry frame. It is
+	not available on mainnet.
+	`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conn, err := GetGRPCClient()
+		if err != nil {
+			panic(err)
+		}
+		defer conn.Close()
+
+		client := protobufs.NewNodeServiceClient(conn)
+		key, err := GetPrivKeyFromConfig(NodeConfig)
+		if err != nil {
+			panic(err)
+		}
+
+		info, err := client.GetNodeInfo(
+			context.Background(),
+			&protobufs.GetNodeInfoRequest{},
+		)
+		if err != nil {
+			panic(err)
+		}
+
+		sig, err := key.Sign(
+			protobufs.FaucetPayload(NodeConfig.P2P.Network, info.MaxFrame),
+		)
+		if err != nil {
+			panic(err)
+		}
+
+		pub, err := key.GetPublic().Raw()
+		if err != nil {
+			panic(err)
+		}
+
+		_, err = client.SendMessage(
+			context.Background(),
+			&protobufs.TokenRequest{
+				Request: &protobufs.TokenRequest_Faucet{
+					Faucet: &protobufs.FaucetRequest{
+						Signature: &protobufs.Ed448Signature{
+							Signature: sig,
+							PublicKey: &protobufs.Ed448PublicKey{
+								KeyValue: pub,
+							},
+						},
+						FrameNumber: info.MaxFrame,
+					},
+				},
+			},
+		)
+		if err != nil {
+			panic(err)
+		}
+	},
+}
+
+func init() {
+	tokenCmd.AddCommand(faucetCmd)
+}
@@ -116,6 +116,7 @@ var mergeCmd = &cobra.Command{
 		}
 
 		// Signing process
+		payload = protobufs.AppendExpiryFrame(payload, tokenExpiryFrame)
 		sig, err := privKey.Sign(payload)
 		if err != nil {
 			panic(err)
@@ -136,6 +137,7 @@ var mergeCmd = &cobra.Command{
 						},
 					},
 				},
+				ExpiryFrame: tokenExpiryFrame,
 			},
 		)
 		if err != nil {
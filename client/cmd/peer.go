@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/spf13/cobra"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+var peerCmd = &cobra.Command{
+	Use:   "peer",
+	Short: "Manages the running node's peer connections",
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		rootCmd.PersistentPreRun(cmd, args)
+		if LightNode {
+			fmt.Println(
+				"peer commands require a running node with ListenGRPCMultiaddr set",
+			)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(peerCmd)
+}
+
+func parsePeerIDArg(args []string) []byte {
+	if len(args) != 1 {
+		fmt.Println("expected a single peer id")
+		os.Exit(1)
+	}
+
+	peerId, err := peer.Decode(args[0])
+	if err != nil {
+		fmt.Printf("invalid peer id: %s\n", args[0])
+		os.Exit(1)
+	}
+
+	return []byte(peerId)
+}
+
+func printPeerConnection(resp *protobufs.PeerConnectionResponse) {
+	fmt.Println("Peer ID:", peer.ID(resp.PeerId).String())
+	fmt.Println("Connectedness:", resp.Connectedness)
+	fmt.Println("Protected:", resp.Protected)
+	fmt.Println("Peer Score:", resp.PeerScore)
+	for _, c := range resp.Connections {
+		fmt.Printf(
+			"Connection: %s %s -> %s (opened %s",
+			c.Direction,
+			c.LocalMultiaddr,
+			c.RemoteMultiaddr,
+			time.UnixMilli(c.OpenedAt).Format(time.RFC3339),
+		)
+		if c.Limited {
+			fmt.Print(", limited")
+		}
+		fmt.Println(")")
+	}
+	for _, a := range resp.KnownMultiaddrs {
+		fmt.Println("Known Multiaddr:", a)
+	}
+}
@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/spf13/cobra"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+var peerBandwidthLimit uint32
+
+var peerBandwidthCmd = &cobra.Command{
+	Use:   "bandwidth",
+	Short: "Shows the node's traffic by protocol and by peer",
+	Run: func(cmd *cobra.Command, args []string) {
+		conn, err := GetGRPCClient()
+		if err != nil {
+			panic(err)
+		}
+		defer conn.Close()
+
+		client := protobufs.NewNodeServiceClient(conn)
+		resp, err := client.GetBandwidthStats(
+			context.Background(),
+			&protobufs.GetBandwidthStatsRequest{PeerLimit: peerBandwidthLimit},
+		)
+		if err != nil {
+			panic(err)
+		}
+
+		printBandwidth("Total", resp.Total)
+		fmt.Println()
+		fmt.Println("Protocols:")
+		for _, p := range resp.Protocols {
+			printBandwidth("  "+p.Protocol, p.Stats)
+		}
+		fmt.Println()
+		fmt.Println("Peers:")
+		for _, p := range resp.Peers {
+			printBandwidth("  "+peer.ID(p.PeerId).String(), p.Stats)
+		}
+	},
+}
+
+func printBandwidth(name string, stats *protobufs.BandwidthStats) {
+	fmt.Printf(
+		"%s: %d B in (%.0f B/s), %d B out (%.0f B/s)\n",
+		name,
+		stats.GetTotalIn(),
+		stats.GetRateIn(),
+		stats.GetTotalOut(),
+		stats.GetRateOut(),
+	)
+}
+
+func init() {
+	peerBandwidthCmd.Flags().Uint32Var(
+		&peerBandwidthLimit,
+		"peers",
+		10,
+		"number of the busiest peers to show, 0 for all",
+	)
+	peerCmd.AddCommand(peerBandwidthCmd)
+}
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+var peerConnectCmd = &cobra.Command{
+	Use:   "connect <Multiaddr>",
+	Short: "Connects the node to a peer",
+	Long: `Connects the node to a peer, bypassing any dial backoff:
+
+	connect /ip4/1.2.3.4/udp/8336/quic-v1/p2p/<Peer ID>
+	`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) != 1 {
+			fmt.Println("expected a single multiaddr")
+			os.Exit(1)
+		}
+
+		conn, err := GetGRPCClient()
+		if err != nil {
+			panic(err)
+		}
+		defer conn.Close()
+
+		client := protobufs.NewNodeServiceClient(conn)
+		resp, err := client.ConnectPeer(
+			context.Background(),
+			&protobufs.ConnectPeerRequest{Multiaddr: args[0]},
+		)
+		if err != nil {
+			panic(err)
+		}
+
+		printPeerConnection(resp)
+	},
+}
+
+func init() {
+	peerCmd.AddCommand(peerConnectCmd)
+}
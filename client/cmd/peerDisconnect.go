@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+var peerDisconnectCmd = &cobra.Command{
+	Use:   "disconnect <Peer ID>",
+	Short: "Closes all connections to a peer",
+	Long: `Closes all connections to a peer. The peer may reconnect later unless it is
+	gated by other means:
+
+	disconnect <Peer ID>
+	`,
+	Run: func(cmd *cobra.Command, args []string) {
+		peerId := parsePeerIDArg(args)
+
+		conn, err := GetGRPCClient()
+		if err != nil {
+			panic(err)
+		}
+		defer conn.Close()
+
+		client := protobufs.NewNodeServiceClient(conn)
+		resp, err := client.DisconnectPeer(
+			context.Background(),
+			&protobufs.DisconnectPeerRequest{PeerId: peerId},
+		)
+		if err != nil {
+			panic(err)
+		}
+
+		printPeerConnection(resp)
+	},
+}
+
+func init() {
+	peerCmd.AddCommand(peerDisconnectCmd)
+}
@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+var (
+	peerDiscoverBootstrapOnly bool
+	peerDiscoverDHTOnly       bool
+)
+
+var peerDiscoverCmd = &cobra.Command{
+	Use:   "discover",
+	Short: "Runs a bootstrap and peer discovery round right away",
+	Long: `Runs a bootstrap round, even if enough bootstrap peers are connected,
+and a peer discovery round over the DHT, then prints what they did:
+
+	discover
+	discover --bootstrap
+	discover --dht
+	`,
+	Run: func(cmd *cobra.Command, args []string) {
+		conn, err := GetGRPCClient()
+		if err != nil {
+			panic(err)
+		}
+		defer conn.Close()
+
+		client := protobufs.NewNodeServiceClient(conn)
+		resp, err := client.RunPeerDiscovery(
+			context.Background(),
+			&protobufs.RunPeerDiscoveryRequest{
+				Bootstrap: !peerDiscoverDHTOnly,
+				Discover:  !peerDiscoverBootstrapOnly,
+			},
+		)
+		if err != nil {
+			panic(err)
+		}
+
+		for _, r := range resp.Rounds {
+			fmt.Printf(
+				"%s: %d attempted, %d connected, %d already connected",
+				r.Source,
+				r.Attempted,
+				r.Connected,
+				r.AlreadyConnected,
+			)
+			if r.Error != "" {
+				fmt.Printf(" (%s)", r.Error)
+			}
+			fmt.Println()
+		}
+		fmt.Println("Connected Peers:", resp.PeerCount)
+	},
+}
+
+func init() {
+	peerDiscoverCmd.Flags().BoolVar(
+		&peerDiscoverBootstrapOnly,
+		"bootstrap",
+		false,
+		"only run the bootstrap round",
+	)
+	peerDiscoverCmd.Flags().BoolVar(
+		&peerDiscoverDHTOnly,
+		"dht",
+		false,
+		"only run the DHT discovery round",
+	)
+	peerDiscoverCmd.MarkFlagsMutuallyExclusive("bootstrap", "dht")
+	peerCmd.AddCommand(peerDiscoverCmd)
+}
@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+var peerProtectCmd = &cobra.Command{
+	Use:   "protect <Peer ID>",
+	Short: "Protects a peer's connections from being trimmed",
+	Run: func(cmd *cobra.Command, args []string) {
+		setPeerProtection(parsePeerIDArg(args), true)
+	},
+}
+
+var peerUnprotectCmd = &cobra.Command{
+	Use:   "unprotect <Peer ID>",
+	Short: "Removes protection previously added with protect",
+	Run: func(cmd *cobra.Command, args []string) {
+		setPeerProtection(parsePeerIDArg(args), false)
+	},
+}
+
+func setPeerProtection(peerId []byte, protect bool) {
+	conn, err := GetGRPCClient()
+	if err != nil {
+		panic(err)
+	}
+	defer conn.Close()
+
+	client := protobufs.NewNodeServiceClient(conn)
+	resp, err := client.ProtectPeer(
+		context.Background(),
+		&protobufs.ProtectPeerRequest{PeerId: peerId, Protect: protect},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	printPeerConnection(resp)
+}
+
+func init() {
+	peerCmd.AddCommand(peerProtectCmd)
+	peerCmd.AddCommand(peerUnprotectCmd)
+}
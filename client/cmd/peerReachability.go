@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+var peerReachabilityCmd = &cobra.Command{
+	Use:   "reachability",
+	Short: "Shows whether the node is externally reachable and at which addresses",
+	Run: func(cmd *cobra.Command, args []string) {
+		conn, err := GetGRPCClient()
+		if err != nil {
+			panic(err)
+		}
+		defer conn.Close()
+
+		client := protobufs.NewNodeServiceClient(conn)
+		resp, err := client.GetReachability(
+			context.Background(),
+			&protobufs.GetReachabilityRequest{},
+		)
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Print("Reachability: ", resp.Reachability)
+		if resp.ReachabilityChangedAt != 0 {
+			fmt.Print(
+				" (since ",
+				time.UnixMilli(resp.ReachabilityChangedAt).Format(time.RFC3339),
+				")",
+			)
+		}
+		fmt.Println()
+
+		printAddrs := func(name string, addrs []string) {
+			fmt.Println(name + ":")
+			for _, addr := range addrs {
+				fmt.Println("  " + addr)
+			}
+		}
+		printAddrs("Listening on", resp.ListenMultiaddrs)
+		printAddrs("Advertised", resp.AdvertisedMultiaddrs)
+		printAddrs("Observed by peers", resp.ObservedMultiaddrs)
+
+		nat := resp.GetNat()
+		fmt.Print("NAT device: ", nat.GetNatState())
+		if nat.GetNatType() != "" {
+			fmt.Print(" (", nat.GetNatType(), ")")
+		}
+		if nat.GetNatExternalIp() != "" {
+			fmt.Print(", external IP ", nat.GetNatExternalIp())
+		}
+		if nat.GetNatError() != "" {
+			fmt.Print(": ", nat.GetNatError())
+		}
+		fmt.Println()
+		for _, m := range nat.GetMappings() {
+			fmt.Printf(
+				"  %s %d -> %d",
+				m.Protocol,
+				m.InternalPort,
+				m.ExternalPort,
+			)
+			if m.Error != "" {
+				fmt.Print(": ", m.Error)
+			}
+			fmt.Println()
+		}
+	},
+}
+
+func init() {
+	peerCmd.AddCommand(peerReachabilityCmd)
+}
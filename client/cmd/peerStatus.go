@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+var peerStatusCmd = &cobra.Command{
+	Use:   "status <Peer ID>",
+	Short: "Shows the node's connection state with a peer",
+	Run: func(cmd *cobra.Command, args []string) {
+		peerId := parsePeerIDArg(args)
+
+		conn, err := GetGRPCClient()
+		if err != nil {
+			panic(err)
+		}
+		defer conn.Close()
+
+		client := protobufs.NewNodeServiceClient(conn)
+		resp, err := client.GetPeerConnection(
+			context.Background(),
+			&protobufs.GetPeerConnectionRequest{PeerId: peerId},
+		)
+		if err != nil {
+			panic(err)
+		}
+
+		printPeerConnection(resp)
+	},
+}
+
+func init() {
+	peerCmd.AddCommand(peerStatusCmd)
+}
@@ -28,7 +28,10 @@ var proverPauseCmd = &cobra.Command{
 	`,
 	Run: func(cmd *cobra.Command, args []string) {
 		logger, err := zap.NewProduction()
-		pubsub := p2p.NewBlossomSub(NodeConfig.P2P, logger)
+		pubsub, err := p2p.NewBlossomSub(NodeConfig.P2P, logger, nil)
+		if err != nil {
+			panic(err)
+		}
 		intrinsicFilter := p2p.GetBloomFilter(application.TOKEN_ADDRESS, 256, 3)
 		pubsub.Subscribe(
 			append([]byte{0x00}, intrinsicFilter...),
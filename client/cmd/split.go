@@ -66,6 +66,7 @@ var splitCmd = &cobra.Command{
 			panic(err)
 		}
 
+		payload = protobufs.AppendExpiryFrame(payload, tokenExpiryFrame)
 		sig, err := key.Sign(payload)
 		if err != nil {
 			panic(err)
@@ -91,6 +92,7 @@ var splitCmd = &cobra.Command{
 						},
 					},
 				},
+				ExpiryFrame: tokenExpiryFrame,
 			},
 		)
 		if err != nil {
@@ -4,11 +4,20 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var tokenExpiryFrame uint64
+
 var tokenCmd = &cobra.Command{
 	Use:   "token",
 	Short: "Performs a token operation",
 }
 
 func init() {
+	tokenCmd.PersistentFlags().Uint64Var(
+		&tokenExpiryFrame,
+		"expiry-frame",
+		0,
+		"last frame the request may be applied in, 0 for no expiry; signed "+
+			"with the request, so only set it once the network honors it",
+	)
 	rootCmd.AddCommand(tokenCmd)
 }
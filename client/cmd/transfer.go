@@ -57,6 +57,7 @@ var transferCmd = &cobra.Command{
 		}
 		payload = append(payload, toaddr...)
 
+		payload = protobufs.AppendExpiryFrame(payload, tokenExpiryFrame)
 		sig, err := key.Sign(payload)
 		if err != nil {
 			panic(err)
@@ -88,6 +89,7 @@ var transferCmd = &cobra.Command{
 						},
 					},
 				},
+				ExpiryFrame: tokenExpiryFrame,
 			},
 		)
 		if err != nil {
@@ -19,6 +19,10 @@ var ErrBitmaskClosed = errors.New("this Bitmask is closed, try opening a new one
 // ErrNilSignKey is returned if a nil private key was provided
 var ErrNilSignKey = errors.New("nil sign key")
 
+// ErrBackpressure is returned by Publish with WithBackpressure if the send queue
+// is full
+var ErrBackpressure = errors.New("send queue is full")
+
 // ErrEmptyPeerID is returned if an empty peer ID was provided
 var ErrEmptyPeerID = errors.New("empty peer ID")
 
@@ -226,9 +230,10 @@ type RouterReady func(rt PubSubRouter, bitmask []byte) (bool, error)
 type ProvideKey func() (crypto.PrivKey, peer.ID)
 
 type PublishOptions struct {
-	ready     RouterReady
-	customKey ProvideKey
-	local     bool
+	ready        RouterReady
+	customKey    ProvideKey
+	local        bool
+	backpressure bool
 }
 
 type PubOpt func(pub *PublishOptions) error
@@ -338,7 +343,7 @@ func (t *Bitmask) Publish(ctx context.Context, bitmask []byte, data []byte, opts
 		}
 	}
 
-	err := t.p.val.PushLocal(&Message{m, nil, t.p.host.ID(), nil, pub.local})
+	err := t.p.val.PushLocal(ctx, &Message{m, nil, t.p.host.ID(), nil, pub.local}, pub.backpressure)
 
 	t.mux.RUnlock()
 	return err
@@ -365,6 +370,16 @@ func WithLocalPublication(local bool) PubOpt {
 	}
 }
 
+// WithBackpressure returns a publishing option that fails with ErrBackpressure
+// instead of waiting when the send queue is full, so that the caller can retry
+// or shed load.
+func WithBackpressure() PubOpt {
+	return func(pub *PublishOptions) error {
+		pub.backpressure = true
+		return nil
+	}
+}
+
 // WithSecretKeyAndPeerId returns a publishing option for providing a custom private key and its corresponding peer ID
 // This option is useful when we want to send messages from "virtual", never-connectable peers in the network
 func WithSecretKeyAndPeerId(key crypto.PrivKey, pid peer.ID) PubOpt {
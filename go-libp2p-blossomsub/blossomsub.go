@@ -661,6 +661,14 @@ func (bs *BlossomSubRouter) RemovePeer(p peer.ID) {
 	delete(bs.outbound, p)
 }
 
+// MeshPeerCount returns the number of peers in the mesh of the bitmask, as
+// opposed to every peer subscribed to it.
+func (bs *BlossomSubRouter) MeshPeerCount(bitmask []byte) int {
+	bs.meshMx.RLock()
+	defer bs.meshMx.RUnlock()
+	return len(bs.mesh[string(bitmask)])
+}
+
 func (bs *BlossomSubRouter) EnoughPeers(bitmask []byte, suggested int) bool {
 	// check all peers in the bitmask
 	tmap, ok := bs.p.bitmasks[string(bitmask)]
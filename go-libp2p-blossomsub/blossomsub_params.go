@@ -0,0 +1,110 @@
+package blossomsub
+
+import (
+	"fmt"
+	"time"
+)
+
+// BlossomSubParamsUpdate holds the subset of BlossomSubParams that is safe to change
+// while the router runs. Zero values leave the corresponding parameter unchanged.
+type BlossomSubParamsUpdate struct {
+	Dlazy                int
+	GossipRetransmission int
+	IWantFollowupTime    time.Duration
+}
+
+// evalSync runs f on the event loop and waits for it to complete. Before the router
+// is attached there is no event loop yet, and f runs directly.
+func (bs *BlossomSubRouter) evalSync(f func()) error {
+	if bs.p == nil {
+		f()
+		return nil
+	}
+
+	done := make(chan struct{})
+	select {
+	case bs.p.eval <- func() {
+		f()
+		close(done)
+	}:
+	case <-bs.p.ctx.Done():
+		return bs.p.ctx.Err()
+	}
+
+	select {
+	case <-done:
+		return nil
+	case <-bs.p.ctx.Done():
+		return bs.p.ctx.Err()
+	}
+}
+
+// Params returns the effective router parameters.
+func (bs *BlossomSubRouter) Params() (BlossomSubParams, error) {
+	var params BlossomSubParams
+	err := bs.evalSync(func() {
+		params = bs.params
+	})
+	return params, err
+}
+
+// PeerScoreParams returns the effective peer score parameters, or nil when peer
+// scoring is disabled. The returned value must not be modified.
+func (bs *BlossomSubRouter) PeerScoreParams() (*PeerScoreParams, error) {
+	var params *PeerScoreParams
+	err := bs.evalSync(func() {
+		if bs.score != nil {
+			params = bs.score.params
+		}
+	})
+	return params, err
+}
+
+// PeerScoreThresholds returns the effective peer score thresholds.
+func (bs *BlossomSubRouter) PeerScoreThresholds() (PeerScoreThresholds, error) {
+	var thresholds PeerScoreThresholds
+	err := bs.evalSync(func() {
+		thresholds = PeerScoreThresholds{
+			GossipThreshold:             bs.gossipThreshold,
+			PublishThreshold:            bs.publishThreshold,
+			GraylistThreshold:           bs.graylistThreshold,
+			AcceptPXThreshold:           bs.acceptPXThreshold,
+			OpportunisticGraftThreshold: bs.opportunisticGraftThreshold,
+		}
+	})
+	return thresholds, err
+}
+
+// UpdateParams applies the update to the running router and returns the resulting
+// parameters. Changes take effect from the next heartbeat, or the next promise for
+// IWantFollowupTime.
+func (bs *BlossomSubRouter) UpdateParams(update BlossomSubParamsUpdate) (
+	BlossomSubParams,
+	error,
+) {
+	if update.Dlazy < 0 {
+		return BlossomSubParams{}, fmt.Errorf("Dlazy must be non-negative")
+	}
+	if update.GossipRetransmission < 0 {
+		return BlossomSubParams{}, fmt.Errorf("GossipRetransmission must be non-negative")
+	}
+	if update.IWantFollowupTime < 0 {
+		return BlossomSubParams{}, fmt.Errorf("IWantFollowupTime must be non-negative")
+	}
+
+	var params BlossomSubParams
+	err := bs.evalSync(func() {
+		if update.Dlazy != 0 {
+			bs.params.Dlazy = update.Dlazy
+		}
+		if update.GossipRetransmission != 0 {
+			bs.params.GossipRetransmission = update.GossipRetransmission
+		}
+		if update.IWantFollowupTime != 0 {
+			bs.params.IWantFollowupTime = update.IWantFollowupTime
+			bs.gossipTracer.setFollowUpTime(update.IWantFollowupTime)
+		}
+		params = bs.params
+	})
+	return params, err
+}
@@ -0,0 +1,50 @@
+package blossomsub
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestBlossomSubRouterUpdateParams(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	hosts := getDefaultHosts(t, 1)
+	ps := getBlossomSub(ctx, hosts[0])
+	rt := ps.rt.(*BlossomSubRouter)
+
+	before, err := rt.Params()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	after, err := rt.UpdateParams(BlossomSubParamsUpdate{
+		Dlazy:             before.Dlazy + 2,
+		IWantFollowupTime: 7 * time.Second,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if after.Dlazy != before.Dlazy+2 {
+		t.Fatalf("expected Dlazy %d, got %d", before.Dlazy+2, after.Dlazy)
+	}
+	if after.GossipRetransmission != before.GossipRetransmission {
+		t.Fatal("expected GossipRetransmission to be unchanged")
+	}
+	if after.IWantFollowupTime != 7*time.Second {
+		t.Fatalf("expected IWantFollowupTime 7s, got %s", after.IWantFollowupTime)
+	}
+
+	current, err := rt.Params()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if current != after {
+		t.Fatal("expected updated params to be effective")
+	}
+
+	if _, err := rt.UpdateParams(BlossomSubParamsUpdate{Dlazy: -1}); err == nil {
+		t.Fatal("expected negative Dlazy to be rejected")
+	}
+}
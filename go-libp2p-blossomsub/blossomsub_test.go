@@ -3185,3 +3185,18 @@ func assertReceivedBitmaskSubgroup(t *testing.T, ctx context.Context, subs [][]*
 		}
 	}
 }
+
+func TestBlossomSubMeshPeerCount(t *testing.T) {
+	bs := &BlossomSubRouter{
+		mesh: map[string]map[peer.ID]struct{}{
+			string([]byte{0x00, 0x01}): {"a": {}, "b": {}},
+		},
+	}
+
+	if count := bs.MeshPeerCount([]byte{0x00, 0x01}); count != 2 {
+		t.Fatalf("expected 2 mesh peers, got %d", count)
+	}
+	if count := bs.MeshPeerCount([]byte{0x00, 0x02}); count != 0 {
+		t.Fatalf("expected no mesh peers in an unjoined bitmask, got %d", count)
+	}
+}
@@ -0,0 +1,69 @@
+package blossomsub
+
+import (
+	"fmt"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/peerstore"
+)
+
+// directPeerProtectTag is the connection manager tag the tag tracer protects
+// direct peers with.
+const directPeerProtectTag = "pubsub:<direct>"
+
+// SetDirectPeers replaces the peers with direct peering agreements while the
+// router runs, as WithDirectPeers sets them at construction. New direct peers
+// are connected to right away, while peers that are no longer direct lose
+// their protection and permanent addresses and are treated like any other
+// peer from the next heartbeat on.
+func (bs *BlossomSubRouter) SetDirectPeers(pis []peer.AddrInfo) error {
+	if bs.p == nil {
+		return fmt.Errorf("router is not attached")
+	}
+
+	direct := make(map[peer.ID]struct{}, len(pis))
+	for _, pi := range pis {
+		direct[pi.ID] = struct{}{}
+	}
+
+	return bs.evalSync(func() {
+		ps := bs.p.host.Peerstore()
+		for p := range bs.direct {
+			if _, ok := direct[p]; ok {
+				continue
+			}
+			ps.UpdateAddrs(p, peerstore.PermanentAddrTTL, peerstore.AddressTTL)
+			if bs.tagTracer != nil {
+				bs.tagTracer.cmgr.Unprotect(p, directPeerProtectTag)
+			}
+		}
+
+		var toconnect []peer.ID
+		for _, pi := range pis {
+			ps.AddAddrs(pi.ID, pi.Addrs, peerstore.PermanentAddrTTL)
+			if _, ok := bs.direct[pi.ID]; ok {
+				continue
+			}
+			if _, connected := bs.peers[pi.ID]; connected {
+				if bs.tagTracer != nil {
+					bs.tagTracer.cmgr.Protect(pi.ID, directPeerProtectTag)
+				}
+			} else {
+				toconnect = append(toconnect, pi.ID)
+			}
+		}
+
+		bs.direct = direct
+		if bs.tagTracer != nil {
+			bs.tagTracer.direct = direct
+		}
+
+		if len(toconnect) > 0 {
+			go func() {
+				for _, p := range toconnect {
+					bs.connect <- connectInfo{p: p}
+				}
+			}()
+		}
+	})
+}
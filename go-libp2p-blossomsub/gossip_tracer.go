@@ -44,6 +44,16 @@ func (gt *gossipTracer) Start(bs *BlossomSubRouter) {
 	gt.followUpTime = bs.params.IWantFollowupTime
 }
 
+func (gt *gossipTracer) setFollowUpTime(followUpTime time.Duration) {
+	if gt == nil {
+		return
+	}
+
+	gt.Lock()
+	gt.followUpTime = followUpTime
+	gt.Unlock()
+}
+
 // track a promise to deliver a message from a list of msgIDs we are requesting
 func (gt *gossipTracer) AddPromise(p peer.ID, msgIDs [][]byte) {
 	if gt == nil {
@@ -1488,6 +1488,11 @@ func (p *PubSub) BlacklistPeer(pid peer.ID) {
 	}
 }
 
+// ValidateQueueLimit returns the number of messages the validate queue currently admits.
+func (p *PubSub) ValidateQueueLimit() int {
+	return int(p.val.validateQueueLimit.Load())
+}
+
 // RegisterBitmaskValidator registers a validator for bitmask.
 // By default validators are asynchronous, which means they will run in a separate goroutine.
 // The number of active goroutines is controlled by global and per bitmask validator
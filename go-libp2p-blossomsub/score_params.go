@@ -233,6 +233,12 @@ func (p *PeerScoreParams) validate() error {
 	return nil
 }
 
+// Validate checks the bitmask score parameters, as is done for the parameters
+// given to WithPeerScore.
+func (p *BitmaskScoreParams) Validate() error {
+	return p.validate()
+}
+
 func (p *BitmaskScoreParams) validate() error {
 	// make sure we have a sane bitmask weight
 	if p.BitmaskWeight < 0 || isInvalidNumber(p.BitmaskWeight) {
@@ -86,7 +86,7 @@ func (t *tagTracer) tagPeerIfDirect(p peer.ID) {
 	// tag peer if it is a direct peer
 	_, direct := t.direct[p]
 	if direct {
-		t.cmgr.Protect(p, "pubsub:<direct>")
+		t.cmgr.Protect(p, directPeerProtectTag)
 	}
 }
 
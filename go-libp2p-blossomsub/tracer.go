@@ -94,6 +94,16 @@ func NewJSONTracer(file string) (*JSONTracer, error) {
 	return OpenJSONTracer(file, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
 }
 
+// NewWriterJSONTracer creates a new JSONTracer writing traces to w, which it
+// closes once the tracer is closed. It allows traces to go to writers that
+// manage their own files, such as rotating ones.
+func NewWriterJSONTracer(w io.WriteCloser) *JSONTracer {
+	tr := &JSONTracer{w: w, basicTracer: basicTracer{ch: make(chan struct{}, 1)}}
+	go tr.doWrite()
+
+	return tr
+}
+
 // OpenJSONTracer creates a new JSONTracer, with explicit control of OpenFile flags and permissions.
 func OpenJSONTracer(file string, flags int, perm os.FileMode) (*JSONTracer, error) {
 	f, err := os.OpenFile(file, flags, perm)
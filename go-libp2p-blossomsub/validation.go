@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"runtime"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
@@ -17,8 +18,18 @@ const (
 	DefaultValidateConcurrency = 1024
 	// DefaultValidateThrottle is the default number of concurrent instances of all validators.
 	DefaultValidateThrottle = 8192
+	// validateQueueShrinkTicks is the number of consecutive drop-free, mostly idle adjust
+	// intervals after which an adaptive validate queue halves its limit.
+	validateQueueShrinkTicks = 12
 )
 
+// ValidateQueueAdjustInterval is how often an adaptive validate queue reconsiders its limit.
+var ValidateQueueAdjustInterval = 5 * time.Second
+
+// SignatureVerifyBatchSize is the maximum number of queued messages a signature
+// verification worker takes at a time.
+var SignatureVerifyBatchSize = 64
+
 // ValidationError is an error that may be signalled from message publication when the message
 // fails validation
 type ValidationError struct {
@@ -79,13 +90,106 @@ type validation struct {
 	defaultVals []*validatorImpl
 
 	// validateQ is the front-end to the validation pipeline
-	validateQ chan *validateReq
+	validateQ *validateQueue
+
+	// validateQueueSize is the initial number of requests admitted to validateQ
+	validateQueueSize int
+
+	// validateQueueMin and validateQueueMax bound the adaptive validate queue limit;
+	// when they are equal the limit is fixed at validateQueueSize
+	validateQueueMin int
+	validateQueueMax int
+
+	// validateQueueLimit is the current number of requests admitted to validateQ
+	validateQueueLimit atomic.Int64
+
+	// validatePending is the number of admitted requests not yet taken by a
+	// validation worker, including those in the signature verification stage
+	validatePending atomic.Int64
+
+	// validateQueueDrops counts requests dropped since the last adjustment
+	validateQueueDrops atomic.Int64
 
 	// validateThrottle limits the number of active validation goroutines
 	validateThrottle chan struct{}
 
 	// this is the number of synchronous validation workers
 	validateWorkers int
+
+	// sigVerifyWorkers is the number of signature verification workers; when
+	// positive, signatures are verified in a separate stage ahead of the
+	// validation workers, which then receive requests through verifiedQ
+	sigVerifyWorkers int
+
+	// verifiedQ hands requests with verified signatures to the validation workers;
+	// requests waiting on it still count against validateQueueLimit
+	verifiedQ *validateQueue
+}
+
+// validateQueue is a FIFO of validation requests. Unlike a channel it only holds
+// memory for the requests it contains, so an adaptive limit does not have to be
+// allocated for at its maximum up front.
+type validateQueue struct {
+	mx    sync.Mutex
+	reqs  []*validateReq
+	ready chan struct{}
+}
+
+func newValidateQueue() *validateQueue {
+	return &validateQueue{ready: make(chan struct{}, 1)}
+}
+
+// push appends a request and wakes a waiting worker.
+func (q *validateQueue) push(req *validateReq) {
+	q.mx.Lock()
+	q.reqs = append(q.reqs, req)
+	q.mx.Unlock()
+	q.signal()
+}
+
+func (q *validateQueue) signal() {
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+}
+
+// tryPop removes the oldest request, or returns nil if the queue is empty.
+func (q *validateQueue) tryPop() *validateReq {
+	q.mx.Lock()
+	defer q.mx.Unlock()
+
+	if len(q.reqs) == 0 {
+		return nil
+	}
+	req := q.reqs[0]
+	q.reqs[0] = nil
+	q.reqs = q.reqs[1:]
+	if len(q.reqs) > 0 {
+		// pass the wakeup on to another waiting worker
+		q.signal()
+	}
+	return req
+}
+
+// pop removes the oldest request, waiting for one until ctx is done.
+func (q *validateQueue) pop(ctx context.Context) *validateReq {
+	for {
+		if req := q.tryPop(); req != nil {
+			return req
+		}
+		select {
+		case <-q.ready:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (q *validateQueue) len() int {
+	q.mx.Lock()
+	defer q.mx.Unlock()
+	return len(q.reqs)
 }
 
 // validation requests
@@ -123,10 +227,11 @@ type rmValReq struct {
 // newValidation creates a new validation pipeline
 func newValidation() *validation {
 	return &validation{
-		bitmaskVals:      make(map[string]*validatorImpl),
-		validateQ:        make(chan *validateReq, DefaultValidateQueueSize),
-		validateThrottle: make(chan struct{}, DefaultValidateThrottle),
-		validateWorkers:  runtime.NumCPU(),
+		bitmaskVals:       make(map[string]*validatorImpl),
+		validateQ:         newValidateQueue(),
+		validateQueueSize: DefaultValidateQueueSize,
+		validateThrottle:  make(chan struct{}, DefaultValidateThrottle),
+		validateWorkers:   runtime.NumCPU(),
 	}
 }
 
@@ -135,8 +240,22 @@ func newValidation() *validation {
 func (v *validation) Start(p *PubSub) {
 	v.p = p
 	v.tracer = p.tracer
+	limit := v.validateQueueSize
+	if v.validateQueueMax > v.validateQueueMin {
+		limit = min(max(limit, v.validateQueueMin), v.validateQueueMax)
+		go v.adjustValidateQueue()
+	}
+	v.validateQueueLimit.Store(int64(limit))
+	q, verified := v.validateQ, false
+	if v.sigVerifyWorkers > 0 {
+		v.verifiedQ = newValidateQueue()
+		q, verified = v.verifiedQ, true
+		for i := 0; i < v.sigVerifyWorkers; i++ {
+			go v.sigVerifyWorker()
+		}
+	}
 	for i := 0; i < v.validateWorkers; i++ {
-		go v.validateWorker()
+		go v.validateWorker(q, verified)
 	}
 }
 
@@ -234,7 +353,9 @@ func (v *validation) RemoveValidator(req *rmValReq) {
 // PushLocal synchronously pushes a locally published message and performs applicable
 // validations.
 // Returns an error if validation fails
-func (v *validation) PushLocal(msg *Message) error {
+// If backpressure is set, it fails with ErrBackpressure rather than waiting
+// when the send queue is full. Waiting on the send queue stops when ctx is done.
+func (v *validation) PushLocal(ctx context.Context, msg *Message, backpressure bool) error {
 	v.p.tracer.PublishMessage(msg)
 
 	err := v.p.checkSigningPolicy(msg)
@@ -242,8 +363,12 @@ func (v *validation) PushLocal(msg *Message) error {
 		return err
 	}
 
+	if backpressure && len(v.p.sendMsg) == cap(v.p.sendMsg) {
+		return ErrBackpressure
+	}
+
 	vals := v.getValidators(msg)
-	return v.validate(vals, msg.ReceivedFrom, msg, true)
+	return v.validate(ctx, vals, msg.ReceivedFrom, msg, true)
 }
 
 // Push pushes a message into the validation pipeline.
@@ -252,18 +377,70 @@ func (v *validation) Push(src peer.ID, msg *Message) bool {
 	vals := v.getValidators(msg)
 
 	if len(vals) > 0 || msg.Signature != nil {
-		select {
-		case v.validateQ <- &validateReq{vals, src, msg}:
-		default:
-			log.Debugf("message validation throttled: queue full; dropping message from %s", src)
-			v.tracer.RejectMessage(msg, RejectValidationQueueFull)
+		if v.validatePending.Add(1) > v.validateQueueLimit.Load() {
+			v.validatePending.Add(-1)
+			v.dropValidateReq(src, msg)
+			return false
 		}
+		v.validateQ.push(&validateReq{vals, src, msg})
 		return false
 	}
 
 	return true
 }
 
+func (v *validation) dropValidateReq(src peer.ID, msg *Message) {
+	log.Debugf("message validation throttled: queue full; dropping message from %s", src)
+	v.validateQueueDrops.Add(1)
+	v.tracer.RejectMessage(msg, RejectValidationQueueFull)
+}
+
+// adjustValidateQueue grows the validate queue limit when messages were dropped during
+// the last interval, and shrinks it after a sustained period without drops in which the
+// queue stayed mostly empty. Doubling on drops lets catch-up bursts be absorbed quickly,
+// while the slow shrink keeps steady state memory low without flapping.
+func (v *validation) adjustValidateQueue() {
+	ticker := time.NewTicker(ValidateQueueAdjustInterval)
+	defer ticker.Stop()
+
+	quiet := 0
+	for {
+		select {
+		case <-ticker.C:
+		case <-v.p.ctx.Done():
+			return
+		}
+		quiet = v.adjustValidateQueueLimit(quiet)
+	}
+}
+
+// adjustValidateQueueLimit reconsiders the validate queue limit once, given the number of
+// preceding quiet intervals, and returns the updated number of quiet intervals.
+func (v *validation) adjustValidateQueueLimit(quiet int) int {
+	limit := int(v.validateQueueLimit.Load())
+	if v.validateQueueDrops.Swap(0) > 0 {
+		if next := min(limit*2, v.validateQueueMax); next != limit {
+			log.Debugf("growing validate queue limit from %d to %d", limit, next)
+			v.validateQueueLimit.Store(int64(next))
+		}
+		return 0
+	}
+
+	if v.validatePending.Load() > int64(limit/4) {
+		return 0
+	}
+
+	quiet++
+	if quiet < validateQueueShrinkTicks {
+		return quiet
+	}
+	if next := max(limit/2, v.validateQueueMin); next != limit {
+		log.Debugf("shrinking validate queue limit from %d to %d", limit, next)
+		v.validateQueueLimit.Store(int64(next))
+	}
+	return 0
+}
+
 // getValidators returns all validators that apply to a given message
 func (v *validation) getValidators(msg *Message) []*validatorImpl {
 	v.mx.Lock()
@@ -284,20 +461,54 @@ func (v *validation) getValidators(msg *Message) []*validatorImpl {
 	return impls
 }
 
-// validateWorker is an active goroutine performing inline validation
-func (v *validation) validateWorker() {
+// validateWorker is an active goroutine performing inline validation; verified
+// indicates that signatures have already been checked by the signature workers
+func (v *validation) validateWorker(q *validateQueue, verified bool) {
 	for {
-		select {
-		case req := <-v.validateQ:
-			v.validate(req.vals, req.src, req.msg, false)
-		case <-v.p.ctx.Done():
+		req := q.pop(v.p.ctx)
+		if req == nil {
 			return
 		}
+		v.validatePending.Add(-1)
+		if verified {
+			v.validatePayload(v.p.ctx, req.vals, req.src, req.msg, false)
+		} else {
+			v.validate(v.p.ctx, req.vals, req.src, req.msg, false)
+		}
 	}
 }
 
-// validate performs validation and only sends the message if all validators succeed
-func (v *validation) validate(vals []*validatorImpl, src peer.ID, msg *Message, synchronous bool) error {
+// sigVerifyWorker is an active goroutine verifying message signatures ahead of the
+// validation workers. It drains up to SignatureVerifyBatchSize pending requests at a
+// time so that bursts are verified back to back, then hands the verified requests on.
+func (v *validation) sigVerifyWorker() {
+	batch := make([]*validateReq, 0, SignatureVerifyBatchSize)
+	for {
+		req := v.validateQ.pop(v.p.ctx)
+		if req == nil {
+			return
+		}
+		batch = append(batch[:0], req)
+		for len(batch) < SignatureVerifyBatchSize {
+			req := v.validateQ.tryPop()
+			if req == nil {
+				break
+			}
+			batch = append(batch, req)
+		}
+
+		for _, req := range batch {
+			if v.checkSignature(req.src, req.msg) != nil {
+				v.validatePending.Add(-1)
+				continue
+			}
+			v.verifiedQ.push(req)
+		}
+	}
+}
+
+// checkSignature verifies the message signature if signature verification is enabled.
+func (v *validation) checkSignature(src peer.ID, msg *Message) error {
 	// If signature verification is enabled, but signing is disabled,
 	// the Signature is required to be nil upon receiving the message in PubSub.pushMsg.
 	if msg.Signature != nil && v.p.signPolicy&msgVerification != 0 {
@@ -308,6 +519,21 @@ func (v *validation) validate(vals []*validatorImpl, src peer.ID, msg *Message,
 		}
 	}
 
+	return nil
+}
+
+// validate performs validation and only sends the message if all validators succeed
+func (v *validation) validate(ctx context.Context, vals []*validatorImpl, src peer.ID, msg *Message, synchronous bool) error {
+	if err := v.checkSignature(src, msg); err != nil {
+		return err
+	}
+
+	return v.validatePayload(ctx, vals, src, msg, synchronous)
+}
+
+// validatePayload runs the validators for a message whose signature has been verified,
+// and only sends the message if all validators succeed
+func (v *validation) validatePayload(ctx context.Context, vals []*validatorImpl, src peer.ID, msg *Message, synchronous bool) error {
 	// we can mark the message as seen now that we have verified the signature
 	// and avoid invoking user validators more than once
 	id := v.p.idGen.ID(msg)
@@ -371,6 +597,8 @@ loop:
 	select {
 	case v.p.sendMsg <- msg:
 		return nil
+	case <-ctx.Done():
+		return ctx.Err()
 	case <-v.p.ctx.Done():
 		return v.p.ctx.Err()
 	}
@@ -540,13 +768,27 @@ func WithDefaultValidator(val interface{}, opts ...ValidatorOpt) Option {
 func WithValidateQueueSize(n int) Option {
 	return func(ps *PubSub) error {
 		if n > 0 {
-			ps.val.validateQ = make(chan *validateReq, n)
+			ps.val.validateQueueSize = n
 			return nil
 		}
 		return fmt.Errorf("validate queue size must be > 0")
 	}
 }
 
+// WithAdaptiveValidateQueue lets the validate queue limit float between min and max based
+// on recent drops, starting from the size set by WithValidateQueueSize clamped into range.
+// The queue grows while messages are being dropped and shrinks back when it stays idle.
+func WithAdaptiveValidateQueue(min, max int) Option {
+	return func(ps *PubSub) error {
+		if min <= 0 || max < min {
+			return fmt.Errorf("adaptive validate queue bounds must satisfy 0 < min <= max")
+		}
+		ps.val.validateQueueMin = min
+		ps.val.validateQueueMax = max
+		return nil
+	}
+}
+
 // WithValidateThrottle sets the upper bound on the number of active validation
 // goroutines across all bitmasks. The default is 8192.
 func WithValidateThrottle(n int) Option {
@@ -572,6 +814,22 @@ func WithValidateWorkers(n int) Option {
 	}
 }
 
+// WithSignatureVerifyWorkers sets the number of signature verification worker
+// goroutines. By default signatures are verified by the synchronous validation workers.
+//
+// With a positive number of workers, signature verification runs as a separate stage
+// ahead of the synchronous validation workers, so that the CPU devoted to verifying
+// signatures can be sized independently of payload validation.
+func WithSignatureVerifyWorkers(n int) Option {
+	return func(ps *PubSub) error {
+		if n < 0 {
+			return fmt.Errorf("number of signature verification workers must be >= 0")
+		}
+		ps.val.sigVerifyWorkers = n
+		return nil
+	}
+}
+
 // WithValidatorTimeout is an option that sets a timeout for an (asynchronous) bitmask validator.
 // By default there is no timeout in asynchronous validators.
 func WithValidatorTimeout(timeout time.Duration) ValidatorOpt {
@@ -3,12 +3,14 @@ package blossomsub
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
+	"source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub/pb"
 )
 
 func TestRegisterUnregisterValidator(t *testing.T) {
@@ -36,6 +38,101 @@ func TestRegisterUnregisterValidator(t *testing.T) {
 	}
 }
 
+func TestAdaptiveValidateQueue(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	hosts := getDefaultHosts(t, 1)
+	psubs := getBlossomSubs(
+		ctx,
+		hosts,
+		WithValidateQueueSize(64),
+		WithAdaptiveValidateQueue(4, 32),
+	)
+	// The initial size is clamped into the adaptive bounds.
+	if limit := psubs[0].ValidateQueueLimit(); limit != 32 {
+		t.Fatalf("expected initial limit 32, got %d", limit)
+	}
+
+	v := newValidation()
+	v.validateQueueMin, v.validateQueueMax = 4, 32
+	v.validateQueueLimit.Store(8)
+
+	// Drops double the limit up to the maximum.
+	v.validateQueueDrops.Add(3)
+	if quiet := v.adjustValidateQueueLimit(5); quiet != 0 {
+		t.Fatalf("expected drops to reset the quiet intervals, got %d", quiet)
+	}
+	if limit := v.validateQueueLimit.Load(); limit != 16 {
+		t.Fatalf("expected limit to grow to 16, got %d", limit)
+	}
+	if n := v.validateQueueDrops.Load(); n != 0 {
+		t.Fatalf("expected drops to be consumed, got %d", n)
+	}
+	v.validateQueueDrops.Add(1)
+	v.adjustValidateQueueLimit(0)
+	v.validateQueueDrops.Add(1)
+	v.adjustValidateQueueLimit(0)
+	if limit := v.validateQueueLimit.Load(); limit != 32 {
+		t.Fatalf("expected limit to stop at 32, got %d", limit)
+	}
+
+	// A busy queue is not quiet.
+	v.validatePending.Store(9)
+	if quiet := v.adjustValidateQueueLimit(3); quiet != 0 {
+		t.Fatalf("expected a busy queue to reset the quiet intervals, got %d", quiet)
+	}
+	v.validatePending.Store(0)
+
+	// The limit halves only after enough quiet intervals, down to the minimum.
+	quiet := 0
+	for i := 1; i < validateQueueShrinkTicks; i++ {
+		quiet = v.adjustValidateQueueLimit(quiet)
+		if quiet != i {
+			t.Fatalf("expected %d quiet intervals, got %d", i, quiet)
+		}
+	}
+	if limit := v.validateQueueLimit.Load(); limit != 32 {
+		t.Fatalf("expected limit to hold at 32, got %d", limit)
+	}
+	quiet = v.adjustValidateQueueLimit(quiet)
+	if limit := v.validateQueueLimit.Load(); limit != 16 || quiet != 0 {
+		t.Fatalf("expected limit to shrink to 16, got %d after %d", limit, quiet)
+	}
+	for i := 0; i < 3*validateQueueShrinkTicks; i++ {
+		quiet = v.adjustValidateQueueLimit(quiet)
+	}
+	if limit := v.validateQueueLimit.Load(); limit != 4 {
+		t.Fatalf("expected limit to stop at 4, got %d", limit)
+	}
+}
+
+func TestValidateQueue(t *testing.T) {
+	q := newValidateQueue()
+	if req := q.tryPop(); req != nil {
+		t.Fatal("expected an empty queue")
+	}
+
+	reqs := []*validateReq{{src: "a"}, {src: "b"}, {src: "c"}}
+	for _, req := range reqs {
+		q.push(req)
+	}
+	if n := q.len(); n != 3 {
+		t.Fatalf("expected 3 requests, got %d", n)
+	}
+	for _, req := range reqs {
+		if got := q.pop(context.Background()); got != req {
+			t.Fatalf("expected request from %s, got %s", req.src, got.src)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if req := q.pop(ctx); req != nil {
+		t.Fatal("expected pop to give up once the context is done")
+	}
+}
+
 func TestRegisterValidatorEx(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -359,3 +456,127 @@ func TestValidateAssortedOptions(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateQueueAdmitsPending(t *testing.T) {
+	v := newValidation()
+	v.validateQueueLimit.Store(2)
+	msg := &Message{Message: &pb.Message{Bitmask: []byte{0x01}, Signature: []byte{0x01}}}
+
+	// Requests held by the signature verification stage have left validateQ but
+	// still count against the limit.
+	v.validatePending.Store(2)
+	v.Push("", msg)
+	if n := v.validateQ.len(); n != 0 {
+		t.Fatalf("expected no request to be admitted, got %d", n)
+	}
+	if n := v.validateQueueDrops.Load(); n != 1 {
+		t.Fatalf("expected 1 drop, got %d", n)
+	}
+
+	v.validatePending.Store(1)
+	v.Push("", msg)
+	if n := v.validateQ.len(); n != 1 {
+		t.Fatalf("expected the request to be admitted, got %d", n)
+	}
+	if n := v.validatePending.Load(); n != 2 {
+		t.Fatalf("expected 2 pending requests, got %d", n)
+	}
+}
+
+func TestSignatureVerifyWorkers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	hosts := getDefaultHosts(t, 1)
+	psubs := getBlossomSubs(
+		ctx,
+		hosts,
+		WithMessageSignaturePolicy(StrictSign),
+		WithSignatureVerifyWorkers(2),
+	)
+	ps := psubs[0]
+
+	if ps.val.verifiedQ == nil {
+		t.Fatal("expected a separate signature verification stage")
+	}
+
+	bitmask := []byte{0x01, 0x00}
+	validated := make(chan []byte, 2)
+	err := ps.RegisterBitmaskValidator(bitmask, func(_ context.Context, _ peer.ID, msg *Message) bool {
+		validated <- msg.Data
+		return true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signed := func(data []byte, seqno byte) *pb.Message {
+		m := &pb.Message{
+			Data:    data,
+			Bitmask: bitmask,
+			From:    []byte(ps.signID),
+			Seqno:   []byte{seqno},
+		}
+		if err := signMessage(ps.signID, ps.signKey, m); err != nil {
+			t.Fatal(err)
+		}
+		return m
+	}
+
+	tampered := signed([]byte("tampered"), 1)
+	tampered.Data = []byte("forged")
+	ps.val.Push(hosts[0].ID(), &Message{Message: tampered})
+	ps.val.Push(hosts[0].ID(), &Message{Message: signed([]byte("valid"), 2)})
+
+	select {
+	case data := <-validated:
+		if !bytes.Equal(data, []byte("valid")) {
+			t.Fatalf("message with invalid signature reached validation: %s", data)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("validly signed message was not validated")
+	}
+
+	select {
+	case data := <-validated:
+		t.Fatalf("unexpected validated message: %s", data)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestPublishBackpressure(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	hosts := getDefaultHosts(t, 1)
+	psubs := getBlossomSubs(ctx, hosts)
+
+	b, err := psubs[0].Join([]byte{0x00, 0x80, 0x00, 0x00})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Stall the event loop so that the send queue stays full.
+	release := make(chan struct{})
+	psubs[0].eval <- func() { <-release }
+	for len(psubs[0].sendMsg) < cap(psubs[0].sendMsg) {
+		psubs[0].sendMsg <- &Message{}
+	}
+
+	err = b[0].Publish(ctx, b[0].bitmask, []byte("shed"), WithBackpressure())
+	if !errors.Is(err, ErrBackpressure) {
+		t.Fatalf("expected backpressure, got %v", err)
+	}
+
+	timeout, cancelTimeout := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancelTimeout()
+	err = b[0].Publish(timeout, b[0].bitmask, []byte("wait"))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+
+	for len(psubs[0].sendMsg) > 0 {
+		<-psubs[0].sendMsg
+	}
+	close(release)
+}
@@ -42,6 +42,27 @@ type nat interface {
 	io.Closer
 }
 
+// natStatusReporter is implemented by NATs that report their port mappings.
+type natStatusReporter interface {
+	Type() string
+	ExternalAddr() netip.Addr
+	Mappings() []inat.Mapping
+}
+
+// NATStatus describes the NAT device found by a NAT manager and the port
+// mappings on it.
+type NATStatus struct {
+	// Discovering is set until NAT discovery finishes.
+	Discovering bool
+	// DiscoveryErr is the reason no NAT device was found.
+	DiscoveryErr error
+	// Type is the mapping protocol of the device, e.g. "NAT-PMP".
+	Type string
+	// ExternalAddr is the external IP reported by the device, if any.
+	ExternalAddr netip.Addr
+	Mappings     []inat.Mapping
+}
+
 // so we can mock it in tests
 var discoverNAT = func(ctx context.Context) (nat, error) { return inat.DiscoverNAT(ctx) }
 
@@ -60,6 +81,9 @@ type natManager struct {
 
 	tracked map[entry]bool // the bool is only used in doSync and has no meaning outside of that function
 
+	discovered   bool  // guarded by natMx
+	discoveryErr error // guarded by natMx
+
 	refCount  sync.WaitGroup
 	ctx       context.Context
 	ctxCancel context.CancelFunc
@@ -94,6 +118,23 @@ func (nmgr *natManager) HasDiscoveredNAT() bool {
 	return h
 }
 
+// Status returns the NAT device found and the state of its port mappings.
+func (nmgr *natManager) Status() NATStatus {
+	nmgr.natMx.RLock()
+	defer nmgr.natMx.RUnlock()
+
+	status := NATStatus{
+		Discovering:  !nmgr.discovered,
+		DiscoveryErr: nmgr.discoveryErr,
+	}
+	if reporter, ok := nmgr.nat.(natStatusReporter); ok {
+		status.Type = reporter.Type()
+		status.ExternalAddr = reporter.ExternalAddr()
+		status.Mappings = reporter.Mappings()
+	}
+	return status
+}
+
 func (nmgr *natManager) background(ctx context.Context) {
 	discoverCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
 
@@ -102,6 +143,8 @@ func (nmgr *natManager) background(ctx context.Context) {
 		log.Info("DiscoverNAT error:", err)
 		nmgr.refCount.Done()
 		nmgr.natMx.Lock()
+		nmgr.discovered = true
+		nmgr.discoveryErr = err
 		if nmgr.nat != nil {
 			nmgr.nat.Close()
 		}
@@ -112,6 +155,7 @@ func (nmgr *natManager) background(ctx context.Context) {
 
 	nmgr.natMx.Lock()
 	nmgr.nat = natInstance
+	nmgr.discovered = true
 	nmgr.natMx.Unlock()
 
 	// sign natManager up for network notifications
@@ -2,6 +2,7 @@ package basichost
 
 import (
 	"context"
+	"errors"
 	"net/netip"
 	"testing"
 	"time"
@@ -10,6 +11,7 @@ import (
 
 	ma "github.com/multiformats/go-multiaddr"
 
+	inat "github.com/libp2p/go-libp2p/p2p/net/nat"
 	swarmt "github.com/libp2p/go-libp2p/p2p/net/swarm/testing"
 
 	"go.uber.org/mock/gomock"
@@ -118,3 +120,69 @@ func TestAddAndRemoveListeners(t *testing.T) {
 	mockNAT.EXPECT().RemoveMapping(gomock.Any(), "tcp", 1234).MaxTimes(1)
 	mockNAT.EXPECT().Close().MaxTimes(1)
 }
+
+// reportingNAT is a NAT that reports its port mappings.
+type reportingNAT struct {
+	*MockNAT
+	mappings []inat.Mapping
+}
+
+func (n *reportingNAT) Type() string             { return "NAT-PMP" }
+func (n *reportingNAT) ExternalAddr() netip.Addr { return netip.AddrFrom4([4]byte{1, 2, 3, 4}) }
+func (n *reportingNAT) Mappings() []inat.Mapping { return n.mappings }
+
+func TestStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockNAT := NewMockNAT(ctrl)
+	mockNAT.EXPECT().Close().MaxTimes(1)
+
+	mapping := inat.Mapping{
+		Protocol:     "tcp",
+		InternalPort: 1234,
+		ExternalPort: 4321,
+		Lease:        time.Minute,
+		Renewed:      time.Unix(1700000000, 0),
+	}
+	found := make(chan struct{})
+	origDiscoverNAT := discoverNAT
+	discoverNAT = func(ctx context.Context) (nat, error) {
+		<-found
+		return &reportingNAT{MockNAT: mockNAT, mappings: []inat.Mapping{mapping}}, nil
+	}
+	defer func() { discoverNAT = origDiscoverNAT }()
+
+	sw := swarmt.GenSwarm(t)
+	defer sw.Close()
+	m := newNATManager(sw)
+	defer m.Close()
+
+	// nothing is reported while discovery runs
+	require.Equal(t, NATStatus{Discovering: true}, m.Status())
+
+	// once found, the device reports its mappings
+	close(found)
+	require.Eventually(t, func() bool { return !m.Status().Discovering }, time.Second, time.Millisecond)
+	require.Equal(t, NATStatus{
+		Type:         "NAT-PMP",
+		ExternalAddr: netip.AddrFrom4([4]byte{1, 2, 3, 4}),
+		Mappings:     []inat.Mapping{mapping},
+	}, m.Status())
+}
+
+func TestStatusDiscoveryError(t *testing.T) {
+	origDiscoverNAT := discoverNAT
+	discoverNAT = func(ctx context.Context) (nat, error) { return nil, errors.New("no NAT found") }
+	defer func() { discoverNAT = origDiscoverNAT }()
+
+	sw := swarmt.GenSwarm(t)
+	defer sw.Close()
+	m := newNATManager(sw)
+	defer m.Close()
+
+	require.Eventually(t, func() bool { return !m.Status().Discovering }, time.Second, time.Millisecond)
+	status := m.Status()
+	require.EqualError(t, status.DiscoveryErr, "no NAT found")
+	require.Empty(t, status.Type)
+	require.Empty(t, status.Mappings)
+}
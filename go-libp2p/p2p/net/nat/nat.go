@@ -30,6 +30,21 @@ type entry struct {
 	port     int
 }
 
+// Mapping describes the state of a port mapping on the NAT device.
+type Mapping struct {
+	Protocol     string
+	InternalPort int
+	// ExternalPort is zero while the device refuses the mapping.
+	ExternalPort int
+	// Lease is how long the device keeps the mapping unless it is renewed,
+	// zero if the device only granted a mapping without a lease.
+	Lease time.Duration
+	// Renewed is when the mapping was last requested from the device.
+	Renewed time.Time
+	// Err is the error of the last request, nil if it succeeded.
+	Err error
+}
+
 // so we can mock it in tests
 var discoverGateway = nat.DiscoverGateway
 
@@ -58,6 +73,7 @@ func DiscoverNAT(ctx context.Context) (*NAT, error) {
 		nat:       natInstance,
 		extAddr:   extAddr,
 		mappings:  make(map[entry]int),
+		statuses:  make(map[entry]Mapping),
 		ctx:       ctx,
 		ctxCancel: cancel,
 	}
@@ -83,9 +99,35 @@ type NAT struct {
 	ctx       context.Context
 	ctxCancel context.CancelFunc
 
-	mappingmu sync.RWMutex // guards mappings
+	mappingmu sync.RWMutex // guards mappings and statuses
 	closed    bool
 	mappings  map[entry]int
+	statuses  map[entry]Mapping
+}
+
+// Type returns the mapping protocol of the NAT device, e.g. "NAT-PMP".
+func (nat *NAT) Type() string {
+	return nat.nat.Type()
+}
+
+// ExternalAddr returns the external IP of the NAT, which is invalid if the
+// device did not report one.
+func (nat *NAT) ExternalAddr() netip.Addr {
+	nat.mappingmu.RLock()
+	defer nat.mappingmu.RUnlock()
+	return nat.extAddr
+}
+
+// Mappings returns the state of the port mappings.
+func (nat *NAT) Mappings() []Mapping {
+	nat.mappingmu.RLock()
+	defer nat.mappingmu.RUnlock()
+
+	mappings := make([]Mapping, 0, len(nat.statuses))
+	for _, m := range nat.statuses {
+		mappings = append(mappings, m)
+	}
+	return mappings
 }
 
 // Close shuts down all port mappings. NAT can no longer be used.
@@ -136,8 +178,9 @@ func (nat *NAT) AddMapping(ctx context.Context, protocol string, port int) error
 
 	// do it once synchronously, so first mapping is done right away, and before exiting,
 	// allowing users -- in the optimistic case -- to use results right after.
-	extPort := nat.establishMapping(ctx, protocol, port)
-	nat.mappings[entry{protocol: protocol, port: port}] = extPort
+	m := nat.establishMapping(ctx, protocol, port)
+	nat.mappings[entry{protocol: protocol, port: port}] = m.ExternalPort
+	nat.statuses[entry{protocol: protocol, port: port}] = m
 	nat.mappingmu.Unlock()
 	return nil
 }
@@ -152,6 +195,7 @@ func (nat *NAT) RemoveMapping(ctx context.Context, protocol string, port int) er
 		e := entry{protocol: protocol, port: port}
 		if _, ok := nat.mappings[e]; ok {
 			delete(nat.mappings, e)
+			delete(nat.statuses, e)
 			nat.mappingmu.Unlock()
 			return nat.nat.DeletePortMapping(ctx, protocol, port)
 		}
@@ -173,7 +217,7 @@ func (nat *NAT) background() {
 	t := time.NewTimer(minTime(nextMappingUpdate, nextAddrUpdate).Sub(now)) // don't use a ticker here. We don't know how long establishing the mappings takes.
 
 	var in []entry
-	var out []int // port numbers
+	var out []Mapping
 	for {
 		select {
 		case now := <-t.C:
@@ -195,7 +239,8 @@ func (nat *NAT) background() {
 					if _, ok := nat.mappings[p]; !ok {
 						continue // entry might have been deleted
 					}
-					nat.mappings[p] = out[i]
+					nat.mappings[p] = out[i].ExternalPort
+					nat.statuses[p] = out[i]
 				}
 				nat.mappingmu.Unlock()
 				nextMappingUpdate = time.Now().Add(mappingUpdate)
@@ -206,7 +251,9 @@ func (nat *NAT) background() {
 				if err == nil {
 					extAddr, _ = netip.AddrFromSlice(extIP)
 				}
+				nat.mappingmu.Lock()
 				nat.extAddr = extAddr
+				nat.mappingmu.Unlock()
 				nextAddrUpdate = time.Now().Add(CacheTime)
 			}
 			t.Reset(time.Until(minTime(nextAddrUpdate, nextMappingUpdate)))
@@ -215,6 +262,7 @@ func (nat *NAT) background() {
 			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 			for e := range nat.mappings {
 				delete(nat.mappings, e)
+				delete(nat.statuses, e)
 				nat.nat.DeletePortMapping(ctx, e.protocol, e.port)
 			}
 			nat.mappingmu.Unlock()
@@ -225,15 +273,22 @@ func (nat *NAT) background() {
 	}
 }
 
-func (nat *NAT) establishMapping(ctx context.Context, protocol string, internalPort int) (externalPort int) {
+func (nat *NAT) establishMapping(ctx context.Context, protocol string, internalPort int) Mapping {
 	log.Debugf("Attempting port map: %s/%d", protocol, internalPort)
 	const comment = "libp2p"
 
+	m := Mapping{
+		Protocol:     protocol,
+		InternalPort: internalPort,
+		Lease:        MappingDuration,
+		Renewed:      time.Now(),
+	}
+
 	nat.natmu.Lock()
-	var err error
-	externalPort, err = nat.nat.AddPortMapping(ctx, protocol, internalPort, comment, MappingDuration)
+	externalPort, err := nat.nat.AddPortMapping(ctx, protocol, internalPort, comment, MappingDuration)
 	if err != nil {
 		// Some hardware does not support mappings with timeout, so try that
+		m.Lease = 0
 		externalPort, err = nat.nat.AddPortMapping(ctx, protocol, internalPort, comment, 0)
 	}
 	nat.natmu.Unlock()
@@ -243,15 +298,19 @@ func (nat *NAT) establishMapping(ctx context.Context, protocol string, internalP
 		if err != nil {
 			log.Warnf("failed to establish port mapping: %s", err)
 		} else {
+			err = errors.New("newport = 0")
 			log.Warnf("failed to establish port mapping: newport = 0")
 		}
 		// we do not close if the mapping failed,
 		// because it may work again next time.
-		return 0
+		m.Lease = 0
+		m.Err = err
+		return m
 	}
 
 	log.Debugf("NAT Mapping: %d --> %d (%s)", externalPort, internalPort, protocol)
-	return externalPort
+	m.ExternalPort = externalPort
+	return m
 }
 
 func minTime(a, b time.Time) time.Time {
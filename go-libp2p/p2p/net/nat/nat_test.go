@@ -6,6 +6,7 @@ import (
 	"net"
 	"net/netip"
 	"testing"
+	"time"
 
 	"github.com/libp2p/go-nat"
 
@@ -67,3 +68,44 @@ func TestRemoveMapping(t *testing.T) {
 	_, found = nat.GetMapping("tcp", 10000)
 	require.False(t, found, "didn't expect port mapping for deleted mapping")
 }
+
+func TestMappings(t *testing.T) {
+	mockNAT, reset := setupMockNAT(t)
+	defer reset()
+
+	mockNAT.EXPECT().GetExternalAddress().Return(net.IPv4(1, 2, 3, 4), nil)
+	nat, err := DiscoverNAT(context.Background())
+	require.NoError(t, err)
+	mockNAT.EXPECT().Type().Return("NAT-PMP")
+	require.Equal(t, "NAT-PMP", nat.Type())
+	require.Equal(t, netip.AddrFrom4([4]byte{1, 2, 3, 4}), nat.ExternalAddr().Unmap())
+	require.Empty(t, nat.Mappings())
+
+	// a mapping with a lease
+	mockNAT.EXPECT().AddPortMapping(gomock.Any(), "tcp", 10000, gomock.Any(), MappingDuration).Return(1234, nil)
+	require.NoError(t, nat.AddMapping(context.Background(), "tcp", 10000))
+	// a device refusing leases grants a permanent mapping
+	mockNAT.EXPECT().AddPortMapping(gomock.Any(), "udp", 10000, gomock.Any(), MappingDuration).Return(0, errors.New("leases unsupported"))
+	mockNAT.EXPECT().AddPortMapping(gomock.Any(), "udp", 10000, gomock.Any(), time.Duration(0)).Return(1235, nil)
+	require.NoError(t, nat.AddMapping(context.Background(), "udp", 10000))
+	// a refused mapping reports the error
+	mockNAT.EXPECT().AddPortMapping(gomock.Any(), "tcp", 10001, gomock.Any(), gomock.Any()).Return(0, errors.New("refused")).Times(2)
+	require.NoError(t, nat.AddMapping(context.Background(), "tcp", 10001))
+
+	mappings := map[entry]Mapping{}
+	for _, m := range nat.Mappings() {
+		require.False(t, m.Renewed.IsZero())
+		m.Renewed = time.Time{}
+		mappings[entry{protocol: m.Protocol, port: m.InternalPort}] = m
+	}
+	require.Equal(t, map[entry]Mapping{
+		{protocol: "tcp", port: 10000}: {Protocol: "tcp", InternalPort: 10000, ExternalPort: 1234, Lease: MappingDuration},
+		{protocol: "udp", port: 10000}: {Protocol: "udp", InternalPort: 10000, ExternalPort: 1235},
+		{protocol: "tcp", port: 10001}: {Protocol: "tcp", InternalPort: 10001, Err: errors.New("refused")},
+	}, mappings)
+
+	// removed mappings are no longer reported
+	mockNAT.EXPECT().DeletePortMapping(gomock.Any(), "tcp", 10001)
+	require.NoError(t, nat.RemoveMapping(context.Background(), "tcp", 10001))
+	require.Len(t, nat.Mappings(), 2)
+}
@@ -368,6 +368,11 @@ func (s *Swarm) resolveAddrs(ctx context.Context, pi peer.AddrInfo) ([]ma.Multia
 		}
 
 		tpt := s.TransportForDialing(addr)
+		if skipper, ok := tpt.(transport.SkipResolver); ok && skipper.SkipResolve(ctx, addr) {
+			resolved = append(resolved, addr)
+			continue
+		}
+
 		resolver, ok := tpt.(transport.Resolver)
 		if ok {
 			resolvedAddrs, err := resolver.Resolve(ctx, addr)
@@ -12,6 +12,7 @@ import (
 	"source.quilibrium.com/quilibrium/monorepo/node/crypto"
 	"source.quilibrium.com/quilibrium/monorepo/node/execution"
 	"source.quilibrium.com/quilibrium/monorepo/node/execution/intrinsics/token"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/shutdown"
 	"source.quilibrium.com/quilibrium/monorepo/node/keys"
 	"source.quilibrium.com/quilibrium/monorepo/node/p2p"
 	"source.quilibrium.com/quilibrium/monorepo/node/store"
@@ -22,6 +23,7 @@ type Node struct {
 	dataProofStore store.DataProofStore
 	clockStore     store.ClockStore
 	coinStore      store.CoinStore
+	metadataStore  store.MetadataStore
 	keyManager     keys.KeyManager
 	pubSub         p2p.PubSub
 	execEngines    map[string]execution.ExecutionEngine
@@ -48,16 +50,20 @@ func newNode(
 	dataProofStore store.DataProofStore,
 	clockStore store.ClockStore,
 	coinStore store.CoinStore,
+	metadataStore store.MetadataStore,
 	keyManager keys.KeyManager,
 	pubSub p2p.PubSub,
 	tokenExecutionEngine *token.TokenExecutionEngine,
 	engine consensus.ConsensusEngine,
 	pebble store.KVDB,
+	peerScores store.PeerScoreStore,
 ) (*Node, error) {
 	if engine == nil {
 		return nil, errors.New("engine must not be nil")
 	}
 
+	pubSub.PersistPeerScores(peerScores)
+
 	execEngines := make(map[string]execution.ExecutionEngine)
 	if tokenExecutionEngine != nil {
 		execEngines[tokenExecutionEngine.GetName()] = tokenExecutionEngine
@@ -68,6 +74,7 @@ func newNode(
 		dataProofStore,
 		clockStore,
 		coinStore,
+		metadataStore,
 		keyManager,
 		pubSub,
 		execEngines,
@@ -167,13 +174,38 @@ func (n *Node) Start() {
 	}
 }
 
-func (n *Node) Stop() {
-	err := <-n.engine.Stop(false)
-	if err != nil {
-		panic(err)
+// Stop stops the consensus engine, saves the peer scores and closes the
+// store, each within the grace period of the watchdog, reporting whether the
+// engine and the store finished without error.
+func (n *Node) Stop(watchdog *shutdown.Watchdog) bool {
+	stopped := watchdog.Stop("consensus engine", func() {
+		err := <-n.engine.Stop(false)
+		if err != nil {
+			panic(err)
+		}
+	})
+
+	if !watchdog.Stop("peer scores", func() {
+		if err := n.pubSub.SavePeerScores(); err != nil {
+			n.logger.Warn("could not save peer scores", zap.Error(err))
+		}
+	}) {
+		return false
 	}
 
-	n.pebble.Close()
+	var closeErr error
+	if !watchdog.Stop("store", func() {
+		closeErr = n.pebble.Close()
+	}) {
+		return false
+	}
+
+	if closeErr != nil {
+		n.logger.Error("could not close store", zap.Error(closeErr))
+		return false
+	}
+
+	return stopped
 }
 
 func (n *Node) GetLogger() *zap.Logger {
@@ -192,10 +224,18 @@ func (n *Node) GetDataProofStore() store.DataProofStore {
 	return n.dataProofStore
 }
 
+func (n *Node) GetMetadataStore() store.MetadataStore {
+	return n.metadataStore
+}
+
 func (n *Node) GetKeyManager() keys.KeyManager {
 	return n.keyManager
 }
 
+func (n *Node) GetKVDB() store.KVDB {
+	return n.pebble
+}
+
 func (n *Node) GetPubSub() p2p.PubSub {
 	return n.pubSub
 }
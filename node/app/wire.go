@@ -8,10 +8,12 @@ import (
 	"go.uber.org/zap"
 	"source.quilibrium.com/quilibrium/monorepo/node/config"
 	"source.quilibrium.com/quilibrium/monorepo/node/consensus"
+	"source.quilibrium.com/quilibrium/monorepo/node/consensus/data"
 	"source.quilibrium.com/quilibrium/monorepo/node/consensus/master"
 	"source.quilibrium.com/quilibrium/monorepo/node/consensus/time"
 	"source.quilibrium.com/quilibrium/monorepo/node/crypto"
 	"source.quilibrium.com/quilibrium/monorepo/node/execution/intrinsics/token"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
 	"source.quilibrium.com/quilibrium/monorepo/node/keys"
 	"source.quilibrium.com/quilibrium/monorepo/node/p2p"
 	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
@@ -44,6 +46,10 @@ var debugLoggerSet = wire.NewSet(
 	debugLogger,
 )
 
+var clockSet = wire.NewSet(
+	clock.NewRealClock,
+)
+
 var keyManagerSet = wire.NewSet(
 	wire.FieldsOf(new(*config.Config), "Key"),
 	keys.NewFileKeyManager,
@@ -58,11 +64,15 @@ var storeSet = wire.NewSet(
 	store.NewPebbleCoinStore,
 	store.NewPebbleKeyStore,
 	store.NewPebbleDataProofStore,
+	store.NewPebbleMetadataStore,
+	store.NewPebblePeerScoreStore,
 	store.NewPeerstoreDatastore,
 	wire.Bind(new(store.ClockStore), new(*store.PebbleClockStore)),
 	wire.Bind(new(store.CoinStore), new(*store.PebbleCoinStore)),
 	wire.Bind(new(store.KeyStore), new(*store.PebbleKeyStore)),
 	wire.Bind(new(store.DataProofStore), new(*store.PebbleDataProofStore)),
+	wire.Bind(new(store.MetadataStore), new(*store.PebbleMetadataStore)),
+	wire.Bind(new(store.PeerScoreStore), new(*store.PebblePeerScoreStore)),
 	wire.Bind(new(store.Peerstore), new(*store.PeerstoreDatastore)),
 )
 
@@ -76,11 +86,11 @@ var pubSubSet = wire.NewSet(
 
 var engineSet = wire.NewSet(
 	wire.FieldsOf(new(*config.Config), "Engine"),
-	crypto.NewWesolowskiFrameProver,
-	wire.Bind(new(crypto.FrameProver), new(*crypto.WesolowskiFrameProver)),
+	crypto.NewFrameProver,
 	crypto.NewKZGInclusionProver,
 	wire.Bind(new(crypto.InclusionProver), new(*crypto.KZGInclusionProver)),
 	time.NewMasterTimeReel,
+	data.NewEngineFactory,
 	token.NewTokenExecutionEngine,
 )
 
@@ -95,6 +105,7 @@ var consensusSet = wire.NewSet(
 func NewDHTNode(*config.Config) (*DHTNode, error) {
 	panic(wire.Build(
 		debugLoggerSet,
+		clockSet,
 		pubSubSet,
 		newDHTNode,
 	))
@@ -103,6 +114,7 @@ func NewDHTNode(*config.Config) (*DHTNode, error) {
 func NewDebugNode(*config.Config, *protobufs.SelfTestReport) (*Node, error) {
 	panic(wire.Build(
 		debugLoggerSet,
+		clockSet,
 		keyManagerSet,
 		storeSet,
 		pubSubSet,
@@ -115,6 +127,7 @@ func NewDebugNode(*config.Config, *protobufs.SelfTestReport) (*Node, error) {
 func NewNode(*config.Config, *protobufs.SelfTestReport) (*Node, error) {
 	panic(wire.Build(
 		loggerSet,
+		clockSet,
 		keyManagerSet,
 		storeSet,
 		pubSubSet,
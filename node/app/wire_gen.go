@@ -11,10 +11,12 @@ import (
 	"go.uber.org/zap"
 	"source.quilibrium.com/quilibrium/monorepo/node/config"
 	"source.quilibrium.com/quilibrium/monorepo/node/consensus"
+	"source.quilibrium.com/quilibrium/monorepo/node/consensus/data"
 	"source.quilibrium.com/quilibrium/monorepo/node/consensus/master"
 	"source.quilibrium.com/quilibrium/monorepo/node/consensus/time"
 	"source.quilibrium.com/quilibrium/monorepo/node/crypto"
 	"source.quilibrium.com/quilibrium/monorepo/node/execution/intrinsics/token"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
 	"source.quilibrium.com/quilibrium/monorepo/node/keys"
 	"source.quilibrium.com/quilibrium/monorepo/node/p2p"
 	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
@@ -26,7 +28,11 @@ import (
 func NewDHTNode(configConfig *config.Config) (*DHTNode, error) {
 	p2PConfig := configConfig.P2P
 	zapLogger := debugLogger()
-	blossomSub := p2p.NewBlossomSub(p2PConfig, zapLogger)
+	clockClock := clock.NewRealClock()
+	blossomSub, err := p2p.NewBlossomSub(p2PConfig, zapLogger, clockClock)
+	if err != nil {
+		return nil, err
+	}
 	dhtNode, err := newDHTNode(blossomSub)
 	if err != nil {
 		return nil, err
@@ -41,19 +47,32 @@ func NewDebugNode(configConfig *config.Config, selfTestReport *protobufs.SelfTes
 	pebbleDataProofStore := store.NewPebbleDataProofStore(pebbleDB, zapLogger)
 	pebbleClockStore := store.NewPebbleClockStore(pebbleDB, zapLogger)
 	pebbleCoinStore := store.NewPebbleCoinStore(pebbleDB, zapLogger)
+	pebbleMetadataStore := store.NewPebbleMetadataStore(pebbleDB, zapLogger)
 	keyConfig := configConfig.Key
 	fileKeyManager := keys.NewFileKeyManager(keyConfig, zapLogger)
 	p2PConfig := configConfig.P2P
-	blossomSub := p2p.NewBlossomSub(p2PConfig, zapLogger)
-	wesolowskiFrameProver := crypto.NewWesolowskiFrameProver(zapLogger)
-	kzgInclusionProver := crypto.NewKZGInclusionProver(zapLogger)
+	clockClock := clock.NewRealClock()
+	blossomSub, err := p2p.NewBlossomSub(p2PConfig, zapLogger, clockClock)
+	if err != nil {
+		return nil, err
+	}
 	engineConfig := configConfig.Engine
-	masterTimeReel := time.NewMasterTimeReel(zapLogger, pebbleClockStore, engineConfig, wesolowskiFrameProver)
+	frameProver, err := crypto.NewFrameProver(zapLogger, engineConfig)
+	if err != nil {
+		return nil, err
+	}
+	kzgInclusionProver := crypto.NewKZGInclusionProver(zapLogger)
+	masterTimeReel := time.NewMasterTimeReel(zapLogger, pebbleClockStore, engineConfig, frameProver)
 	inMemoryPeerInfoManager := p2p.NewInMemoryPeerInfoManager(zapLogger)
 	pebbleKeyStore := store.NewPebbleKeyStore(pebbleDB, zapLogger)
-	tokenExecutionEngine := token.NewTokenExecutionEngine(zapLogger, configConfig, fileKeyManager, blossomSub, wesolowskiFrameProver, kzgInclusionProver, pebbleClockStore, pebbleDataProofStore, pebbleCoinStore, masterTimeReel, inMemoryPeerInfoManager, pebbleKeyStore, selfTestReport)
-	masterClockConsensusEngine := master.NewMasterClockConsensusEngine(engineConfig, zapLogger, pebbleClockStore, fileKeyManager, blossomSub, kzgInclusionProver, wesolowskiFrameProver, masterTimeReel, inMemoryPeerInfoManager, selfTestReport)
-	node, err := newNode(zapLogger, pebbleDataProofStore, pebbleClockStore, pebbleCoinStore, fileKeyManager, blossomSub, tokenExecutionEngine, masterClockConsensusEngine, pebbleDB)
+	engineFactory, err := data.NewEngineFactory(zapLogger, engineConfig)
+	if err != nil {
+		return nil, err
+	}
+	tokenExecutionEngine := token.NewTokenExecutionEngine(zapLogger, clockClock, configConfig, fileKeyManager, blossomSub, frameProver, kzgInclusionProver, pebbleClockStore, pebbleDataProofStore, pebbleCoinStore, masterTimeReel, inMemoryPeerInfoManager, pebbleKeyStore, selfTestReport, engineFactory)
+	masterClockConsensusEngine := master.NewMasterClockConsensusEngine(engineConfig, zapLogger, pebbleClockStore, pebbleMetadataStore, fileKeyManager, blossomSub, kzgInclusionProver, frameProver, masterTimeReel, inMemoryPeerInfoManager, selfTestReport)
+	pebblePeerScoreStore := store.NewPebblePeerScoreStore(pebbleDB, zapLogger)
+	node, err := newNode(zapLogger, pebbleDataProofStore, pebbleClockStore, pebbleCoinStore, pebbleMetadataStore, fileKeyManager, blossomSub, tokenExecutionEngine, masterClockConsensusEngine, pebbleDB, pebblePeerScoreStore)
 	if err != nil {
 		return nil, err
 	}
@@ -67,19 +86,32 @@ func NewNode(configConfig *config.Config, selfTestReport *protobufs.SelfTestRepo
 	pebbleDataProofStore := store.NewPebbleDataProofStore(pebbleDB, zapLogger)
 	pebbleClockStore := store.NewPebbleClockStore(pebbleDB, zapLogger)
 	pebbleCoinStore := store.NewPebbleCoinStore(pebbleDB, zapLogger)
+	pebbleMetadataStore := store.NewPebbleMetadataStore(pebbleDB, zapLogger)
 	keyConfig := configConfig.Key
 	fileKeyManager := keys.NewFileKeyManager(keyConfig, zapLogger)
 	p2PConfig := configConfig.P2P
-	blossomSub := p2p.NewBlossomSub(p2PConfig, zapLogger)
-	wesolowskiFrameProver := crypto.NewWesolowskiFrameProver(zapLogger)
-	kzgInclusionProver := crypto.NewKZGInclusionProver(zapLogger)
+	clockClock := clock.NewRealClock()
+	blossomSub, err := p2p.NewBlossomSub(p2PConfig, zapLogger, clockClock)
+	if err != nil {
+		return nil, err
+	}
 	engineConfig := configConfig.Engine
-	masterTimeReel := time.NewMasterTimeReel(zapLogger, pebbleClockStore, engineConfig, wesolowskiFrameProver)
+	frameProver, err := crypto.NewFrameProver(zapLogger, engineConfig)
+	if err != nil {
+		return nil, err
+	}
+	kzgInclusionProver := crypto.NewKZGInclusionProver(zapLogger)
+	masterTimeReel := time.NewMasterTimeReel(zapLogger, pebbleClockStore, engineConfig, frameProver)
 	inMemoryPeerInfoManager := p2p.NewInMemoryPeerInfoManager(zapLogger)
 	pebbleKeyStore := store.NewPebbleKeyStore(pebbleDB, zapLogger)
-	tokenExecutionEngine := token.NewTokenExecutionEngine(zapLogger, configConfig, fileKeyManager, blossomSub, wesolowskiFrameProver, kzgInclusionProver, pebbleClockStore, pebbleDataProofStore, pebbleCoinStore, masterTimeReel, inMemoryPeerInfoManager, pebbleKeyStore, selfTestReport)
-	masterClockConsensusEngine := master.NewMasterClockConsensusEngine(engineConfig, zapLogger, pebbleClockStore, fileKeyManager, blossomSub, kzgInclusionProver, wesolowskiFrameProver, masterTimeReel, inMemoryPeerInfoManager, selfTestReport)
-	node, err := newNode(zapLogger, pebbleDataProofStore, pebbleClockStore, pebbleCoinStore, fileKeyManager, blossomSub, tokenExecutionEngine, masterClockConsensusEngine, pebbleDB)
+	engineFactory, err := data.NewEngineFactory(zapLogger, engineConfig)
+	if err != nil {
+		return nil, err
+	}
+	tokenExecutionEngine := token.NewTokenExecutionEngine(zapLogger, clockClock, configConfig, fileKeyManager, blossomSub, frameProver, kzgInclusionProver, pebbleClockStore, pebbleDataProofStore, pebbleCoinStore, masterTimeReel, inMemoryPeerInfoManager, pebbleKeyStore, selfTestReport, engineFactory)
+	masterClockConsensusEngine := master.NewMasterClockConsensusEngine(engineConfig, zapLogger, pebbleClockStore, pebbleMetadataStore, fileKeyManager, blossomSub, kzgInclusionProver, frameProver, masterTimeReel, inMemoryPeerInfoManager, selfTestReport)
+	pebblePeerScoreStore := store.NewPebblePeerScoreStore(pebbleDB, zapLogger)
+	node, err := newNode(zapLogger, pebbleDataProofStore, pebbleClockStore, pebbleCoinStore, pebbleMetadataStore, fileKeyManager, blossomSub, tokenExecutionEngine, masterClockConsensusEngine, pebbleDB, pebblePeerScoreStore)
 	if err != nil {
 		return nil, err
 	}
@@ -130,13 +162,15 @@ var debugLoggerSet = wire.NewSet(
 	debugLogger,
 )
 
+var clockSet = wire.NewSet(clock.NewRealClock)
+
 var keyManagerSet = wire.NewSet(wire.FieldsOf(new(*config.Config), "Key"), keys.NewFileKeyManager, wire.Bind(new(keys.KeyManager), new(*keys.FileKeyManager)))
 
-var storeSet = wire.NewSet(wire.FieldsOf(new(*config.Config), "DB"), store.NewPebbleDB, wire.Bind(new(store.KVDB), new(*store.PebbleDB)), store.NewPebbleClockStore, store.NewPebbleCoinStore, store.NewPebbleKeyStore, store.NewPebbleDataProofStore, store.NewPeerstoreDatastore, wire.Bind(new(store.ClockStore), new(*store.PebbleClockStore)), wire.Bind(new(store.CoinStore), new(*store.PebbleCoinStore)), wire.Bind(new(store.KeyStore), new(*store.PebbleKeyStore)), wire.Bind(new(store.DataProofStore), new(*store.PebbleDataProofStore)), wire.Bind(new(store.Peerstore), new(*store.PeerstoreDatastore)))
+var storeSet = wire.NewSet(wire.FieldsOf(new(*config.Config), "DB"), store.NewPebbleDB, wire.Bind(new(store.KVDB), new(*store.PebbleDB)), store.NewPebbleClockStore, store.NewPebbleCoinStore, store.NewPebbleKeyStore, store.NewPebbleDataProofStore, store.NewPebbleMetadataStore, store.NewPebblePeerScoreStore, store.NewPeerstoreDatastore, wire.Bind(new(store.ClockStore), new(*store.PebbleClockStore)), wire.Bind(new(store.CoinStore), new(*store.PebbleCoinStore)), wire.Bind(new(store.KeyStore), new(*store.PebbleKeyStore)), wire.Bind(new(store.DataProofStore), new(*store.PebbleDataProofStore)), wire.Bind(new(store.MetadataStore), new(*store.PebbleMetadataStore)), wire.Bind(new(store.PeerScoreStore), new(*store.PebblePeerScoreStore)), wire.Bind(new(store.Peerstore), new(*store.PeerstoreDatastore)))
 
 var pubSubSet = wire.NewSet(wire.FieldsOf(new(*config.Config), "P2P"), p2p.NewInMemoryPeerInfoManager, p2p.NewBlossomSub, wire.Bind(new(p2p.PubSub), new(*p2p.BlossomSub)), wire.Bind(new(p2p.PeerInfoManager), new(*p2p.InMemoryPeerInfoManager)))
 
-var engineSet = wire.NewSet(wire.FieldsOf(new(*config.Config), "Engine"), crypto.NewWesolowskiFrameProver, wire.Bind(new(crypto.FrameProver), new(*crypto.WesolowskiFrameProver)), crypto.NewKZGInclusionProver, wire.Bind(new(crypto.InclusionProver), new(*crypto.KZGInclusionProver)), time.NewMasterTimeReel, token.NewTokenExecutionEngine)
+var engineSet = wire.NewSet(wire.FieldsOf(new(*config.Config), "Engine"), crypto.NewFrameProver, crypto.NewKZGInclusionProver, wire.Bind(new(crypto.InclusionProver), new(*crypto.KZGInclusionProver)), time.NewMasterTimeReel, data.NewEngineFactory, token.NewTokenExecutionEngine)
 
 var consensusSet = wire.NewSet(master.NewMasterClockConsensusEngine, wire.Bind(
 	new(consensus.ConsensusEngine),
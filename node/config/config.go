@@ -30,6 +30,12 @@ type Config struct {
 	ListenGRPCMultiaddr string        `yaml:"listenGrpcMultiaddr"`
 	ListenRestMultiaddr string        `yaml:"listenRESTMultiaddr"`
 	LogFile             string        `yaml:"logFile"`
+	// Serves a small web UI showing recent frames, peers, sync and prover
+	// status under /explorer/ on the REST listener.
+	EnableExplorer bool `yaml:"enableExplorer"`
+
+	// Overrides the built-in genesis for networks other than mainnet.
+	Genesis *GenesisConfig `yaml:"genesis,omitempty"`
 }
 
 func NewConfig(configPath string) (*Config, error) {
@@ -110,6 +116,9 @@ type SignedGenesisUnlock struct {
 	Signatures     []Signature `json:"signatures"`
 	// Until the broader primitives are in place, a beacon needs to kick this off
 	Beacon []byte `json:"beacon"`
+	// Additional members of the initial prover set, only used by private
+	// networks.
+	Provers [][]byte `json:"provers,omitempty"`
 }
 
 var Signatories = []string{
@@ -135,7 +144,9 @@ var Signatories = []string{
 var unlock *SignedGenesisUnlock
 
 func DownloadAndVerifyGenesis(network uint) (*SignedGenesisUnlock, error) {
-	if network != 0 {
+	if network != 0 && privateGenesis != nil {
+		unlock = privateGenesis
+	} else if network != 0 {
 		unlock = &SignedGenesisUnlock{
 			GenesisSeedHex: "726573697374206d7563682c206f626579206c6974746c657c00000000000000000000000C",
 			Beacon: []byte{
@@ -427,6 +438,16 @@ func LoadConfig(configPath string, proverKey string, skipGenesisCheck bool) (
 		config.Engine.GenesisSeed = genesisSeed
 	}
 
+	if config.Genesis != nil {
+		if config.P2P.Network == 0 {
+			return nil, errors.New("genesis override is not allowed on mainnet")
+		}
+
+		if err := SetPrivateGenesis(config.Genesis); err != nil {
+			return nil, errors.Wrap(err, "load config")
+		}
+	}
+
 	// upgrade quic string to quic-v1
 	if strings.HasSuffix(config.P2P.ListenMultiaddr, "/quic") {
 		config.P2P.ListenMultiaddr += "-v1"
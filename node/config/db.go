@@ -2,4 +2,19 @@ package config
 
 type DBConfig struct {
 	Path string `yaml:"path"`
+	// Number of most recent frames scanned for consistency on startup after an
+	// unclean shutdown. Inconsistent frames are rolled back and synced again.
+	// Defaults to 100, set to -1 to disable.
+	IntegrityScanDepth int `yaml:"integrityScanDepth"`
+	// Free space, in bytes, below which the store volume is considered full:
+	// frame sync and acceptance pause until space is freed. Disabled when zero
+	// or negative, 2 GiB (2147483648) leaves room for a day of frames.
+	MinimumFreeSpace int64 `yaml:"minimumFreeSpace"`
+	// Daily UTC time, as "15:04", at which the node enters maintenance: it
+	// stops proving, drains sync serving, compacts and backs up the store, then
+	// resumes. Leave empty to only run maintenance when scheduled over RPC.
+	MaintenanceTime string `yaml:"maintenanceTime"`
+	// Directory into which maintenance writes a timestamped checkpoint of the
+	// store. Leave empty to skip backups.
+	MaintenanceBackupDir string `yaml:"maintenanceBackupDir"`
 }
@@ -21,7 +21,12 @@ type EngineConfig struct {
 	// Alternative configuration path to manually specify data workers by multiaddr
 	DataWorkerMultiaddrs []string `yaml:"dataWorkerMultiaddrs"`
 	// Number of data worker processes to spawn.
-	DataWorkerCount               int      `yaml:"dataWorkerCount"`
+	DataWorkerCount int `yaml:"dataWorkerCount"`
+	// Binds the listeners of the spawned data workers before starting them and
+	// hands them down, so that workers never race other processes for their
+	// ports. Listeners passed by systemd socket activation, one per worker, are
+	// handed down whether or not this is set.
+	DataWorkerPreBind             bool     `yaml:"dataWorkerPreBind"`
 	MultisigProverEnrollmentPaths []string `yaml:"multisigProverEnrollmentPaths"`
 	// Fully verifies execution, omit to enable light prover
 	FullProver bool `yaml:"fullProver"`
@@ -30,6 +35,102 @@ type EngineConfig struct {
 	AutoMergeCoins bool `yaml:"autoMergeCoins"`
 	// Maximum wait time for a frame to be downloaded from a peer.
 	SyncTimeout time.Duration `yaml:"syncTimeout"`
+	// Maximum number of pending (unverified) frames ahead of the head that are
+	// remembered across restarts. Defaults to 1000, set to -1 to disable.
+	// Frames past it are still staged, but not revisited after a restart.
+	PendingFramesBudget int `yaml:"pendingFramesBudget"`
+	// Minimum number of peers in the frame bitmask mesh required before a
+	// prover will prove or publish frames. An isolated prover pauses instead of
+	// extending a stale head and forking on reconnect. Zero disables the gate.
+	MinimumMeshPeersForProving int `yaml:"minimumMeshPeersForProving"`
+	// Includes the operator annotations set via the metadata RPCs in the local
+	// node's peer manifest.
+	IncludeMetadataInManifest bool `yaml:"includeMetadataInManifest"`
+	// Restricts serving GetDataFrame to these peer IDs. Combined with
+	// SyncAllowProvers, peers matching either are served. When both are unset
+	// every peer is served.
+	SyncAllowlist []string `yaml:"syncAllowlist"`
+	// Serves GetDataFrame to peers currently in the prover trie.
+	SyncAllowProvers bool `yaml:"syncAllowProvers"`
+	// Name of the registered frame prover implementation to use. Defaults to
+	// "wesolowski".
+	FrameProver string `yaml:"frameProver"`
+	// Name of the registered data consensus engine implementation to use.
+	// Defaults to "data".
+	ConsensusEngine string `yaml:"consensusEngine"`
+	// Maximum number of frames ahead of the local head that a peer's reported
+	// max frame is trusted for; larger claims are clamped. Defaults to 1000.
+	MaxFrameHorizon uint64 `yaml:"maxFrameHorizon"`
+	// Locality label advertised to peers, e.g. "us-east". When set, sync
+	// prefers peers in the same region over equally advanced peers elsewhere.
+	Region string `yaml:"region"`
+	// Region labels for specific peer IDs, overriding the region the peer
+	// advertises.
+	PeerRegions map[string]string `yaml:"peerRegions"`
+	// Time budget for applying staged transactions while proving a frame.
+	// Transactions expected to overrun it, by recent per-transaction timings,
+	// are deferred to the next frame. Defaults to half the recent time taken by
+	// the frame's VDF proof (5s until one is timed), set to a negative value to
+	// always apply every staged transaction.
+	ProveApplyBudget time.Duration `yaml:"proveApplyBudget"`
+	// Maximum number of transactions staged for proving per filter they were
+	// published to, so that a busy shard cannot crowd out the others.
+	// Transactions beyond it are dropped. Defaults to 10000, set to a negative
+	// value for no limit.
+	MaxStagedTransactionsPerShard int `yaml:"maxStagedTransactionsPerShard"`
+	// Maximum number of frames below the head that the data time reel will
+	// reorganize onto a heavier fork. Frames buried deeper are final and
+	// reported as such over RPC. Unset reorganizes at any depth and reports
+	// no frame as final.
+	ReorgLimit uint64 `yaml:"reorgLimit"`
+	// Weakens sync verification to re-sync faster after short outages: when
+	// set above 1, only every Nth synced frame (and the last frame of each
+	// sync) has its VDF proof verified inline, while the others are checked
+	// for valid signatures and have their proofs verified in the background.
+	// If a background check fails, the data clock rolls back to the parent
+	// of the invalid frame and fast verify is turned off. Zero verifies every
+	// frame fully.
+	SyncFastVerifyInterval int `yaml:"syncFastVerifyInterval"`
+	// Advertises that this node serves state snapshots, so that peers far
+	// behind prefer it as a sync candidate.
+	SnapshotProvider bool `yaml:"snapshotProvider"`
+	// Bandwidth class advertised to peers for sync candidate weighting, one of
+	// "low", "medium" or "high". Unset or unknown values advertise no class.
+	BandwidthClass string `yaml:"bandwidthClass"`
+	// Upload bandwidth, in megabits per second, this node is willing to spend
+	// serving sync, advertised to peers. Peers prefer candidates with larger
+	// budgets and spread their syncs by it. Zero advertises no budget.
+	UploadBudgetMbps uint32 `yaml:"uploadBudgetMbps"`
+	// Latency objective for serving GetDataFrame. While the serving latency at
+	// ServingLatencyQuantile over the last minute exceeds it, frame requests
+	// are refused with RESOURCE_EXHAUSTED so that serving peers does not starve
+	// the proving loop. Zero never refuses requests.
+	ServingLatencySLO time.Duration `yaml:"servingLatencySLO"`
+	// Quantile of the serving latency held to ServingLatencySLO, between zero
+	// and one. Defaults to 0.95.
+	ServingLatencyQuantile float64 `yaml:"servingLatencyQuantile"`
+	// Window within which head announcements are coalesced into one with the
+	// latest head, so that bursts of frames while catching up are not
+	// announced frame by frame. Defaults to 2s, set to a negative value to
+	// announce every head.
+	HeadAnnounceDampening time.Duration `yaml:"headAnnounceDampening"`
+	// Hex encoded Ed448 public keys of external provers allowed to submit
+	// proven frames over the SubmitFrame RPC for this node to validate and
+	// publish. A submitted frame must be signed by one of them. Unset rejects
+	// every submission.
+	ExternalProverKeys []string `yaml:"externalProverKeys"`
+	// Time after startup during which peers that cannot be reached or fail to
+	// answer while syncing are neither marked uncooperative nor penalized, as
+	// the node's own connectivity may not have settled yet. Peers serving
+	// invalid frames are penalized regardless. Defaults to 2m, set to a
+	// negative value to disable.
+	SyncStartupGracePeriod time.Duration `yaml:"syncStartupGracePeriod"`
+	// Time each subsystem may take to stop during shutdown. A subsystem still
+	// stopping after it has the stacks of every goroutine dumped to stderr and
+	// the node exit with status 124, so that supervisors can tell a hung
+	// shutdown apart. Defaults to 30s, set to a negative value to wait as long
+	// as it takes.
+	ShutdownGracePeriod time.Duration `yaml:"shutdownGracePeriod"`
 
 	// Values used only for testing – do not override these in production, your
 	// node will get kicked out
@@ -0,0 +1,65 @@
+package config
+
+import (
+	"encoding/hex"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/pkg/errors"
+)
+
+// GenesisConfig replaces the built-in genesis of a network other than
+// mainnet, so that private networks can choose their own seed, beacon and
+// initial prover set instead of sharing the public testnet's.
+type GenesisConfig struct {
+	// Hex encoded genesis seed.
+	SeedHex string `yaml:"seedHex"`
+	// Hex encoded Ed448 peer public key of the beacon, which is always the
+	// first member of the initial prover set.
+	BeaconHex string `yaml:"beaconHex"`
+	// Hex encoded Ed448 peer public keys of additional initial provers.
+	ProverHexes []string `yaml:"proverHexes"`
+}
+
+var privateGenesis *SignedGenesisUnlock
+
+// SetPrivateGenesis makes DownloadAndVerifyGenesis return the given genesis
+// for every network other than mainnet.
+func SetPrivateGenesis(g *GenesisConfig) error {
+	if _, err := hex.DecodeString(g.SeedHex); err != nil {
+		return errors.Wrap(err, "set private genesis")
+	}
+
+	beacon, err := decodeGenesisKey(g.BeaconHex)
+	if err != nil {
+		return errors.Wrap(err, "set private genesis")
+	}
+
+	provers := make([][]byte, 0, len(g.ProverHexes))
+	for _, p := range g.ProverHexes {
+		key, err := decodeGenesisKey(p)
+		if err != nil {
+			return errors.Wrap(err, "set private genesis")
+		}
+		provers = append(provers, key)
+	}
+
+	privateGenesis = &SignedGenesisUnlock{
+		GenesisSeedHex: g.SeedHex,
+		Beacon:         beacon,
+		Provers:        provers,
+	}
+	return nil
+}
+
+func decodeGenesisKey(keyHex string) ([]byte, error) {
+	key, err := hex.DecodeString(keyHex)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode genesis key")
+	}
+
+	if _, err := crypto.UnmarshalEd448PublicKey(key); err != nil {
+		return nil, errors.Wrap(err, "decode genesis key")
+	}
+
+	return key, nil
+}
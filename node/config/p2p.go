@@ -4,49 +4,155 @@ import (
 	"time"
 )
 
+// GossipLimit limits the inbound gossip accepted from each peer on the hex
+// encoded bitmask. An empty bitmask applies to every bitmask without a limit
+// of its own.
+type GossipLimit struct {
+	Bitmask           string  `yaml:"bitmask"`
+	MessagesPerSecond float64 `yaml:"messagesPerSecond"`
+	BytesPerSecond    float64 `yaml:"bytesPerSecond"`
+}
+
+// BitmaskScoreParams are the peer scoring weights of the hex encoded bitmask,
+// applied to it and to each of its constituent bits. A parameter group whose
+// weight is zero is not scored.
+type BitmaskScoreParams struct {
+	Bitmask string `yaml:"bitmask"`
+	// Weight of the bitmask's score in the peer score, defaults to 1.
+	BitmaskWeight float64 `yaml:"bitmaskWeight"`
+	// Positive weight of the time spent in the mesh, counted in quanta
+	// (defaulting to one second) up to the cap.
+	TimeInMeshWeight  float64       `yaml:"timeInMeshWeight"`
+	TimeInMeshQuantum time.Duration `yaml:"timeInMeshQuantum"`
+	TimeInMeshCap     float64       `yaml:"timeInMeshCap"`
+	// Positive weight of the messages first delivered by the peer, a counter
+	// decaying by the factor in (0, 1) every decay interval up to the cap.
+	FirstMessageDeliveriesWeight float64 `yaml:"firstMessageDeliveriesWeight"`
+	FirstMessageDeliveriesDecay  float64 `yaml:"firstMessageDeliveriesDecay"`
+	FirstMessageDeliveriesCap    float64 `yaml:"firstMessageDeliveriesCap"`
+	// Negative weight of the square of the invalid messages delivered by the
+	// peer, a counter decaying by the factor in (0, 1) every decay interval.
+	InvalidMessageDeliveriesWeight float64 `yaml:"invalidMessageDeliveriesWeight"`
+	InvalidMessageDeliveriesDecay  float64 `yaml:"invalidMessageDeliveriesDecay"`
+}
+
+// ResourceScopeLimits override the resource manager limits of a scope. Zero
+// keeps the limit computed from the connection watermarks, a negative value
+// lifts it.
+type ResourceScopeLimits struct {
+	// Memory in bytes.
+	Memory          int64 `yaml:"memory"`
+	FD              int   `yaml:"fd"`
+	Conns           int   `yaml:"conns"`
+	ConnsInbound    int   `yaml:"connsInbound"`
+	ConnsOutbound   int   `yaml:"connsOutbound"`
+	Streams         int   `yaml:"streams"`
+	StreamsInbound  int   `yaml:"streamsInbound"`
+	StreamsOutbound int   `yaml:"streamsOutbound"`
+}
+
+// ResourceLimits override the resource manager limits computed from the
+// connection watermarks. Service and Protocol apply to each service and
+// protocol.
+type ResourceLimits struct {
+	// Lifts every limit, for environments where resources are policed by a
+	// supervisor instead.
+	Unlimited bool                `yaml:"unlimited"`
+	System    ResourceScopeLimits `yaml:"system"`
+	Transient ResourceScopeLimits `yaml:"transient"`
+	Service   ResourceScopeLimits `yaml:"service"`
+	Protocol  ResourceScopeLimits `yaml:"protocol"`
+}
+
 type P2PConfig struct {
-	D                         int           `yaml:"d"`
-	DLo                       int           `yaml:"dLo"`
-	DHi                       int           `yaml:"dHi"`
-	DScore                    int           `yaml:"dScore"`
-	DOut                      int           `yaml:"dOut"`
-	HistoryLength             int           `yaml:"historyLength"`
-	HistoryGossip             int           `yaml:"historyGossip"`
-	DLazy                     int           `yaml:"dLazy"`
-	GossipRetransmission      int           `yaml:"gossipRetransmission"`
-	HeartbeatInitialDelay     time.Duration `yaml:"heartbeatInitialDelay"`
-	HeartbeatInterval         time.Duration `yaml:"heartbeatInterval"`
-	FanoutTTL                 time.Duration `yaml:"fanoutTTL"`
-	PrunePeers                int           `yaml:"prunePeers"`
-	PruneBackoff              time.Duration `yaml:"pruneBackoff"`
-	UnsubscribeBackoff        time.Duration `yaml:"unsubscribeBackoff"`
-	Connectors                int           `yaml:"connectors"`
-	MaxPendingConnections     int           `yaml:"maxPendingConnections"`
-	ConnectionTimeout         time.Duration `yaml:"connectionTimeout"`
-	DirectConnectTicks        uint64        `yaml:"directConnectTicks"`
-	DirectConnectInitialDelay time.Duration `yaml:"directConnectInitialDelay"`
-	OpportunisticGraftTicks   uint64        `yaml:"opportunisticGraftTicks"`
-	OpportunisticGraftPeers   int           `yaml:"opportunisticGraftPeers"`
-	GraftFloodThreshold       time.Duration `yaml:"graftFloodThreshold"`
-	MaxIHaveLength            int           `yaml:"maxIHaveLength"`
-	MaxIHaveMessages          int           `yaml:"maxIHaveMessages"`
-	IWantFollowupTime         time.Duration `yaml:"iWantFollowupTime"`
-	BootstrapPeers            []string      `yaml:"bootstrapPeers"`
-	ListenMultiaddr           string        `yaml:"listenMultiaddr"`
-	PeerPrivKey               string        `yaml:"peerPrivKey"`
-	TraceLogFile              string        `yaml:"traceLogFile"`
-	Network                   uint8         `yaml:"network"`
-	LowWatermarkConnections   int           `yaml:"lowWatermarkConnections"`
-	HighWatermarkConnections  int           `yaml:"highWatermarkConnections"`
-	DirectPeers               []string      `yaml:"directPeers"`
-	GrpcServerRateLimit       int           `yaml:"grpcServerRateLimit"`
-	MinBootstrapPeers         int           `yaml:"minBootstrapPeers"`
-	BootstrapParallelism      int           `yaml:"bootstrapParallelism"`
-	DiscoveryParallelism      int           `yaml:"discoveryParallelism"`
-	DiscoveryPeerLookupLimit  int           `yaml:"discoveryPeerLookupLimit"`
-	PingTimeout               time.Duration `yaml:"pingTimeout"`
-	PingPeriod                time.Duration `yaml:"pingPeriod"`
-	PingAttempts              int           `yaml:"pingAttempts"`
-	ValidateQueueSize         int           `yaml:"validateQueueSize"`
-	ValidateWorkers           int           `yaml:"validateWorkers"`
+	D                         int                  `yaml:"d"`
+	DLo                       int                  `yaml:"dLo"`
+	DHi                       int                  `yaml:"dHi"`
+	DScore                    int                  `yaml:"dScore"`
+	DOut                      int                  `yaml:"dOut"`
+	HistoryLength             int                  `yaml:"historyLength"`
+	HistoryGossip             int                  `yaml:"historyGossip"`
+	DLazy                     int                  `yaml:"dLazy"`
+	GossipRetransmission      int                  `yaml:"gossipRetransmission"`
+	HeartbeatInitialDelay     time.Duration        `yaml:"heartbeatInitialDelay"`
+	HeartbeatInterval         time.Duration        `yaml:"heartbeatInterval"`
+	FanoutTTL                 time.Duration        `yaml:"fanoutTTL"`
+	PrunePeers                int                  `yaml:"prunePeers"`
+	PruneBackoff              time.Duration        `yaml:"pruneBackoff"`
+	UnsubscribeBackoff        time.Duration        `yaml:"unsubscribeBackoff"`
+	Connectors                int                  `yaml:"connectors"`
+	MaxPendingConnections     int                  `yaml:"maxPendingConnections"`
+	ConnectionTimeout         time.Duration        `yaml:"connectionTimeout"`
+	DirectConnectTicks        uint64               `yaml:"directConnectTicks"`
+	DirectConnectInitialDelay time.Duration        `yaml:"directConnectInitialDelay"`
+	OpportunisticGraftTicks   uint64               `yaml:"opportunisticGraftTicks"`
+	OpportunisticGraftPeers   int                  `yaml:"opportunisticGraftPeers"`
+	GraftFloodThreshold       time.Duration        `yaml:"graftFloodThreshold"`
+	MaxIHaveLength            int                  `yaml:"maxIHaveLength"`
+	MaxIHaveMessages          int                  `yaml:"maxIHaveMessages"`
+	IWantFollowupTime         time.Duration        `yaml:"iWantFollowupTime"`
+	BootstrapPeers            []string             `yaml:"bootstrapPeers"`
+	ListenMultiaddr           string               `yaml:"listenMultiaddr"`
+	PeerPrivKey               string               `yaml:"peerPrivKey"`
+	TraceLogFile              string               `yaml:"traceLogFile"`
+	TraceLogDir               string               `yaml:"traceLogDir"`
+	TraceLogMaxSize           int64                `yaml:"traceLogMaxSize"`
+	TraceLogMaxAge            time.Duration        `yaml:"traceLogMaxAge"`
+	TraceLogMaxBackups        int                  `yaml:"traceLogMaxBackups"`
+	TraceLogSampleRate        float64              `yaml:"traceLogSampleRate"`
+	Network                   uint8                `yaml:"network"`
+	LowWatermarkConnections   int                  `yaml:"lowWatermarkConnections"`
+	HighWatermarkConnections  int                  `yaml:"highWatermarkConnections"`
+	DirectPeers               []string             `yaml:"directPeers"`
+	GrpcServerRateLimit       int                  `yaml:"grpcServerRateLimit"`
+	MinBootstrapPeers         int                  `yaml:"minBootstrapPeers"`
+	BootstrapParallelism      int                  `yaml:"bootstrapParallelism"`
+	DiscoveryParallelism      int                  `yaml:"discoveryParallelism"`
+	DiscoveryPeerLookupLimit  int                  `yaml:"discoveryPeerLookupLimit"`
+	PingTimeout               time.Duration        `yaml:"pingTimeout"`
+	PingPeriod                time.Duration        `yaml:"pingPeriod"`
+	PingAttempts              int                  `yaml:"pingAttempts"`
+	ValidateQueueSize         int                  `yaml:"validateQueueSize"`
+	ValidateQueueMinSize      int                  `yaml:"validateQueueMinSize"`
+	ValidateQueueMaxSize      int                  `yaml:"validateQueueMaxSize"`
+	ValidateWorkers           int                  `yaml:"validateWorkers"`
+	SignatureVerifyWorkers    int                  `yaml:"signatureVerifyWorkers"`
+	ChurnWindow               time.Duration        `yaml:"churnWindow"`
+	ChurnBackoff              time.Duration        `yaml:"churnBackoff"`
+	ChurnPeerLimit            int                  `yaml:"churnPeerLimit"`
+	ChurnSubnetLimit          int                  `yaml:"churnSubnetLimit"`
+	HandlerPanicThreshold     int                  `yaml:"handlerPanicThreshold"`
+	HandlerBreakerCooldown    time.Duration        `yaml:"handlerBreakerCooldown"`
+	DirectChannelPurposes     []string             `yaml:"directChannelPurposes"`
+	DirectChannelAllowlist    []string             `yaml:"directChannelAllowlist"`
+	DirectChannelProversOnly  bool                 `yaml:"directChannelProversOnly"`
+	AddressFailureLimit       int                  `yaml:"addressFailureLimit"`
+	EnableHolePunching        bool                 `yaml:"enableHolePunching"`
+	AnnounceMultiaddrs        []string             `yaml:"announceMultiaddrs"`
+	AnnounceRotationPeriod    time.Duration        `yaml:"announceRotationPeriod"`
+	NoAnnounceMultiaddrs      []string             `yaml:"noAnnounceMultiaddrs"`
+	AdvertisementPrivacy      bool                 `yaml:"advertisementPrivacy"`
+	NetworkPSK                string               `yaml:"networkPSK"`
+	PeerstorePath             string               `yaml:"peerstorePath"`
+	PeerstoreGCInterval       time.Duration        `yaml:"peerstoreGCInterval"`
+	ProxyAddr                 string               `yaml:"proxyAddr"`
+	Transports                []string             `yaml:"transports"`
+	MetricsBitmaskPrefix      int                  `yaml:"metricsBitmaskPrefix"`
+	DirectPeerCheckPeriod     time.Duration        `yaml:"directPeerCheckPeriod"`
+	GossipLimits              []GossipLimit        `yaml:"gossipLimits"`
+	GossipLimitPenalty        int64                `yaml:"gossipLimitPenalty"`
+	MaxMessageSizes           map[string]int       `yaml:"maxMessageSizes"`
+	AllowCIDRs                []string             `yaml:"allowCIDRs"`
+	DenyCIDRs                 []string             `yaml:"denyCIDRs"`
+	PrivateBitmasks           map[string]string    `yaml:"privateBitmasks"`
+	PeerScoreHalfLife         time.Duration        `yaml:"peerScoreHalfLife"`
+	BitmaskScoreParams        []BitmaskScoreParams `yaml:"bitmaskScoreParams"`
+	BootstrapResolveInterval  time.Duration        `yaml:"bootstrapResolveInterval"`
+	ResourceLimits            *ResourceLimits      `yaml:"resourceLimits"`
+	MetricsBandwidthPeers     int                  `yaml:"metricsBandwidthPeers"`
+	DeadLetterCapacity        int                  `yaml:"deadLetterCapacity"`
+	DeadLetterMaxSize         int                  `yaml:"deadLetterMaxSize"`
+	DirectChannelDialTimeout  time.Duration        `yaml:"directChannelDialTimeout"`
+	DirectChannelDialRetries  *int                 `yaml:"directChannelDialRetries"`
+	DirectChannelDialBackoff  time.Duration        `yaml:"directChannelDialBackoff"`
 }
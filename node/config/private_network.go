@@ -0,0 +1,271 @@
+package config
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// PrivateNetworkSpec declaratively describes a private network. Every bitmask
+// is prefixed with the network byte, so a private network never shares
+// gossip with mainnet or the public testnet.
+type PrivateNetworkSpec struct {
+	// Network identifier, must not be zero (mainnet) or one (public testnet).
+	Network uint8 `yaml:"network"`
+	// Human readable genesis message, combined with random entropy to form
+	// the genesis seed.
+	Message string `yaml:"message"`
+	// Number of nodes to generate configs for.
+	Nodes int `yaml:"nodes"`
+	// Number of nodes, starting with the first, in the initial prover set. The
+	// first node is always the beacon. Defaults to 1.
+	Provers int `yaml:"provers"`
+	// Number of nodes, starting with the first, that act as bootstrap peers
+	// for the others. Defaults to 1.
+	Bootstraps int `yaml:"bootstraps"`
+	// Host of each node, as an IP address. Nodes beyond the list, or all nodes
+	// when it is empty, use 127.0.0.1.
+	Hosts []string `yaml:"hosts"`
+	// P2P listen port of the first node, incremented per node. Defaults to
+	// 8336.
+	BaseP2PPort int `yaml:"baseP2PPort"`
+	// gRPC listen port of the first node, incremented per node. The REST
+	// gateway listens on the gRPC port plus 1000. Zero disables both.
+	BaseGRPCPort int `yaml:"baseGrpcPort"`
+	// Data worker processes per node. Defaults to 1.
+	DataWorkers int `yaml:"dataWorkers"`
+	// Data worker listen port of the first node's first worker, incremented
+	// per worker. Defaults to 40000.
+	BaseDataWorkerPort int `yaml:"baseDataWorkerPort"`
+}
+
+// PrivateNetwork is a generated private network: one ready-to-run config per
+// node, all sharing the same genesis.
+type PrivateNetwork struct {
+	Genesis *GenesisConfig
+	Configs []*Config
+	PeerIDs []peer.ID
+}
+
+// LoadPrivateNetworkSpec reads a PrivateNetworkSpec from a YAML file.
+func LoadPrivateNetworkSpec(path string) (*PrivateNetworkSpec, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "load private network spec")
+	}
+
+	spec := &PrivateNetworkSpec{}
+	if err := yaml.UnmarshalStrict(b, spec); err != nil {
+		return nil, errors.Wrap(err, "load private network spec")
+	}
+
+	return spec, nil
+}
+
+func (s *PrivateNetworkSpec) withDefaults() (*PrivateNetworkSpec, error) {
+	spec := *s
+	if spec.Network < 2 {
+		return nil, errors.New("network must be greater than 1")
+	}
+	if spec.Nodes <= 0 {
+		return nil, errors.New("nodes must be positive")
+	}
+	if spec.Provers == 0 {
+		spec.Provers = 1
+	}
+	if spec.Bootstraps == 0 {
+		spec.Bootstraps = 1
+	}
+	if spec.Provers < 0 || spec.Provers > spec.Nodes {
+		return nil, errors.New("provers must be between 1 and nodes")
+	}
+	if spec.Bootstraps < 0 || spec.Bootstraps > spec.Nodes {
+		return nil, errors.New("bootstraps must be between 1 and nodes")
+	}
+	if spec.BaseP2PPort == 0 {
+		spec.BaseP2PPort = 8336
+	}
+	if spec.DataWorkers == 0 {
+		spec.DataWorkers = 1
+	}
+	if spec.BaseDataWorkerPort == 0 {
+		spec.BaseDataWorkerPort = 40000
+	}
+	return &spec, nil
+}
+
+func (s *PrivateNetworkSpec) host(i int) string {
+	if i < len(s.Hosts) {
+		return s.Hosts[i]
+	}
+	return "127.0.0.1"
+}
+
+// GeneratePrivateNetwork generates fresh peer keys, a genesis and a config per
+// node for the given spec. Node databases and key files are placed under
+// baseDir/node-<i>, matching WritePrivateNetwork.
+func GeneratePrivateNetwork(
+	spec *PrivateNetworkSpec,
+	baseDir string,
+) (*PrivateNetwork, error) {
+	spec, err := spec.withDefaults()
+	if err != nil {
+		return nil, errors.Wrap(err, "generate private network")
+	}
+
+	entropy := make([]byte, 16)
+	if _, err := rand.Read(entropy); err != nil {
+		return nil, errors.Wrap(err, "generate private network")
+	}
+
+	network := &PrivateNetwork{
+		Genesis: &GenesisConfig{
+			SeedHex: hex.EncodeToString(
+				append([]byte(spec.Message+"|"), entropy...),
+			),
+		},
+	}
+
+	bootstrapPeers := []string{}
+	for i := 0; i < spec.Nodes; i++ {
+		privKey, pubKey, err := crypto.GenerateEd448Key(rand.Reader)
+		if err != nil {
+			return nil, errors.Wrap(err, "generate private network")
+		}
+
+		rawPriv, err := privKey.Raw()
+		if err != nil {
+			return nil, errors.Wrap(err, "generate private network")
+		}
+
+		rawPub, err := pubKey.Raw()
+		if err != nil {
+			return nil, errors.Wrap(err, "generate private network")
+		}
+
+		peerID, err := peer.IDFromPublicKey(pubKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "generate private network")
+		}
+
+		keystoreKey := make([]byte, 32)
+		if _, err := rand.Read(keystoreKey); err != nil {
+			return nil, errors.Wrap(err, "generate private network")
+		}
+
+		switch {
+		case i == 0:
+			network.Genesis.BeaconHex = hex.EncodeToString(rawPub)
+		case i < spec.Provers:
+			network.Genesis.ProverHexes = append(
+				network.Genesis.ProverHexes,
+				hex.EncodeToString(rawPub),
+			)
+		}
+
+		listenAddr := fmt.Sprintf(
+			"/ip4/0.0.0.0/udp/%d/quic-v1",
+			spec.BaseP2PPort+i,
+		)
+		if i < spec.Bootstraps {
+			bootstrapPeers = append(bootstrapPeers, fmt.Sprintf(
+				"/ip4/%s/udp/%d/quic-v1/p2p/%s",
+				spec.host(i),
+				spec.BaseP2PPort+i,
+				peerID.String(),
+			))
+		}
+
+		nodeDir := filepath.Join(baseDir, fmt.Sprintf("node-%d", i))
+		cfg := &Config{
+			DB: &DBConfig{
+				Path: filepath.Join(nodeDir, "store"),
+			},
+			Key: &KeyConfig{
+				KeyStore: KeyManagerTypeFile,
+				KeyStoreFile: &KeyStoreFileConfig{
+					Path:          filepath.Join(nodeDir, "keys.yml"),
+					EncryptionKey: hex.EncodeToString(keystoreKey),
+				},
+			},
+			P2P: &P2PConfig{
+				ListenMultiaddr: listenAddr,
+				PeerPrivKey:     hex.EncodeToString(rawPriv),
+				Network:         spec.Network,
+			},
+			Engine: &EngineConfig{
+				ProvingKeyId:                  "default-proving-key",
+				Filter:                        "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+				GenesisSeed:                   network.Genesis.SeedHex,
+				MaxFrames:                     -1,
+				PendingCommitWorkers:          4,
+				DataWorkerCount:               spec.DataWorkers,
+				DataWorkerBaseListenMultiaddr: "/ip4/127.0.0.1/tcp/%d",
+				DataWorkerBaseListenPort: uint16(
+					spec.BaseDataWorkerPort + i*spec.DataWorkers,
+				),
+			},
+			Genesis: network.Genesis,
+		}
+		if spec.BaseGRPCPort != 0 {
+			cfg.ListenGRPCMultiaddr = fmt.Sprintf(
+				"/ip4/127.0.0.1/tcp/%d",
+				spec.BaseGRPCPort+i,
+			)
+			cfg.ListenRestMultiaddr = fmt.Sprintf(
+				"/ip4/127.0.0.1/tcp/%d",
+				spec.BaseGRPCPort+1000+i,
+			)
+		}
+
+		network.Configs = append(network.Configs, cfg)
+		network.PeerIDs = append(network.PeerIDs, peerID)
+	}
+
+	// Bootstrap nodes recognize themselves in the list, as on mainnet.
+	for _, cfg := range network.Configs {
+		cfg.P2P.BootstrapPeers = bootstrapPeers
+	}
+
+	return network, nil
+}
+
+// WritePrivateNetwork writes each node's config.yml and an empty keys.yml to
+// baseDir/node-<i>. Existing node directories are not overwritten.
+func WritePrivateNetwork(baseDir string, network *PrivateNetwork) error {
+	for i, cfg := range network.Configs {
+		nodeDir := filepath.Join(baseDir, fmt.Sprintf("node-%d", i))
+		if _, err := os.Stat(nodeDir); err == nil {
+			return errors.Wrap(
+				errors.Errorf("%s already exists", nodeDir),
+				"write private network",
+			)
+		}
+
+		if err := os.MkdirAll(nodeDir, fs.FileMode(0700)); err != nil {
+			return errors.Wrap(err, "write private network")
+		}
+
+		if err := SaveConfig(nodeDir, cfg); err != nil {
+			return errors.Wrap(err, "write private network")
+		}
+
+		if err := os.WriteFile(
+			filepath.Join(nodeDir, "keys.yml"),
+			[]byte("null:\n"),
+			fs.FileMode(0600),
+		); err != nil {
+			return errors.Wrap(err, "write private network")
+		}
+	}
+
+	return nil
+}
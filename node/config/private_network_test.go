@@ -0,0 +1,58 @@
+package config_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+)
+
+func TestGeneratePrivateNetwork(t *testing.T) {
+	dir := t.TempDir()
+	network, err := config.GeneratePrivateNetwork(&config.PrivateNetworkSpec{
+		Network:    7,
+		Message:    "private",
+		Nodes:      4,
+		Provers:    2,
+		Bootstraps: 2,
+	}, dir)
+	require.NoError(t, err)
+	require.Len(t, network.Configs, 4)
+	assert.Len(t, network.Genesis.ProverHexes, 1)
+
+	beacon, err := hex.DecodeString(network.Genesis.BeaconHex)
+	require.NoError(t, err)
+	pub, err := crypto.UnmarshalEd448PublicKey(beacon)
+	require.NoError(t, err)
+	beaconID, err := peer.IDFromPublicKey(pub)
+	require.NoError(t, err)
+	assert.Equal(t, network.PeerIDs[0], beaconID)
+
+	for _, cfg := range network.Configs {
+		assert.Equal(t, uint8(7), cfg.P2P.Network)
+		assert.Len(t, cfg.P2P.BootstrapPeers, 2)
+		assert.Equal(t, network.Genesis.SeedHex, cfg.Engine.GenesisSeed)
+	}
+
+	require.NoError(t, config.WritePrivateNetwork(dir, network))
+	assert.Error(t, config.WritePrivateNetwork(dir, network))
+
+	loaded, err := config.LoadConfig(dir+"/node-1", "", true)
+	require.NoError(t, err)
+	assert.Equal(t, network.Genesis.SeedHex, loaded.Genesis.SeedHex)
+
+	genesis, err := config.DownloadAndVerifyGenesis(7)
+	require.NoError(t, err)
+	assert.Equal(t, beacon, genesis.Beacon)
+	assert.Len(t, genesis.Provers, 1)
+
+	_, err = config.GeneratePrivateNetwork(&config.PrivateNetworkSpec{
+		Network: 0,
+		Nodes:   1,
+	}, dir)
+	assert.Error(t, err)
+}
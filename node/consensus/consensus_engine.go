@@ -1,14 +1,27 @@
 package consensus
 
 import (
+	"context"
 	"crypto"
 
+	"github.com/pkg/errors"
 	"source.quilibrium.com/quilibrium/monorepo/node/config"
 	"source.quilibrium.com/quilibrium/monorepo/node/execution"
 	"source.quilibrium.com/quilibrium/monorepo/node/keys"
 	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/tries"
 )
 
+// ErrFrameSubmissionUnauthorized is returned by SubmitFrame for a frame not
+// signed by one of the configured external prover keys.
+var ErrFrameSubmissionUnauthorized = errors.New(
+	"frame submission unauthorized",
+)
+
+// ErrFrameSubmissionRejected is returned by SubmitFrame for a frame that is
+// invalid or that the node cannot publish.
+var ErrFrameSubmissionRejected = errors.New("frame submission rejected")
+
 type EngineState int
 
 const (
@@ -33,12 +46,18 @@ type ConsensusEngine interface {
 	GetFrameChannel() <-chan *protobufs.ClockFrame
 }
 
+// DataConsensusEngine collects, proves and syncs data clock frames for an
+// execution engine, and reports its state. Implementations other than the
+// default data engine are selected by name through the engine config.
 type DataConsensusEngine interface {
 	Start() <-chan error
 	Stop(force bool) <-chan error
 	RegisterExecutor(exec execution.ExecutionEngine, frame uint64) <-chan error
 	UnregisterExecutor(name string, frame uint64, force bool) <-chan error
 	GetFrame() *protobufs.ClockFrame
+	GetFinalizedFrameNumber() uint64
+	SubscribeHead() (<-chan struct{}, func())
+	GetNetworkHeadEstimate() uint64
 	GetDifficulty() uint32
 	GetState() EngineState
 	GetProvingKey(
@@ -46,4 +65,12 @@ type DataConsensusEngine interface {
 	) (crypto.Signer, keys.KeyType, []byte, []byte)
 	IsInProverTrie(key []byte) bool
 	GetPeerInfo() *protobufs.PeerInfoResponse
+	GetFrameProverTries() []*tries.RollingFrecencyCritbitTrie
+	GetWorkerCount() uint32
+	EnterMaintenance(ctx context.Context) error
+	ExitMaintenance()
+	GetSyncClients(limit int) []*protobufs.SyncClient
+	GetServingLatency() *protobufs.ServingLatencyResponse
+	GetProverStats() *protobufs.ProverStats
+	SubmitFrame(frame *protobufs.ClockFrame) error
 }
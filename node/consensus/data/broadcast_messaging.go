@@ -1,19 +1,46 @@
 package data
 
 import (
+	"context"
 	"strings"
 	"time"
 
 	"github.com/iden3/go-iden3-crypto/poseidon"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub/pb"
 	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p"
 	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
 )
 
+const (
+	// How long a proven frame is retried while the send queue is full.
+	framePublishTimeout = 5 * time.Second
+	// Delay before the first retry of a frame publish, doubled on every later
+	// one.
+	framePublishBackoff = 50 * time.Millisecond
+	// Window within which head announcements are coalesced, so that a burst
+	// of frames during catch-up is announced once rather than frame by frame.
+	defaultHeadAnnounceDampening = 2 * time.Second
+)
+
+var headAnnouncementsCoalescedTotal = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Namespace: "data",
+		Name:      "head_announcements_coalesced_total",
+		Help:      "Head announcements coalesced into a later one.",
+	},
+)
+
+func init() {
+	prometheus.MustRegister(headAnnouncementsCoalescedTotal)
+}
+
 func (e *DataClockConsensusEngine) handleFrameMessage(
 	message *pb.Message,
 ) error {
@@ -52,7 +79,8 @@ func (e *DataClockConsensusEngine) publishProof(
 		zap.Uint64("frame_number", frame.FrameNumber),
 	)
 
-	timestamp := time.Now().UnixMilli()
+	timestamp := e.clock.Now().UnixMilli()
+	capabilities := e.localCapabilities()
 
 	e.peerMapMx.Lock()
 	e.peerMap[string(e.pubSub.GetPeerID())] = &peerInfo{
@@ -64,27 +92,52 @@ func (e *DataClockConsensusEngine) publishProof(
 		totalDistance: e.dataTimeReel.GetTotalDistance().FillBytes(
 			make([]byte, 256),
 		),
+		region:       e.config.Engine.Region,
+		capabilities: capabilities,
+	}
+	e.peerMapMx.Unlock()
+	if e.headAnnouncer.Update(frame) {
+		headAnnouncementsCoalescedTotal.Inc()
+	}
+
+	if err := e.publishFrame(frame); err != nil {
+		e.logger.Warn(
+			"could not publish frame",
+			zap.Uint64("frame_number", frame.FrameNumber),
+			zap.Error(err),
+		)
 	}
+
+	return nil
+}
+
+// announceHead announces the frame as the local head to the peer list.
+func (e *DataClockConsensusEngine) announceHead(frame *protobufs.ClockFrame) {
 	list := &protobufs.DataPeerListAnnounce{
 		Peer: &protobufs.DataPeer{
 			PeerId:    nil,
 			Multiaddr: "",
 			MaxFrame:  frame.FrameNumber,
 			Version:   config.GetVersion(),
-			Timestamp: timestamp,
+			Timestamp: e.clock.Now().UnixMilli(),
 			TotalDistance: e.dataTimeReel.GetTotalDistance().FillBytes(
 				make([]byte, 256),
 			),
+			Region:               e.config.Engine.Region,
+			Capabilities:         e.localCapabilities().toProto(),
+			MinimumVersion:       config.GetMinimumVersion(),
+			MinimumVersionCutoff: config.GetMinimumVersionCutoff().UnixMilli(),
 		},
 	}
-	e.peerMapMx.Unlock()
-	if err := e.publishMessage(e.infoFilter, list); err != nil {
+	// The announcement is repeated with every frame, so it is shed rather than
+	// waited on when the send queue is full.
+	if err := e.publishMessageContext(
+		e.ctx,
+		e.infoFilter,
+		list,
+	); err != nil {
 		e.logger.Debug("error publishing message", zap.Error(err))
 	}
-
-	e.publishMessage(e.frameFilter, frame)
-
-	return nil
 }
 
 func (e *DataClockConsensusEngine) insertTxMessage(
@@ -141,9 +194,87 @@ func (e *DataClockConsensusEngine) publishMessage(
 	filter []byte,
 	message proto.Message,
 ) error {
+	data, err := e.wrapMessage(message)
+	if err != nil {
+		return errors.Wrap(err, "publish message")
+	}
+	return e.pubSub.PublishToBitmask(filter, data)
+}
+
+// publishMessageContext is publishMessage bounded by ctx, failing with
+// p2p.ErrBackpressure rather than waiting when the send queue is full.
+func (e *DataClockConsensusEngine) publishMessageContext(
+	ctx context.Context,
+	filter []byte,
+	message proto.Message,
+) error {
+	data, err := e.wrapMessage(message)
+	if err != nil {
+		return errors.Wrap(err, "publish message context")
+	}
+	return errors.Wrap(
+		e.pubSub.PublishContext(ctx, filter, data),
+		"publish message context",
+	)
+}
+
+// authorizeFramePublish lets the node publish to the frame bitmask only while
+// its proving key is in the prover trie, and never on behalf of RPC callers.
+func (e *DataClockConsensusEngine) authorizeFramePublish(
+	role p2p.PublishRole,
+	bitmask []byte,
+	data []byte,
+) error {
+	if role != p2p.PublishRoleNode {
+		return errors.Errorf("role %s may not publish", role)
+	}
+
+	e.frameProverTriesMx.RLock()
+	isProver := len(e.frameProverTries) != 0 &&
+		e.frameProverTries[0].Contains(e.provingKeyAddress)
+	e.frameProverTriesMx.RUnlock()
+	if !isProver {
+		return errors.New("not in prover trie")
+	}
+
+	return nil
+}
+
+// publishFrame publishes a proven frame, retrying with backoff while the send
+// queue is full until framePublishTimeout passes.
+func (e *DataClockConsensusEngine) publishFrame(
+	frame *protobufs.ClockFrame,
+) error {
+	ctx, cancel := clock.WithTimeout(e.ctx, e.clock, framePublishTimeout)
+	defer cancel()
+
+	backoff := framePublishBackoff
+	for {
+		err := e.publishMessageContext(ctx, e.frameFilter, frame)
+		if !errors.Is(err, p2p.ErrBackpressure) {
+			return errors.Wrap(err, "publish frame")
+		}
+
+		e.logger.Debug(
+			"send queue full, retrying frame publish",
+			zap.Uint64("frame_number", frame.FrameNumber),
+			zap.Duration("backoff", backoff),
+		)
+		select {
+		case <-ctx.Done():
+			return errors.Wrap(err, "publish frame")
+		case <-e.clock.After(backoff):
+		}
+		backoff = min(2*backoff, framePublishTimeout/4)
+	}
+}
+
+func (e *DataClockConsensusEngine) wrapMessage(
+	message proto.Message,
+) ([]byte, error) {
 	any := &anypb.Any{}
 	if err := any.MarshalFrom(message); err != nil {
-		return errors.Wrap(err, "publish message")
+		return nil, errors.Wrap(err, "wrap message")
 	}
 
 	any.TypeUrl = strings.Replace(
@@ -155,12 +286,12 @@ func (e *DataClockConsensusEngine) publishMessage(
 
 	payload, err := proto.Marshal(any)
 	if err != nil {
-		return errors.Wrap(err, "publish message")
+		return nil, errors.Wrap(err, "wrap message")
 	}
 
 	h, err := poseidon.HashBytes(payload)
 	if err != nil {
-		return errors.Wrap(err, "publish message")
+		return nil, errors.Wrap(err, "wrap message")
 	}
 
 	msg := &protobufs.Message{
@@ -170,7 +301,7 @@ func (e *DataClockConsensusEngine) publishMessage(
 	}
 	data, err := proto.Marshal(msg)
 	if err != nil {
-		return errors.Wrap(err, "publish message")
+		return nil, errors.Wrap(err, "wrap message")
 	}
-	return e.pubSub.PublishToBitmask(filter, data)
+	return data, nil
 }
@@ -0,0 +1,34 @@
+package data
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p"
+	"source.quilibrium.com/quilibrium/monorepo/node/tries"
+)
+
+func TestAuthorizeFramePublish(t *testing.T) {
+	address := bytes.Repeat([]byte{0x01}, 32)
+	proverTrie := &tries.RollingFrecencyCritbitTrie{}
+	e := &DataClockConsensusEngine{
+		provingKeyAddress: address,
+		frameProverTries:  []*tries.RollingFrecencyCritbitTrie{proverTrie},
+	}
+	authorize := func(role p2p.PublishRole) error {
+		return e.authorizeFramePublish(role, e.frameFilter, []byte("frame"))
+	}
+
+	// Outside the prover trie, the node may not publish frames.
+	assert.Error(t, authorize(p2p.PublishRoleNode))
+
+	proverTrie.Add(address, 0)
+	assert.NoError(t, authorize(p2p.PublishRoleNode))
+
+	// Frames submitted over RPC are refused even while the node proves.
+	assert.Error(t, authorize(p2p.PublishRoleRPC))
+
+	e.frameProverTries = nil
+	assert.Error(t, authorize(p2p.PublishRoleNode))
+}
@@ -2,26 +2,162 @@ package data
 
 import (
 	"bytes"
-	"context"
 	"time"
 
 	"golang.org/x/crypto/sha3"
 	"source.quilibrium.com/quilibrium/monorepo/node/config"
 	"source.quilibrium.com/quilibrium/monorepo/node/consensus"
 	"source.quilibrium.com/quilibrium/monorepo/node/consensus/data/internal"
+	qcrypto "source.quilibrium.com/quilibrium/monorepo/node/crypto"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
 	"source.quilibrium.com/quilibrium/monorepo/node/internal/frametime"
 
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
 	"source.quilibrium.com/quilibrium/monorepo/node/execution/intrinsics/token/application"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p"
 	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
 )
 
 const defaultSyncTimeout = 4 * time.Second
 
+const defaultSyncStartupGracePeriod = 2 * time.Minute
+
+const (
+	maxSyncVerifyAttempts  = 3
+	syncVerifyRetryBackoff = 250 * time.Millisecond
+)
+
+const (
+	defaultMaxFrameHorizon = 1000
+	// A peer is ignored as a sync candidate after this many claims in a row it
+	// could not back with a single verifiable frame.
+	maxUnfulfilledClaims    = 3
+	unfulfilledClaimPenalty = -10000
+)
+
+const (
+	// Attempts at syncing from a candidate within a collect round, as long as
+	// it fails without being found uncooperative.
+	candidateSyncAttempts     = 2
+	candidateSyncRetryBackoff = 500 * time.Millisecond
+	// Half-life of the failures remembered per sync candidate. A candidate
+	// that failed in a collect round is skipped until its failures decay.
+	candidateFailureHalfLife = time.Minute
+	// Half-life of the syncs remembered per sync candidate. The weight of a
+	// candidate is divided by one plus its recent syncs relative to its
+	// bandwidth, so repeated syncs move on to other candidates.
+	candidateLoadHalfLife = 5 * time.Minute
+)
+
+var syncCandidatesSkippedTotal = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Namespace: "data",
+		Name:      "sync_candidates_skipped_total",
+		Help:      "Sync candidates skipped by collect because they recently failed.",
+	},
+)
+
+func init() {
+	prometheus.MustRegister(syncCandidatesSkippedTotal)
+}
+
+const (
+	// Same-region sync candidates within this many frames of the most advanced
+	// candidate are considered comparable, and have their weight multiplied.
+	sameRegionFrameTolerance = 5
+	sameRegionWeightFactor   = 4
+)
+
+const (
+	// Sync candidates among the best peers of the frame bitmask, by peer score
+	// and round trip time, have their weight multiplied by up to
+	// bestPeerWeightFactor, the best of them getting the full factor.
+	bestSyncPeers        = 8
+	bestPeerWeightFactor = 2
+)
+
+// bestPeerFactors returns the weight factor of each of the best peers of the
+// frame bitmask.
+func (e *DataClockConsensusEngine) bestPeerFactors() map[string]float64 {
+	best, err := e.pubSub.GetBestPeers(e.frameFilter, bestSyncPeers)
+	if err != nil {
+		e.logger.Debug("could not rank peers", zap.Error(err))
+		return nil
+	}
+
+	factors := make(map[string]float64, len(best))
+	for rank, peerId := range best {
+		factors[string(peerId)] = 1 + (bestPeerWeightFactor-1)*
+			float64(len(best)-rank)/float64(len(best))
+	}
+	return factors
+}
+
+// peerRegion returns the region configured for the peer, falling back to the
+// region it advertised.
+func (e *DataClockConsensusEngine) peerRegion(
+	peerId []byte,
+	advertised string,
+) string {
+	if region, ok := e.config.Engine.PeerRegions[peer.ID(peerId).String()]; ok {
+		return region
+	}
+	return advertised
+}
+
+func (e *DataClockConsensusEngine) maxFrameHorizon() uint64 {
+	if e.config.Engine.MaxFrameHorizon == 0 {
+		return defaultMaxFrameHorizon
+	}
+	return e.config.Engine.MaxFrameHorizon
+}
+
+// recordClaimOutcome strikes a peer whose claimed frames did not materialize,
+// penalizing its app score, or clears its strikes once it serves a verified
+// frame. The score is adjusted after peerMapMx is released, so that the pubsub
+// is never called with it held.
+func (e *DataClockConsensusEngine) recordClaimOutcome(
+	peerId []byte,
+	fulfilled bool,
+) {
+	e.peerMapMx.Lock()
+	if fulfilled {
+		delete(e.unfulfilledClaims, string(peerId))
+		e.peerMapMx.Unlock()
+		return
+	}
+
+	claim, ok := e.unfulfilledClaims[string(peerId)]
+	if !ok {
+		claim = &unfulfilledClaim{}
+		e.unfulfilledClaims[string(peerId)] = claim
+	}
+	claim.strikes++
+	claim.lastStrike = e.clock.Now().UnixMilli()
+	strikes := claim.strikes
+	e.peerMapMx.Unlock()
+
+	e.logger.Debug(
+		"peer did not fulfill claimed max frame",
+		zap.String("peer_id", peer.ID(peerId).String()),
+		zap.Int("strikes", strikes),
+	)
+	e.pubSub.AddPeerScore(peerId, unfulfilledClaimPenalty)
+}
+
+// hasTooManyUnfulfilledClaims must be called with peerMapMx held.
+func (e *DataClockConsensusEngine) hasTooManyUnfulfilledClaims(
+	peerId []byte,
+) bool {
+	claim, ok := e.unfulfilledClaims[string(peerId)]
+	return ok && claim.strikes >= maxUnfulfilledClaims
+}
+
 func (e *DataClockConsensusEngine) collect(
 	enqueuedFrame *protobufs.ClockFrame,
 ) (*protobufs.ClockFrame, error) {
@@ -30,19 +166,20 @@ func (e *DataClockConsensusEngine) collect(
 	latest := enqueuedFrame
 	for {
 		candidates := e.GetAheadPeers(max(latest.FrameNumber, e.latestFrameReceived))
-		if len(candidates) == 0 {
-			break
-		}
+		tried := false
 		for _, candidate := range candidates {
 			if candidate.MaxFrame <= max(latest.FrameNumber, e.latestFrameReceived) {
 				continue
 			}
-			var err error
-			latest, err = e.sync(latest, candidate.MaxFrame, candidate.PeerID)
-			if err != nil {
-				e.logger.Debug("error syncing frame", zap.Error(err))
+			if e.candidateFailures.Skip(candidate.PeerID) {
+				syncCandidatesSkippedTotal.Inc()
 				continue
 			}
+			tried = true
+			latest = e.syncCandidate(latest, candidate)
+		}
+		if !tried {
+			break
 		}
 	}
 
@@ -55,6 +192,60 @@ func (e *DataClockConsensusEngine) collect(
 	return latest, nil
 }
 
+// syncCandidate syncs from the candidate, retrying within its budget, and
+// remembers whether it made progress so that a failing candidate is skipped
+// in the following rounds instead of being retried every round.
+func (e *DataClockConsensusEngine) syncCandidate(
+	latest *protobufs.ClockFrame,
+	candidate internal.PeerCandidate,
+) *protobufs.ClockFrame {
+	for attempt := 1; ; attempt++ {
+		e.candidateLoad.RecordSync(candidate.PeerID)
+		synced, err := e.sync(latest, candidate.MaxFrame, candidate.PeerID)
+		if err != nil {
+			e.logger.Debug("error syncing frame", zap.Error(err))
+		}
+		if synced.FrameNumber > latest.FrameNumber {
+			e.candidateFailures.RecordSuccess(candidate.PeerID)
+			return synced
+		}
+
+		if attempt >= candidateSyncAttempts ||
+			e.isUncooperative(candidate.PeerID) {
+			if !e.inStartupGrace() {
+				e.candidateFailures.RecordFailure(candidate.PeerID)
+			}
+			return latest
+		}
+
+		select {
+		case <-e.ctx.Done():
+			return latest
+		case <-e.clock.After(candidateSyncRetryBackoff):
+		}
+	}
+}
+
+// inStartupGrace reports whether the engine started too recently for sync
+// failures to be blamed on the peer rather than on the node's own
+// connectivity.
+func (e *DataClockConsensusEngine) inStartupGrace() bool {
+	grace := e.config.Engine.SyncStartupGracePeriod
+	if grace == 0 {
+		grace = defaultSyncStartupGracePeriod
+	}
+	return e.clock.Since(e.startedAt) < grace
+}
+
+// isUncooperative reports whether the peer is excluded from syncing.
+func (e *DataClockConsensusEngine) isUncooperative(peerId []byte) bool {
+	e.peerMapMx.RLock()
+	defer e.peerMapMx.RUnlock()
+
+	_, ok := e.uncooperativePeersMap[string(peerId)]
+	return ok || e.hasTooManyUnfulfilledClaims(peerId)
+}
+
 func (e *DataClockConsensusEngine) prove(
 	previousFrame *protobufs.ClockFrame,
 ) (*protobufs.ClockFrame, error) {
@@ -82,34 +273,44 @@ func (e *DataClockConsensusEngine) prove(
 		return nil, errors.Wrap(err, "prove")
 	}
 
-	if e.stagedTransactions == nil {
-		e.stagedTransactions = &protobufs.TokenRequests{}
+	if expired := e.stagedTransactions.expire(
+		previousFrame.FrameNumber + 1,
+	); expired != 0 {
+		e.logger.Debug(
+			"dropped expired staged transactions",
+			zap.Int("expired", expired),
+		)
 	}
-
+	apply := e.stagedTransactions.take(e.applyLimit())
+	deferred := e.stagedTransactions.len()
 	e.logger.Info(
 		"proving new frame",
-		zap.Int("transactions", len(e.stagedTransactions.Requests)),
+		zap.Int("transactions", len(apply)),
+		zap.Int("deferred", deferred),
 	)
+	if newlyDeferred := e.stagedTransactions.deferRemaining(); newlyDeferred != 0 {
+		deferredTransactionsTotal.Add(float64(newlyDeferred))
+	}
 
 	var validTransactions *protobufs.TokenRequests
 	var invalidTransactions *protobufs.TokenRequests
+	applyStart := e.clock.Now()
 	app, validTransactions, invalidTransactions, err = app.ApplyTransitions(
 		previousFrame.FrameNumber+1,
-		e.stagedTransactions,
+		&protobufs.TokenRequests{Requests: apply},
 		true,
 	)
 	if err != nil {
-		e.stagedTransactions = &protobufs.TokenRequests{}
 		e.stagedTransactionsMx.Unlock()
 		return nil, errors.Wrap(err, "prove")
 	}
+	e.recordApplyTime(len(apply), e.clock.Since(applyStart))
 
 	e.logger.Info(
 		"applied transitions",
 		zap.Int("successful", len(validTransactions.Requests)),
 		zap.Int("failed", len(invalidTransactions.Requests)),
 	)
-	e.stagedTransactions = &protobufs.TokenRequests{}
 	e.stagedTransactionsMx.Unlock()
 
 	outputState, err := app.MaterializeStateFromApplication()
@@ -127,6 +328,7 @@ func (e *DataClockConsensusEngine) prove(
 	if err != nil {
 		return nil, errors.Wrap(err, "prove")
 	}
+	executionOutput.Events = app.Events
 
 	data, err := proto.Marshal(executionOutput)
 	if err != nil {
@@ -174,6 +376,7 @@ func (e *DataClockConsensusEngine) prove(
 
 	e.logger.Debug("finalizing execution proof")
 
+	vdfStart := e.clock.Now()
 	frame, err := e.frameProver.ProveDataClockFrame(
 		previousFrame,
 		[][]byte{proof},
@@ -195,12 +398,15 @@ func (e *DataClockConsensusEngine) prove(
 			},
 		},
 		e.provingKey,
-		time.Now().UnixMilli(),
+		e.clock.Now().UnixMilli(),
 		e.difficulty,
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "prove")
 	}
+	e.stagedTransactionsMx.Lock()
+	e.recordVDFTime(e.clock.Since(vdfStart))
+	e.stagedTransactionsMx.Unlock()
 
 	e.lastProven = previousFrame.FrameNumber
 	e.logger.Info(
@@ -225,7 +431,11 @@ func (e *DataClockConsensusEngine) GetAheadPeers(frameNumber uint64) []internal.
 	)
 
 	candidates := make([]internal.WeightedPeerCandidate, 0, len(e.peerMap))
+	regions := make([]string, 0, len(e.peerMap))
+	capabilityFactors := make([]float64, 0, len(e.peerMap))
+	loadShares := make([]float64, 0, len(e.peerMap))
 	maxDiff := uint64(0)
+	horizon := e.maxFrameHorizon()
 
 	e.peerMapMx.RLock()
 	for _, v := range e.peerMap {
@@ -242,19 +452,42 @@ func (e *DataClockConsensusEngine) GetAheadPeers(frameNumber uint64) []internal.
 		if _, ok := e.uncooperativePeersMap[string(v.peerId)]; ok {
 			continue
 		}
-		if v.timestamp <= config.GetMinimumVersionCutoff().UnixMilli() {
-			continue
+		// The version the peer sent when connecting is authoritative, the one
+		// of its announcements is only trusted once they postdate the cutoff.
+		if metadata := e.pubSub.GetPeerMetadata(v.peerId); metadata != nil {
+			if bytes.Compare(
+				metadata.Version,
+				config.GetMinimumVersion(),
+			) < 0 {
+				continue
+			}
+		} else {
+			if v.timestamp <= config.GetMinimumVersionCutoff().UnixMilli() {
+				continue
+			}
+			if bytes.Compare(v.version, config.GetMinimumVersion()) < 0 {
+				continue
+			}
 		}
-		if bytes.Compare(v.version, config.GetMinimumVersion()) < 0 {
+		if e.hasTooManyUnfulfilledClaims(v.peerId) {
 			continue
 		}
-		maxDiff = max(maxDiff, v.maxFrame-frameNumber)
+		// Clamp the claim so that an absurd max frame neither dominates the
+		// weighting nor keeps collect chasing frames that do not exist.
+		maxFrame := min(v.maxFrame, frameNumber+horizon)
+		maxDiff = max(maxDiff, maxFrame-frameNumber)
 		candidates = append(candidates, internal.WeightedPeerCandidate{
 			PeerCandidate: internal.PeerCandidate{
 				PeerID:   v.peerId,
-				MaxFrame: v.maxFrame,
+				MaxFrame: maxFrame,
 			},
 		})
+		regions = append(regions, v.region)
+		capabilityFactors = append(
+			capabilityFactors,
+			v.capabilities.syncWeightFactor(v.maxFrame-frameNumber),
+		)
+		loadShares = append(loadShares, v.capabilities.bandwidthFactor())
 	}
 	e.peerMapMx.RUnlock()
 
@@ -262,8 +495,20 @@ func (e *DataClockConsensusEngine) GetAheadPeers(frameNumber uint64) []internal.
 		return nil
 	}
 
+	region := e.config.Engine.Region
+	bestPeers := e.bestPeerFactors()
 	for i := range candidates {
 		candidates[i].Weight = float64(candidates[i].MaxFrame-frameNumber) / float64(maxDiff)
+		if region != "" && regions[i] == region &&
+			candidates[i].MaxFrame+sameRegionFrameTolerance >= frameNumber+maxDiff {
+			candidates[i].Weight *= sameRegionWeightFactor
+		}
+		candidates[i].Weight *= capabilityFactors[i]
+		candidates[i].Weight /= 1 +
+			e.candidateLoad.Load(candidates[i].PeerID)/loadShares[i]
+		if factor, ok := bestPeers[string(candidates[i].PeerID)]; ok {
+			candidates[i].Weight *= factor
+		}
 	}
 	return internal.WeightedSampleWithoutReplacement(candidates, len(candidates))
 }
@@ -283,6 +528,14 @@ func (e *DataClockConsensusEngine) sync(
 		zap.Uint64("max_frame", maxFrame),
 	)
 	var cooperative bool = true
+	// The claim is only trusted once the first frame fetched from the peer
+	// verifies; a peer that answers without ever producing one is struck.
+	var answered, fulfilled bool
+	defer func() {
+		if answered || fulfilled {
+			e.recordClaimOutcome(peerId, fulfilled)
+		}
+	}()
 	defer func() {
 		if cooperative {
 			return
@@ -291,7 +544,7 @@ func (e *DataClockConsensusEngine) sync(
 		defer e.peerMapMx.Unlock()
 		if _, ok := e.peerMap[string(peerId)]; ok {
 			e.uncooperativePeersMap[string(peerId)] = e.peerMap[string(peerId)]
-			e.uncooperativePeersMap[string(peerId)].timestamp = time.Now().UnixMilli()
+			e.uncooperativePeersMap[string(peerId)].timestamp = e.clock.Now().UnixMilli()
 			delete(e.peerMap, string(peerId))
 		}
 	}()
@@ -301,7 +554,16 @@ func (e *DataClockConsensusEngine) sync(
 			"could not establish direct channel",
 			zap.Error(err),
 		)
-		cooperative = false
+		// A peer that does not serve sync will not start to, while one that is
+		// offline may come back and is only skipped for a while as a failed
+		// candidate.
+		switch {
+		case errors.Is(err, p2p.ErrProtocolNotSupported):
+			cooperative = false
+		case errors.Is(err, p2p.ErrPeerOffline):
+		case !e.inStartupGrace():
+			cooperative = false
+		}
 		return latest, errors.Wrap(err, "sync")
 	}
 	defer func() {
@@ -318,7 +580,7 @@ func (e *DataClockConsensusEngine) sync(
 	}
 
 	for e.GetState() < consensus.EngineStateStopping {
-		ctx, cancel := context.WithTimeout(e.ctx, syncTimeout)
+		ctx, cancel := clock.WithTimeout(e.ctx, e.clock, syncTimeout)
 		response, err := client.GetDataFrame(
 			ctx,
 			&protobufs.GetDataFrameRequest{
@@ -332,7 +594,10 @@ func (e *DataClockConsensusEngine) sync(
 				"could not get frame",
 				zap.Error(err),
 			)
-			cooperative = false
+			if !e.inStartupGrace() {
+				cooperative = false
+				answered = true
+			}
 			return latest, errors.Wrap(err, "sync")
 		}
 
@@ -346,6 +611,7 @@ func (e *DataClockConsensusEngine) sync(
 			response.ClockFrame.Timestamp < latest.Timestamp {
 			e.logger.Debug("received invalid response from peer")
 			cooperative = false
+			answered = true
 			return latest, nil
 		}
 		e.logger.Info(
@@ -353,17 +619,20 @@ func (e *DataClockConsensusEngine) sync(
 			zap.Uint64("frame_number", response.ClockFrame.FrameNumber),
 			zap.Duration("frame_age", frametime.Since(response.ClockFrame)),
 		)
-		if !e.IsInProverTrie(
-			response.ClockFrame.GetPublicKeySignatureEd448().PublicKey.KeyValue,
-		) {
-			cooperative = false
-		}
-		if err := e.frameProver.VerifyDataClockFrame(
+		if err := e.verifySyncedFrame(
 			response.ClockFrame,
+			peerId,
+			response.ClockFrame.FrameNumber >= maxFrame,
 		); err != nil {
-			return nil, errors.Wrap(err, "sync")
+			if errors.Is(err, qcrypto.ErrInvalidFrame) {
+				e.logger.Debug("peer served invalid frame", zap.Error(err))
+				cooperative = false
+				answered = true
+			}
+			return latest, errors.Wrap(err, "sync")
 		}
 		e.dataTimeReel.Insert(response.ClockFrame, true)
+		fulfilled = true
 		latest = response.ClockFrame
 		if latest.FrameNumber >= maxFrame {
 			return latest, nil
@@ -371,3 +640,80 @@ func (e *DataClockConsensusEngine) sync(
 	}
 	return latest, nil
 }
+
+// verifySyncedFrame verifies a frame received during sync. Failures proving
+// the frame itself is invalid are returned immediately so the peer can be
+// penalized, while failures caused locally (including prover panics) are
+// retried a bounded number of times before giving up on the peer without
+// penalty. The header signature and the prover are checked first, so that
+// invalid frames are rejected before their proof is verified. With fast
+// verify enabled, the proofs of frames other than spot checks and the last
+// frame of the sync are verified in the background.
+func (e *DataClockConsensusEngine) verifySyncedFrame(
+	frame *protobufs.ClockFrame,
+	peerId []byte,
+	last bool,
+) error {
+	if err := e.retryVerifyDataClockFrame(frame, false); err != nil {
+		return err
+	}
+
+	if !e.IsInProverTrie(
+		frame.GetPublicKeySignatureEd448().GetPublicKey().GetKeyValue(),
+	) {
+		rejectFrame(frameSourceSync, frameStageProver)
+		return errors.Wrap(qcrypto.ErrInvalidFrame, "prover not in trie")
+	}
+
+	if !last && e.defersFrameProof(frame) && e.deferFrameProof(frame, peerId) {
+		return nil
+	}
+
+	return e.retryVerifyDataClockFrame(frame, true)
+}
+
+// retryVerifyDataClockFrame verifies the proof of a frame if proof is set, or
+// otherwise its header signature.
+func (e *DataClockConsensusEngine) retryVerifyDataClockFrame(
+	frame *protobufs.ClockFrame,
+	proof bool,
+) error {
+	var err error
+	for attempt := 1; attempt <= maxSyncVerifyAttempts; attempt++ {
+		err = e.tryVerifyDataClockFrame(frame, proof)
+		if err == nil || errors.Is(err, qcrypto.ErrInvalidFrame) {
+			return err
+		}
+
+		e.logger.Warn(
+			"local error verifying frame",
+			zap.Uint64("frame_number", frame.FrameNumber),
+			zap.Int("attempt", attempt),
+			zap.Error(err),
+		)
+
+		select {
+		case <-e.ctx.Done():
+			return err
+		case <-e.clock.After(time.Duration(attempt) * syncVerifyRetryBackoff):
+		}
+	}
+
+	return err
+}
+
+func (e *DataClockConsensusEngine) tryVerifyDataClockFrame(
+	frame *protobufs.ClockFrame,
+	proof bool,
+) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Errorf("panic while verifying frame: %v", r)
+		}
+	}()
+
+	if !proof {
+		return e.verifyFrameSignature(frameSourceSync, frame)
+	}
+	return e.verifyFrameProof(frameSourceSync, frame)
+}
@@ -0,0 +1,170 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	"source.quilibrium.com/quilibrium/monorepo/node/consensus/data/internal"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/tries"
+)
+
+// metadataPubSub returns the metadata peers sent when connecting.
+type metadataPubSub struct {
+	pubsub
+	metadata map[string]*protobufs.PeerMetadata
+}
+
+func (p *metadataPubSub) GetPeerMetadata(
+	peerId []byte,
+) *protobufs.PeerMetadata {
+	return p.metadata[string(peerId)]
+}
+
+func newAheadPeersTestEngine(
+	t *testing.T,
+	ps *metadataPubSub,
+) *DataClockConsensusEngine {
+	clk := clock.NewFakeClock(time.UnixMilli(1700000000000))
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	return &DataClockConsensusEngine{
+		ctx:    ctx,
+		cancel: cancel,
+		logger: zap.NewNop(),
+		clock:  clk,
+		pubSub: ps,
+		config: &config.Config{Engine: &config.EngineConfig{}},
+		frameProverTries: []*tries.RollingFrecencyCritbitTrie{
+			{},
+		},
+		provingKeyAddress:     bytes.Repeat([]byte{0x01}, 32),
+		peerMap:               map[string]*peerInfo{},
+		uncooperativePeersMap: map[string]*peerInfo{},
+		unfulfilledClaims:     map[string]*unfulfilledClaim{},
+		candidateLoad:         internal.NewCandidateLoad(clk, time.Minute),
+	}
+}
+
+func TestGetAheadPeersVersion(t *testing.T) {
+	current := []byte{0x02, 0x00, 0x04}
+	outdated := []byte{0x02, 0x00, 0x02}
+	afterCutoff := config.GetMinimumVersionCutoff().UnixMilli() + 1
+	beforeCutoff := config.GetMinimumVersionCutoff().UnixMilli() - 1
+
+	ps := &metadataPubSub{metadata: map[string]*protobufs.PeerMetadata{
+		"current-metadata":  {Version: current},
+		"outdated-metadata": {Version: outdated},
+	}}
+	e := newAheadPeersTestEngine(t, ps)
+	for _, v := range []*peerInfo{
+		// The version sent when connecting wins over the announced one.
+		{
+			peerId:    []byte("current-metadata"),
+			version:   outdated,
+			timestamp: beforeCutoff,
+		},
+		{
+			peerId:    []byte("outdated-metadata"),
+			version:   current,
+			timestamp: afterCutoff,
+		},
+		// Without metadata the announcement counts once past the cutoff.
+		{
+			peerId:    []byte("current-announced"),
+			version:   current,
+			timestamp: afterCutoff,
+		},
+		{
+			peerId:    []byte("outdated-announced"),
+			version:   outdated,
+			timestamp: afterCutoff,
+		},
+		{
+			peerId:    []byte("before-cutoff"),
+			version:   current,
+			timestamp: beforeCutoff,
+		},
+		// Peers not ahead are never candidates.
+		{
+			peerId:    []byte("behind"),
+			version:   current,
+			timestamp: afterCutoff,
+			maxFrame:  10,
+		},
+	} {
+		if v.maxFrame == 0 {
+			v.maxFrame = 20
+		}
+		e.peerMap[string(v.peerId)] = v
+	}
+
+	var peers []string
+	for _, candidate := range e.GetAheadPeers(10) {
+		peers = append(peers, string(candidate.PeerID))
+		assert.Equal(t, uint64(20), candidate.MaxFrame)
+	}
+	slices.Sort(peers)
+	assert.Equal(t, []string{"current-announced", "current-metadata"}, peers)
+}
+
+// lockCheckingPubSub records app score changes, failing the test if one is
+// made while the peer map lock of the engine is held.
+type lockCheckingPubSub struct {
+	metadataPubSub
+	t      *testing.T
+	e      *DataClockConsensusEngine
+	scores map[string]int64
+}
+
+func (p *lockCheckingPubSub) AddPeerScore(peerId []byte, scoreDelta int64) {
+	if !p.e.peerMapMx.TryLock() {
+		p.t.Error("peer score changed with the peer map locked")
+		return
+	}
+	p.e.peerMapMx.Unlock()
+	p.scores[string(peerId)] += scoreDelta
+}
+
+func TestRecordClaimOutcome(t *testing.T) {
+	ps := &lockCheckingPubSub{t: t, scores: map[string]int64{}}
+	e := newAheadPeersTestEngine(t, &ps.metadataPubSub)
+	e.pubSub = ps
+	ps.e = e
+	e.peerMap["peer"] = &peerInfo{
+		peerId:    []byte("peer"),
+		version:   []byte{0x02, 0x00, 0x04},
+		timestamp: config.GetMinimumVersionCutoff().UnixMilli() + 1,
+		maxFrame:  20,
+	}
+
+	// Each unfulfilled claim is struck and penalized, until the peer is no
+	// longer a candidate.
+	for i := 1; i < maxUnfulfilledClaims; i++ {
+		e.recordClaimOutcome([]byte("peer"), false)
+		assert.Len(t, e.GetAheadPeers(10), 1)
+	}
+	e.recordClaimOutcome([]byte("peer"), false)
+	assert.Empty(t, e.GetAheadPeers(10))
+	assert.Equal(
+		t,
+		int64(maxUnfulfilledClaims*unfulfilledClaimPenalty),
+		ps.scores["peer"],
+	)
+
+	// A verified frame clears the strikes without changing the score.
+	e.recordClaimOutcome([]byte("peer"), true)
+	assert.Len(t, e.GetAheadPeers(10), 1)
+	assert.Equal(
+		t,
+		int64(maxUnfulfilledClaims*unfulfilledClaimPenalty),
+		ps.scores["peer"],
+	)
+}
@@ -7,8 +7,10 @@ import (
 	"fmt"
 	"math/rand"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/p2p/discovery/backoff"
 	"github.com/multiformats/go-multiaddr"
 	mn "github.com/multiformats/go-multiaddr/net"
@@ -23,12 +25,16 @@ import (
 	"source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub/pb"
 	"source.quilibrium.com/quilibrium/monorepo/node/config"
 	"source.quilibrium.com/quilibrium/monorepo/node/consensus"
+	"source.quilibrium.com/quilibrium/monorepo/node/consensus/data/internal"
 	qtime "source.quilibrium.com/quilibrium/monorepo/node/consensus/time"
 	qcrypto "source.quilibrium.com/quilibrium/monorepo/node/crypto"
 	"source.quilibrium.com/quilibrium/monorepo/node/execution"
 	"source.quilibrium.com/quilibrium/monorepo/node/internal/cas"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/diskspace"
 	"source.quilibrium.com/quilibrium/monorepo/node/internal/frametime"
 	qgrpc "source.quilibrium.com/quilibrium/monorepo/node/internal/grpc"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/startup"
 	"source.quilibrium.com/quilibrium/monorepo/node/keys"
 	"source.quilibrium.com/quilibrium/monorepo/node/p2p"
 	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
@@ -38,6 +44,7 @@ import (
 
 const PEER_INFO_TTL = 60 * 60 * 1000
 const UNCOOPERATIVE_PEER_INFO_TTL = 60 * 1000
+const UNFULFILLED_CLAIM_TTL = 60 * 60 * 1000
 
 type SyncStatusType int
 
@@ -56,6 +63,15 @@ type peerInfo struct {
 	lastSeen      int64
 	version       []byte
 	totalDistance []byte
+	region        string
+	capabilities  peerCapabilities
+}
+
+// unfulfilledClaim tracks a peer that advertised frames ahead of us but did
+// not serve a single verifiable frame when asked.
+type unfulfilledClaim struct {
+	strikes    int
+	lastStrike int64
 }
 
 type ChannelServer = protobufs.DataService_GetPublicChannelServer
@@ -70,6 +86,7 @@ type DataClockConsensusEngine struct {
 	difficulty                  uint32
 	config                      *config.Config
 	logger                      *zap.Logger
+	clock                       clock.Clock
 	state                       consensus.EngineState
 	stateMx                     sync.RWMutex
 	clockStore                  store.ClockStore
@@ -87,6 +104,7 @@ type DataClockConsensusEngine struct {
 	provingKeyAddress           []byte
 	lastFrameReceivedAt         time.Time
 	latestFrameReceived         uint64
+	directPeerHead              uint64
 	frameProverTries            []*tries.RollingFrecencyCritbitTrie
 	preMidnightMintMx           sync.Mutex
 	preMidnightMint             map[string]struct{}
@@ -101,6 +119,20 @@ type DataClockConsensusEngine struct {
 	currentReceivingSyncPeersMx sync.Mutex
 	currentReceivingSyncPeers   int
 	announcedJoin               int
+	diskSpace                   *diskspace.Watcher
+	maintenance                 atomic.Bool
+	versionCutOff               atomic.Bool
+	meshPeersLow                atomic.Bool
+	announcedCutoffsMx          sync.Mutex
+	announcedCutoffs            map[string]versionCutoff
+	startedAt                   time.Time
+	syncServing                 atomic.Int64
+	syncAudit                   *SyncAudit
+	deferredFrames              chan *deferredFrame
+	fastVerifyFailed            atomic.Bool
+	proverStatsMx               sync.Mutex
+	proverStats                 *protobufs.ProverStats
+	unconfirmedFrames           []*protobufs.ClockFrame
 
 	frameChan                      chan *protobufs.ClockFrame
 	executionEngines               map[string]execution.ExecutionEngine
@@ -115,13 +147,21 @@ type DataClockConsensusEngine struct {
 	previousHead                   *protobufs.ClockFrame
 	engineMx                       sync.Mutex
 	dependencyMapMx                sync.Mutex
-	stagedTransactions             *protobufs.TokenRequests
+	stagedTransactions             *stagedShards
+	applyTimePerTx                 time.Duration
+	vdfTime                        time.Duration
 	stagedTransactionsMx           sync.Mutex
 	peerMapMx                      sync.RWMutex
 	peerAnnounceMapMx              sync.Mutex
 	lastKeyBundleAnnouncementFrame uint64
 	peerMap                        map[string]*peerInfo
 	uncooperativePeersMap          map[string]*peerInfo
+	unfulfilledClaims              map[string]*unfulfilledClaim
+	candidateFailures              *internal.CandidateFailures
+	candidateLoad                  *internal.CandidateLoad
+	servingLatency                 *internal.ServingLatency
+	servingShed                    atomic.Uint64
+	headAnnouncer                  *internal.Dampener[*protobufs.ClockFrame]
 	frameMessageProcessorCh        chan *pb.Message
 	txMessageProcessorCh           chan *pb.Message
 	infoMessageProcessorCh         chan *pb.Message
@@ -132,6 +172,7 @@ type DataClockConsensusEngine struct {
 	previousTree                   *mt.MerkleTree
 	clientReconnectTest            int
 	requestSyncCh                  chan *protobufs.ClockFrame
+	syncAllowlist                  map[peer.ID]struct{}
 }
 
 var _ consensus.DataConsensusEngine = (*DataClockConsensusEngine)(nil)
@@ -139,6 +180,7 @@ var _ consensus.DataConsensusEngine = (*DataClockConsensusEngine)(nil)
 func NewDataClockConsensusEngine(
 	cfg *config.Config,
 	logger *zap.Logger,
+	clk clock.Clock,
 	keyManager keys.KeyManager,
 	clockStore store.ClockStore,
 	coinStore store.CoinStore,
@@ -162,6 +204,10 @@ func NewDataClockConsensusEngine(
 		panic(errors.New("engine config is nil"))
 	}
 
+	if clk == nil {
+		panic(errors.New("clock is nil"))
+	}
+
 	if keyManager == nil {
 		panic(errors.New("key manager is nil"))
 	}
@@ -221,6 +267,16 @@ func NewDataClockConsensusEngine(
 		rateLimit = 10
 	}
 
+	headAnnounceDampening := cfg.Engine.HeadAnnounceDampening
+	if headAnnounceDampening == 0 {
+		headAnnounceDampening = defaultHeadAnnounceDampening
+	}
+
+	maxStagedTransactionsPerShard := cfg.Engine.MaxStagedTransactionsPerShard
+	if maxStagedTransactionsPerShard == 0 {
+		maxStagedTransactionsPerShard = defaultMaxStagedTransactionsPerShard
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	e := &DataClockConsensusEngine{
 		ctx:              ctx,
@@ -250,6 +306,7 @@ func NewDataClockConsensusEngine(
 		syncingStatus:             SyncStatusNotSyncing,
 		peerMap:                   map[string]*peerInfo{},
 		uncooperativePeersMap:     map[string]*peerInfo{},
+		unfulfilledClaims:         map[string]*unfulfilledClaim{},
 		minimumPeersRequired:      minimumPeersRequired,
 		report:                    report,
 		frameProver:               frameProver,
@@ -260,14 +317,43 @@ func NewDataClockConsensusEngine(
 		txMessageProcessorCh:      make(chan *pb.Message),
 		infoMessageProcessorCh:    make(chan *pb.Message),
 		config:                    cfg,
+		clock:                     clk,
 		preMidnightMint:           map[string]struct{}{},
+		stagedTransactions:        newStagedShards(maxStagedTransactionsPerShard),
 		grpcRateLimiter: NewRateLimiter(
 			rateLimit,
 			time.Minute,
 		),
-		requestSyncCh: make(chan *protobufs.ClockFrame, 1),
+		requestSyncCh:    make(chan *protobufs.ClockFrame, 1),
+		syncAudit:        NewSyncAudit(syncAuditMaxClients, syncAuditSampleRate),
+		deferredFrames:   make(chan *deferredFrame, fastVerifyQueueSize),
+		announcedCutoffs: map[string]versionCutoff{},
+		diskSpace: diskspace.NewWatcher(
+			logger.Named("disk-space"),
+			clk,
+			cfg.DB.Path,
+			uint64(max(cfg.DB.MinimumFreeSpace, 0)),
+			30*time.Second,
+		),
 	}
 
+	e.candidateFailures = internal.NewCandidateFailures(
+		e.clock,
+		candidateFailureHalfLife,
+	)
+	e.candidateLoad = internal.NewCandidateLoad(e.clock, candidateLoadHalfLife)
+	e.servingLatency = internal.NewServingLatency(
+		e.clock,
+		servingLatencyWindow,
+		servingLatencyMaxSamples,
+	)
+	e.headAnnouncer = internal.NewDampener(
+		e.ctx,
+		e.clock,
+		headAnnounceDampening,
+		e.announceHead,
+	)
+
 	logger.Info("constructing consensus engine")
 
 	signer, keyType, bytes, address := e.GetProvingKey(
@@ -284,11 +370,21 @@ func NewDataClockConsensusEngine(
 	e.provingKeyBytes = bytes
 	e.provingKeyAddress = address
 
+	e.syncAllowlist = map[peer.ID]struct{}{}
+	for _, id := range cfg.Engine.SyncAllowlist {
+		peerID, err := peer.Decode(id)
+		if err != nil {
+			panic(errors.Wrap(err, "invalid sync allowlist peer id"))
+		}
+		e.syncAllowlist[peerID] = struct{}{}
+	}
+
 	return e
 }
 
 func (e *DataClockConsensusEngine) Start() <-chan error {
 	e.logger.Info("starting data consensus engine")
+	e.startedAt = e.clock.Now()
 	e.stateMx.Lock()
 	e.state = consensus.EngineStateStarting
 	e.stateMx.Unlock()
@@ -304,6 +400,7 @@ func (e *DataClockConsensusEngine) Start() <-chan error {
 	}
 
 	e.frameProverTries = e.dataTimeReel.GetFrameProverTries()
+	startup.Set(e.logger, startup.PhaseSyncing)
 
 	err = e.createCommunicationKeys()
 	if err != nil {
@@ -318,11 +415,16 @@ func (e *DataClockConsensusEngine) Start() <-chan error {
 	e.pubSub.RegisterValidator(e.frameFilter, e.validateFrameMessage, true)
 	e.pubSub.RegisterValidator(e.txFilter, e.validateTxMessage, true)
 	e.pubSub.RegisterValidator(e.infoFilter, e.validateInfoMessage, true)
+	// A frame cut short cannot be decoded, rejected ones are kept whole.
+	e.pubSub.SetDeadLetterMaxSize(e.frameFilter, 0)
+	e.pubSub.SetPublishAuthorizer(e.frameFilter, e.authorizeFramePublish)
+	e.pubSub.SetDirectChannelAuthorizer(e.IsInProverTrie)
 	e.pubSub.Subscribe(e.frameFilter, e.handleFrameMessage)
 	e.pubSub.Subscribe(e.txFilter, e.handleTxMessage)
 	e.pubSub.Subscribe(e.infoFilter, e.handleInfoMessage)
 	go func() {
 		server := qgrpc.NewServer(
+			p2p.DirectChannelCreds(),
 			grpc.MaxSendMsgSize(20*1024*1024),
 			grpc.MaxRecvMsgSize(20*1024*1024),
 		)
@@ -332,6 +434,10 @@ func (e *DataClockConsensusEngine) Start() <-chan error {
 			"sync",
 			server,
 		); err != nil {
+			if errors.Is(err, p2p.ErrDirectChannelPurposeDisabled) {
+				e.logger.Info("not serving sync, disabled by config")
+				return
+			}
 			panic(err)
 		}
 	}()
@@ -339,6 +445,7 @@ func (e *DataClockConsensusEngine) Start() <-chan error {
 	go func() {
 		if e.dataTimeReel.GetFrameProverTries()[0].Contains(e.provingKeyAddress) {
 			server := qgrpc.NewServer(
+				p2p.DirectChannelCreds(),
 				grpc.MaxSendMsgSize(1*1024*1024),
 				grpc.MaxRecvMsgSize(1*1024*1024),
 			)
@@ -349,6 +456,10 @@ func (e *DataClockConsensusEngine) Start() <-chan error {
 				"worker",
 				server,
 			); err != nil {
+				if errors.Is(err, p2p.ErrDirectChannelPurposeDisabled) {
+					e.logger.Info("not serving worker channel, disabled by config")
+					return
+				}
 				panic(err)
 			}
 		}
@@ -358,6 +469,8 @@ func (e *DataClockConsensusEngine) Start() <-chan error {
 	e.state = consensus.EngineStateCollecting
 	e.stateMx.Unlock()
 
+	go e.warmFromDirectPeers()
+
 	go func() {
 		const baseDuration = 2 * time.Minute
 		const maxBackoff = 3
@@ -369,7 +482,7 @@ func (e *DataClockConsensusEngine) Start() <-chan error {
 		source := rand.New(rand.NewSource(rand.Int63()))
 		for e.GetState() < consensus.EngineStateStopping {
 			// Use exponential backoff with jitter in order to avoid hammering the bootstrappers.
-			time.Sleep(
+			e.clock.Sleep(
 				backoff.FullJitter(
 					baseDuration<<currentBackoff,
 					baseDuration,
@@ -405,13 +518,14 @@ func (e *DataClockConsensusEngine) Start() <-chan error {
 
 			if frame.FrameNumber-100 >= nextFrame.FrameNumber ||
 				nextFrame.FrameNumber == 0 {
-				time.Sleep(120 * time.Second)
+				e.clock.Sleep(120 * time.Second)
 				continue
 			}
 
 			frame = nextFrame
 
-			timestamp := time.Now().UnixMilli()
+			timestamp := e.clock.Now().UnixMilli()
+			capabilities := e.localCapabilities()
 			list := &protobufs.DataPeerListAnnounce{
 				Peer: &protobufs.DataPeer{
 					PeerId:    nil,
@@ -422,6 +536,10 @@ func (e *DataClockConsensusEngine) Start() <-chan error {
 					TotalDistance: e.dataTimeReel.GetTotalDistance().FillBytes(
 						make([]byte, 256),
 					),
+					Region:               e.config.Engine.Region,
+					Capabilities:         capabilities.toProto(),
+					MinimumVersion:       config.GetMinimumVersion(),
+					MinimumVersionCutoff: config.GetMinimumVersionCutoff().UnixMilli(),
 				},
 			}
 
@@ -442,13 +560,15 @@ func (e *DataClockConsensusEngine) Start() <-chan error {
 				totalDistance: e.dataTimeReel.GetTotalDistance().FillBytes(
 					make([]byte, 256),
 				),
+				region:       e.config.Engine.Region,
+				capabilities: capabilities,
 			}
 			deletes := []*peerInfo{}
 			for _, v := range e.peerMap {
 				if v == nil {
 					continue
 				}
-				if v.timestamp <= time.Now().UnixMilli()-PEER_INFO_TTL {
+				if v.timestamp <= e.clock.Now().UnixMilli()-PEER_INFO_TTL {
 					deletes = append(deletes, v)
 				}
 			}
@@ -460,7 +580,7 @@ func (e *DataClockConsensusEngine) Start() <-chan error {
 				if v == nil {
 					continue
 				}
-				if v.timestamp <= time.Now().UnixMilli()-UNCOOPERATIVE_PEER_INFO_TTL ||
+				if v.timestamp <= e.clock.Now().UnixMilli()-UNCOOPERATIVE_PEER_INFO_TTL ||
 					thresholdBeforeConfirming > 0 {
 					deletes = append(deletes, v)
 				}
@@ -468,6 +588,11 @@ func (e *DataClockConsensusEngine) Start() <-chan error {
 			for _, v := range deletes {
 				delete(e.uncooperativePeersMap, string(v.peerId))
 			}
+			for k, v := range e.unfulfilledClaims {
+				if v.lastStrike <= e.clock.Now().UnixMilli()-UNFULFILLED_CLAIM_TTL {
+					delete(e.unfulfilledClaims, k)
+				}
+			}
 			e.peerMapMx.Unlock()
 
 			e.logger.Info(
@@ -484,16 +609,21 @@ func (e *DataClockConsensusEngine) Start() <-chan error {
 				thresholdBeforeConfirming--
 			}
 
-			time.Sleep(120 * time.Second)
+			e.clock.Sleep(120 * time.Second)
 		}
 	}()
 
+	e.loadProverStats()
+
+	go e.diskSpace.Run(e.ctx)
 	go e.runLoop()
 	go e.runSync()
 	go e.runFramePruning()
+	go e.runFastVerify()
+	go e.runVersionCutoffMonitor()
 
 	go func() {
-		time.Sleep(30 * time.Second)
+		e.clock.Sleep(30 * time.Second)
 		e.logger.Info("checking for snapshots to play forward")
 		if err := e.downloadSnapshot(e.config.DB.Path, e.config.P2P.Network); err != nil {
 			e.logger.Debug("error downloading snapshot", zap.Error(err))
@@ -630,7 +760,7 @@ func (e *DataClockConsensusEngine) Stop(force bool) <-chan error {
 				},
 			},
 		},
-		Timestamp: time.Now().UnixMilli(),
+		Timestamp: e.clock.Now().UnixMilli(),
 	})
 
 	wg := sync.WaitGroup{}
@@ -688,6 +818,18 @@ func (e *DataClockConsensusEngine) GetFrame() *protobufs.ClockFrame {
 	return frame
 }
 
+// GetFinalizedFrameNumber returns the highest frame number at and below
+// which the data time reel no longer reorganizes.
+func (e *DataClockConsensusEngine) GetFinalizedFrameNumber() uint64 {
+	return e.dataTimeReel.FinalizedFrameNumber()
+}
+
+// SubscribeHead returns a channel notified whenever the head of the data time
+// reel changes, and a function ending the subscription.
+func (e *DataClockConsensusEngine) SubscribeHead() (<-chan struct{}, func()) {
+	return e.dataTimeReel.SubscribeHead()
+}
+
 func (e *DataClockConsensusEngine) GetState() consensus.EngineState {
 	e.stateMx.RLock()
 	defer e.stateMx.RUnlock()
@@ -0,0 +1,142 @@
+package data
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	"source.quilibrium.com/quilibrium/monorepo/node/consensus"
+	qtime "source.quilibrium.com/quilibrium/monorepo/node/consensus/time"
+	qcrypto "source.quilibrium.com/quilibrium/monorepo/node/crypto"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/keys"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+// DefaultEngine is the registered name of the data consensus engine used when
+// none is configured.
+const DefaultEngine = "data"
+
+// EngineParams are the dependencies handed to a data consensus engine. The
+// data time reel is owned by the execution engine, which applies frames to
+// its state, so engines do not create their own.
+type EngineParams struct {
+	Config          *config.Config
+	Logger          *zap.Logger
+	Clock           clock.Clock
+	KeyManager      keys.KeyManager
+	ClockStore      store.ClockStore
+	CoinStore       store.CoinStore
+	DataProofStore  store.DataProofStore
+	KeyStore        store.KeyStore
+	PubSub          p2p.PubSub
+	FrameProver     qcrypto.FrameProver
+	InclusionProver qcrypto.InclusionProver
+	MasterTimeReel  *qtime.MasterTimeReel
+	DataTimeReel    *qtime.DataTimeReel
+	PeerInfoManager p2p.PeerInfoManager
+	Report          *protobufs.SelfTestReport
+	Filter          []byte
+	Seed            []byte
+}
+
+// EngineFactory constructs a data consensus engine.
+type EngineFactory func(params *EngineParams) (
+	consensus.DataConsensusEngine,
+	error,
+)
+
+var (
+	enginesMx sync.RWMutex
+	engines   = map[string]EngineFactory{
+		DefaultEngine: func(params *EngineParams) (
+			consensus.DataConsensusEngine,
+			error,
+		) {
+			return NewDataClockConsensusEngine(
+				params.Config,
+				params.Logger,
+				params.Clock,
+				params.KeyManager,
+				params.ClockStore,
+				params.CoinStore,
+				params.DataProofStore,
+				params.KeyStore,
+				params.PubSub,
+				params.FrameProver,
+				params.InclusionProver,
+				params.MasterTimeReel,
+				params.DataTimeReel,
+				params.PeerInfoManager,
+				params.Report,
+				params.Filter,
+				params.Seed,
+			), nil
+		},
+	}
+)
+
+// RegisterEngine makes a data consensus engine implementation, such as a
+// follower-only or archival-only engine, selectable by name through the engine
+// config. Registering a name twice panics.
+func RegisterEngine(name string, factory EngineFactory) {
+	enginesMx.Lock()
+	defer enginesMx.Unlock()
+
+	if _, ok := engines[name]; ok {
+		panic("data consensus engine already registered: " + name)
+	}
+
+	engines[name] = factory
+}
+
+// Engines returns the names of all registered data consensus engines, sorted.
+func Engines() []string {
+	enginesMx.RLock()
+	defer enginesMx.RUnlock()
+
+	names := make([]string, 0, len(engines))
+	for name := range engines {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// NewEngineFactory returns the factory of the data consensus engine selected
+// by the engine config, falling back to DefaultEngine. An unknown name fails
+// here, at wiring time, rather than once the execution engine starts.
+func NewEngineFactory(
+	logger *zap.Logger,
+	engineConfig *config.EngineConfig,
+) (EngineFactory, error) {
+	name := engineConfig.ConsensusEngine
+	if name == "" {
+		name = DefaultEngine
+	}
+
+	enginesMx.RLock()
+	factory, ok := engines[name]
+	enginesMx.RUnlock()
+
+	if !ok {
+		return nil, errors.Wrap(
+			errors.Errorf("unknown data consensus engine %q", name),
+			"new engine factory",
+		)
+	}
+
+	if name != DefaultEngine {
+		logger.Info(
+			"using alternative data consensus engine",
+			zap.String("name", name),
+		)
+	}
+
+	return factory, nil
+}
@@ -0,0 +1,179 @@
+package data
+
+import (
+	"slices"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	qcrypto "source.quilibrium.com/quilibrium/monorepo/node/crypto"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+// Maximum number of synced frames awaiting background proof verification.
+// Once full, synced frames are fully verified inline again.
+const fastVerifyQueueSize = 1000
+
+var fastVerifyFramesTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "data",
+		Name:      "sync_fast_verify_frames_total",
+		Help:      "Synced frames whose proof verification was deferred to the background, by outcome.",
+	},
+	[]string{"result"},
+)
+
+func init() {
+	prometheus.MustRegister(fastVerifyFramesTotal)
+}
+
+type deferredFrame struct {
+	frame  *protobufs.ClockFrame
+	peerId []byte
+}
+
+// defersFrameProof reports whether the VDF proof of a synced frame may be
+// verified in the background. Only every SyncFastVerifyInterval-th frame is
+// spot checked inline, and fast verify is turned off for the rest of the
+// process once a deferred frame fails verification.
+func (e *DataClockConsensusEngine) defersFrameProof(
+	frame *protobufs.ClockFrame,
+) bool {
+	interval := uint64(max(e.config.Engine.SyncFastVerifyInterval, 0))
+	return interval > 1 &&
+		frame.FrameNumber%interval != 0 &&
+		!e.fastVerifyFailed.Load()
+}
+
+// deferFrameProof queues a synced frame for background proof verification,
+// reporting false if the queue is full. Queued frames are recorded in the
+// clock store until verified, so that a restart verifies them again.
+func (e *DataClockConsensusEngine) deferFrameProof(
+	frame *protobufs.ClockFrame,
+	peerId []byte,
+) bool {
+	if len(e.deferredFrames) == cap(e.deferredFrames) {
+		return false
+	}
+
+	if err := e.clockStore.PutDeferredDataClockFrame(
+		e.filter,
+		frame.FrameNumber,
+		peerId,
+	); err != nil {
+		e.logger.Warn("could not record deferred frame", zap.Error(err))
+		return false
+	}
+
+	select {
+	case e.deferredFrames <- &deferredFrame{frame: frame, peerId: peerId}:
+		return true
+	default:
+		e.forgetDeferredFrames(frame.FrameNumber, frame.FrameNumber+1)
+		return false
+	}
+}
+
+// restoreDeferredFrames verifies the frames a previous run deferred but did
+// not get to verify.
+func (e *DataClockConsensusEngine) restoreDeferredFrames() {
+	deferred, err := e.clockStore.GetDeferredDataClockFrames(e.filter)
+	if err != nil {
+		e.logger.Error("could not restore deferred frames", zap.Error(err))
+		return
+	}
+
+	frameNumbers := make([]uint64, 0, len(deferred))
+	for frameNumber := range deferred {
+		frameNumbers = append(frameNumbers, frameNumber)
+	}
+	slices.Sort(frameNumbers)
+
+	if len(frameNumbers) != 0 {
+		e.logger.Info(
+			"verifying frames deferred by a previous run",
+			zap.Int("frames", len(frameNumbers)),
+		)
+	}
+
+	for _, frameNumber := range frameNumbers {
+		if e.ctx.Err() != nil {
+			return
+		}
+
+		frame, _, err := e.clockStore.GetDataClockFrame(
+			e.filter,
+			frameNumber,
+			false,
+		)
+		if err != nil {
+			// Never committed, or since rolled back.
+			e.forgetDeferredFrames(frameNumber, frameNumber+1)
+			continue
+		}
+
+		e.verifyDeferredFrame(&deferredFrame{
+			frame:  frame,
+			peerId: deferred[frameNumber],
+		})
+	}
+}
+
+func (e *DataClockConsensusEngine) forgetDeferredFrames(from, to uint64) {
+	if err := e.clockStore.DeleteDeferredDataClockFrames(
+		e.filter,
+		from,
+		to,
+	); err != nil {
+		e.logger.Warn("could not forget deferred frames", zap.Error(err))
+	}
+}
+
+func (e *DataClockConsensusEngine) runFastVerify() {
+	e.restoreDeferredFrames()
+	for {
+		select {
+		case <-e.ctx.Done():
+			return
+		case d := <-e.deferredFrames:
+			e.verifyDeferredFrame(d)
+		}
+	}
+}
+
+func (e *DataClockConsensusEngine) verifyDeferredFrame(d *deferredFrame) {
+	err := e.tryVerifyDataClockFrame(d.frame, true)
+	switch {
+	case err == nil:
+		fastVerifyFramesTotal.WithLabelValues("valid").Inc()
+		e.forgetDeferredFrames(d.frame.FrameNumber, d.frame.FrameNumber+1)
+	case errors.Is(err, qcrypto.ErrInvalidFrame):
+		fastVerifyFramesTotal.WithLabelValues("invalid").Inc()
+		e.fastVerifyFailed.Store(true)
+		e.pubSub.AddPeerScore(d.peerId, -100000)
+		e.logger.Error(
+			"frame accepted during fast sync failed full verification, "+
+				"disabling fast verify and rolling back to its parent",
+			zap.Uint64("frame_number", d.frame.FrameNumber),
+			zap.String("peer_id", peer.ID(d.peerId).String()),
+			zap.Error(err),
+		)
+
+		// The frames above are synced again and, with fast verify disabled,
+		// fully verified inline.
+		if err := e.dataTimeReel.Rewind(d.frame.FrameNumber - 1); err != nil {
+			e.logger.Error("could not roll back invalid frame", zap.Error(err))
+			return
+		}
+		e.forgetDeferredFrames(d.frame.FrameNumber, 0xffffffffffffffff)
+	default:
+		// The record is kept, so that the frame is verified again on restart.
+		fastVerifyFramesTotal.WithLabelValues("error").Inc()
+		e.logger.Warn(
+			"local error verifying deferred frame",
+			zap.Uint64("frame_number", d.frame.FrameNumber),
+			zap.Error(err),
+		)
+	}
+}
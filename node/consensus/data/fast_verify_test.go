@@ -0,0 +1,188 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"strings"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/poseidon"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	qtime "source.quilibrium.com/quilibrium/monorepo/node/consensus/time"
+	qcrypto "source.quilibrium.com/quilibrium/monorepo/node/crypto"
+	"source.quilibrium.com/quilibrium/monorepo/node/keys"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+	"source.quilibrium.com/quilibrium/monorepo/node/tries"
+)
+
+// invalidFrameProver rejects the proof of a single frame.
+type invalidFrameProver struct {
+	qcrypto.FrameProver
+	invalid uint64
+}
+
+func (p *invalidFrameProver) VerifyDataClockFrameProof(
+	frame *protobufs.ClockFrame,
+) error {
+	if frame.FrameNumber == p.invalid {
+		return qcrypto.ErrInvalidFrame
+	}
+	return nil
+}
+
+type scoringPubSub struct {
+	pubsub
+	scores map[string]int64
+}
+
+func (p *scoringPubSub) AddPeerScore(peerId []byte, scoreDelta int64) {
+	p.scores[string(peerId)] += scoreDelta
+}
+
+// testReel is a running data time reel whose frames are proven by a single
+// prover.
+type testReel struct {
+	reel       *qtime.DataTimeReel
+	clockStore store.ClockStore
+	prover     qcrypto.FrameProver
+	signer     crypto.Signer
+	proverTrie *tries.RollingFrecencyCritbitTrie
+}
+
+// startTestReel starts a reel on filter with the given number of frames past
+// genesis.
+func startTestReel(t *testing.T, filter []byte, frames int64) *testReel {
+	logger := zap.NewNop()
+	clockStore := store.NewPebbleClockStore(store.NewInMemKVDB(), logger)
+	prover := qcrypto.NewWesolowskiFrameProver(logger)
+
+	keyManager := keys.NewInMemoryKeyManager()
+	_, err := keyManager.CreateSigningKey("proving-key", keys.KeyTypeEd448)
+	assert.NoError(t, err)
+	signer, err := keyManager.GetSigningKey("proving-key")
+	assert.NoError(t, err)
+	rawKey, err := keyManager.GetRawKey("proving-key")
+	assert.NoError(t, err)
+	address, err := poseidon.HashBytes(rawKey.PublicKey)
+	assert.NoError(t, err)
+	proverTrie := &tries.RollingFrecencyCritbitTrie{}
+	proverTrie.Add(address.FillBytes(make([]byte, 32)), 0)
+
+	reel := qtime.NewDataTimeReel(
+		filter,
+		logger,
+		clockStore,
+		&config.EngineConfig{
+			Filter:      strings.Repeat("00", 32),
+			GenesisSeed: strings.Repeat("00", 516),
+			Difficulty:  10,
+		},
+		prover,
+		func(
+			txn store.Transaction,
+			frame *protobufs.ClockFrame,
+			triesAtFrame []*tries.RollingFrecencyCritbitTrie,
+		) (
+			[]*tries.RollingFrecencyCritbitTrie,
+			error,
+		) {
+			return []*tries.RollingFrecencyCritbitTrie{proverTrie}, nil
+		},
+		nil,
+		bytes.Repeat([]byte{0x00}, 516),
+		&qcrypto.InclusionAggregateProof{
+			InclusionCommitments: []*qcrypto.InclusionCommitment{},
+			AggregateCommitment:  []byte{},
+			Proof:                []byte{},
+		},
+		[][]byte{rawKey.PublicKey},
+		true,
+		func() []*tries.RollingFrecencyCritbitTrie {
+			return []*tries.RollingFrecencyCritbitTrie{}
+		},
+	)
+	assert.NoError(t, reel.Start())
+	t.Cleanup(reel.Stop)
+
+	r := &testReel{
+		reel:       reel,
+		clockStore: clockStore,
+		prover:     prover,
+		signer:     signer,
+		proverTrie: proverTrie,
+	}
+	for i := int64(1); i <= frames; i++ {
+		assert.NoError(t, reel.Insert(r.next(t), false))
+		<-reel.NewFrameCh()
+	}
+	return r
+}
+
+// next proves the frame after the head.
+func (r *testReel) next(t *testing.T) *protobufs.ClockFrame {
+	head, err := r.reel.Head()
+	assert.NoError(t, err)
+	frame, err := r.prover.ProveDataClockFrame(
+		head,
+		[][]byte{},
+		[]*protobufs.InclusionAggregateProof{},
+		r.signer,
+		int64(head.FrameNumber+1),
+		10,
+	)
+	assert.NoError(t, err)
+	return frame
+}
+
+func TestFastVerifyRestoreAndRollback(t *testing.T) {
+	logger := zap.NewNop()
+	filter := bytes.Repeat([]byte{0x01}, 32)
+	r := startTestReel(t, filter, 5)
+	reel, clockStore, prover := r.reel, r.clockStore, r.prover
+
+	engine := func(frameProver qcrypto.FrameProver) *DataClockConsensusEngine {
+		return &DataClockConsensusEngine{
+			ctx:            context.Background(),
+			logger:         logger,
+			clockStore:     clockStore,
+			filter:         filter,
+			frameProver:    frameProver,
+			pubSub:         &scoringPubSub{scores: map[string]int64{}},
+			dataTimeReel:   reel,
+			deferredFrames: make(chan *deferredFrame, fastVerifyQueueSize),
+		}
+	}
+
+	// Deferred frames are recorded until verified, and a frame that was never
+	// committed is recorded as well.
+	first := engine(prover)
+	for frameNumber := uint64(3); frameNumber <= 4; frameNumber++ {
+		frame, _, err := clockStore.GetDataClockFrame(filter, frameNumber, false)
+		assert.NoError(t, err)
+		assert.True(t, first.deferFrameProof(frame, []byte{byte(frameNumber)}))
+	}
+	assert.NoError(t, clockStore.PutDeferredDataClockFrame(filter, 9, []byte{9}))
+
+	// After a restart, the recorded frames are verified again, and the first
+	// invalid one rolls the reel back to its parent.
+	restarted := engine(&invalidFrameProver{invalid: 4})
+	restarted.restoreDeferredFrames()
+
+	head, err := reel.Head()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), head.FrameNumber)
+	assert.True(t, restarted.fastVerifyFailed.Load())
+	assert.Equal(
+		t,
+		map[string]int64{"\x04": -100000},
+		restarted.pubSub.(*scoringPubSub).scores,
+	)
+
+	deferred, err := clockStore.GetDeferredDataClockFrames(filter)
+	assert.NoError(t, err)
+	assert.Empty(t, deferred)
+}
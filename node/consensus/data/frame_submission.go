@@ -0,0 +1,97 @@
+package data
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+
+	"github.com/iden3/go-iden3-crypto/poseidon"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/consensus"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+func rejectSubmittedFrame(err error) error {
+	return errors.Wrap(
+		errors.Wrap(consensus.ErrFrameSubmissionRejected, err.Error()),
+		"submit frame",
+	)
+}
+
+// isExternalProverKey reports whether the Ed448 public key is one of the
+// configured external prover keys.
+func (e *DataClockConsensusEngine) isExternalProverKey(key []byte) bool {
+	encoded := hex.EncodeToString(key)
+	for _, allowed := range e.config.Engine.ExternalProverKeys {
+		if strings.EqualFold(strings.TrimPrefix(allowed, "0x"), encoded) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SubmitFrame validates a frame proven by an external prover and, if it
+// extends the head, inserts and publishes it as though this node had proven
+// it. The frame's signature authenticates the submission: it must be made with
+// one of the configured external prover keys, by a prover in the prover trie.
+func (e *DataClockConsensusEngine) SubmitFrame(
+	frame *protobufs.ClockFrame,
+) error {
+	if frame == nil {
+		return rejectSubmittedFrame(errors.New("frame is nil"))
+	}
+
+	signature := frame.GetPublicKeySignatureEd448()
+	if signature == nil || signature.PublicKey == nil ||
+		!e.isExternalProverKey(signature.PublicKey.KeyValue) {
+		return errors.Wrap(consensus.ErrFrameSubmissionUnauthorized, "submit frame")
+	}
+
+	if !bytes.Equal(frame.Filter, e.filter) {
+		return rejectSubmittedFrame(errors.New("frame is for another filter"))
+	}
+
+	if e.maintenance.Load() || e.versionCutOff.Load() {
+		return rejectSubmittedFrame(errors.New("proving is paused"))
+	}
+
+	addr, err := poseidon.HashBytes(signature.PublicKey.KeyValue)
+	if err != nil {
+		return rejectSubmittedFrame(err)
+	}
+	if !e.GetFrameProverTries()[0].Contains(addr.FillBytes(make([]byte, 32))) {
+		return rejectSubmittedFrame(errors.New("prover not in prover trie"))
+	}
+
+	if err := e.frameProver.VerifyDataClockFrame(frame); err != nil {
+		return rejectSubmittedFrame(err)
+	}
+
+	head, err := e.dataTimeReel.Head()
+	if err != nil {
+		return errors.Wrap(err, "submit frame")
+	}
+	if frame.FrameNumber <= head.FrameNumber {
+		return rejectSubmittedFrame(errors.Errorf(
+			"frame %d is not ahead of head %d",
+			frame.FrameNumber,
+			head.FrameNumber,
+		))
+	}
+
+	if e.diskSpace.Low() {
+		return rejectSubmittedFrame(errors.New("disk space low"))
+	}
+
+	e.logger.Info(
+		"publishing externally proven frame",
+		zap.Uint64("frame_number", frame.FrameNumber),
+	)
+	if err := e.dataTimeReel.Insert(frame, false); err != nil {
+		return errors.Wrap(err, "submit frame")
+	}
+
+	return errors.Wrap(e.publishProof(frame), "submit frame")
+}
@@ -0,0 +1,115 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	"source.quilibrium.com/quilibrium/monorepo/node/consensus"
+	"source.quilibrium.com/quilibrium/monorepo/node/consensus/data/internal"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/tries"
+)
+
+type publishingPubSub struct {
+	pubsub
+	mx        sync.Mutex
+	published [][]byte
+}
+
+func (p *publishingPubSub) PublishContext(
+	ctx context.Context,
+	bitmask []byte,
+	data []byte,
+) error {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+	p.published = append(p.published, bitmask)
+	return nil
+}
+
+func TestSubmitFrame(t *testing.T) {
+	filter := bytes.Repeat([]byte{0x01}, 32)
+	r := startTestReel(t, filter, 2)
+	key := r.next(t).GetPublicKeySignatureEd448().PublicKey.KeyValue
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	clk := clock.NewFakeClock(time.UnixMilli(1700000000000))
+	ps := &publishingPubSub{}
+	e := &DataClockConsensusEngine{
+		ctx:              ctx,
+		logger:           zap.NewNop(),
+		clock:            clk,
+		config:           &config.Config{Engine: &config.EngineConfig{}},
+		filter:           filter,
+		frameFilter:      append([]byte{0x00, 0x00, 0x00}, filter...),
+		frameProver:      r.prover,
+		pubSub:           ps,
+		dataTimeReel:     r.reel,
+		frameProverTries: []*tries.RollingFrecencyCritbitTrie{r.proverTrie},
+		peerMap:          map[string]*peerInfo{},
+		headAnnouncer: internal.NewDampener(
+			ctx,
+			clk,
+			0,
+			func(*protobufs.ClockFrame) {},
+		),
+	}
+
+	// Only frames signed with a configured external prover key are accepted.
+	frame := r.next(t)
+	assert.ErrorIs(
+		t,
+		e.SubmitFrame(frame),
+		consensus.ErrFrameSubmissionUnauthorized,
+	)
+	e.config.Engine.ExternalProverKeys = []string{"0x" + hex.EncodeToString(key)}
+
+	// The key must also be a prover's.
+	e.frameProverTries = []*tries.RollingFrecencyCritbitTrie{{}}
+	assert.ErrorIs(
+		t,
+		e.SubmitFrame(frame),
+		consensus.ErrFrameSubmissionRejected,
+	)
+	e.frameProverTries = []*tries.RollingFrecencyCritbitTrie{r.proverTrie}
+
+	// Frames that do not verify are rejected.
+	tampered := proto.Clone(frame).(*protobufs.ClockFrame)
+	tampered.Timestamp++
+	assert.ErrorIs(
+		t,
+		e.SubmitFrame(tampered),
+		consensus.ErrFrameSubmissionRejected,
+	)
+
+	// As are frames not ahead of the head.
+	head, err := r.reel.Head()
+	assert.NoError(t, err)
+	assert.ErrorIs(
+		t,
+		e.SubmitFrame(head),
+		consensus.ErrFrameSubmissionRejected,
+	)
+	assert.Empty(t, ps.published)
+
+	// Accepted frames extend the head and are published as proven locally.
+	assert.NoError(t, e.SubmitFrame(frame))
+	for inserted := <-r.reel.NewFrameCh(); inserted.FrameNumber < frame.FrameNumber; {
+		inserted = <-r.reel.NewFrameCh()
+	}
+	head, err = r.reel.Head()
+	assert.NoError(t, err)
+	assert.Equal(t, frame.Output, head.Output)
+	assert.Equal(t, [][]byte{e.frameFilter}, ps.published)
+	assert.Equal(t, frame.FrameNumber, e.peerMap[string(ps.GetPeerID())].maxFrame)
+}
@@ -0,0 +1,66 @@
+package data
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	qcrypto "source.quilibrium.com/quilibrium/monorepo/node/crypto"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+// Sources of the frames verified.
+const (
+	frameSourceGossip = "gossip"
+	frameSourceSync   = "sync"
+)
+
+// Stages of frame verification, in the order they run. The header signature
+// and prover checks are cheap, so frames failing them never reach the VDF
+// proof verification.
+const (
+	frameStageSignature = "signature"
+	frameStageProver    = "prover"
+	frameStageProof     = "proof"
+)
+
+var framesRejectedTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "data",
+		Name:      "frames_rejected_total",
+		Help:      "Frames rejected as invalid, by source and verification stage.",
+	},
+	[]string{"source", "stage"},
+)
+
+func init() {
+	prometheus.MustRegister(framesRejectedTotal)
+}
+
+func rejectFrame(source string, stage string) {
+	framesRejectedTotal.WithLabelValues(source, stage).Inc()
+}
+
+// verifyFrameSignature verifies the header of a frame, its Ed448 signature
+// included, but not its proof.
+func (e *DataClockConsensusEngine) verifyFrameSignature(
+	source string,
+	frame *protobufs.ClockFrame,
+) error {
+	err := e.frameProver.VerifyDataClockFrameSignature(frame)
+	if errors.Is(err, qcrypto.ErrInvalidFrame) {
+		rejectFrame(source, frameStageSignature)
+	}
+	return err
+}
+
+// verifyFrameProof verifies the VDF proof of a frame whose signature was
+// verified with verifyFrameSignature.
+func (e *DataClockConsensusEngine) verifyFrameProof(
+	source string,
+	frame *protobufs.ClockFrame,
+) error {
+	err := e.frameProver.VerifyDataClockFrameProof(frame)
+	if errors.Is(err, qcrypto.ErrInvalidFrame) {
+		rejectFrame(source, frameStageProof)
+	}
+	return err
+}
@@ -0,0 +1,216 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/iden3/go-iden3-crypto/poseidon"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	qcrypto "source.quilibrium.com/quilibrium/monorepo/node/crypto"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/tries"
+)
+
+// countingFrameProver counts the frame verifications, failing the signatures
+// of the frames numbered invalid.
+type countingFrameProver struct {
+	qcrypto.FrameProver
+	invalid    uint64
+	signatures int
+	proofs     int
+}
+
+func (p *countingFrameProver) VerifyDataClockFrameSignature(
+	frame *protobufs.ClockFrame,
+) error {
+	p.signatures++
+	if frame.FrameNumber == p.invalid {
+		return qcrypto.ErrInvalidFrame
+	}
+	return nil
+}
+
+func (p *countingFrameProver) VerifyDataClockFrameProof(
+	frame *protobufs.ClockFrame,
+) error {
+	p.proofs++
+	return nil
+}
+
+func TestVerifySyncedFrameOrder(t *testing.T) {
+	prover := bytes.Repeat([]byte{0x01}, 57)
+	address, err := poseidon.HashBytes(prover)
+	assert.NoError(t, err)
+	proverTrie := &tries.RollingFrecencyCritbitTrie{}
+	proverTrie.Add(address.FillBytes(make([]byte, 32)), 0)
+
+	frameProver := &countingFrameProver{invalid: 3}
+	e := &DataClockConsensusEngine{
+		ctx:              context.Background(),
+		logger:           zap.NewNop(),
+		frameProver:      frameProver,
+		frameProverTries: []*tries.RollingFrecencyCritbitTrie{proverTrie},
+	}
+	frame := func(frameNumber uint64, key []byte) *protobufs.ClockFrame {
+		return &protobufs.ClockFrame{
+			FrameNumber: frameNumber,
+			PublicKeySignature: &protobufs.ClockFrame_PublicKeySignatureEd448{
+				PublicKeySignatureEd448: &protobufs.Ed448Signature{
+					PublicKey: &protobufs.Ed448PublicKey{KeyValue: key},
+				},
+			},
+		}
+	}
+	rejected := func(stage string) float64 {
+		return testutil.ToFloat64(
+			framesRejectedTotal.WithLabelValues(frameSourceSync, stage),
+		)
+	}
+	signatureRejected := rejected(frameStageSignature)
+	proverRejected := rejected(frameStageProver)
+
+	assert.NoError(t, e.verifySyncedFrame(frame(1, prover), nil, true))
+	assert.Equal(t, 1, frameProver.signatures)
+	assert.Equal(t, 1, frameProver.proofs)
+
+	// A frame of a prover outside the trie is rejected before its proof is
+	// verified.
+	err = e.verifySyncedFrame(
+		frame(2, bytes.Repeat([]byte{0x02}, 57)),
+		nil,
+		true,
+	)
+	assert.True(t, errors.Is(err, qcrypto.ErrInvalidFrame))
+	assert.Equal(t, 2, frameProver.signatures)
+	assert.Equal(t, 1, frameProver.proofs)
+	assert.Equal(t, proverRejected+1, rejected(frameStageProver))
+
+	// And so is a frame whose signature is invalid, before its prover is
+	// looked up.
+	err = e.verifySyncedFrame(frame(3, prover), nil, true)
+	assert.True(t, errors.Is(err, qcrypto.ErrInvalidFrame))
+	assert.Equal(t, 3, frameProver.signatures)
+	assert.Equal(t, 1, frameProver.proofs)
+	assert.Equal(t, signatureRejected+1, rejected(frameStageSignature))
+	assert.Equal(t, proverRejected+1, rejected(frameStageProver))
+}
+
+// failingFrameProver fails every signature verification, with err or by
+// panicking if err is nil.
+type failingFrameProver struct {
+	qcrypto.FrameProver
+	err      error
+	attempts atomic.Int32
+}
+
+func (p *failingFrameProver) VerifyDataClockFrameSignature(
+	frame *protobufs.ClockFrame,
+) error {
+	p.attempts.Add(1)
+	if p.err == nil {
+		panic("prover failure")
+	}
+	return p.err
+}
+
+func TestSyncVerifyFailures(t *testing.T) {
+	prover := bytes.Repeat([]byte{0x01}, 57)
+	address, err := poseidon.HashBytes(prover)
+	assert.NoError(t, err)
+	proverTrie := &tries.RollingFrecencyCritbitTrie{}
+	proverTrie.Add(address.FillBytes(make([]byte, 32)), 0)
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	protobufs.RegisterDataServiceServer(server, &headDataService{
+		head: &protobufs.ClockFrame{
+			FrameNumber: 2,
+			PublicKeySignature: &protobufs.ClockFrame_PublicKeySignatureEd448{
+				PublicKeySignatureEd448: &protobufs.Ed448Signature{
+					PublicKey: &protobufs.Ed448PublicKey{KeyValue: prover},
+				},
+			},
+		},
+	})
+	go server.Serve(listener)
+	defer server.Stop()
+
+	peerId := []byte("peer")
+	newEngine := func(
+		frameProver qcrypto.FrameProver,
+	) (*DataClockConsensusEngine, *clock.FakeClock) {
+		clk := clock.NewFakeClock(time.UnixMilli(1700000000000))
+		return &DataClockConsensusEngine{
+			ctx:              context.Background(),
+			logger:           zap.NewNop(),
+			config:           &config.Config{Engine: &config.EngineConfig{}},
+			clock:            clk,
+			frameProver:      frameProver,
+			frameProverTries: []*tries.RollingFrecencyCritbitTrie{proverTrie},
+			pubSub:           &directChannelPubSub{listener: listener},
+			peerMap: map[string]*peerInfo{
+				string(peerId): {peerId: peerId, maxFrame: 2},
+			},
+			uncooperativePeersMap: map[string]*peerInfo{},
+			unfulfilledClaims:     map[string]*unfulfilledClaim{},
+		}, clk
+	}
+	latest := &protobufs.ClockFrame{FrameNumber: 1}
+
+	// A frame proven invalid fails at once, and the peer is no longer synced
+	// with.
+	frameProver := &failingFrameProver{err: qcrypto.ErrInvalidFrame}
+	e, _ := newEngine(frameProver)
+	_, err = e.sync(latest, 2, peerId)
+	assert.ErrorIs(t, err, qcrypto.ErrInvalidFrame)
+	assert.Equal(t, int32(1), frameProver.attempts.Load())
+	assert.True(t, e.isUncooperative(peerId))
+
+	// Local failures, prover panics included, are retried with a growing wait,
+	// and the peer is given up on for this sync without penalty.
+	for _, frameProver := range []*failingFrameProver{
+		{err: errors.New("local failure")},
+		{},
+	} {
+		e, clk := newEngine(frameProver)
+		done := make(chan error, 1)
+		go func() {
+			_, err := e.sync(latest, 2, peerId)
+			done <- err
+		}()
+		// The sync timeout is cancelled once the frame is fetched, so the only
+		// timer left after each attempt is the wait before the next.
+		for attempt := 1; attempt <= maxSyncVerifyAttempts; attempt++ {
+			assert.Eventually(t, func() bool {
+				return frameProver.attempts.Load() == int32(attempt)
+			}, 10*time.Second, time.Millisecond)
+			clk.BlockUntil(1)
+			clk.Advance(time.Duration(attempt) * syncVerifyRetryBackoff)
+		}
+		select {
+		case err := <-done:
+			assert.Error(t, err)
+			assert.NotErrorIs(t, err, qcrypto.ErrInvalidFrame)
+		case <-time.After(10 * time.Second):
+			t.Fatal("sync did not give up")
+		}
+		assert.Equal(
+			t,
+			int32(maxSyncVerifyAttempts),
+			frameProver.attempts.Load(),
+		)
+		assert.False(t, e.isUncooperative(peerId))
+		assert.Contains(t, e.peerMap, string(peerId))
+		assert.Empty(t, e.unfulfilledClaims)
+	}
+}
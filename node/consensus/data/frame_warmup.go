@@ -0,0 +1,135 @@
+package data
+
+import (
+	"sync/atomic"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/cas"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+// warmFromDirectPeers asks the configured direct peers for their head frames
+// right after startup, before peer list announcements have been heard. Heads
+// ahead of ours are verified and handed to the time reel, which keeps them
+// pending until sync fills the gap, and the peers become sync candidates so
+// that sync does not have to wait for discovery.
+func (e *DataClockConsensusEngine) warmFromDirectPeers() {
+	for _, addr := range e.config.P2P.DirectPeers {
+		info, err := peer.AddrInfoFromString(addr)
+		if err != nil {
+			continue
+		}
+		frame, err := e.getDirectPeerHead([]byte(info.ID))
+		if err != nil {
+			e.logger.Debug(
+				"could not get head frame of direct peer",
+				zap.String("peer_id", info.ID.String()),
+				zap.Error(err),
+			)
+			continue
+		}
+		e.logger.Info(
+			"received head frame of direct peer",
+			zap.String("peer_id", info.ID.String()),
+			zap.Uint64("frame_number", frame.FrameNumber),
+		)
+	}
+}
+
+func (e *DataClockConsensusEngine) getDirectPeerHead(
+	peerId []byte,
+) (*protobufs.ClockFrame, error) {
+	head, err := e.dataTimeReel.Head()
+	if err != nil {
+		return nil, errors.Wrap(err, "get direct peer head")
+	}
+
+	cc, err := e.pubSub.GetDirectChannel(peerId, "sync")
+	if err != nil {
+		return nil, errors.Wrap(err, "get direct peer head")
+	}
+	defer func() {
+		if err := cc.Close(); err != nil {
+			e.logger.Error("error while closing connection", zap.Error(err))
+		}
+	}()
+
+	syncTimeout := e.config.Engine.SyncTimeout
+	if syncTimeout == 0 {
+		syncTimeout = defaultSyncTimeout
+	}
+	ctx, cancel := clock.WithTimeout(e.ctx, e.clock, syncTimeout)
+	defer cancel()
+
+	// Frame number zero asks for the peer's head.
+	response, err := protobufs.NewDataServiceClient(cc).GetDataFrame(
+		ctx,
+		&protobufs.GetDataFrameRequest{},
+		grpc.MaxCallRecvMsgSize(600*1024*1024),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "get direct peer head")
+	}
+	frame := response.GetClockFrame()
+	if frame == nil {
+		return nil, errors.Wrap(
+			errors.New("no head frame"),
+			"get direct peer head",
+		)
+	}
+	if frame.FrameNumber <= head.FrameNumber {
+		return frame, nil
+	}
+
+	if err := e.verifySyncedFrame(frame, peerId, true); err != nil {
+		return nil, errors.Wrap(err, "get direct peer head")
+	}
+	if err := e.dataTimeReel.Insert(frame, true); err != nil {
+		return nil, errors.Wrap(err, "get direct peer head")
+	}
+	cas.IfLessThanUint64(&e.directPeerHead, frame.FrameNumber)
+
+	e.peerMapMx.Lock()
+	_, uncooperative := e.uncooperativePeersMap[string(peerId)]
+	existing, ok := e.peerMap[string(peerId)]
+	if !uncooperative && (!ok || existing.timestamp < frame.Timestamp) {
+		// The version of the peer is unknown until it announces itself, so it
+		// only becomes a sync candidate once its connection metadata vouches
+		// for a current one. The entry is replaced by the announcement.
+		e.peerMap[string(peerId)] = &peerInfo{
+			peerId:    peerId,
+			multiaddr: e.pubSub.GetMultiaddrOfPeer(peerId),
+			maxFrame:  frame.FrameNumber,
+			lastSeen:  e.clock.Now().Unix(),
+			timestamp: frame.Timestamp,
+			region:    e.peerRegion(peerId, ""),
+		}
+	}
+	e.peerMapMx.Unlock()
+
+	select {
+	case <-e.ctx.Done():
+	case e.requestSyncCh <- nil:
+	default:
+	}
+
+	return frame, nil
+}
+
+// GetNetworkHeadEstimate returns the highest frame number known to exist,
+// from our head, the frames received over pubsub and the heads of the direct
+// peers queried at startup.
+func (e *DataClockConsensusEngine) GetNetworkHeadEstimate() uint64 {
+	estimate := max(
+		atomic.LoadUint64(&e.latestFrameReceived),
+		atomic.LoadUint64(&e.directPeerHead),
+	)
+	if head, err := e.dataTimeReel.Head(); err == nil {
+		estimate = max(estimate, head.FrameNumber)
+	}
+	return estimate
+}
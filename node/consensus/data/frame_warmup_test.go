@@ -0,0 +1,118 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	qcrypto "source.quilibrium.com/quilibrium/monorepo/node/crypto"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/tries"
+)
+
+// headDataService serves head as the head frame.
+type headDataService struct {
+	protobufs.UnimplementedDataServiceServer
+	head *protobufs.ClockFrame
+}
+
+func (s *headDataService) GetDataFrame(
+	ctx context.Context,
+	req *protobufs.GetDataFrameRequest,
+) (*protobufs.DataFrameResponse, error) {
+	return &protobufs.DataFrameResponse{ClockFrame: s.head}, nil
+}
+
+// directChannelPubSub dials its direct channels to the listener.
+type directChannelPubSub struct {
+	pubsub
+	listener *bufconn.Listener
+}
+
+func (p *directChannelPubSub) GetDirectChannel(
+	peerId []byte,
+	purpose string,
+) (*grpc.ClientConn, error) {
+	return grpc.NewClient(
+		"passthrough:///direct",
+		grpc.WithContextDialer(
+			func(ctx context.Context, _ string) (net.Conn, error) {
+				return p.listener.DialContext(ctx)
+			},
+		),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+}
+
+func TestGetDirectPeerHead(t *testing.T) {
+	filter := bytes.Repeat([]byte{0x01}, 32)
+	r := startTestReel(t, filter, 2)
+
+	listener := bufconn.Listen(1 << 20)
+	service := &headDataService{}
+	server := grpc.NewServer()
+	protobufs.RegisterDataServiceServer(server, service)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	e := &DataClockConsensusEngine{
+		ctx:         context.Background(),
+		logger:      zap.NewNop(),
+		config:      &config.Config{Engine: &config.EngineConfig{}},
+		clock:       clock.NewRealClock(),
+		frameProver: r.prover,
+		frameProverTries: []*tries.RollingFrecencyCritbitTrie{
+			r.proverTrie,
+		},
+		pubSub:                &directChannelPubSub{listener: listener},
+		dataTimeReel:          r.reel,
+		peerMap:               map[string]*peerInfo{},
+		uncooperativePeersMap: map[string]*peerInfo{},
+	}
+	peerId := []byte("direct")
+
+	// A head no further than ours is returned but not inserted.
+	service.head, _ = r.reel.Head()
+	frame, err := e.getDirectPeerHead(peerId)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), frame.FrameNumber)
+	assert.Empty(t, e.peerMap)
+
+	// A forged head is refused.
+	forged := proto.Clone(r.next(t)).(*protobufs.ClockFrame)
+	forged.Timestamp++
+	service.head = forged
+	_, err = e.getDirectPeerHead(peerId)
+	assert.True(t, errors.Is(err, qcrypto.ErrInvalidFrame))
+	assert.Empty(t, e.peerMap)
+
+	// A head ahead of ours is inserted, and the peer becomes a sync candidate
+	// without a version until it announces one.
+	service.head = r.next(t)
+	frame, err = e.getDirectPeerHead(peerId)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), frame.FrameNumber)
+	select {
+	case inserted := <-r.reel.NewFrameCh():
+		assert.Equal(t, uint64(3), inserted.FrameNumber)
+	case <-time.After(10 * time.Second):
+		assert.Fail(t, "frame was not inserted")
+	}
+	assert.Equal(t, uint64(3), e.directPeerHead)
+	assert.Equal(t, uint64(3), e.GetNetworkHeadEstimate())
+	info := e.peerMap[string(peerId)]
+	assert.NotNil(t, info)
+	assert.Equal(t, uint64(3), info.maxFrame)
+	assert.Nil(t, info.version)
+}
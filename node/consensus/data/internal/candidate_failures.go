@@ -0,0 +1,89 @@
+package internal
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+)
+
+// A candidate is skipped while its decayed failure count is above this, so a
+// single failure skips it for one half-life and every further failure adds
+// about another half-life.
+const candidateFailureThreshold = 0.5
+
+type candidateFailure struct {
+	count   float64
+	updated time.Time
+}
+
+// CandidateFailures remembers the sync candidates that recently failed, so
+// that they are skipped for a while rather than retried every collect round.
+// Failure counts halve every half-life.
+type CandidateFailures struct {
+	clock    clock.Clock
+	halfLife time.Duration
+
+	mx       sync.Mutex
+	failures map[string]*candidateFailure
+}
+
+// NewCandidateFailures remembers failures with the given half-life.
+func NewCandidateFailures(
+	clk clock.Clock,
+	halfLife time.Duration,
+) *CandidateFailures {
+	return &CandidateFailures{
+		clock:    clk,
+		halfLife: halfLife,
+		failures: map[string]*candidateFailure{},
+	}
+}
+
+// decayed must be called with mx held.
+func (c *CandidateFailures) decayed(f *candidateFailure, now time.Time) float64 {
+	elapsed := now.Sub(f.updated)
+	return f.count * math.Pow(0.5, float64(elapsed)/float64(c.halfLife))
+}
+
+// RecordFailure counts a failure of the peer.
+func (c *CandidateFailures) RecordFailure(peerID []byte) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	now := c.clock.Now()
+	f, ok := c.failures[string(peerID)]
+	if !ok {
+		f = &candidateFailure{updated: now}
+		c.failures[string(peerID)] = f
+	}
+	f.count = c.decayed(f, now) + 1
+	f.updated = now
+}
+
+// RecordSuccess forgets the failures of the peer.
+func (c *CandidateFailures) RecordSuccess(peerID []byte) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	delete(c.failures, string(peerID))
+}
+
+// Skip reports whether the peer failed too recently to be tried again.
+func (c *CandidateFailures) Skip(peerID []byte) bool {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	f, ok := c.failures[string(peerID)]
+	if !ok {
+		return false
+	}
+
+	if c.decayed(f, c.clock.Now()) > candidateFailureThreshold {
+		return true
+	}
+
+	delete(c.failures, string(peerID))
+	return false
+}
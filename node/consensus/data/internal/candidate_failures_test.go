@@ -0,0 +1,38 @@
+package internal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"source.quilibrium.com/quilibrium/monorepo/node/consensus/data/internal"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+)
+
+func TestCandidateFailures(t *testing.T) {
+	clk := clock.NewFakeClock(time.Unix(0, 0))
+	failures := internal.NewCandidateFailures(clk, time.Minute)
+	a, b := []byte("a"), []byte("b")
+
+	assert.False(t, failures.Skip(a))
+
+	failures.RecordFailure(a)
+	assert.True(t, failures.Skip(a))
+	assert.False(t, failures.Skip(b))
+
+	clk.Advance(61 * time.Second)
+	assert.False(t, failures.Skip(a))
+
+	// Repeated failures are skipped for longer.
+	failures.RecordFailure(a)
+	failures.RecordFailure(a)
+	failures.RecordFailure(a)
+	clk.Advance(2 * time.Minute)
+	assert.True(t, failures.Skip(a))
+	clk.Advance(time.Minute)
+	assert.False(t, failures.Skip(a))
+
+	failures.RecordFailure(b)
+	failures.RecordSuccess(b)
+	assert.False(t, failures.Skip(b))
+}
@@ -0,0 +1,79 @@
+package internal
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+)
+
+// Loads that decayed below this are forgotten.
+const candidateLoadForgetThreshold = 0.01
+
+type candidateLoad struct {
+	count   float64
+	updated time.Time
+}
+
+// CandidateLoad remembers how often the sync candidates were recently synced
+// from, so that sync spreads over the candidates instead of returning to the
+// same well-known peer every round. Sync counts halve every half-life.
+type CandidateLoad struct {
+	clock    clock.Clock
+	halfLife time.Duration
+
+	mx    sync.Mutex
+	loads map[string]*candidateLoad
+}
+
+// NewCandidateLoad remembers syncs with the given half-life.
+func NewCandidateLoad(
+	clk clock.Clock,
+	halfLife time.Duration,
+) *CandidateLoad {
+	return &CandidateLoad{
+		clock:    clk,
+		halfLife: halfLife,
+		loads:    map[string]*candidateLoad{},
+	}
+}
+
+// decayed must be called with mx held.
+func (c *CandidateLoad) decayed(l *candidateLoad, now time.Time) float64 {
+	elapsed := now.Sub(l.updated)
+	return l.count * math.Pow(0.5, float64(elapsed)/float64(c.halfLife))
+}
+
+// RecordSync counts a sync from the peer.
+func (c *CandidateLoad) RecordSync(peerID []byte) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	now := c.clock.Now()
+	l, ok := c.loads[string(peerID)]
+	if !ok {
+		l = &candidateLoad{updated: now}
+		c.loads[string(peerID)] = l
+	}
+	l.count = c.decayed(l, now) + 1
+	l.updated = now
+}
+
+// Load returns the decayed count of recent syncs from the peer.
+func (c *CandidateLoad) Load(peerID []byte) float64 {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	l, ok := c.loads[string(peerID)]
+	if !ok {
+		return 0
+	}
+
+	load := c.decayed(l, c.clock.Now())
+	if load < candidateLoadForgetThreshold {
+		delete(c.loads, string(peerID))
+		return 0
+	}
+	return load
+}
@@ -0,0 +1,32 @@
+package internal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"source.quilibrium.com/quilibrium/monorepo/node/consensus/data/internal"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+)
+
+func TestCandidateLoad(t *testing.T) {
+	clk := clock.NewFakeClock(time.Unix(0, 0))
+	load := internal.NewCandidateLoad(clk, time.Minute)
+	a, b := []byte("a"), []byte("b")
+
+	assert.Zero(t, load.Load(a))
+
+	load.RecordSync(a)
+	load.RecordSync(a)
+	assert.InDelta(t, 2, load.Load(a), 1e-9)
+	assert.Zero(t, load.Load(b))
+
+	clk.Advance(time.Minute)
+	assert.InDelta(t, 1, load.Load(a), 1e-9)
+
+	load.RecordSync(a)
+	assert.InDelta(t, 2, load.Load(a), 1e-9)
+
+	clk.Advance(time.Hour)
+	assert.Zero(t, load.Load(a))
+}
@@ -0,0 +1,85 @@
+package internal
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+)
+
+// Dampener coalesces bursts of updates, passing at most one update per window
+// on to a function. The first update after a quiet window passes immediately,
+// and the updates arriving within the window after it are coalesced into one
+// passed at the end of the window, with the latest value.
+type Dampener[T any] struct {
+	ctx    context.Context
+	clock  clock.Clock
+	window time.Duration
+	fn     func(T)
+
+	mx      sync.Mutex
+	last    time.Time
+	pending bool
+	latest  T
+}
+
+// NewDampener creates a dampener passing updates on to fn until ctx is done.
+// A window of zero or less passes every update.
+func NewDampener[T any](
+	ctx context.Context,
+	clk clock.Clock,
+	window time.Duration,
+	fn func(T),
+) *Dampener[T] {
+	return &Dampener[T]{ctx: ctx, clock: clk, window: window, fn: fn}
+}
+
+// Update passes v on now if the window since the last update passed has
+// elapsed, or otherwise at the end of the window unless a later update
+// replaces it. It reports whether v was coalesced rather than passed now.
+func (d *Dampener[T]) Update(v T) bool {
+	d.mx.Lock()
+	if d.window <= 0 {
+		d.mx.Unlock()
+		d.fn(v)
+		return false
+	}
+
+	d.latest = v
+	if d.pending {
+		d.mx.Unlock()
+		return true
+	}
+
+	now := d.clock.Now()
+	elapsed := now.Sub(d.last)
+	if d.last.IsZero() || elapsed >= d.window {
+		d.last = now
+		d.mx.Unlock()
+		d.fn(v)
+		return false
+	}
+
+	d.pending = true
+	// The wait starts now rather than in the goroutine, so that it does not
+	// depend on when the goroutine is scheduled.
+	after := d.clock.After(d.window - elapsed)
+	d.mx.Unlock()
+
+	go func() {
+		select {
+		case <-d.ctx.Done():
+			return
+		case <-after:
+		}
+
+		d.mx.Lock()
+		v := d.latest
+		d.pending = false
+		d.last = d.clock.Now()
+		d.mx.Unlock()
+		d.fn(v)
+	}()
+	return true
+}
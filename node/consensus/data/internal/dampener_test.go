@@ -0,0 +1,54 @@
+package internal_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"source.quilibrium.com/quilibrium/monorepo/node/consensus/data/internal"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+)
+
+func TestDampener(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	clk := clock.NewFakeClock(time.Unix(0, 0))
+	passed := make(chan int, 10)
+	dampener := internal.NewDampener(
+		ctx,
+		clk,
+		time.Second,
+		func(v int) { passed <- v },
+	)
+
+	// The first update passes immediately.
+	assert.False(t, dampener.Update(1))
+	assert.Equal(t, 1, <-passed)
+
+	// A burst is coalesced into its latest update at the end of the window.
+	assert.True(t, dampener.Update(2))
+	assert.True(t, dampener.Update(3))
+	clk.Advance(500 * time.Millisecond)
+	assert.True(t, dampener.Update(4))
+	assert.Empty(t, passed)
+	clk.Advance(500 * time.Millisecond)
+	assert.Equal(t, 4, <-passed)
+
+	// Updates after a quiet window pass immediately again.
+	clk.Advance(time.Second)
+	assert.False(t, dampener.Update(5))
+	assert.Equal(t, 5, <-passed)
+
+	undampened := internal.NewDampener(
+		ctx,
+		clk,
+		0,
+		func(v int) { passed <- v },
+	)
+	assert.False(t, undampened.Update(6))
+	assert.False(t, undampened.Update(7))
+	assert.Equal(t, 6, <-passed)
+	assert.Equal(t, 7, <-passed)
+}
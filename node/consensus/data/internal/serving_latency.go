@@ -0,0 +1,143 @@
+package internal
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+)
+
+const (
+	// Summaries are recomputed at most this often, so that checking the
+	// objective on every request does not sort the samples every time.
+	servingLatencyRefresh = time.Second
+	// The objective is only held breached over at least this many requests,
+	// so that a few slow requests after a quiet period do not refuse service.
+	servingLatencyMinSamples = 20
+)
+
+type latencySample struct {
+	at      time.Time
+	latency time.Duration
+	failed  bool
+}
+
+// LatencySummary is the latency and error count of the requests served over
+// the window.
+type LatencySummary struct {
+	Requests int
+	Errors   int
+	P50      time.Duration
+	P95      time.Duration
+	P99      time.Duration
+}
+
+// ErrorRate returns the share of the requests that failed.
+func (s LatencySummary) ErrorRate() float64 {
+	if s.Requests == 0 {
+		return 0
+	}
+	return float64(s.Errors) / float64(s.Requests)
+}
+
+// ServingLatency tracks the latency and errors of the requests served over a
+// sliding window, keeping at most a number of the latest requests.
+type ServingLatency struct {
+	clock  clock.Clock
+	window time.Duration
+
+	mx         sync.Mutex
+	samples    []latencySample
+	next       int
+	summary    LatencySummary
+	latencies  []time.Duration
+	summarized time.Time
+}
+
+// NewServingLatency tracks the requests of the last window, up to maxSamples
+// of them.
+func NewServingLatency(
+	clk clock.Clock,
+	window time.Duration,
+	maxSamples int,
+) *ServingLatency {
+	return &ServingLatency{
+		clock:   clk,
+		window:  window,
+		samples: make([]latencySample, 0, maxSamples),
+	}
+}
+
+// Record accounts a served request.
+func (s *ServingLatency) Record(latency time.Duration, failed bool) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	sample := latencySample{at: s.clock.Now(), latency: latency, failed: failed}
+	if len(s.samples) < cap(s.samples) {
+		s.samples = append(s.samples, sample)
+		return
+	}
+	s.samples[s.next] = sample
+	s.next = (s.next + 1) % len(s.samples)
+}
+
+// refresh must be called with mx held.
+func (s *ServingLatency) refresh() {
+	now := s.clock.Now()
+	if !s.summarized.IsZero() && now.Sub(s.summarized) < servingLatencyRefresh {
+		return
+	}
+	s.summarized = now
+
+	s.summary = LatencySummary{}
+	s.latencies = s.latencies[:0]
+	for _, sample := range s.samples {
+		if now.Sub(sample.at) > s.window {
+			continue
+		}
+		s.summary.Requests++
+		if sample.failed {
+			s.summary.Errors++
+		}
+		s.latencies = append(s.latencies, sample.latency)
+	}
+	sort.Slice(s.latencies, func(i, j int) bool {
+		return s.latencies[i] < s.latencies[j]
+	})
+	s.summary.P50 = s.quantile(0.5)
+	s.summary.P95 = s.quantile(0.95)
+	s.summary.P99 = s.quantile(0.99)
+}
+
+// quantile must be called with mx held, after refresh.
+func (s *ServingLatency) quantile(q float64) time.Duration {
+	if len(s.latencies) == 0 {
+		return 0
+	}
+	i := int(q * float64(len(s.latencies)))
+	return s.latencies[min(i, len(s.latencies)-1)]
+}
+
+// Summary returns the latency and errors of the requests of the window.
+func (s *ServingLatency) Summary() LatencySummary {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	s.refresh()
+	return s.summary
+}
+
+// Breached reports whether the latency at quantile q of the requests of the
+// window exceeds objective.
+func (s *ServingLatency) Breached(q float64, objective time.Duration) bool {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	s.refresh()
+	if len(s.latencies) < servingLatencyMinSamples {
+		return false
+	}
+	return s.quantile(q) > objective
+}
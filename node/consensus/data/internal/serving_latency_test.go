@@ -0,0 +1,40 @@
+package internal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"source.quilibrium.com/quilibrium/monorepo/node/consensus/data/internal"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+)
+
+func TestServingLatency(t *testing.T) {
+	clk := clock.NewFakeClock(time.Unix(0, 0))
+	latency := internal.NewServingLatency(clk, time.Minute, 1000)
+
+	assert.Equal(t, internal.LatencySummary{}, latency.Summary())
+	assert.False(t, latency.Breached(0.95, time.Millisecond))
+
+	for i := 1; i <= 100; i++ {
+		latency.Record(time.Duration(i)*time.Millisecond, i%10 == 0)
+	}
+	// Summaries are cached for a second.
+	assert.Zero(t, latency.Summary().Requests)
+	clk.Advance(time.Second)
+
+	summary := latency.Summary()
+	assert.Equal(t, 100, summary.Requests)
+	assert.Equal(t, 10, summary.Errors)
+	assert.InDelta(t, 0.1, summary.ErrorRate(), 1e-9)
+	assert.Equal(t, 51*time.Millisecond, summary.P50)
+	assert.Equal(t, 96*time.Millisecond, summary.P95)
+	assert.Equal(t, 100*time.Millisecond, summary.P99)
+	assert.True(t, latency.Breached(0.95, 90*time.Millisecond))
+	assert.False(t, latency.Breached(0.95, 100*time.Millisecond))
+
+	// Requests out of the window are forgotten.
+	clk.Advance(time.Minute)
+	assert.Zero(t, latency.Summary().Requests)
+	assert.False(t, latency.Breached(0.95, 90*time.Millisecond))
+}
@@ -11,10 +11,18 @@ import (
 	"source.quilibrium.com/quilibrium/monorepo/node/execution/intrinsics/token/application"
 	"source.quilibrium.com/quilibrium/monorepo/node/internal/cas"
 	"source.quilibrium.com/quilibrium/monorepo/node/internal/frametime"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/startup"
 	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
 	"source.quilibrium.com/quilibrium/monorepo/node/tries"
 )
 
+const (
+	// The first wait before checking again for mesh peers to republish the
+	// proved head to, doubling up to maxRepublishBackoff.
+	republishBackoff    = 1 * time.Second
+	maxRepublishBackoff = 1 * time.Minute
+)
+
 func (
 	e *DataClockConsensusEngine,
 ) GetFrameProverTries() []*tries.RollingFrecencyCritbitTrie {
@@ -62,7 +70,7 @@ func (e *DataClockConsensusEngine) runFramePruning() {
 		select {
 		case <-e.ctx.Done():
 			return
-		case <-time.After(1 * time.Hour):
+		case <-e.clock.After(1 * time.Hour):
 			head, err := e.dataTimeReel.Head()
 			if err != nil {
 				panic(err)
@@ -100,6 +108,10 @@ func (e *DataClockConsensusEngine) runSync() {
 					panic(err)
 				}
 			}
+			if e.diskSpace.Low() {
+				e.logger.Debug("disk space low, skipping sync")
+				continue
+			}
 			if err := e.pubSub.Bootstrap(e.ctx); err != nil {
 				e.logger.Error("could not bootstrap", zap.Error(err))
 			}
@@ -120,7 +132,7 @@ func (e *DataClockConsensusEngine) runLoop() {
 				"waiting for minimum peers",
 				zap.Int("peer_count", peerCount),
 			)
-			time.Sleep(1 * time.Second)
+			e.clock.Sleep(1 * time.Second)
 		} else {
 			latestFrame, err := e.dataTimeReel.Head()
 			if err != nil {
@@ -134,6 +146,7 @@ func (e *DataClockConsensusEngine) runLoop() {
 						panic(err)
 					}
 
+					go e.republishProvedHead(dataFrame)
 					latestFrame = e.processFrame(latestFrame, dataFrame)
 				}
 				runOnce = false
@@ -143,7 +156,8 @@ func (e *DataClockConsensusEngine) runLoop() {
 			case <-e.ctx.Done():
 				return
 			case dataFrame := <-dataFrameCh:
-				if e.GetFrameProverTries()[0].Contains(e.provingKeyAddress) {
+				if e.GetFrameProverTries()[0].Contains(e.provingKeyAddress) &&
+					!e.versionCutOff.Load() && e.hasMeshPeersForProving() {
 					if err = e.publishProof(dataFrame); err != nil {
 						e.logger.Error("could not publish", zap.Error(err))
 						e.stateMx.Lock()
@@ -159,6 +173,90 @@ func (e *DataClockConsensusEngine) runLoop() {
 	}
 }
 
+// republishProvedHead publishes the head again if this node proved it, as a
+// restart between proving a frame and publishing it would otherwise drop the
+// frame. Publishing a frame peers already have is harmless, so nothing is
+// stored per proved frame to tell whether it went out.
+func (e *DataClockConsensusEngine) republishProvedHead(
+	head *protobufs.ClockFrame,
+) {
+	address, err := head.GetAddress()
+	if err != nil || !bytes.Equal(address, e.provingKeyAddress) {
+		return
+	}
+
+	if !e.awaitRepublish(head) {
+		return
+	}
+
+	e.logger.Info(
+		"republishing proved head frame",
+		zap.Uint64("frame_number", head.FrameNumber),
+	)
+	if err := e.publishProof(head); err != nil {
+		e.logger.Error("could not republish", zap.Error(err))
+	}
+}
+
+// awaitRepublish waits, backing off between checks, until there are enough
+// mesh peers to publish the frame. Returns false if the frame stops being the
+// head or the engine stops first.
+func (e *DataClockConsensusEngine) awaitRepublish(
+	frame *protobufs.ClockFrame,
+) bool {
+	backoff := republishBackoff
+	for {
+		head, err := e.dataTimeReel.Head()
+		if err != nil || head.FrameNumber != frame.FrameNumber ||
+			!bytes.Equal(head.Output, frame.Output) || e.versionCutOff.Load() {
+			return false
+		}
+
+		if e.hasMeshPeersForProving() {
+			return true
+		}
+
+		select {
+		case <-e.ctx.Done():
+			return false
+		case <-e.clock.After(backoff):
+		}
+		backoff = min(2*backoff, maxRepublishBackoff)
+	}
+}
+
+// hasMeshPeersForProving reports whether enough peers are in the frame bitmask
+// mesh for this node to prove and publish frames. A prover cut off from the
+// mesh would otherwise keep extending its own head and fork on reconnect. The
+// pause and its end are logged once each, as this is checked every frame and
+// on every republish attempt.
+func (e *DataClockConsensusEngine) hasMeshPeersForProving() bool {
+	minimum := e.config.Engine.MinimumMeshPeersForProving
+	if minimum <= 0 {
+		return true
+	}
+
+	meshPeers := e.pubSub.GetMeshPeersCount(e.frameFilter)
+	if meshPeers < minimum {
+		if !e.meshPeersLow.Swap(true) {
+			e.logger.Warn(
+				"too few mesh peers, pausing proving",
+				zap.Int("mesh_peer_count", meshPeers),
+				zap.Int("minimum_mesh_peers", minimum),
+			)
+		}
+		return false
+	}
+
+	if e.meshPeersLow.Swap(false) {
+		e.logger.Info(
+			"enough mesh peers, resuming proving",
+			zap.Int("mesh_peer_count", meshPeers),
+		)
+	}
+	return true
+}
+
 func (e *DataClockConsensusEngine) processFrame(
 	latestFrame *protobufs.ClockFrame,
 	dataFrame *protobufs.ClockFrame,
@@ -181,9 +279,11 @@ func (e *DataClockConsensusEngine) processFrame(
 	}
 
 	cas.IfLessThanUint64(&e.latestFrameReceived, latestFrame.FrameNumber)
+	e.confirmProvedFrames(dataFrame)
 	e.frameProverTriesMx.Lock()
 	e.frameProverTries = e.dataTimeReel.GetFrameProverTries()
 	e.frameProverTriesMx.Unlock()
+	e.updatePeerMetadata()
 
 	trie := e.GetFrameProverTries()[0]
 	selBI, _ := dataFrame.GetSelector()
@@ -194,9 +294,17 @@ func (e *DataClockConsensusEngine) processFrame(
 		trie.FindNearest(sel).Key,
 		e.provingKeyAddress,
 	) {
+		if !e.hasMeshPeersForProving() || e.diskSpace.Low() ||
+			e.maintenance.Load() || e.versionCutOff.Load() {
+			e.recordMissedFrame(dataFrame.FrameNumber + 1)
+			return dataFrame
+		}
+
+		startup.Set(e.logger, startup.PhaseProving)
 		var nextFrame *protobufs.ClockFrame
 		if nextFrame, err = e.prove(dataFrame); err != nil {
 			e.logger.Error("could not prove", zap.Error(err))
+			e.recordMissedFrame(dataFrame.FrameNumber + 1)
 			e.stateMx.Lock()
 			if e.state < consensus.EngineStateStopping {
 				e.state = consensus.EngineStateCollecting
@@ -205,12 +313,14 @@ func (e *DataClockConsensusEngine) processFrame(
 			return dataFrame
 		}
 
+		e.recordProvedFrame(nextFrame)
 		e.dataTimeReel.Insert(nextFrame, true)
 
 		return nextFrame
 	} else {
-		if latestFrame.Timestamp > time.Now().UnixMilli()-120000 {
+		if latestFrame.Timestamp > e.clock.Now().UnixMilli()-120000 {
 			if !e.IsInProverTrie(e.pubSub.GetPeerID()) {
+				startup.Set(e.logger, startup.PhaseReady)
 				e.logger.Info("announcing prover join")
 				for _, eng := range e.executionEngines {
 					eng.AnnounceProverJoin()
@@ -254,7 +364,7 @@ func (e *DataClockConsensusEngine) processFrame(
 											"client failed, reconnecting after 50ms",
 											zap.Uint32("client", uint32(i)),
 										)
-										time.Sleep(50 * time.Millisecond)
+										e.clock.Sleep(50 * time.Millisecond)
 										client, err = e.createParallelDataClientsFromListAndIndex(uint32(i))
 										if err != nil {
 											e.logger.Error("failed to reconnect", zap.Error(err))
@@ -264,7 +374,7 @@ func (e *DataClockConsensusEngine) processFrame(
 											"client failed, reconnecting after 50ms",
 											zap.Uint32("client", uint32(i)),
 										)
-										time.Sleep(50 * time.Millisecond)
+										e.clock.Sleep(50 * time.Millisecond)
 										client, err =
 											e.createParallelDataClientsFromBaseMultiaddrAndIndex(uint32(i))
 										if err != nil {
@@ -286,6 +396,7 @@ func (e *DataClockConsensusEngine) processFrame(
 					e.clientReconnectTest = 0
 				}
 
+				startup.Set(e.logger, startup.PhaseProving)
 				outputs := e.PerformTimeProof(latestFrame, latestFrame.Difficulty, ring)
 				if outputs == nil || len(outputs) < 3 {
 					e.logger.Error("could not successfully build proof, reattempting")
@@ -335,7 +446,7 @@ func (e *DataClockConsensusEngine) processFrame(
 							},
 						},
 					},
-					Timestamp: time.Now().UnixMilli(),
+					Timestamp: e.clock.Now().UnixMilli(),
 				})
 
 				if e.config.Engine.AutoMergeCoins {
@@ -376,11 +487,13 @@ func (e *DataClockConsensusEngine) processFrame(
 									},
 								},
 							},
-							Timestamp: time.Now().UnixMilli(),
+							Timestamp: e.clock.Now().UnixMilli(),
 						})
 					}
 				}
 			}
+		} else {
+			startup.Set(e.logger, startup.PhaseSyncing)
 		}
 		return latestFrame
 	}
@@ -0,0 +1,167 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	qtime "source.quilibrium.com/quilibrium/monorepo/node/consensus/time"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+type meshPubSub struct {
+	pubsub
+	meshPeers atomic.Int32
+}
+
+func (p *meshPubSub) GetMeshPeersCount(bitmask []byte) int {
+	return int(p.meshPeers.Load())
+}
+
+func newRepublishTestEngine(
+	t *testing.T,
+	head *protobufs.ClockFrame,
+) (*DataClockConsensusEngine, *clock.FakeClock, *meshPubSub) {
+	clk := clock.NewFakeClock(time.UnixMilli(1700000000000))
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	ps := &meshPubSub{}
+	e := &DataClockConsensusEngine{
+		ctx:          ctx,
+		cancel:       cancel,
+		logger:       zap.NewNop(),
+		clock:        clk,
+		pubSub:       ps,
+		dataTimeReel: &qtime.DataTimeReel{},
+		config: &config.Config{
+			Engine: &config.EngineConfig{MinimumMeshPeersForProving: 1},
+		},
+	}
+	e.dataTimeReel.SetHead(head)
+
+	return e, clk, ps
+}
+
+func TestAwaitRepublish(t *testing.T) {
+	head := &protobufs.ClockFrame{
+		FrameNumber: 5,
+		Output:      bytes.Repeat([]byte{0x05}, 516),
+	}
+	e, clk, ps := newRepublishTestEngine(t, head)
+
+	done := make(chan bool, 1)
+	go func() { done <- e.awaitRepublish(head) }()
+
+	// Without mesh peers the check is repeated, the wait doubling each time.
+	clk.BlockUntil(1)
+	clk.Advance(republishBackoff)
+	clk.BlockUntil(1)
+	ps.meshPeers.Store(1)
+	clk.Advance(republishBackoff)
+	select {
+	case <-done:
+		t.Fatal("republish did not back off")
+	default:
+	}
+	clk.Advance(republishBackoff)
+	assert.True(t, <-done)
+}
+
+func TestAwaitRepublishSuperseded(t *testing.T) {
+	head := &protobufs.ClockFrame{
+		FrameNumber: 5,
+		Output:      bytes.Repeat([]byte{0x05}, 516),
+	}
+	e, clk, _ := newRepublishTestEngine(t, head)
+
+	done := make(chan bool, 1)
+	go func() { done <- e.awaitRepublish(head) }()
+
+	// A frame replaced as head while waiting is no longer published.
+	clk.BlockUntil(1)
+	e.dataTimeReel.SetHead(&protobufs.ClockFrame{
+		FrameNumber: 5,
+		Output:      bytes.Repeat([]byte{0x06}, 516),
+	})
+	clk.Advance(republishBackoff)
+	assert.False(t, <-done)
+
+	// Nor is it once the engine stops.
+	e.dataTimeReel.SetHead(head)
+	go func() { done <- e.awaitRepublish(head) }()
+	clk.BlockUntil(1)
+	e.cancel()
+	assert.False(t, <-done)
+}
+
+func TestRepublishProvedHeadSkipsOtherProvers(t *testing.T) {
+	head := &protobufs.ClockFrame{
+		FrameNumber: 5,
+		Output:      bytes.Repeat([]byte{0x05}, 516),
+		PublicKeySignature: &protobufs.ClockFrame_PublicKeySignatureEd448{
+			PublicKeySignatureEd448: &protobufs.Ed448Signature{
+				PublicKey: &protobufs.Ed448PublicKey{
+					KeyValue: bytes.Repeat([]byte{0x01}, 57),
+				},
+			},
+		},
+	}
+	e, _, _ := newRepublishTestEngine(t, head)
+	e.provingKeyAddress = bytes.Repeat([]byte{0x02}, 32)
+
+	// A head proved by another prover is left alone without waiting for mesh
+	// peers.
+	done := make(chan struct{})
+	go func() {
+		e.republishProvedHead(head)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("republish waited for mesh peers")
+	}
+}
+
+func TestHasMeshPeersForProving(t *testing.T) {
+	e, _, ps := newRepublishTestEngine(t, &protobufs.ClockFrame{})
+	core, logs := observer.New(zapcore.InfoLevel)
+	e.logger = zap.New(core)
+
+	// The pause is logged when it starts rather than on every check.
+	for i := 0; i < 3; i++ {
+		assert.False(t, e.hasMeshPeersForProving())
+	}
+	assert.Equal(
+		t,
+		1,
+		logs.FilterMessage("too few mesh peers, pausing proving").Len(),
+	)
+
+	// As is its end.
+	ps.meshPeers.Store(1)
+	for i := 0; i < 3; i++ {
+		assert.True(t, e.hasMeshPeersForProving())
+	}
+	assert.Equal(
+		t,
+		1,
+		logs.FilterMessage("enough mesh peers, resuming proving").Len(),
+	)
+
+	ps.meshPeers.Store(0)
+	assert.False(t, e.hasMeshPeersForProving())
+	assert.Equal(
+		t,
+		2,
+		logs.FilterMessage("too few mesh peers, pausing proving").Len(),
+	)
+}
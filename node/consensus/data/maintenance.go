@@ -0,0 +1,38 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// EnterMaintenance stops proving and serving frames to syncing peers, then
+// waits for in-flight sync requests to drain. The node keeps syncing, so that
+// it is caught up when maintenance ends.
+func (e *DataClockConsensusEngine) EnterMaintenance(ctx context.Context) error {
+	if !e.maintenance.CompareAndSwap(false, true) {
+		return errors.Wrap(
+			errors.New("already in maintenance"),
+			"enter maintenance",
+		)
+	}
+
+	e.logger.Info("entering maintenance, proving and sync serving paused")
+	for e.syncServing.Load() > 0 {
+		select {
+		case <-ctx.Done():
+			return errors.Wrap(ctx.Err(), "enter maintenance")
+		case <-e.clock.After(100 * time.Millisecond):
+		}
+	}
+
+	return nil
+}
+
+// ExitMaintenance resumes proving and sync serving.
+func (e *DataClockConsensusEngine) ExitMaintenance() {
+	if e.maintenance.CompareAndSwap(true, false) {
+		e.logger.Info("leaving maintenance, proving and sync serving resumed")
+	}
+}
@@ -2,7 +2,7 @@ package data
 
 import (
 	"bytes"
-	"time"
+	"encoding/hex"
 
 	"github.com/iden3/go-iden3-crypto/poseidon"
 	"github.com/libp2p/go-libp2p/core/peer"
@@ -133,7 +133,10 @@ func (e *DataClockConsensusEngine) runTxMessageHandler() {
 									continue
 								}
 
-								if err := e.handleTokenRequest(t); err != nil {
+								if err := e.handleTokenRequest(
+									requestFilter(appMessage.Address),
+									t,
+								); err != nil {
 									continue
 								}
 							}
@@ -203,6 +206,13 @@ func (e *DataClockConsensusEngine) handleClockFrame(
 		return errors.Wrap(errors.New("frame is nil"), "handle clock frame")
 	}
 
+	// The header is verified before the prover is looked up, and both before
+	// the much more expensive proof.
+	if err := e.verifyFrameSignature(frameSourceGossip, frame); err != nil {
+		e.logger.Debug("could not verify clock frame", zap.Error(err))
+		return errors.Wrap(err, "handle clock frame data")
+	}
+
 	addr, err := poseidon.HashBytes(
 		frame.GetPublicKeySignatureEd448().PublicKey.KeyValue,
 	)
@@ -212,6 +222,7 @@ func (e *DataClockConsensusEngine) handleClockFrame(
 
 	trie := e.GetFrameProverTries()[0]
 	if !trie.Contains(addr.FillBytes(make([]byte, 32))) {
+		rejectFrame(frameSourceGossip, frameStageProver)
 		e.logger.Debug(
 			"prover not in trie at frame, address may be in fork",
 			zap.Binary("address", address),
@@ -229,7 +240,7 @@ func (e *DataClockConsensusEngine) handleClockFrame(
 		zap.Int("proof_count", len(frame.AggregateProofs)),
 	)
 
-	if err := e.frameProver.VerifyDataClockFrame(frame); err != nil {
+	if err := e.verifyFrameProof(frameSourceGossip, frame); err != nil {
 		e.logger.Debug("could not verify clock frame", zap.Error(err))
 		return errors.Wrap(err, "handle clock frame data")
 	}
@@ -247,6 +258,13 @@ func (e *DataClockConsensusEngine) handleClockFrame(
 	}
 
 	if frame.FrameNumber > head.FrameNumber {
+		if e.diskSpace.Low() {
+			e.logger.Debug(
+				"disk space low, not accepting frame",
+				zap.Uint64("frame_number", frame.FrameNumber),
+			)
+			return nil
+		}
 		e.dataTimeReel.Insert(frame, false)
 	}
 
@@ -290,6 +308,8 @@ func (e *DataClockConsensusEngine) handleDataPeerListAnnounce(
 		return nil
 	}
 
+	e.recordAnnouncedCutoff(peerID, p)
+
 	head, err := e.dataTimeReel.Head()
 	if err != nil {
 		return errors.Wrap(err, "handle data peer list announce")
@@ -314,9 +334,23 @@ func (e *DataClockConsensusEngine) handleDataPeerListAnnounce(
 		e.peerMapMx.RUnlock()
 		return nil
 	}
+	_, struck := e.unfulfilledClaims[string(peerID)]
 	e.peerMapMx.RUnlock()
 
-	e.pubSub.SetPeerScore(peerID, 10)
+	if p.MaxFrame > head.FrameNumber+e.maxFrameHorizon() {
+		e.logger.Debug(
+			"peer claimed max frame beyond horizon",
+			zap.String("peer_id", peer.ID(peerID).String()),
+			zap.Uint64("max_frame", p.MaxFrame),
+			zap.Uint64("head_frame", head.FrameNumber),
+		)
+	}
+
+	// Keep the penalty of a peer with unfulfilled claims until it serves a
+	// frame or the strikes expire.
+	if !struck {
+		e.pubSub.SetPeerScore(peerID, 10)
+	}
 
 	e.peerMapMx.RLock()
 	existing, ok := e.peerMap[string(peerID)]
@@ -332,10 +366,12 @@ func (e *DataClockConsensusEngine) handleDataPeerListAnnounce(
 		peerId:        peerID,
 		multiaddr:     multiaddr,
 		maxFrame:      p.MaxFrame,
-		lastSeen:      time.Now().Unix(),
+		lastSeen:      e.clock.Now().Unix(),
 		timestamp:     p.Timestamp,
 		version:       p.Version,
 		totalDistance: p.TotalDistance,
+		region:        e.peerRegion(peerID, p.Region),
+		capabilities:  capabilitiesFromProto(p.Capabilities),
 	}
 	e.peerMapMx.Unlock()
 
@@ -350,16 +386,20 @@ func (e *DataClockConsensusEngine) handleDataPeerListAnnounce(
 }
 
 func (e *DataClockConsensusEngine) handleTokenRequest(
+	filter []byte,
 	transition *protobufs.TokenRequest,
 ) error {
+	// The faucet only exists on test networks, do not stage claims for it
+	// elsewhere.
+	if _, ok := transition.Request.(*protobufs.TokenRequest_Faucet); ok &&
+		e.pubSub.GetNetwork() == 0 {
+		return nil
+	}
+
 	if e.GetFrameProverTries()[0].Contains(e.provingKeyAddress) {
 		e.stagedTransactionsMx.Lock()
-		if e.stagedTransactions == nil {
-			e.stagedTransactions = &protobufs.TokenRequests{}
-		}
-
 		found := false
-		for _, ti := range e.stagedTransactions.Requests {
+		for _, ti := range e.stagedTransactions.all() {
 			switch t := ti.Request.(type) {
 			case *protobufs.TokenRequest_Transfer:
 				switch r := transition.Request.(type) {
@@ -459,13 +499,23 @@ func (e *DataClockConsensusEngine) handleTokenRequest(
 						found = true
 					}
 				}
+			case *protobufs.TokenRequest_Faucet:
+				switch r := transition.Request.(type) {
+				case *protobufs.TokenRequest_Faucet:
+					if bytes.Equal(
+						t.Faucet.GetSignature().GetPublicKey().GetKeyValue(),
+						r.Faucet.GetSignature().GetPublicKey().GetKeyValue(),
+					) {
+						found = true
+					}
+				}
 			}
 		}
 
-		if !found {
-			e.stagedTransactions.Requests = append(
-				e.stagedTransactions.Requests,
-				transition,
+		if !found && !e.stagedTransactions.add(filter, transition) {
+			e.logger.Debug(
+				"staged transactions of filter at limit, dropping transaction",
+				zap.String("filter", hex.EncodeToString(filter)),
 			)
 		}
 		e.stagedTransactionsMx.Unlock()
@@ -12,101 +12,121 @@ import (
 	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
 )
 
-func (e *DataClockConsensusEngine) validateFrameMessage(peerID peer.ID, message *pb.Message) p2p.ValidationResult {
+// Reasons messages are rejected for, as reported with dead letters.
+const (
+	rejectMalformedMessage  = "malformed_message"
+	rejectMalformedPayload  = "malformed_payload"
+	rejectUnexpectedType    = "unexpected_type"
+	rejectMalformedFrame    = "malformed_frame"
+	rejectMalformedRequest  = "malformed_request"
+	rejectMalformedMint     = "malformed_mint"
+	rejectMalformedAnnounce = "malformed_announce"
+)
+
+func (e *DataClockConsensusEngine) validateFrameMessage(peerID peer.ID, message *pb.Message) (p2p.ValidationResult, string) {
 	msg := &protobufs.Message{}
 	if err := proto.Unmarshal(message.Data, msg); err != nil {
-		return p2p.ValidationResultReject
+		return p2p.ValidationResultReject, rejectMalformedMessage
 	}
 	a := &anypb.Any{}
 	if err := proto.Unmarshal(msg.Payload, a); err != nil {
-		return p2p.ValidationResultReject
+		return p2p.ValidationResultReject, rejectMalformedPayload
 	}
 	switch a.TypeUrl {
 	case protobufs.ClockFrameType:
 		frame := &protobufs.ClockFrame{}
 		if err := proto.Unmarshal(a.Value, frame); err != nil {
-			return p2p.ValidationResultReject
+			return p2p.ValidationResultReject, rejectMalformedFrame
 		}
-		if ts := time.UnixMilli(frame.Timestamp); time.Since(ts) > 2*time.Minute {
-			return p2p.ValidationResultIgnore
+		if ts := time.UnixMilli(frame.Timestamp); e.clock.Since(ts) > 2*time.Minute {
+			return p2p.ValidationResultIgnore, ""
 		}
-		return p2p.ValidationResultAccept
+		return p2p.ValidationResultAccept, ""
 	default:
-		return p2p.ValidationResultReject
+		return p2p.ValidationResultReject, rejectUnexpectedType
 	}
 }
 
-func (e *DataClockConsensusEngine) validateTxMessage(peerID peer.ID, message *pb.Message) p2p.ValidationResult {
+func (e *DataClockConsensusEngine) validateTxMessage(peerID peer.ID, message *pb.Message) (p2p.ValidationResult, string) {
 	msg := &protobufs.Message{}
 	if err := proto.Unmarshal(message.Data, msg); err != nil {
-		return p2p.ValidationResultReject
+		return p2p.ValidationResultReject, rejectMalformedMessage
 	}
 	a := &anypb.Any{}
 	if err := proto.Unmarshal(msg.Payload, a); err != nil {
-		return p2p.ValidationResultReject
+		return p2p.ValidationResultReject, rejectMalformedPayload
 	}
 	switch a.TypeUrl {
 	case protobufs.TokenRequestType:
 		tx := &protobufs.TokenRequest{}
 		if err := proto.Unmarshal(a.Value, tx); err != nil {
-			return p2p.ValidationResultReject
+			return p2p.ValidationResultReject, rejectMalformedRequest
 		}
 		if mint := tx.GetMint(); mint != nil {
 			if len(mint.Proofs) < 3 {
-				return p2p.ValidationResultReject
+				return p2p.ValidationResultReject, rejectMalformedMint
 			}
 			if len(mint.Proofs[1]) != 4 {
-				return p2p.ValidationResultReject
+				return p2p.ValidationResultReject, rejectMalformedMint
 			}
 			if len(mint.Proofs[2]) != 8 {
-				return p2p.ValidationResultReject
+				return p2p.ValidationResultReject, rejectMalformedMint
 			}
 			head, err := e.dataTimeReel.Head()
 			if err != nil {
 				panic(err)
 			}
 			if frameNumber := binary.BigEndian.Uint64(mint.Proofs[2]); frameNumber+2 < head.FrameNumber {
-				return p2p.ValidationResultIgnore
+				return p2p.ValidationResultIgnore, ""
+			}
+		}
+		if tx.ExpiryFrame != 0 {
+			head, err := e.dataTimeReel.Head()
+			if err != nil {
+				panic(err)
+			}
+			if tx.ExpiredAt(head.FrameNumber + 1) {
+				return p2p.ValidationResultIgnore, ""
 			}
 		}
 		if tx.Timestamp == 0 {
 			// NOTE: The timestamp was added in later versions of the protocol,
 			// and as such it is possible to receive requests without it.
 			// We avoid logging due to this reason.
-			return p2p.ValidationResultAccept
+			return p2p.ValidationResultAccept, ""
 		}
-		if ts := time.UnixMilli(tx.Timestamp); time.Since(ts) > 10*time.Minute {
-			return p2p.ValidationResultIgnore
+		if ts := time.UnixMilli(tx.Timestamp); e.clock.Since(ts) > 10*time.Minute {
+			return p2p.ValidationResultIgnore, ""
 		}
-		return p2p.ValidationResultAccept
+		return p2p.ValidationResultAccept, ""
 	default:
-		return p2p.ValidationResultReject
+		return p2p.ValidationResultReject, rejectUnexpectedType
 	}
 }
 
-func (e *DataClockConsensusEngine) validateInfoMessage(peerID peer.ID, message *pb.Message) p2p.ValidationResult {
+func (e *DataClockConsensusEngine) validateInfoMessage(peerID peer.ID, message *pb.Message) (p2p.ValidationResult, string) {
 	msg := &protobufs.Message{}
 	if err := proto.Unmarshal(message.Data, msg); err != nil {
-		return p2p.ValidationResultReject
+		return p2p.ValidationResultReject, rejectMalformedMessage
 	}
 	a := &anypb.Any{}
 	if err := proto.Unmarshal(msg.Payload, a); err != nil {
-		return p2p.ValidationResultReject
+		return p2p.ValidationResultReject, rejectMalformedPayload
 	}
 	switch a.TypeUrl {
 	case protobufs.DataPeerListAnnounceType:
 		announce := &protobufs.DataPeerListAnnounce{}
 		if err := proto.Unmarshal(a.Value, announce); err != nil {
-			return p2p.ValidationResultReject
+			return p2p.ValidationResultReject, rejectMalformedAnnounce
 		}
 		if announce.Peer == nil {
-			return p2p.ValidationResultIgnore
+			return p2p.ValidationResultIgnore, ""
 		}
-		if ts := time.UnixMilli(announce.Peer.Timestamp); time.Since(ts) > 10*time.Minute {
-			return p2p.ValidationResultIgnore
+		if ts := time.UnixMilli(announce.Peer.Timestamp); e.clock.Since(ts) > 10*time.Minute {
+			return p2p.ValidationResultIgnore, ""
 		}
-		return p2p.ValidationResultAccept
+		return p2p.ValidationResultAccept, ""
 	default:
-		return p2p.ValidationResultReject
+		return p2p.ValidationResultReject, rejectUnexpectedType
 	}
 }
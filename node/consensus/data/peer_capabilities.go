@@ -0,0 +1,197 @@
+package data
+
+import (
+	"encoding/binary"
+	"math"
+
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+// Capability protocol identifiers advertised in data peer announcements. The
+// announcements are carried in signed pubsub messages, so the capabilities
+// are attributable to the announcing peer.
+const (
+	archivalCapability         uint32 = 0x020100
+	snapshotProviderCapability uint32 = 0x020200
+	// The bandwidth class is carried as a single byte of additional metadata.
+	bandwidthClassCapability uint32 = 0x020300
+	// The upload budget is carried as four bytes of additional metadata, in
+	// megabits per second, big-endian.
+	uploadBudgetCapability uint32 = 0x020400
+)
+
+type bandwidthClass uint8
+
+const (
+	bandwidthClassUnknown bandwidthClass = iota
+	bandwidthClassLow
+	bandwidthClassMedium
+	bandwidthClassHigh
+)
+
+var bandwidthClassesByName = map[string]bandwidthClass{
+	"low":    bandwidthClassLow,
+	"medium": bandwidthClassMedium,
+	"high":   bandwidthClassHigh,
+}
+
+const (
+	// Nodes that prune keep at least this many frames below their head, so a
+	// candidate further ahead than this may no longer have the frames we need
+	// unless it keeps full history.
+	deepHistoryFrameGap = 1000
+	// Weight multiplier for archival or snapshot providing candidates when
+	// syncing across more than deepHistoryFrameGap frames.
+	deepHistoryWeightFactor = 2
+)
+
+const (
+	// Upload budgets below lowUploadBudgetMbps are in the low bandwidth class,
+	// and budgets from highUploadBudgetMbps on in the high class.
+	lowUploadBudgetMbps  = 50
+	highUploadBudgetMbps = 500
+	// The upload budget whose weight multiplier is one, and the bounds of the
+	// multiplier, which grows with the square root of the budget.
+	referenceUploadBudgetMbps = 100
+	minUploadBudgetFactor     = 0.5
+	maxUploadBudgetFactor     = 4
+)
+
+// bandwidthClassOfBudget returns the class of an upload budget.
+func bandwidthClassOfBudget(mbps uint32) bandwidthClass {
+	switch {
+	case mbps == 0:
+		return bandwidthClassUnknown
+	case mbps < lowUploadBudgetMbps:
+		return bandwidthClassLow
+	case mbps < highUploadBudgetMbps:
+		return bandwidthClassMedium
+	default:
+		return bandwidthClassHigh
+	}
+}
+
+// weightFactor returns the sync candidate weight multiplier of the class.
+func (c bandwidthClass) weightFactor() float64 {
+	switch c {
+	case bandwidthClassLow:
+		return 0.5
+	case bandwidthClassHigh:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// peerCapabilities are the sync related capabilities of a peer.
+type peerCapabilities struct {
+	archival         bool
+	snapshotProvider bool
+	bandwidthClass   bandwidthClass
+	// Upload bandwidth the peer spends serving sync, zero if not advertised.
+	uploadBudgetMbps uint32
+}
+
+// capabilitiesFromProto extracts the known capabilities, ignoring any others.
+func capabilitiesFromProto(
+	capabilities []*protobufs.Capability,
+) peerCapabilities {
+	c := peerCapabilities{}
+	for _, capability := range capabilities {
+		switch capability.ProtocolIdentifier {
+		case archivalCapability:
+			c.archival = true
+		case snapshotProviderCapability:
+			c.snapshotProvider = true
+		case bandwidthClassCapability:
+			if len(capability.AdditionalMetadata) == 1 &&
+				bandwidthClass(capability.AdditionalMetadata[0]) <=
+					bandwidthClassHigh {
+				c.bandwidthClass = bandwidthClass(capability.AdditionalMetadata[0])
+			}
+		case uploadBudgetCapability:
+			if len(capability.AdditionalMetadata) == 4 {
+				c.uploadBudgetMbps = binary.BigEndian.Uint32(
+					capability.AdditionalMetadata,
+				)
+			}
+		}
+	}
+	return c
+}
+
+func (c peerCapabilities) toProto() []*protobufs.Capability {
+	capabilities := []*protobufs.Capability{}
+	if c.archival {
+		capabilities = append(capabilities, &protobufs.Capability{
+			ProtocolIdentifier: archivalCapability,
+		})
+	}
+	if c.snapshotProvider {
+		capabilities = append(capabilities, &protobufs.Capability{
+			ProtocolIdentifier: snapshotProviderCapability,
+		})
+	}
+	if c.bandwidthClass != bandwidthClassUnknown {
+		capabilities = append(capabilities, &protobufs.Capability{
+			ProtocolIdentifier: bandwidthClassCapability,
+			AdditionalMetadata: []byte{byte(c.bandwidthClass)},
+		})
+	}
+	if c.uploadBudgetMbps != 0 {
+		capabilities = append(capabilities, &protobufs.Capability{
+			ProtocolIdentifier: uploadBudgetCapability,
+			AdditionalMetadata: binary.BigEndian.AppendUint32(
+				nil,
+				c.uploadBudgetMbps,
+			),
+		})
+	}
+	return capabilities
+}
+
+// bandwidthFactor returns the sync candidate weight multiplier of the upload
+// budget of the peer, falling back to its bandwidth class.
+func (c peerCapabilities) bandwidthFactor() float64 {
+	if c.uploadBudgetMbps == 0 {
+		return c.bandwidthClass.weightFactor()
+	}
+	return max(
+		min(
+			math.Sqrt(float64(c.uploadBudgetMbps)/referenceUploadBudgetMbps),
+			maxUploadBudgetFactor,
+		),
+		minUploadBudgetFactor,
+	)
+}
+
+// syncWeightFactor returns the multiplier applied to the weight of a sync
+// candidate that is lead frames ahead of the local head.
+func (c peerCapabilities) syncWeightFactor(lead uint64) float64 {
+	factor := c.bandwidthFactor()
+	if lead > deepHistoryFrameGap && (c.archival || c.snapshotProvider) {
+		factor *= deepHistoryWeightFactor
+	}
+	return factor
+}
+
+// localCapabilities returns the capabilities the local node advertises. A
+// node is archival when it keeps every frame by design, see runFramePruning;
+// a MaxFrames too low to prune with is a misconfiguration rather than a
+// promise of full history, so it does not count. Without a
+// configured bandwidth class, the class follows from the upload budget, so
+// that peers weighting by class alone see it too.
+func (e *DataClockConsensusEngine) localCapabilities() peerCapabilities {
+	class, ok := bandwidthClassesByName[e.config.Engine.BandwidthClass]
+	if !ok {
+		class = bandwidthClassOfBudget(e.config.Engine.UploadBudgetMbps)
+	}
+	return peerCapabilities{
+		archival: e.config.Engine.FullProver ||
+			e.config.Engine.MaxFrames == -1 ||
+			e.GetFrameProverTries()[0].Contains(e.provingKeyAddress),
+		snapshotProvider: e.config.Engine.SnapshotProvider,
+		bandwidthClass:   class,
+		uploadBudgetMbps: e.config.Engine.UploadBudgetMbps,
+	}
+}
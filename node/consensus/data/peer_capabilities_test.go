@@ -0,0 +1,73 @@
+package data
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	"source.quilibrium.com/quilibrium/monorepo/node/tries"
+)
+
+func TestLocalCapabilitiesArchival(t *testing.T) {
+	address := bytes.Repeat([]byte{0x01}, 32)
+	proverTrie := &tries.RollingFrecencyCritbitTrie{}
+	e := &DataClockConsensusEngine{
+		config:            &config.Config{Engine: &config.EngineConfig{}},
+		provingKeyAddress: address,
+		frameProverTries:  []*tries.RollingFrecencyCritbitTrie{proverTrie},
+	}
+
+	for _, tc := range []struct {
+		maxFrames int64
+		archival  bool
+	}{
+		{maxFrames: -1, archival: true},
+		{maxFrames: 0, archival: false},
+		{maxFrames: 500, archival: false},
+		{maxFrames: 5000, archival: false},
+	} {
+		e.config.Engine.MaxFrames = tc.maxFrames
+		assert.Equal(t, tc.archival, e.localCapabilities().archival, tc.maxFrames)
+	}
+
+	// Full provers and nodes in the prover trie never prune.
+	e.config.Engine.MaxFrames = 5000
+	e.config.Engine.FullProver = true
+	assert.True(t, e.localCapabilities().archival)
+	e.config.Engine.FullProver = false
+	proverTrie.Add(address, 0)
+	assert.True(t, e.localCapabilities().archival)
+}
+
+func TestLocalCapabilitiesBandwidth(t *testing.T) {
+	e := &DataClockConsensusEngine{
+		config: &config.Config{Engine: &config.EngineConfig{
+			UploadBudgetMbps: 20,
+		}},
+		frameProverTries: []*tries.RollingFrecencyCritbitTrie{{}},
+	}
+	assert.Equal(t, bandwidthClassLow, e.localCapabilities().bandwidthClass)
+
+	// A configured class overrides the one following from the budget.
+	e.config.Engine.BandwidthClass = "high"
+	assert.Equal(t, bandwidthClassHigh, e.localCapabilities().bandwidthClass)
+}
+
+func TestCapabilitiesProtoRoundTrip(t *testing.T) {
+	c := peerCapabilities{
+		archival:         true,
+		bandwidthClass:   bandwidthClassMedium,
+		uploadBudgetMbps: 400,
+	}
+	assert.Equal(t, c, capabilitiesFromProto(c.toProto()))
+	assert.Equal(t, peerCapabilities{}, capabilitiesFromProto(nil))
+
+	// The upload budget takes precedence over the class, and deep history
+	// only counts when syncing across more than deepHistoryFrameGap frames.
+	assert.Equal(t, 2.0, c.syncWeightFactor(deepHistoryFrameGap))
+	assert.Equal(t, 4.0, c.syncWeightFactor(deepHistoryFrameGap+1))
+	assert.Equal(t, 0.5, peerCapabilities{
+		bandwidthClass: bandwidthClassLow,
+	}.syncWeightFactor(0))
+}
@@ -27,6 +27,20 @@ import (
 
 var ErrNoNewFrames = errors.New("peer reported no frames")
 
+// isSyncServingAllowed reports whether frames may be served to the peer under
+// the configured sync allowlist.
+func (e *DataClockConsensusEngine) isSyncServingAllowed(peerID peer.ID) bool {
+	if len(e.syncAllowlist) == 0 && !e.config.Engine.SyncAllowProvers {
+		return true
+	}
+
+	if _, ok := e.syncAllowlist[peerID]; ok {
+		return true
+	}
+
+	return e.config.Engine.SyncAllowProvers && e.IsInProverTrie([]byte(peerID))
+}
+
 func (e *DataClockConsensusEngine) GetDataFrame(
 	ctx context.Context,
 	request *protobufs.GetDataFrameRequest,
@@ -35,8 +49,43 @@ func (e *DataClockConsensusEngine) GetDataFrame(
 	if !ok {
 		return nil, status.Error(codes.Internal, "remote peer ID not found")
 	}
+
+	start := e.clock.Now()
+	result := SyncResultError
+	defer func() {
+		e.recordServingLatency(start, result)
+		if e.syncAudit.Record(peerID, request.FrameNumber, result) {
+			e.logger.Info(
+				"sync request",
+				zap.String("peer_id", peerID.String()),
+				zap.Uint64("frame_number", request.FrameNumber),
+				zap.String("result", result),
+			)
+		}
+	}()
+
+	if !e.isSyncServingAllowed(peerID) {
+		result = SyncResultDenied
+		return nil, status.Error(codes.PermissionDenied, "peer not allowed to sync")
+	}
+	e.syncServing.Add(1)
+	defer e.syncServing.Add(-1)
+	if e.maintenance.Load() {
+		result = SyncResultUnavailable
+		return nil, status.Error(codes.Unavailable, "node in maintenance")
+	}
+	if e.shedServing() {
+		result = SyncResultShed
+		e.servingShed.Add(1)
+		servingShedTotal.Inc()
+		return nil, status.Error(
+			codes.ResourceExhausted,
+			"serving latency objective exceeded",
+		)
+	}
 	if e.config.P2P.GrpcServerRateLimit != -1 {
 		if err := e.grpcRateLimiter.Allow(peerID); err != nil {
+			result = SyncResultRateLimited
 			return nil, err
 		}
 	}
@@ -74,11 +123,20 @@ func (e *DataClockConsensusEngine) GetDataFrame(
 		return nil, errors.Wrap(err, "get data frame")
 	}
 
+	result = SyncResultServed
 	return &protobufs.DataFrameResponse{
 		ClockFrame: frame,
 	}, nil
 }
 
+// GetSyncClients returns the peers that requested frames from this node, by
+// descending request count.
+func (e *DataClockConsensusEngine) GetSyncClients(
+	limit int,
+) []*protobufs.SyncClient {
+	return e.syncAudit.Leaderboard(limit)
+}
+
 func (e *DataClockConsensusEngine) NegotiateCompressedSyncFrames(
 	server protobufs.DataService_NegotiateCompressedSyncFramesServer,
 ) error {
@@ -250,7 +308,7 @@ func (e *DataClockConsensusEngine) handleMint(
 			t.Proofs[0],
 			[]byte("pre-dusk"),
 		) && (!bytes.Equal(t.Proofs[1], make([]byte, 32)) ||
-		time.Now().Unix() < 1730523600) && e.GetFrameProverTries()[0].Contains(
+		e.clock.Now().Unix() < 1730523600) && e.GetFrameProverTries()[0].Contains(
 		e.provingKeyAddress,
 	) {
 		prevInput := []byte{}
@@ -543,7 +601,7 @@ func (e *DataClockConsensusEngine) handleMint(
 							},
 						},
 					},
-					Timestamp: time.Now().UnixMilli(),
+					Timestamp: e.clock.Now().UnixMilli(),
 				},
 			)
 			if err != nil {
@@ -609,9 +667,10 @@ func (e *DataClockConsensusEngine) GetPublicChannelForProvingKey(
 		svrChan := make(
 			chan protobufs.DataService_GetPublicChannelServer,
 		)
-		after := time.After(20 * time.Second)
+		after := e.clock.After(20 * time.Second)
 		go func() {
 			server := qgrpc.NewServer(
+				p2p.DirectChannelCreds(),
 				grpc.MaxSendMsgSize(600*1024*1024),
 				grpc.MaxRecvMsgSize(600*1024*1024),
 			)
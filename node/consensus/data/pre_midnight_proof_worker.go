@@ -37,7 +37,7 @@ func (e *DataClockConsensusEngine) runPreMidnightProofWorker() {
 	for {
 		if e.GetState() < consensus.EngineStateCollecting {
 			e.logger.Info("waiting for node to finish starting")
-			time.Sleep(10 * time.Second)
+			e.clock.Sleep(10 * time.Second)
 			continue
 		}
 		break
@@ -66,7 +66,7 @@ func (e *DataClockConsensusEngine) runPreMidnightProofWorker() {
 
 		if len(tries) == 0 || e.pubSub.GetNetworkPeersCount() < 3 {
 			e.logger.Info("waiting for more peer info to appear")
-			time.Sleep(10 * time.Second)
+			e.clock.Sleep(10 * time.Second)
 			continue
 		}
 
@@ -91,7 +91,7 @@ func (e *DataClockConsensusEngine) runPreMidnightProofWorker() {
 			"could not establish direct channel, waiting...",
 			zap.Error(err),
 		)
-		time.Sleep(10 * time.Second)
+		e.clock.Sleep(10 * time.Second)
 	}
 	for {
 		state := e.GetState()
@@ -117,7 +117,7 @@ func (e *DataClockConsensusEngine) runPreMidnightProofWorker() {
 					zap.Error(err),
 				)
 				cc = nil
-				time.Sleep(10 * time.Second)
+				e.clock.Sleep(10 * time.Second)
 				continue
 			}
 		}
@@ -138,7 +138,7 @@ func (e *DataClockConsensusEngine) runPreMidnightProofWorker() {
 					"got error response, waiting...",
 					zap.Error(err),
 				)
-				time.Sleep(10 * time.Second)
+				e.clock.Sleep(10 * time.Second)
 				cc.Close()
 				cc = nil
 				err = e.pubSub.Reconnect([]byte(peerId))
@@ -147,7 +147,7 @@ func (e *DataClockConsensusEngine) runPreMidnightProofWorker() {
 						"got error response, waiting...",
 						zap.Error(err),
 					)
-					time.Sleep(10 * time.Second)
+					e.clock.Sleep(10 * time.Second)
 				}
 				continue
 			}
@@ -243,14 +243,14 @@ func (e *DataClockConsensusEngine) runPreMidnightProofWorker() {
 					resume = make([]byte, 32)
 					cc.Close()
 					cc = nil
-					time.Sleep(10 * time.Second)
+					e.clock.Sleep(10 * time.Second)
 					err = e.pubSub.Reconnect([]byte(peerId))
 					if err != nil {
 						e.logger.Error(
 							"got error response, waiting...",
 							zap.Error(err),
 						)
-						time.Sleep(10 * time.Second)
+						e.clock.Sleep(10 * time.Second)
 					}
 					break
 				}
@@ -0,0 +1,93 @@
+package data
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// Share of the recent time taken by the frame's VDF proof that applying
+	// staged transactions may take when no budget is configured, so that
+	// proving a frame takes at most half again as long with transactions.
+	proveApplyBudgetShare = 0.5
+	// Budget used until a frame's VDF proof has been timed.
+	defaultProveApplyBudget = 5 * time.Second
+	// Weight of the latest sample in the apply and VDF time averages.
+	applyTimeSmoothing = 0.2
+)
+
+var deferredTransactionsTotal = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Namespace: "data",
+		Name:      "prove_deferred_transactions_total",
+		Help:      "Staged transactions deferred to a later frame to fit the prove budget, each counted once.",
+	},
+)
+
+func init() {
+	prometheus.MustRegister(deferredTransactionsTotal)
+}
+
+// applyLimit returns how many staged transactions are expected to apply
+// within the prove budget, or -1 for all of them. The rest is deferred. At
+// least one transaction is always applied so that a slow one cannot stall the
+// queue. Must be called with stagedTransactionsMx held.
+func (e *DataClockConsensusEngine) applyLimit() int {
+	budget := e.applyBudget()
+	if budget < 0 || e.applyTimePerTx <= 0 {
+		return -1
+	}
+
+	return max(int(budget/e.applyTimePerTx), 1)
+}
+
+// applyBudget returns the configured prove budget or, if none is, a share of
+// the recent VDF proving time, which grows and shrinks with the difficulty.
+// Must be called with stagedTransactionsMx held.
+func (e *DataClockConsensusEngine) applyBudget() time.Duration {
+	if budget := e.config.Engine.ProveApplyBudget; budget != 0 {
+		return budget
+	}
+	if e.vdfTime <= 0 {
+		return defaultProveApplyBudget
+	}
+
+	return time.Duration(proveApplyBudgetShare * float64(e.vdfTime))
+}
+
+// recordApplyTime folds the time taken to apply count transactions into the
+// per-transaction apply time average. Must be called with
+// stagedTransactionsMx held.
+func (e *DataClockConsensusEngine) recordApplyTime(
+	count int,
+	elapsed time.Duration,
+) {
+	if count == 0 {
+		return
+	}
+
+	e.applyTimePerTx = smoothDuration(
+		e.applyTimePerTx,
+		elapsed/time.Duration(count),
+	)
+}
+
+// recordVDFTime folds the time taken by a frame's VDF proof into its average.
+// Must be called with stagedTransactionsMx held.
+func (e *DataClockConsensusEngine) recordVDFTime(elapsed time.Duration) {
+	e.vdfTime = smoothDuration(e.vdfTime, elapsed)
+}
+
+// smoothDuration folds the sample into the average, which is zero before the
+// first sample.
+func smoothDuration(average, sample time.Duration) time.Duration {
+	if average == 0 {
+		return sample
+	}
+
+	return time.Duration(
+		applyTimeSmoothing*float64(sample) +
+			(1-applyTimeSmoothing)*float64(average),
+	)
+}
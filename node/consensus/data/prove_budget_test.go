@@ -0,0 +1,52 @@
+package data
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+)
+
+func TestApplyLimit(t *testing.T) {
+	e := &DataClockConsensusEngine{
+		config: &config.Config{Engine: &config.EngineConfig{}},
+	}
+
+	// Nothing is deferred until applying a transaction has been timed.
+	assert.Equal(t, -1, e.applyLimit())
+
+	e.recordApplyTime(10, 100*time.Millisecond)
+	assert.Equal(t, 10*time.Millisecond, e.applyTimePerTx)
+	assert.Equal(t, int(defaultProveApplyBudget/(10*time.Millisecond)), e.applyLimit())
+
+	// Without a configured budget, the budget follows the VDF proving time.
+	e.recordVDFTime(2 * time.Second)
+	assert.Equal(t, time.Second, e.applyBudget())
+	assert.Equal(t, 100, e.applyLimit())
+	e.recordVDFTime(12 * time.Second)
+	assert.Equal(t, 4*time.Second, e.vdfTime)
+	assert.Equal(t, 200, e.applyLimit())
+
+	e.config.Engine.ProveApplyBudget = 50 * time.Millisecond
+	assert.Equal(t, 5, e.applyLimit())
+
+	// A transaction slower than the budget is still applied alone.
+	e.config.Engine.ProveApplyBudget = time.Millisecond
+	assert.Equal(t, 1, e.applyLimit())
+
+	e.config.Engine.ProveApplyBudget = -1
+	assert.Equal(t, -1, e.applyLimit())
+}
+
+func TestRecordApplyTime(t *testing.T) {
+	e := &DataClockConsensusEngine{}
+
+	e.recordApplyTime(0, time.Second)
+	assert.Zero(t, e.applyTimePerTx)
+
+	e.recordApplyTime(4, 40*time.Millisecond)
+	assert.Equal(t, 10*time.Millisecond, e.applyTimePerTx)
+	e.recordApplyTime(1, 60*time.Millisecond)
+	assert.Equal(t, 20*time.Millisecond, e.applyTimePerTx)
+}
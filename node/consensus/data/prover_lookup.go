@@ -65,3 +65,13 @@ func (e *DataClockConsensusEngine) IsInProverTrie(key []byte) bool {
 
 	return false
 }
+
+// updatePeerMetadata sends the prover status of the node, and the shard it
+// proves if any, to its peers.
+func (e *DataClockConsensusEngine) updatePeerMetadata() {
+	if !e.IsInProverTrie(e.provingKeyBytes) {
+		e.pubSub.SetPeerMetadata(false, nil)
+		return
+	}
+	e.pubSub.SetPeerMetadata(true, [][]byte{e.filter})
+}
@@ -0,0 +1,178 @@
+package data
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+// Maximum number of proved frames awaiting confirmation by a later head. The
+// oldest are dropped unconfirmed first.
+const maxUnconfirmedFrames = 16
+
+const (
+	proverFrameProved   = "proved"
+	proverFrameMissed   = "missed"
+	proverFrameRejected = "rejected"
+)
+
+var proverFramesTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "data",
+		Name:      "prover_frames_total",
+		Help:      "Frames this node was the nearest prover for, by outcome. Includes the totals persisted by earlier runs.",
+	},
+	[]string{"result"},
+)
+
+func init() {
+	prometheus.MustRegister(proverFramesTotal)
+}
+
+// loadProverStats restores the proving counters persisted for the proving
+// key, and seeds the Prometheus counters with them.
+func (e *DataClockConsensusEngine) loadProverStats() {
+	stats, err := e.clockStore.GetProverStats(e.provingKeyAddress)
+	if err != nil {
+		if !errors.Is(err, store.ErrNotFound) {
+			e.logger.Warn("could not load prover stats", zap.Error(err))
+		}
+		stats = &protobufs.ProverStats{
+			ProverAddress: e.provingKeyAddress,
+		}
+	}
+
+	proverFramesTotal.WithLabelValues(proverFrameProved).Add(
+		float64(stats.Proved),
+	)
+	proverFramesTotal.WithLabelValues(proverFrameMissed).Add(
+		float64(stats.Missed),
+	)
+	proverFramesTotal.WithLabelValues(proverFrameRejected).Add(
+		float64(stats.Rejected),
+	)
+
+	e.proverStatsMx.Lock()
+	e.proverStats = stats
+	e.proverStatsMx.Unlock()
+}
+
+// GetProverStats returns the proving counters of this node's proving key.
+func (e *DataClockConsensusEngine) GetProverStats() *protobufs.ProverStats {
+	e.proverStatsMx.Lock()
+	defer e.proverStatsMx.Unlock()
+
+	if e.proverStats == nil {
+		return &protobufs.ProverStats{ProverAddress: e.provingKeyAddress}
+	}
+
+	return proto.Clone(e.proverStats).(*protobufs.ProverStats)
+}
+
+// recordProvedFrame counts a frame this node proved, and remembers it until a
+// later head shows whether the network built on it.
+func (e *DataClockConsensusEngine) recordProvedFrame(
+	frame *protobufs.ClockFrame,
+) {
+	e.updateProverStats(proverFrameProved, frame.FrameNumber)
+
+	e.proverStatsMx.Lock()
+	defer e.proverStatsMx.Unlock()
+
+	if len(e.unconfirmedFrames) >= maxUnconfirmedFrames {
+		e.unconfirmedFrames = e.unconfirmedFrames[1:]
+	}
+	e.unconfirmedFrames = append(e.unconfirmedFrames, frame)
+}
+
+// recordMissedFrame counts a frame this node was the nearest prover for but
+// did not prove. A frame is counted once, however often it is seen as head.
+func (e *DataClockConsensusEngine) recordMissedFrame(frameNumber uint64) {
+	e.proverStatsMx.Lock()
+	counted := e.proverStats != nil && e.proverStats.Missed != 0 &&
+		e.proverStats.LastMissedFrameNumber == frameNumber
+	e.proverStatsMx.Unlock()
+	if counted {
+		return
+	}
+
+	e.updateProverStats(proverFrameMissed, frameNumber)
+}
+
+// confirmProvedFrames checks the proved frames below the new head against the
+// stored frames at their frame numbers. A proved frame that was replaced by a
+// different one is counted as rejected.
+func (e *DataClockConsensusEngine) confirmProvedFrames(
+	head *protobufs.ClockFrame,
+) {
+	e.proverStatsMx.Lock()
+	var settled []*protobufs.ClockFrame
+	pending := e.unconfirmedFrames[:0]
+	for _, frame := range e.unconfirmedFrames {
+		if frame.FrameNumber < head.FrameNumber {
+			settled = append(settled, frame)
+		} else {
+			pending = append(pending, frame)
+		}
+	}
+	e.unconfirmedFrames = pending
+	e.proverStatsMx.Unlock()
+
+	for _, frame := range settled {
+		stored, _, err := e.clockStore.GetDataClockFrame(
+			e.filter,
+			frame.FrameNumber,
+			true,
+		)
+		if err != nil && !errors.Is(err, store.ErrNotFound) {
+			e.logger.Warn("could not confirm proved frame", zap.Error(err))
+			continue
+		}
+
+		if stored != nil && bytes.Equal(stored.Output, frame.Output) {
+			continue
+		}
+
+		e.logger.Warn(
+			"proved frame was rejected",
+			zap.Uint64("frame_number", frame.FrameNumber),
+		)
+		e.updateProverStats(proverFrameRejected, frame.FrameNumber)
+	}
+}
+
+func (e *DataClockConsensusEngine) updateProverStats(
+	result string,
+	frameNumber uint64,
+) {
+	proverFramesTotal.WithLabelValues(result).Inc()
+
+	e.proverStatsMx.Lock()
+	if e.proverStats == nil {
+		e.proverStats = &protobufs.ProverStats{
+			ProverAddress: e.provingKeyAddress,
+		}
+	}
+	switch result {
+	case proverFrameProved:
+		e.proverStats.Proved++
+		e.proverStats.LastProvedFrameNumber = frameNumber
+	case proverFrameMissed:
+		e.proverStats.Missed++
+		e.proverStats.LastMissedFrameNumber = frameNumber
+	case proverFrameRejected:
+		e.proverStats.Rejected++
+		e.proverStats.LastRejectedFrameNumber = frameNumber
+	}
+	stats := proto.Clone(e.proverStats).(*protobufs.ProverStats)
+	e.proverStatsMx.Unlock()
+
+	if err := e.clockStore.PutProverStats(stats); err != nil {
+		e.logger.Warn("could not persist prover stats", zap.Error(err))
+	}
+}
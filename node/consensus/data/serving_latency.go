@@ -0,0 +1,98 @@
+package data
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+const (
+	// Window and maximum number of requests over which the serving latency is
+	// tracked.
+	servingLatencyWindow     = time.Minute
+	servingLatencyMaxSamples = 4096
+
+	defaultServingLatencyQuantile = 0.95
+)
+
+var (
+	servingLatencySeconds = prometheus.NewSummaryVec(
+		prometheus.SummaryOpts{
+			Namespace: "data",
+			Name:      "serving_latency_seconds",
+			Help:      "Latency of the frame requests served, by result.",
+			Objectives: map[float64]float64{
+				0.5:  0.05,
+				0.95: 0.01,
+				0.99: 0.001,
+			},
+			MaxAge: servingLatencyWindow,
+		},
+		[]string{"result"},
+	)
+	servingShedTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "data",
+			Name:      "serving_shed_total",
+			Help:      "Frame requests refused over the serving latency objective.",
+		},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(servingLatencySeconds, servingShedTotal)
+}
+
+// recordServingLatency accounts a frame request that was served or failed
+// once admitted. Refused requests say nothing of the cost of serving.
+func (e *DataClockConsensusEngine) recordServingLatency(
+	start time.Time,
+	result string,
+) {
+	if result != SyncResultServed && result != SyncResultError {
+		return
+	}
+	latency := e.clock.Since(start)
+	servingLatencySeconds.WithLabelValues(result).Observe(latency.Seconds())
+	e.servingLatency.Record(latency, result == SyncResultError)
+}
+
+func (e *DataClockConsensusEngine) servingLatencyQuantile() float64 {
+	q := e.config.Engine.ServingLatencyQuantile
+	if q <= 0 || q > 1 {
+		return defaultServingLatencyQuantile
+	}
+	return q
+}
+
+// shedServing reports whether frame requests are to be refused, as the
+// serving latency exceeds its objective.
+func (e *DataClockConsensusEngine) shedServing() bool {
+	slo := e.config.Engine.ServingLatencySLO
+	if slo <= 0 {
+		return false
+	}
+	return e.servingLatency.Breached(e.servingLatencyQuantile(), slo)
+}
+
+// GetServingLatency reports the latency and errors of the frame requests
+// served over the last minute.
+func (e *DataClockConsensusEngine) GetServingLatency() *protobufs.ServingLatencyResponse {
+	summary := e.servingLatency.Summary()
+	resp := &protobufs.ServingLatencyResponse{
+		Requests:  uint64(summary.Requests),
+		Errors:    uint64(summary.Errors),
+		ErrorRate: summary.ErrorRate(),
+		P50Micros: uint64(summary.P50.Microseconds()),
+		P95Micros: uint64(summary.P95.Microseconds()),
+		P99Micros: uint64(summary.P99.Microseconds()),
+		Shedding:  e.shedServing(),
+		Shed:      e.servingShed.Load(),
+	}
+	if slo := e.config.Engine.ServingLatencySLO; slo > 0 {
+		resp.SloMicros = uint64(slo.Microseconds())
+		resp.SloQuantile = e.servingLatencyQuantile()
+	}
+	return resp
+}
@@ -0,0 +1,199 @@
+package data
+
+import (
+	"encoding/hex"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+const defaultMaxStagedTransactionsPerShard = 10000
+
+var (
+	stagedTransactionsGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "data",
+			Name:      "staged_transactions",
+			Help:      "Transactions staged for proving, by the filter they target.",
+		},
+		[]string{"filter"},
+	)
+	stagedTransactionsRejectedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "data",
+			Name:      "staged_transactions_rejected_total",
+			Help:      "Transactions not staged as their shard was full, by the filter they target.",
+		},
+		[]string{"filter"},
+	)
+	stagedTransactionsExpiredTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "data",
+			Name:      "staged_transactions_expired_total",
+			Help:      "Staged transactions dropped as they expired before being proven.",
+		},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(stagedTransactionsGauge)
+	prometheus.MustRegister(stagedTransactionsRejectedTotal)
+	prometheus.MustRegister(stagedTransactionsExpiredTotal)
+}
+
+// requestFilter returns the filter of the application at the address, which
+// requests processed for it are staged by. The bitmask a request arrived on is
+// chosen by its sender, so it is not used.
+func requestFilter(address []byte) []byte {
+	return p2p.GetBloomFilter(address, 256, 3)
+}
+
+// stagedShards holds the transactions staged for proving by the filter of the
+// application they target. Each shard is admitted up to its own limit, and frames
+// take from the shards in turn, so that a busy shard can neither crowd the
+// others out of the staging area nor out of a frame. It must be used with
+// stagedTransactionsMx held.
+type stagedShards struct {
+	// Maximum transactions per shard, zero or less for no limit.
+	limit int
+	// Shard filters in the order they were first staged to.
+	order  []string
+	shards map[string][]*protobufs.TokenRequest
+	// Requests left staged by a frame that was proven.
+	deferred map[*protobufs.TokenRequest]struct{}
+}
+
+func newStagedShards(limit int) *stagedShards {
+	return &stagedShards{
+		limit:    limit,
+		shards:   map[string][]*protobufs.TokenRequest{},
+		deferred: map[*protobufs.TokenRequest]struct{}{},
+	}
+}
+
+// add stages the request in the shard of the filter, returning false if the
+// shard is full.
+func (s *stagedShards) add(
+	filter []byte,
+	request *protobufs.TokenRequest,
+) bool {
+	key := string(filter)
+	shard, ok := s.shards[key]
+	if s.limit > 0 && len(shard) >= s.limit {
+		stagedTransactionsRejectedTotal.WithLabelValues(
+			hex.EncodeToString(filter),
+		).Inc()
+		return false
+	}
+	if !ok {
+		s.order = append(s.order, key)
+	}
+	s.shards[key] = append(shard, request)
+	s.report(key)
+	return true
+}
+
+// all returns every staged request.
+func (s *stagedShards) all() []*protobufs.TokenRequest {
+	var requests []*protobufs.TokenRequest
+	for _, key := range s.order {
+		requests = append(requests, s.shards[key]...)
+	}
+	return requests
+}
+
+func (s *stagedShards) len() int {
+	count := 0
+	for _, shard := range s.shards {
+		count += len(shard)
+	}
+	return count
+}
+
+// take removes up to n requests, every request if n is negative, taking one
+// from each shard in turn. Shards keep their requests in staging order.
+func (s *stagedShards) take(n int) []*protobufs.TokenRequest {
+	if n < 0 {
+		n = s.len()
+	}
+
+	taken := make([]*protobufs.TokenRequest, 0, min(n, s.len()))
+	for len(taken) < n && len(s.order) != 0 {
+		for _, key := range s.order {
+			if len(taken) == n {
+				break
+			}
+			shard := s.shards[key]
+			if len(shard) == 0 {
+				continue
+			}
+			taken = append(taken, shard[0])
+			delete(s.deferred, shard[0])
+			s.shards[key] = shard[1:]
+		}
+		s.compact()
+	}
+	return taken
+}
+
+// expire drops the requests that can no longer be applied in the frame,
+// returning how many were dropped.
+func (s *stagedShards) expire(frameNumber uint64) int {
+	expired := 0
+	for _, key := range s.order {
+		shard := s.shards[key]
+		kept := shard[:0]
+		for _, request := range shard {
+			if request.ExpiredAt(frameNumber) {
+				delete(s.deferred, request)
+				expired++
+				continue
+			}
+			kept = append(kept, request)
+		}
+		clear(shard[len(kept):])
+		s.shards[key] = kept
+	}
+	if expired != 0 {
+		stagedTransactionsExpiredTotal.Add(float64(expired))
+		s.compact()
+	}
+	return expired
+}
+
+// deferRemaining marks every staged request as deferred by a proven frame, returning
+// how many were not deferred before, so that a request left staged over
+// several frames is counted once.
+func (s *stagedShards) deferRemaining() int {
+	count := 0
+	for _, shard := range s.shards {
+		for _, request := range shard {
+			if _, ok := s.deferred[request]; !ok {
+				s.deferred[request] = struct{}{}
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// compact drops the emptied shards.
+func (s *stagedShards) compact() {
+	order := s.order[:0]
+	for _, key := range s.order {
+		s.report(key)
+		if len(s.shards[key]) == 0 {
+			delete(s.shards, key)
+			continue
+		}
+		order = append(order, key)
+	}
+	s.order = order
+}
+
+func (s *stagedShards) report(key string) {
+	stagedTransactionsGauge.WithLabelValues(
+		hex.EncodeToString([]byte(key)),
+	).Set(float64(len(s.shards[key])))
+}
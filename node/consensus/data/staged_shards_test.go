@@ -0,0 +1,81 @@
+package data
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"source.quilibrium.com/quilibrium/monorepo/node/execution/intrinsics/token/application"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+func TestRequestFilter(t *testing.T) {
+	tokenFilter := requestFilter(application.TOKEN_ADDRESS)
+	assert.Equal(
+		t,
+		p2p.GetBloomFilter(application.TOKEN_ADDRESS, 256, 3),
+		tokenFilter,
+	)
+	assert.NotEqual(t, tokenFilter, requestFilter([]byte{0x01}))
+}
+
+func TestStagedShards(t *testing.T) {
+	a, b := []byte{0x01}, []byte{0x02}
+	s := newStagedShards(2)
+	request := func(expiry uint64) *protobufs.TokenRequest {
+		return &protobufs.TokenRequest{ExpiryFrame: expiry}
+	}
+	a1, a2, a3 := request(0), request(5), request(0)
+	b1, b2 := request(5), request(0)
+
+	// Each shard is admitted up to its own limit.
+	assert.True(t, s.add(a, a1))
+	assert.True(t, s.add(a, a2))
+	assert.False(t, s.add(a, a3))
+	assert.True(t, s.add(b, b1))
+	assert.True(t, s.add(b, b2))
+	assert.Equal(t, 4, s.len())
+	assert.Equal(t, []*protobufs.TokenRequest{a1, a2, b1, b2}, s.all())
+
+	// Frames take from the shards in turn.
+	assert.Equal(t, []*protobufs.TokenRequest{a1, b1}, s.take(2))
+	assert.Equal(t, []*protobufs.TokenRequest{a2, b2}, s.all())
+	assert.True(t, s.add(a, a3))
+
+	// Expired requests are dropped, and emptied shards forgotten.
+	assert.Equal(t, 1, s.expire(6))
+	assert.Equal(t, []*protobufs.TokenRequest{a3, b2}, s.all())
+	assert.Equal(t, []*protobufs.TokenRequest{a3, b2}, s.take(-1))
+	assert.Equal(t, 0, s.len())
+	assert.Empty(t, s.order)
+	assert.Empty(t, s.shards)
+}
+
+func TestStagedShardsDeferRemaining(t *testing.T) {
+	a, b := []byte{0x01}, []byte{0x02}
+	s := newStagedShards(0)
+	a1, a2 := &protobufs.TokenRequest{}, &protobufs.TokenRequest{ExpiryFrame: 5}
+	b1 := &protobufs.TokenRequest{}
+	s.add(a, a1)
+	s.add(a, a2)
+
+	// Requests left staged over several frames are counted once.
+	assert.Equal(t, 2, s.deferRemaining())
+	assert.Equal(t, 0, s.deferRemaining())
+	s.add(b, b1)
+	assert.Equal(t, 1, s.deferRemaining())
+
+	// Taken and expired requests are forgotten.
+	assert.Equal(t, []*protobufs.TokenRequest{a1}, s.take(1))
+	assert.Equal(t, 1, s.expire(6))
+	assert.Equal(t, map[*protobufs.TokenRequest]struct{}{b1: {}}, s.deferred)
+}
+
+func TestStagedShardsUnlimited(t *testing.T) {
+	s := newStagedShards(0)
+	for i := 0; i < 100; i++ {
+		assert.True(t, s.add([]byte{0x01}, &protobufs.TokenRequest{}))
+	}
+	assert.Len(t, s.take(10), 10)
+	assert.Equal(t, 90, s.len())
+}
@@ -0,0 +1,146 @@
+package data
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prometheus/client_golang/prometheus"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+// Sync request results, as recorded by the sync audit.
+const (
+	SyncResultServed      = "served"
+	SyncResultDenied      = "denied"
+	SyncResultRateLimited = "rate_limited"
+	SyncResultUnavailable = "unavailable"
+	SyncResultShed        = "shed"
+	SyncResultError       = "error"
+)
+
+const (
+	syncAuditMaxClients = 4096
+	syncAuditSampleRate = 100
+)
+
+var syncRequestsTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "data",
+		Name:      "sync_requests_total",
+		Help:      "Frame requests received from syncing peers, by result.",
+	},
+	[]string{"result"},
+)
+
+func init() {
+	prometheus.MustRegister(syncRequestsTotal)
+}
+
+type syncClient struct {
+	requests    uint64
+	denied      uint64
+	minFrame    uint64
+	maxFrame    uint64
+	lastRequest time.Time
+}
+
+// SyncAudit tracks which peers request which frames from the local data
+// service. It keeps at most maxClients peers, forgetting the least recently
+// seen one to make room for a new one.
+type SyncAudit struct {
+	mx         sync.Mutex
+	clients    map[peer.ID]*syncClient
+	maxClients int
+	sampleRate uint64
+}
+
+// NewSyncAudit creates a sync audit. Requests are sampled for logging once
+// every sampleRate requests of each peer, in addition to its first request.
+func NewSyncAudit(maxClients int, sampleRate uint64) *SyncAudit {
+	if sampleRate == 0 {
+		sampleRate = 1
+	}
+
+	return &SyncAudit{
+		clients:    make(map[peer.ID]*syncClient),
+		maxClients: maxClients,
+		sampleRate: sampleRate,
+	}
+}
+
+// Record accounts a frame request from peerID, and reports whether the
+// request is sampled for logging.
+func (a *SyncAudit) Record(
+	peerID peer.ID,
+	frameNumber uint64,
+	result string,
+) bool {
+	syncRequestsTotal.WithLabelValues(result).Inc()
+
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	client, ok := a.clients[peerID]
+	if !ok {
+		if len(a.clients) >= a.maxClients {
+			a.evictLocked()
+		}
+		client = &syncClient{minFrame: frameNumber, maxFrame: frameNumber}
+		a.clients[peerID] = client
+	}
+
+	client.requests++
+	if result != SyncResultServed {
+		client.denied++
+	}
+	client.minFrame = min(client.minFrame, frameNumber)
+	client.maxFrame = max(client.maxFrame, frameNumber)
+	client.lastRequest = time.Now()
+
+	return client.requests == 1 || client.requests%a.sampleRate == 0
+}
+
+func (a *SyncAudit) evictLocked() {
+	var oldestID peer.ID
+	var oldest time.Time
+	for id, client := range a.clients {
+		if oldest.IsZero() || client.lastRequest.Before(oldest) {
+			oldestID = id
+			oldest = client.lastRequest
+		}
+	}
+	delete(a.clients, oldestID)
+}
+
+// Leaderboard returns the tracked peers by descending request count, at most
+// limit of them unless limit is zero.
+func (a *SyncAudit) Leaderboard(limit int) []*protobufs.SyncClient {
+	a.mx.Lock()
+	clients := make([]*protobufs.SyncClient, 0, len(a.clients))
+	for id, client := range a.clients {
+		clients = append(clients, &protobufs.SyncClient{
+			PeerId:               []byte(id),
+			Requests:             client.requests,
+			Denied:               client.denied,
+			MinFrameNumber:       client.minFrame,
+			MaxFrameNumber:       client.maxFrame,
+			LastRequestTimestamp: client.lastRequest.UnixMilli(),
+		})
+	}
+	a.mx.Unlock()
+
+	sort.Slice(clients, func(i, j int) bool {
+		if clients[i].Requests != clients[j].Requests {
+			return clients[i].Requests > clients[j].Requests
+		}
+		return clients[i].LastRequestTimestamp > clients[j].LastRequestTimestamp
+	})
+
+	if limit > 0 && len(clients) > limit {
+		clients = clients[:limit]
+	}
+
+	return clients
+}
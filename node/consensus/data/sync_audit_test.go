@@ -0,0 +1,37 @@
+package data_test
+
+import (
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+	"source.quilibrium.com/quilibrium/monorepo/node/consensus/data"
+)
+
+func TestSyncAudit(t *testing.T) {
+	audit := data.NewSyncAudit(2, 10)
+
+	assert.True(t, audit.Record(peer.ID("a"), 5, data.SyncResultServed))
+	for i := uint64(6); i < 15; i++ {
+		sampled := audit.Record(peer.ID("a"), i, data.SyncResultServed)
+		assert.Equal(t, i == 14, sampled)
+	}
+	audit.Record(peer.ID("b"), 3, data.SyncResultRateLimited)
+
+	leaderboard := audit.Leaderboard(0)
+	assert.Len(t, leaderboard, 2)
+	assert.Equal(t, []byte("a"), leaderboard[0].PeerId)
+	assert.Equal(t, uint64(10), leaderboard[0].Requests)
+	assert.Equal(t, uint64(5), leaderboard[0].MinFrameNumber)
+	assert.Equal(t, uint64(14), leaderboard[0].MaxFrameNumber)
+	assert.Equal(t, uint64(1), leaderboard[1].Denied)
+
+	// A third client evicts the least recently seen one.
+	audit.Record(peer.ID("c"), 1, data.SyncResultServed)
+	leaderboard = audit.Leaderboard(1)
+	assert.Len(t, leaderboard, 1)
+	assert.Len(t, audit.Leaderboard(0), 2)
+	for _, client := range audit.Leaderboard(0) {
+		assert.NotEqual(t, []byte("a"), client.PeerId)
+	}
+}
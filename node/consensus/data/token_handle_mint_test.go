@@ -7,6 +7,7 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
+	"net"
 	"slices"
 	"testing"
 	"time"
@@ -22,11 +23,13 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub/pb"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
 	"source.quilibrium.com/quilibrium/monorepo/node/consensus"
 	qtime "source.quilibrium.com/quilibrium/monorepo/node/consensus/time"
 	qcrypto "source.quilibrium.com/quilibrium/monorepo/node/crypto"
 	"source.quilibrium.com/quilibrium/monorepo/node/execution"
 	"source.quilibrium.com/quilibrium/monorepo/node/execution/intrinsics/token/application"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
 	"source.quilibrium.com/quilibrium/monorepo/node/keys"
 	"source.quilibrium.com/quilibrium/monorepo/node/p2p"
 	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
@@ -39,19 +42,35 @@ type pubsub struct {
 	pubkey  []byte
 }
 
-func (pubsub) GetBitmaskPeers() map[string][]string                                    { return nil }
-func (pubsub) Publish(address []byte, data []byte) error                               { return nil }
-func (pubsub) PublishToBitmask(bitmask []byte, data []byte) error                      { return nil }
-func (pubsub) Subscribe(bitmask []byte, handler func(message *pb.Message) error) error { return nil }
-func (pubsub) Unsubscribe(bitmask []byte, raw bool)                                    {}
-func (pubsub) RegisterValidator(bitmask []byte, validator func(peerID peer.ID, message *pb.Message) p2p.ValidationResult, sync bool) error {
+func (pubsub) GetBitmaskPeers() map[string][]string                                       { return nil }
+func (pubsub) Publish(address []byte, data []byte) error                                  { return nil }
+func (pubsub) PublishToBitmask(bitmask []byte, data []byte) error                         { return nil }
+func (pubsub) PublishToBitmaskAs(role p2p.PublishRole, bitmask []byte, data []byte) error { return nil }
+func (pubsub) SetPublishAuthorizer(bitmask []byte, authorizer p2p.PublishAuthorizer)      {}
+func (pubsub) PublishContext(ctx context.Context, bitmask []byte, data []byte) error {
 	return nil
 }
-func (pubsub) UnregisterValidator(bitmask []byte) error     { return nil }
-func (pubsub) GetPeerID() []byte                            { return nil }
-func (pubsub) GetPeerstoreCount() int                       { return 0 }
-func (pubsub) GetNetworkPeersCount() int                    { return 0 }
-func (pubsub) GetRandomPeer(bitmask []byte) ([]byte, error) { return nil, nil }
+func (pubsub) PublishContextAs(ctx context.Context, role p2p.PublishRole, bitmask []byte, data []byte) error {
+	return nil
+}
+func (pubsub) Subscribe(bitmask []byte, handler func(message *pb.Message) error) (p2p.Subscription, error) {
+	return nil, nil
+}
+func (pubsub) Unsubscribe(bitmask []byte, raw bool) {}
+func (pubsub) RegisterValidator(bitmask []byte, validator p2p.Validator, sync bool) error {
+	return nil
+}
+func (pubsub) UnregisterValidator(bitmask []byte) error      { return nil }
+func (pubsub) GetPeerID() []byte                             { return nil }
+func (pubsub) GetPeerstoreCount() int                        { return 0 }
+func (pubsub) GetNetworkPeersCount() int                     { return 0 }
+func (pubsub) GetMeshPeersCount(bitmask []byte) int          { return 0 }
+func (pubsub) PersistPeerScores(scores store.PeerScoreStore) {}
+func (pubsub) SavePeerScores() error                         { return nil }
+func (pubsub) GetRandomPeer(bitmask []byte) ([]byte, error)  { return nil, nil }
+func (pubsub) GetBestPeers(bitmask []byte, n int) ([][]byte, error) {
+	return nil, nil
+}
 func (pubsub) GetMultiaddrOfPeerStream(ctx context.Context, peerId []byte) <-chan multiaddr.Multiaddr {
 	return nil
 }
@@ -67,9 +86,81 @@ func (pubsub) StartDirectChannelListener(
 func (pubsub) GetDirectChannel(peerId []byte, purpose string) (*grpc.ClientConn, error) {
 	return nil, nil
 }
+func (pubsub) OpenChannel(peerId []byte, protocolSuffix string) (net.Conn, error) {
+	return nil, nil
+}
+func (pubsub) RegisterChannelHandler(
+	protocolSuffix string,
+	handler p2p.ChannelHandler,
+) error {
+	return nil
+}
+func (pubsub) UnregisterChannelHandler(protocolSuffix string) {}
 func (pubsub) GetNetworkInfo() *protobufs.NetworkInfoResponse {
 	return nil
 }
+func (pubsub) SetPeerMetadata(prover bool, filters [][]byte) {}
+func (pubsub) GetPeerMetadata(peerId []byte) *protobufs.PeerMetadata {
+	return nil
+}
+func (pubsub) GetPeerConnection(peerId []byte) *protobufs.PeerConnectionResponse {
+	return nil
+}
+func (pubsub) GetScoredPeers() (*protobufs.ScoredPeersResponse, error) {
+	return nil, nil
+}
+func (pubsub) OverrideGraylist(peerId []byte, duration time.Duration) *protobufs.ScoredPeer {
+	return nil
+}
+func (pubsub) BanPeer(peerId []byte, ttl time.Duration) (*protobufs.PeerBan, error) {
+	return nil, nil
+}
+func (pubsub) UnbanPeer(peerId []byte) (*protobufs.PeerBansResponse, error) {
+	return nil, nil
+}
+func (pubsub) ListBans() (*protobufs.PeerBansResponse, error) {
+	return nil, nil
+}
+func (pubsub) GetDeadLetters([]byte, int) (*protobufs.DeadLettersResponse, error) {
+	return nil, nil
+}
+func (pubsub) SetDeadLetterMaxSize([]byte, int) {}
+func (pubsub) GetBandwidthStats(int) *protobufs.BandwidthStatsResponse {
+	return nil
+}
+func (pubsub) SetDirectChannelAuthorizer(func(peerId []byte) bool) {}
+func (pubsub) GetReachability() *protobufs.ReachabilityResponse {
+	return nil
+}
+func (pubsub) GetPeerHealth() *protobufs.PeerHealthResponse {
+	return nil
+}
+func (pubsub) GetNATStatus() *protobufs.NATStatusResponse {
+	return nil
+}
+func (pubsub) GetBlossomSubParams() (*protobufs.BlossomSubParamsResponse, error) {
+	return nil, nil
+}
+func (pubsub) UpdateBlossomSubParams(
+	req *protobufs.UpdateBlossomSubParamsRequest,
+) (*protobufs.BlossomSubParamsResponse, error) {
+	return nil, nil
+}
+func (pubsub) StartTraceLog(
+	req *protobufs.StartTraceLogRequest,
+) (*protobufs.TraceLogStatusResponse, error) {
+	return nil, nil
+}
+func (pubsub) StopTraceLog() (*protobufs.TraceLogStatusResponse, error) {
+	return nil, nil
+}
+func (pubsub) ConnectPeer(ctx context.Context, multiaddr string) ([]byte, error) {
+	return nil, nil
+}
+func (pubsub) DisconnectPeer(peerId []byte) error                             { return nil }
+func (pubsub) ProtectPeer(peerId []byte, protect bool)                        {}
+func (pubsub) RegisterMessagePlugin(bitmask []byte, plugin p2p.MessagePlugin) {}
+func (pubsub) UnregisterMessagePlugin(name string)                            {}
 func (p pubsub) SignMessage(msg []byte) ([]byte, error) {
 	return p.privkey.Sign(rand.Reader, msg, gocrypto.Hash(0))
 }
@@ -80,6 +171,14 @@ func (pubsub) AddPeerScore(peerId []byte, scoreDelta int64) {}
 func (pubsub) Reconnect(peerId []byte) error                { return nil }
 func (pubsub) Bootstrap(context.Context) error              { return nil }
 func (pubsub) DiscoverPeers(context.Context) error          { return nil }
+func (pubsub) RunPeerDiscovery(
+	context.Context,
+	bool,
+	bool,
+) *protobufs.PeerDiscoveryResponse {
+	return nil
+}
+func (pubsub) ReloadPeers(*config.P2PConfig) error { return nil }
 
 type outputs struct {
 	difficulty  uint32
@@ -107,6 +206,7 @@ func TestHandlePreMidnightMint(t *testing.T) {
 	d := &DataClockConsensusEngine{
 		difficulty:       200000,
 		logger:           log,
+		clock:            clock.NewRealClock(),
 		state:            consensus.EngineStateStopped,
 		clockStore:       clockstore,
 		coinStore:        app.CoinStore,
@@ -648,15 +748,15 @@ func TestHandlePreMidnightMint(t *testing.T) {
 	err = proto.Unmarshal(appMsg.Value, tr)
 	assert.NoError(t, err)
 
-	d.stagedTransactions = &protobufs.TokenRequests{
+	staged := &protobufs.TokenRequests{
 		Requests: []*protobufs.TokenRequest{tr},
 	}
 	// confirm operation cannot occur twice:
-	d.stagedTransactions.Requests = append(
-		d.stagedTransactions.Requests,
-		d.stagedTransactions.Requests[0],
+	staged.Requests = append(
+		staged.Requests,
+		staged.Requests[0],
 	)
-	app, success, fail, err := app.ApplyTransitions(1, d.stagedTransactions, true)
+	app, success, fail, err := app.ApplyTransitions(1, staged, true)
 	assert.NoError(t, err)
 
 	assert.Len(t, success.Requests, 1)
@@ -691,7 +791,7 @@ func TestHandlePreMidnightMint(t *testing.T) {
 	}
 	err = txn.Commit()
 	// confirm updated app state does fail transition
-	_, _, _, err = app.ApplyTransitions(1, d.stagedTransactions, false)
+	_, _, _, err = app.ApplyTransitions(1, staged, false)
 	assert.Error(t, err)
 
 	_, _, coin, err := app.CoinStore.GetCoinsForOwner(addr)
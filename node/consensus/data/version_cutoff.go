@@ -0,0 +1,144 @@
+package data
+
+import (
+	"bytes"
+	"time"
+
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+const (
+	// How often the version cutoff monitor checks the minimum versions
+	// announced by peers.
+	versionCutoffPollInterval = 10 * time.Minute
+	// Minimum number of peers that must announce the same minimum version
+	// before it is acted upon, which must also be a third of the peers
+	// announcing one.
+	versionCutoffQuorum = 3
+)
+
+// versionCutoff is a minimum version the network requires from a cutoff on.
+type versionCutoff struct {
+	version []byte
+	cutoff  time.Time
+}
+
+// versionCutoffWarningInterval is how long to wait before warning again about
+// a version cutoff remaining away, warning more often as it approaches.
+func versionCutoffWarningInterval(remaining time.Duration) time.Duration {
+	switch {
+	case remaining > 7*24*time.Hour:
+		return 24 * time.Hour
+	case remaining > 24*time.Hour:
+		return 6 * time.Hour
+	case remaining > 2*time.Hour:
+		return time.Hour
+	default:
+		return 5 * time.Minute
+	}
+}
+
+// recordAnnouncedCutoff keeps the minimum version a peer announced, if the
+// peer itself satisfies it.
+func (e *DataClockConsensusEngine) recordAnnouncedCutoff(
+	peerId []byte,
+	p *protobufs.DataPeer,
+) {
+	if (len(p.MinimumVersion) != 3 && len(p.MinimumVersion) != 4) ||
+		bytes.Compare(p.Version, p.MinimumVersion) < 0 {
+		return
+	}
+
+	e.announcedCutoffsMx.Lock()
+	defer e.announcedCutoffsMx.Unlock()
+	e.announcedCutoffs[string(peerId)] = versionCutoff{
+		version: p.MinimumVersion,
+		cutoff:  time.UnixMilli(p.MinimumVersionCutoff),
+	}
+}
+
+// networkVersionCutoff returns the earliest cutoff of a minimum version newer
+// than the local one that enough peers announce, so that a few peers cannot
+// stop the node from proving.
+func (e *DataClockConsensusEngine) networkVersionCutoff() (
+	versionCutoff,
+	bool,
+) {
+	e.announcedCutoffsMx.Lock()
+	defer e.announcedCutoffsMx.Unlock()
+
+	quorum := max(versionCutoffQuorum, len(e.announcedCutoffs)/3)
+	counts := map[string]int{}
+	for _, c := range e.announcedCutoffs {
+		if bytes.Compare(config.GetVersion(), c.version) < 0 {
+			counts[string(c.version)+c.cutoff.String()]++
+		}
+	}
+
+	var earliest versionCutoff
+	found := false
+	for _, c := range e.announcedCutoffs {
+		if counts[string(c.version)+c.cutoff.String()] < quorum {
+			continue
+		}
+		if !found || c.cutoff.Before(earliest.cutoff) {
+			earliest = c
+			found = true
+		}
+	}
+	return earliest, found
+}
+
+// runVersionCutoffMonitor warns, increasingly often, while the local version
+// is older than a minimum version the network announces for an upcoming
+// cutoff, and switches the node to follower-only once the cutoff passes. Past
+// the cutoff the network rejects frames from older versions, so proving would
+// only waste work and cost the node its peer scores. The node keeps syncing
+// frames, and resumes proving should the announcements be withdrawn.
+func (e *DataClockConsensusEngine) runVersionCutoffMonitor() {
+	var nextWarning time.Time
+	for {
+		wait := versionCutoffPollInterval
+		c, ok := e.networkVersionCutoff()
+		now := e.clock.Now()
+		remaining := c.cutoff.Sub(now)
+		switch {
+		case !ok:
+			if e.versionCutOff.Swap(false) {
+				e.logger.Info("version cutoff withdrawn, resuming proving")
+			}
+		case remaining <= 0:
+			if !e.versionCutOff.Swap(true) {
+				e.logger.Error(
+					"version cutoff passed, proving stopped and following the "+
+						"network only, upgrade to resume proving",
+					zap.String("version", config.GetVersionString()),
+					zap.String("minimum_version", config.FormatVersion(c.version)),
+					zap.Time("cutoff", c.cutoff),
+				)
+			}
+		default:
+			e.versionCutOff.Store(false)
+			if !now.Before(nextWarning) {
+				e.logger.Warn(
+					"version is older than the upcoming minimum version, proving "+
+						"will stop at the cutoff unless upgraded",
+					zap.String("version", config.GetVersionString()),
+					zap.String("minimum_version", config.FormatVersion(c.version)),
+					zap.Time("cutoff", c.cutoff),
+					zap.Duration("remaining", remaining),
+				)
+				nextWarning = now.Add(versionCutoffWarningInterval(remaining))
+			}
+			wait = min(wait, nextWarning.Sub(now), remaining)
+		}
+
+		select {
+		case <-e.ctx.Done():
+			return
+		case <-e.clock.After(wait):
+		}
+	}
+}
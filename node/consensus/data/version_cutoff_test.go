@@ -0,0 +1,77 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+func TestVersionCutoffMonitor(t *testing.T) {
+	clk := clock.NewFakeClock(time.UnixMilli(1700000000000))
+	core, logs := observer.New(zapcore.InfoLevel)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	e := &DataClockConsensusEngine{
+		ctx:              ctx,
+		logger:           zap.New(core),
+		clock:            clk,
+		announcedCutoffs: map[string]versionCutoff{},
+	}
+
+	cutoff := clk.Now().Add(72 * time.Hour).UnixMilli()
+	announce := func(peerId string, version []byte, minimumVersion []byte) {
+		e.recordAnnouncedCutoff([]byte(peerId), &protobufs.DataPeer{
+			Version:              version,
+			MinimumVersion:       minimumVersion,
+			MinimumVersionCutoff: cutoff,
+		})
+	}
+	// Too few peers announce a newer minimum version, or do not run it
+	// themselves.
+	announce("a", []byte{0x02, 0x01, 0x00}, []byte{0x02, 0x01, 0x00})
+	announce("b", []byte{0x02, 0x01, 0x00}, []byte{0x02, 0x01, 0x00})
+	announce("c", []byte{0x02, 0x00, 0x04}, []byte{0x02, 0x01, 0x00})
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		e.runVersionCutoffMonitor()
+	}()
+	clk.BlockUntil(1)
+	assert.Zero(t, logs.FilterLevelExact(zapcore.WarnLevel).Len())
+
+	// Once enough peers agree, warnings start, repeating as the cutoff nears.
+	announce("c", []byte{0x02, 0x01, 0x00}, []byte{0x02, 0x01, 0x00})
+	clk.Advance(versionCutoffPollInterval)
+	clk.BlockUntil(1)
+	assert.Equal(t, 1, logs.FilterLevelExact(zapcore.WarnLevel).Len())
+	clk.Advance(time.Hour)
+	clk.BlockUntil(1)
+	assert.Equal(t, 1, logs.FilterLevelExact(zapcore.WarnLevel).Len())
+	clk.Advance(5 * time.Hour)
+	clk.BlockUntil(1)
+	assert.Equal(t, 2, logs.FilterLevelExact(zapcore.WarnLevel).Len())
+	assert.False(t, e.versionCutOff.Load())
+
+	// Past the cutoff, proving stops.
+	clk.Advance(72 * time.Hour)
+	clk.BlockUntil(1)
+	assert.True(t, e.versionCutOff.Load())
+	assert.Equal(t, 1, logs.FilterLevelExact(zapcore.ErrorLevel).Len())
+
+	// Proving resumes if the announcements are withdrawn.
+	announce("c", []byte{0x02, 0x00, 0x04}, []byte{0x02, 0x00, 0x03})
+	clk.Advance(versionCutoffPollInterval)
+	clk.BlockUntil(1)
+	assert.False(t, e.versionCutOff.Load())
+
+	cancel()
+	<-done
+}
@@ -55,6 +55,7 @@ type MasterClockConsensusEngine struct {
 	seenFrames       []*protobufs.ClockFrame
 
 	clockStore                  store.ClockStore
+	metadataStore               store.MetadataStore
 	masterTimeReel              *qtime.MasterTimeReel
 	peerInfoManager             p2p.PeerInfoManager
 	report                      *protobufs.SelfTestReport
@@ -75,6 +76,7 @@ func NewMasterClockConsensusEngine(
 	engineConfig *config.EngineConfig,
 	logger *zap.Logger,
 	clockStore store.ClockStore,
+	metadataStore store.MetadataStore,
 	keyManager keys.KeyManager,
 	pubSub p2p.PubSub,
 	dataProver crypto.InclusionProver,
@@ -128,6 +130,7 @@ func NewMasterClockConsensusEngine(
 		lastFrameReceivedAt:  time.Time{},
 		syncingStatus:        SyncStatusNotSyncing,
 		clockStore:           clockStore,
+		metadataStore:        metadataStore,
 		dataProver:           dataProver,
 		frameProver:          frameProver,
 		masterTimeReel:       masterTimeReel,
@@ -324,6 +327,16 @@ func (
 			)
 		}
 
+		if e.engineConfig.IncludeMetadataInManifest &&
+			bytes.Equal([]byte(peerId), e.pubSub.GetPeerID()) {
+			metadata, err := e.metadataStore.ListMetadata()
+			if err != nil {
+				e.logger.Error("could not list metadata", zap.Error(err))
+			} else {
+				manifest.Metadata = protobufs.MetadataEntriesFromMap(metadata)
+			}
+		}
+
 		response.PeerManifests = append(
 			response.PeerManifests,
 			manifest,
@@ -3,6 +3,7 @@ package time
 import (
 	"bytes"
 	"encoding/hex"
+	"math"
 	"math/big"
 	"os"
 	"sync"
@@ -24,12 +25,19 @@ var unknownDistance = new(big.Int).SetBytes([]byte{
 	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
 })
 
+const defaultPendingFramesBudget = 1000
+
 type pendingFrame struct {
 	selector       *big.Int
 	parentSelector *big.Int
 	frameNumber    uint64
 }
 
+type rewindRequest struct {
+	frameNumber uint64
+	done        chan error
+}
+
 type DataTimeReel struct {
 	rwMutex sync.RWMutex
 	running bool
@@ -47,18 +55,32 @@ type DataTimeReel struct {
 		[]*tries.RollingFrecencyCritbitTrie,
 		error,
 	)
+	// Rolls the state exec produced back to a frame, ahead of a rewind.
+	unwind func(frameNumber uint64) error
 
 	origin                []byte
 	initialInclusionProof *crypto.InclusionAggregateProof
 	initialProverKeys     [][]byte
-	head                  *protobufs.ClockFrame
-	totalDistance         *big.Int
-	headDistance          *big.Int
-	lruFrames             *lru.Cache[string, string]
-	proverTries           []*tries.RollingFrecencyCritbitTrie
+	// Written only by the reel, read by others under headMx.
+	head   *protobufs.ClockFrame
+	headMx sync.RWMutex
+	// Notified, without blocking, whenever the head changes.
+	headSubscribers map[chan struct{}]struct{}
+	totalDistance   *big.Int
+	headDistance    *big.Int
+	lruFrames       *lru.Cache[string, string]
+	proverTries     []*tries.RollingFrecencyCritbitTrie
 	// pending               map[uint64][]*pendingFrame
 	incompleteForks map[uint64][]*pendingFrame
+	// persisted pending marker counts by frame number, bounded by pendingBudget.
+	// The budget only limits the frames revisited after a restart, frames past
+	// it are still staged.
+	pendingMx       sync.Mutex
+	pendingBranches map[uint64]int
+	pendingBudget   int
+	reorgLimit      uint64
 	frames          chan *pendingFrame
+	rewinds         chan *rewindRequest
 	newFrameCh      chan *protobufs.ClockFrame
 	badFrameCh      chan *protobufs.ClockFrame
 	done            chan bool
@@ -80,6 +102,7 @@ func NewDataTimeReel(
 		[]*tries.RollingFrecencyCritbitTrie,
 		error,
 	),
+	unwind func(frameNumber uint64) error,
 	origin []byte,
 	initialInclusionProof *crypto.InclusionAggregateProof,
 	initialProverKeys [][]byte,
@@ -115,6 +138,11 @@ func NewDataTimeReel(
 		panic(err)
 	}
 
+	pendingBudget := engineConfig.PendingFramesBudget
+	if pendingBudget == 0 {
+		pendingBudget = defaultPendingFramesBudget
+	}
+
 	return &DataTimeReel{
 		running:               false,
 		logger:                logger,
@@ -123,13 +151,19 @@ func NewDataTimeReel(
 		clockStore:            clockStore,
 		frameProver:           frameProver,
 		exec:                  exec,
+		unwind:                unwind,
 		origin:                origin,
 		initialInclusionProof: initialInclusionProof,
 		initialProverKeys:     initialProverKeys,
 		lruFrames:             cache,
 		// pending:               make(map[uint64][]*pendingFrame),
 		incompleteForks: make(map[uint64][]*pendingFrame),
+		pendingBranches: make(map[uint64]int),
+		pendingBudget:   pendingBudget,
+		reorgLimit:      engineConfig.ReorgLimit,
+		headSubscribers: make(map[chan struct{}]struct{}),
 		frames:          make(chan *pendingFrame),
+		rewinds:         make(chan *rewindRequest),
 		newFrameCh:      make(chan *protobufs.ClockFrame),
 		badFrameCh:      make(chan *protobufs.ClockFrame),
 		done:            make(chan bool),
@@ -145,7 +179,9 @@ func (d *DataTimeReel) Start() error {
 	}
 
 	if frame == nil {
-		d.head, d.proverTries = d.createGenesisFrame()
+		var genesis *protobufs.ClockFrame
+		genesis, d.proverTries = d.createGenesisFrame()
+		d.setHeadFrame(genesis)
 		d.totalDistance = big.NewInt(0)
 		d.headDistance = big.NewInt(0)
 	} else {
@@ -163,7 +199,7 @@ func (d *DataTimeReel) Start() error {
 			d.logger.Info("encountered trie corruption, invoking restoration")
 			tries = d.restore()
 		}
-		d.head = frame
+		d.setHeadFrame(frame)
 		if err != nil {
 			panic(err)
 		}
@@ -173,6 +209,7 @@ func (d *DataTimeReel) Start() error {
 	}
 
 	d.running = true
+	d.restorePending()
 	go d.runLoop()
 
 	return nil
@@ -183,13 +220,59 @@ func (d *DataTimeReel) SetHead(frame *protobufs.ClockFrame) {
 		panic("internal test function should never be called outside of tests")
 	}
 
-	d.head = frame
+	d.setHeadFrame(frame)
 }
 
 func (d *DataTimeReel) Head() (*protobufs.ClockFrame, error) {
+	d.headMx.RLock()
+	defer d.headMx.RUnlock()
 	return d.head, nil
 }
 
+func (d *DataTimeReel) setHeadFrame(frame *protobufs.ClockFrame) {
+	d.headMx.Lock()
+	defer d.headMx.Unlock()
+	d.head = frame
+	for ch := range d.headSubscribers {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// SubscribeHead returns a channel notified whenever the head changes, and a
+// function ending the subscription. Notifications are coalesced, so a slow
+// subscriber is notified once for several changes.
+func (d *DataTimeReel) SubscribeHead() (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+	d.headMx.Lock()
+	d.headSubscribers[ch] = struct{}{}
+	d.headMx.Unlock()
+
+	return ch, func() {
+		d.headMx.Lock()
+		delete(d.headSubscribers, ch)
+		d.headMx.Unlock()
+	}
+}
+
+// FinalizedFrameNumber returns the number of the highest frame buried deeper
+// than the reorg limit, which the reel never reorganizes at or below, or zero
+// without a reorg limit.
+func (d *DataTimeReel) FinalizedFrameNumber() uint64 {
+	if d.reorgLimit == 0 {
+		return 0
+	}
+
+	head, _ := d.Head()
+	if head == nil || head.FrameNumber <= d.reorgLimit {
+		return 0
+	}
+
+	return head.FrameNumber - d.reorgLimit
+}
+
 // Insert enqueues a structurally valid frame into the time reel. If the frame
 // is the next one in sequence, it advances the reel head forward and emits a
 // new frame on the new frame channel.
@@ -218,10 +301,11 @@ func (d *DataTimeReel) Insert(frame *protobufs.ClockFrame, isSync bool) error {
 
 	distance, _ := d.GetDistance(frame)
 
-	if d.head.FrameNumber < frame.FrameNumber {
+	head, _ := d.Head()
+	if head.FrameNumber < frame.FrameNumber {
 		d.storePending(selector, parent, distance, frame)
 
-		if d.head.FrameNumber+1 == frame.FrameNumber {
+		if head.FrameNumber+1 == frame.FrameNumber {
 			go func() {
 				d.frames <- &pendingFrame{
 					selector:       selector,
@@ -249,6 +333,19 @@ func (d *DataTimeReel) BadFrameCh() <-chan *protobufs.ClockFrame {
 	return d.badFrameCh
 }
 
+// Rewind rolls the head back to the committed frame with the given number,
+// forgetting every frame above it, so that they are synced again. It does
+// nothing if the head is not above the frame.
+func (d *DataTimeReel) Rewind(frameNumber uint64) error {
+	if !d.running {
+		return errors.Wrap(errors.New("time reel not running"), "rewind")
+	}
+
+	req := &rewindRequest{frameNumber: frameNumber, done: make(chan error, 1)}
+	d.rewinds <- req
+	return <-req.done
+}
+
 func (d *DataTimeReel) Stop() {
 	d.done <- true
 }
@@ -459,6 +556,8 @@ func (d *DataTimeReel) runLoop() {
 				// 	}
 				// }
 			}
+		case req := <-d.rewinds:
+			req.done <- d.rewind(req.frameNumber)
 		case <-d.done:
 			d.running = false
 			return
@@ -466,6 +565,63 @@ func (d *DataTimeReel) runLoop() {
 	}
 }
 
+func (d *DataTimeReel) rewind(frameNumber uint64) error {
+	head := d.head.FrameNumber
+	if frameNumber >= head {
+		return nil
+	}
+
+	frame, proverTries, err := d.clockStore.GetDataClockFrame(
+		d.filter,
+		frameNumber,
+		false,
+	)
+	if err != nil {
+		return errors.Wrap(err, "rewind")
+	}
+
+	// The executed state goes first, if it cannot be rolled back the frames
+	// are kept so that it still matches the head.
+	if d.unwind != nil {
+		if err := d.unwind(frameNumber); err != nil {
+			return errors.Wrap(err, "rewind")
+		}
+	}
+
+	// Removing the staged frames too keeps processPending from walking the
+	// same frames back onto the head.
+	if err := d.clockStore.DeleteDataClockFrameRange(
+		d.filter,
+		frameNumber+1,
+		head+1,
+	); err != nil {
+		return errors.Wrap(err, "rewind")
+	}
+	d.dropPending(frameNumber)
+
+	if err := d.clockStore.SetLatestDataClockFrameNumber(
+		d.filter,
+		frameNumber,
+	); err != nil {
+		return errors.Wrap(err, "rewind")
+	}
+
+	d.logger.Warn(
+		"rewound data time reel",
+		zap.Uint64("frame_number", frameNumber),
+		zap.Uint64("previous_head_frame_number", head),
+	)
+
+	d.proverTries = proverTries
+	d.totalDistance = big.NewInt(0)
+	d.headDistance, err = d.GetDistance(frame)
+	if err != nil {
+		d.headDistance = unknownDistance
+	}
+	d.setHeadFrame(frame)
+	return nil
+}
+
 // func (d *DataTimeReel) addPending(
 // 	selector *big.Int,
 // 	parent *big.Int,
@@ -544,9 +700,153 @@ func (d *DataTimeReel) storePending(
 			txn.Abort()
 			panic(err)
 		}
+
+		d.pendingMx.Lock()
+		defer d.pendingMx.Unlock()
+		marked := d.hasPendingBudget()
+		if marked {
+			err = d.clockStore.PutPendingDataClockFrame(
+				d.filter,
+				frame.FrameNumber,
+				selector.FillBytes(make([]byte, 32)),
+				txn,
+			)
+			if err != nil {
+				txn.Abort()
+				panic(err)
+			}
+		}
+
 		if err = txn.Commit(); err != nil {
 			panic(err)
 		}
+
+		if marked {
+			d.pendingBranches[frame.FrameNumber]++
+		}
+	}
+}
+
+// hasPendingBudget reports whether another pending frame may be marked for
+// revisiting after a restart. Callers must hold pendingMx.
+func (d *DataTimeReel) hasPendingBudget() bool {
+	if d.pendingBudget < 0 {
+		return false
+	}
+
+	total := 0
+	for _, count := range d.pendingBranches {
+		total += count
+	}
+
+	return total < d.pendingBudget
+}
+
+// restorePending reloads the pending branches persisted by a previous run,
+// drops the ones the head has already passed, and re-enqueues the frames that
+// directly follow the head so the remaining branches are walked again.
+func (d *DataTimeReel) restorePending() {
+	if d.pendingBudget < 0 {
+		return
+	}
+
+	frames, err := d.clockStore.GetPendingDataClockFrames(d.filter)
+	if err != nil {
+		d.logger.Error("could not restore pending frames", zap.Error(err))
+		return
+	}
+
+	d.pendingMx.Lock()
+	defer d.pendingMx.Unlock()
+
+	restored := 0
+	for _, frame := range frames {
+		if frame.FrameNumber <= d.head.FrameNumber {
+			continue
+		}
+
+		d.pendingBranches[frame.FrameNumber]++
+		restored++
+
+		if frame.FrameNumber != d.head.FrameNumber+1 {
+			continue
+		}
+
+		selector, err := frame.GetSelector()
+		if err != nil {
+			panic(err)
+		}
+
+		next := &pendingFrame{
+			selector:       selector,
+			parentSelector: new(big.Int).SetBytes(frame.ParentSelector),
+			frameNumber:    frame.FrameNumber,
+		}
+		go func() {
+			d.frames <- next
+		}()
+	}
+
+	if err := d.clockStore.DeletePendingDataClockFrames(
+		d.filter,
+		0,
+		d.head.FrameNumber+1,
+	); err != nil {
+		d.logger.Error("could not prune pending frames", zap.Error(err))
+	}
+
+	d.logger.Info(
+		"restored pending frames",
+		zap.Int("pending_frames", restored),
+		zap.Uint64("head_frame_number", d.head.FrameNumber),
+	)
+}
+
+// prunePending forgets persisted pending frames at or below the given frame
+// number, as they can no longer extend the head.
+func (d *DataTimeReel) prunePending(frameNumber uint64) {
+	d.pendingMx.Lock()
+	defer d.pendingMx.Unlock()
+
+	stale := false
+	for pendingFrameNumber := range d.pendingBranches {
+		if pendingFrameNumber <= frameNumber {
+			delete(d.pendingBranches, pendingFrameNumber)
+			stale = true
+		}
+	}
+
+	if !stale {
+		return
+	}
+
+	if err := d.clockStore.DeletePendingDataClockFrames(
+		d.filter,
+		0,
+		frameNumber+1,
+	); err != nil {
+		d.logger.Error("could not prune pending frames", zap.Error(err))
+	}
+}
+
+// dropPending forgets persisted pending frames above the given frame number,
+// as a rewind abandons the branches they extend.
+func (d *DataTimeReel) dropPending(frameNumber uint64) {
+	d.pendingMx.Lock()
+	defer d.pendingMx.Unlock()
+
+	for pendingFrameNumber := range d.pendingBranches {
+		if pendingFrameNumber > frameNumber {
+			delete(d.pendingBranches, pendingFrameNumber)
+		}
+	}
+
+	if err := d.clockStore.DeletePendingDataClockFrames(
+		d.filter,
+		frameNumber+1,
+		math.MaxUint64,
+	); err != nil {
+		d.logger.Error("could not drop pending frames", zap.Error(err))
 	}
 }
 
@@ -682,7 +982,8 @@ func (d *DataTimeReel) setHead(frame *protobufs.ClockFrame, distance *big.Int) e
 	}
 
 	d.proverTries = tries
-	d.head = frame
+	d.setHeadFrame(frame)
+	d.prunePending(frame.FrameNumber)
 
 	d.headDistance = distance
 	if d.alwaysSend {
@@ -844,9 +1145,21 @@ func (d *DataTimeReel) forkChoice(
 		rightTotal.Add(rightTotal, rightIndexDistance)
 	}
 
+	finalized := d.FinalizedFrameNumber()
+
 	// Walk backwards through the parents, until we find a matching parent
 	// selector:
 	for !bytes.Equal(left, right) {
+		// The fork would replace leftIndex, refuse if it is already final.
+		if finalized != 0 && leftIndex.FrameNumber <= finalized {
+			d.logger.Warn(
+				"proposed fork is deeper than the reorg limit",
+				zap.Uint64("frame_number", frame.FrameNumber),
+				zap.Uint64("finalized_frame_number", finalized),
+			)
+			return
+		}
+
 		d.logger.Debug(
 			"scan backwards",
 			zap.String("left_parent", hex.EncodeToString(leftIndex.ParentSelector)),
@@ -976,7 +1289,7 @@ func (d *DataTimeReel) forkChoice(
 		panic(err)
 	}
 
-	d.head = frame
+	d.setHeadFrame(frame)
 	d.totalDistance.Sub(d.totalDistance, leftTotal)
 	d.totalDistance.Add(d.totalDistance, rightTotal)
 	d.headDistance = distance
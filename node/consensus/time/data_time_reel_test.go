@@ -2,6 +2,7 @@ package time_test
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"math/rand"
 	"strings"
@@ -179,6 +180,7 @@ func TestDataTimeReel(t *testing.T) {
 		) {
 			return []*tries.RollingFrecencyCritbitTrie{proverTrie}, nil
 		},
+		nil,
 		bytes.Repeat([]byte{0x00}, 516),
 		&qcrypto.InclusionAggregateProof{
 			InclusionCommitments: []*qcrypto.InclusionCommitment{},
@@ -409,3 +411,288 @@ func TestDataTimeReel(t *testing.T) {
 		dataFrames[len(dataFrames)-1].GetPublicKeySignatureEd448().PublicKey.KeyValue,
 	)
 }
+
+// startTestDataTimeReel starts a data time reel over an empty store, and
+// returns it with a function proving the frame following a given one.
+func startTestDataTimeReel(
+	t *testing.T,
+	reorgLimit uint64,
+	unwind func(frameNumber uint64) error,
+) (
+	*time.DataTimeReel,
+	store.ClockStore,
+	func(frame *protobufs.ClockFrame) *protobufs.ClockFrame,
+) {
+	clockStore := store.NewPebbleClockStore(store.NewInMemKVDB(), zap.NewNop())
+	start, next := newTestDataTimeReels(t, clockStore)
+	d := start(&config.EngineConfig{ReorgLimit: reorgLimit}, unwind)
+	return d, clockStore, next
+}
+
+// newTestDataTimeReels returns a function starting data time reels over the
+// store, filling in the filter, genesis seed and difficulty of the engine
+// config, and a function proving the frame following a given one. The reels
+// share their provers, as a node restarting on its store does.
+func newTestDataTimeReels(t *testing.T, clockStore store.ClockStore) (
+	func(
+		engineConfig *config.EngineConfig,
+		unwind func(frameNumber uint64) error,
+	) *time.DataTimeReel,
+	func(frame *protobufs.ClockFrame) *protobufs.ClockFrame,
+) {
+	logger := zap.NewNop()
+	prover := qcrypto.NewWesolowskiFrameProver(logger)
+	keyManager, _, pubKeys, _, addrMap, proverTrie := generateTestProvers()
+
+	start := func(
+		engineConfig *config.EngineConfig,
+		unwind func(frameNumber uint64) error,
+	) *time.DataTimeReel {
+		engineConfig.Filter = "0000000000000000000000000000000000000000000000000000000000000000"
+		engineConfig.GenesisSeed = strings.Repeat("00", 516)
+		engineConfig.Difficulty = 10
+		d := time.NewDataTimeReel(
+			bytes.Repeat([]byte{0x01}, 32),
+			logger,
+			clockStore,
+			engineConfig,
+			prover,
+			func(
+				txn store.Transaction,
+				frame *protobufs.ClockFrame,
+				triesAtFrame []*tries.RollingFrecencyCritbitTrie,
+			) (
+				[]*tries.RollingFrecencyCritbitTrie,
+				error,
+			) {
+				return []*tries.RollingFrecencyCritbitTrie{proverTrie}, nil
+			},
+			unwind,
+			bytes.Repeat([]byte{0x00}, 516),
+			&qcrypto.InclusionAggregateProof{
+				InclusionCommitments: []*qcrypto.InclusionCommitment{},
+				AggregateCommitment:  []byte{},
+				Proof:                []byte{},
+			},
+			pubKeys,
+			true,
+			func() []*tries.RollingFrecencyCritbitTrie {
+				return []*tries.RollingFrecencyCritbitTrie{}
+			},
+		)
+		assert.NoError(t, d.Start())
+		return d
+	}
+
+	next := func(frame *protobufs.ClockFrame) *protobufs.ClockFrame {
+		prev := make([]byte, 32)
+		if frame.FrameNumber != 0 {
+			selector, err := frame.GetSelector()
+			assert.NoError(t, err)
+			prev = selector.FillBytes(prev)
+		}
+		signer, _ := keyManager.GetSigningKey(
+			addrMap[string(proverTrie.FindNearest(prev).Key)],
+		)
+		next, err := prover.ProveDataClockFrame(
+			frame,
+			[][]byte{},
+			[]*protobufs.InclusionAggregateProof{},
+			signer,
+			int64(frame.FrameNumber)+1,
+			10,
+		)
+		assert.NoError(t, err)
+		return next
+	}
+
+	return start, next
+}
+
+// awaitFrame waits for the reel to emit the frame. A reel that always sends
+// may emit a frame twice, so repeats of earlier frames are skipped.
+func awaitFrame(t *testing.T, d *time.DataTimeReel, frameNumber uint64) {
+	frame := <-d.NewFrameCh()
+	for frame.FrameNumber < frameNumber {
+		frame = <-d.NewFrameCh()
+	}
+	assert.Equal(t, frameNumber, frame.FrameNumber)
+}
+
+func TestDataTimeReelFinality(t *testing.T) {
+	for _, reorgLimit := range []uint64{0, 3} {
+		d, _, next := startTestDataTimeReel(t, reorgLimit, nil)
+
+		headChanged, unsubscribe := d.SubscribeHead()
+		frame, err := d.Head()
+		assert.NoError(t, err)
+		for i := 0; i < 10; i++ {
+			frame = next(frame)
+			// Ended subscriptions are no longer notified.
+			if i == 9 {
+				unsubscribe()
+			}
+			assert.NoError(t, d.Insert(frame, false))
+			awaitFrame(t, d, frame.FrameNumber)
+			if i < 9 {
+				<-headChanged
+			}
+		}
+
+		// Without a reorg limit, no frame is ever final.
+		if reorgLimit == 0 {
+			assert.Zero(t, d.FinalizedFrameNumber())
+		} else {
+			assert.Equal(t, frame.FrameNumber-reorgLimit, d.FinalizedFrameNumber())
+		}
+
+		select {
+		case <-headChanged:
+			t.Fatal("notified after unsubscribing")
+		default:
+		}
+		d.Stop()
+	}
+}
+
+func TestDataTimeReelRewind(t *testing.T) {
+	var unwound []uint64
+	unwindErr := error(nil)
+	d, clockStore, next := startTestDataTimeReel(
+		t,
+		0,
+		func(frameNumber uint64) error {
+			unwound = append(unwound, frameNumber)
+			return unwindErr
+		},
+	)
+	filter := bytes.Repeat([]byte{0x01}, 32)
+
+	frame, err := d.Head()
+	assert.NoError(t, err)
+	frames := []*protobufs.ClockFrame{frame}
+	for i := 0; i < 5; i++ {
+		frame = next(frame)
+		assert.NoError(t, d.Insert(frame, false))
+		awaitFrame(t, d, frame.FrameNumber)
+		frames = append(frames, frame)
+	}
+
+	assert.NoError(t, d.Rewind(6))
+	head, _ := d.Head()
+	assert.Equal(t, uint64(5), head.FrameNumber)
+	assert.Empty(t, unwound)
+
+	// Frames are kept when the executed state cannot be rolled back.
+	unwindErr = errors.New("no undo record")
+	assert.Error(t, d.Rewind(2))
+	head, _ = d.Head()
+	assert.Equal(t, uint64(5), head.FrameNumber)
+	_, _, err = clockStore.GetDataClockFrame(filter, 3, false)
+	assert.NoError(t, err)
+
+	// Pending frames above the frame rewound to are dropped.
+	assert.NoError(t, d.Insert(next(next(frames[5])), false))
+	assert.Equal(t, []uint64{7}, pendingFrameNumbers(t, clockStore, filter))
+
+	unwindErr = nil
+	assert.NoError(t, d.Rewind(2))
+	assert.Empty(t, pendingFrameNumbers(t, clockStore, filter))
+	assert.Equal(t, []uint64{2, 2}, unwound)
+	head, _ = d.Head()
+	assert.Equal(t, frames[2].Output, head.Output)
+	latest, _, err := clockStore.GetLatestDataClockFrame(filter)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), latest.FrameNumber)
+	_, _, err = clockStore.GetDataClockFrame(filter, 3, false)
+	assert.ErrorIs(t, err, store.ErrNotFound)
+
+	// The rolled back frames are accepted again once synced.
+	assert.NoError(t, d.Insert(frames[3], false))
+	awaitFrame(t, d, 3)
+	d.Stop()
+}
+
+// pendingFrameNumbers returns the numbers of the frames marked pending in the
+// store.
+func pendingFrameNumbers(
+	t *testing.T,
+	clockStore store.ClockStore,
+	filter []byte,
+) []uint64 {
+	frames, err := clockStore.GetPendingDataClockFrames(filter)
+	assert.NoError(t, err)
+	frameNumbers := []uint64{}
+	for _, frame := range frames {
+		frameNumbers = append(frameNumbers, frame.FrameNumber)
+	}
+	return frameNumbers
+}
+
+// stagePending stages the frame and marks it pending, as a time reel does
+// with frames ahead of its head.
+func stagePending(
+	t *testing.T,
+	clockStore store.ClockStore,
+	filter []byte,
+	frame *protobufs.ClockFrame,
+) {
+	selector, err := frame.GetSelector()
+	assert.NoError(t, err)
+	txn, err := clockStore.NewTransaction(false)
+	assert.NoError(t, err)
+	assert.NoError(t, clockStore.StageDataClockFrame(
+		selector.FillBytes(make([]byte, 32)),
+		frame,
+		txn,
+	))
+	assert.NoError(t, clockStore.PutPendingDataClockFrame(
+		filter,
+		frame.FrameNumber,
+		selector.FillBytes(make([]byte, 32)),
+		txn,
+	))
+	assert.NoError(t, txn.Commit())
+}
+
+func TestDataTimeReelRestorePending(t *testing.T) {
+	clockStore := store.NewPebbleClockStore(store.NewInMemKVDB(), zap.NewNop())
+	start, next := newTestDataTimeReels(t, clockStore)
+	filter := bytes.Repeat([]byte{0x01}, 32)
+
+	d := start(&config.EngineConfig{PendingFramesBudget: 2}, nil)
+	frame, err := d.Head()
+	assert.NoError(t, err)
+	frames := []*protobufs.ClockFrame{frame}
+	for i := 0; i < 6; i++ {
+		frame = next(frame)
+		frames = append(frames, frame)
+	}
+	for _, frame := range frames[1:3] {
+		assert.NoError(t, d.Insert(frame, false))
+		awaitFrame(t, d, frame.FrameNumber)
+	}
+
+	// Frames ahead of the head are all staged, but only marked pending up to
+	// the budget.
+	for _, frame := range frames[4:] {
+		assert.NoError(t, d.Insert(frame, false))
+	}
+	assert.Equal(t, []uint64{4, 5}, pendingFrameNumbers(t, clockStore, filter))
+	d.Stop()
+
+	// A frame following the head was left pending, along with a stale one the
+	// head has passed.
+	stagePending(t, clockStore, filter, frames[3])
+	stagePending(t, clockStore, filter, frames[2])
+
+	// On restart the stale frame is pruned, and the frame following the head is
+	// processed again, walking the staged frames past it.
+	d = start(&config.EngineConfig{PendingFramesBudget: 2}, nil)
+	assert.NotContains(t, pendingFrameNumbers(t, clockStore, filter), uint64(2))
+	awaitFrame(t, d, 6)
+	head, _ := d.Head()
+	assert.Equal(t, frames[6].Output, head.Output)
+	assert.Empty(t, pendingFrameNumbers(t, clockStore, filter))
+	d.Stop()
+}
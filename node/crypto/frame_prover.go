@@ -3,10 +3,15 @@ package crypto
 import (
 	"crypto"
 
+	"github.com/pkg/errors"
 	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
 	"source.quilibrium.com/quilibrium/monorepo/node/tries"
 )
 
+// ErrInvalidFrame is wrapped by verification failures that are caused by the
+// frame itself, as opposed to errors encountered locally while verifying it.
+var ErrInvalidFrame = errors.New("invalid frame")
+
 type FrameProver interface {
 	ProveMasterClockFrame(
 		previousFrame *protobufs.ClockFrame,
@@ -40,6 +45,12 @@ type FrameProver interface {
 	VerifyDataClockFrame(
 		frame *protobufs.ClockFrame,
 	) error
+	VerifyDataClockFrameSignature(
+		frame *protobufs.ClockFrame,
+	) error
+	VerifyDataClockFrameProof(
+		frame *protobufs.ClockFrame,
+	) error
 	GenerateWeakRecursiveProofIndex(
 		frame *protobufs.ClockFrame,
 	) (uint64, error)
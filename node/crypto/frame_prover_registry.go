@@ -0,0 +1,94 @@
+package crypto
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+)
+
+// DefaultFrameProver is the registered name of the frame prover used when
+// none is configured.
+const DefaultFrameProver = "wesolowski"
+
+// FrameProverFactory constructs a registered FrameProver.
+type FrameProverFactory func(logger *zap.Logger) (FrameProver, error)
+
+var (
+	frameProversMx sync.RWMutex
+	frameProvers   = map[string]FrameProverFactory{
+		DefaultFrameProver: func(logger *zap.Logger) (FrameProver, error) {
+			return NewWesolowskiFrameProver(logger), nil
+		},
+	}
+)
+
+// RegisterFrameProver makes a frame prover implementation selectable by name
+// through the engine config. Alternative implementations must produce and
+// accept the same proofs as the default, otherwise the node will fork off the
+// network. Registering a name twice panics.
+func RegisterFrameProver(name string, factory FrameProverFactory) {
+	frameProversMx.Lock()
+	defer frameProversMx.Unlock()
+
+	if _, ok := frameProvers[name]; ok {
+		panic("frame prover already registered: " + name)
+	}
+
+	frameProvers[name] = factory
+}
+
+// FrameProvers returns the names of all registered frame provers, sorted.
+func FrameProvers() []string {
+	frameProversMx.RLock()
+	defer frameProversMx.RUnlock()
+
+	names := make([]string, 0, len(frameProvers))
+	for name := range frameProvers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// NewFrameProverByName constructs the registered frame prover with the given
+// name.
+func NewFrameProverByName(
+	name string,
+	logger *zap.Logger,
+) (FrameProver, error) {
+	frameProversMx.RLock()
+	factory, ok := frameProvers[name]
+	frameProversMx.RUnlock()
+
+	if !ok {
+		return nil, errors.Wrap(
+			errors.Errorf("unknown frame prover %q", name),
+			"new frame prover",
+		)
+	}
+
+	prover, err := factory(logger)
+	return prover, errors.Wrap(err, "new frame prover")
+}
+
+// NewFrameProver constructs the frame prover selected by the engine config,
+// falling back to DefaultFrameProver.
+func NewFrameProver(
+	logger *zap.Logger,
+	engineConfig *config.EngineConfig,
+) (FrameProver, error) {
+	name := engineConfig.FrameProver
+	if name == "" {
+		name = DefaultFrameProver
+	}
+
+	if name != DefaultFrameProver {
+		logger.Info("using alternative frame prover", zap.String("name", name))
+	}
+
+	return NewFrameProverByName(name, logger)
+}
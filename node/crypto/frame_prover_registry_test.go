@@ -0,0 +1,81 @@
+package crypto_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	"source.quilibrium.com/quilibrium/monorepo/node/crypto"
+)
+
+type stubFrameProver struct {
+	*crypto.WesolowskiFrameProver
+}
+
+func TestFrameProverRegistry(t *testing.T) {
+	l, _ := zap.NewProduction()
+
+	p, err := crypto.NewFrameProver(l, &config.EngineConfig{})
+	assert.NoError(t, err)
+	assert.IsType(t, &crypto.WesolowskiFrameProver{}, p)
+
+	_, err = crypto.NewFrameProver(l, &config.EngineConfig{
+		FrameProver: "missing",
+	})
+	assert.Error(t, err)
+
+	crypto.RegisterFrameProver(
+		"stub",
+		func(logger *zap.Logger) (crypto.FrameProver, error) {
+			return &stubFrameProver{crypto.NewWesolowskiFrameProver(logger)}, nil
+		},
+	)
+	assert.Contains(t, crypto.FrameProvers(), "stub")
+
+	p, err = crypto.NewFrameProver(l, &config.EngineConfig{FrameProver: "stub"})
+	assert.NoError(t, err)
+	assert.IsType(t, &stubFrameProver{}, p)
+
+	assert.Panics(t, func() {
+		crypto.RegisterFrameProver("stub", nil)
+	})
+}
+
+// BenchmarkFrameProvers runs every registered frame prover through the same
+// challenge proof, so that alternative implementations can be compared with
+// the default: go test -bench FrameProvers ./crypto
+func BenchmarkFrameProvers(b *testing.B) {
+	l := zap.NewNop()
+	challenge := bytes.Repeat([]byte{0x01}, 32)
+	const difficulty = 10000
+
+	for _, name := range crypto.FrameProvers() {
+		p, err := crypto.NewFrameProverByName(name, l)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		b.Run(name+"/prove", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := p.CalculateChallengeProof(challenge, difficulty); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+
+		proof, err := p.CalculateChallengeProof(challenge, difficulty)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		b.Run(name+"/verify", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if !p.VerifyChallengeProof(challenge, difficulty, proof) {
+					b.Fatal("proof did not verify")
+				}
+			}
+		})
+	}
+}
@@ -376,23 +376,65 @@ func (w *WesolowskiFrameProver) CreateDataGenesisFrame(
 func (w *WesolowskiFrameProver) VerifyDataClockFrame(
 	frame *protobufs.ClockFrame,
 ) error {
-	var pubkey []byte
-	var signature []byte
-	pubkeyType := keys.KeyTypeEd448
-	ed448PublicKey := frame.GetPublicKeySignatureEd448()
-	if ed448PublicKey != nil {
-		pubkey = ed448PublicKey.PublicKey.KeyValue
-		signature = ed448PublicKey.Signature
-	} else {
+	b, err := w.verifyDataClockFrameSignature(frame)
+	if err != nil {
+		return err
+	}
+
+	return verifyDataClockFrameOutput(frame, b)
+}
+
+// VerifyDataClockFrameSignature performs every check of VerifyDataClockFrame
+// except the VDF proof verification.
+func (w *WesolowskiFrameProver) VerifyDataClockFrameSignature(
+	frame *protobufs.ClockFrame,
+) error {
+	_, err := w.verifyDataClockFrameSignature(frame)
+	return err
+}
+
+// VerifyDataClockFrameProof performs the VDF proof verification of
+// VerifyDataClockFrame alone, for frames whose signature was verified with
+// VerifyDataClockFrameSignature.
+func (w *WesolowskiFrameProver) VerifyDataClockFrameProof(
+	frame *protobufs.ClockFrame,
+) error {
+	b, err := dataClockFrameChallenge(frame)
+	if err != nil {
+		return err
+	}
+
+	return verifyDataClockFrameOutput(frame, b)
+}
+
+func verifyDataClockFrameOutput(frame *protobufs.ClockFrame, b [32]byte) error {
+	proof := [516]byte{}
+	copy(proof[:], frame.Output)
+	if !vdf.WesolowskiVerify(b, frame.Difficulty, proof) {
 		return errors.Wrap(
-			errors.New("no valid signature provided"),
+			errors.Wrap(ErrInvalidFrame, "invalid proof"),
 			"verify clock frame",
 		)
 	}
 
-	h, err := poseidon.HashBytes(pubkey)
+	return nil
+}
+
+// dataClockFrameChallenge computes the challenge of the VDF proof of a frame
+// from its header.
+func dataClockFrameChallenge(frame *protobufs.ClockFrame) ([32]byte, error) {
+	var b [32]byte
+	ed448PublicKey := frame.GetPublicKeySignatureEd448()
+	if ed448PublicKey == nil || ed448PublicKey.PublicKey == nil {
+		return b, errors.Wrap(
+			errors.Wrap(ErrInvalidFrame, "no valid signature provided"),
+			"verify clock frame",
+		)
+	}
+
+	h, err := poseidon.HashBytes(ed448PublicKey.PublicKey.KeyValue)
 	if err != nil {
-		return errors.Wrap(
+		return b, errors.Wrap(
 			errors.New("could not hash proving key"),
 			"verify clock frame",
 		)
@@ -409,22 +451,33 @@ func (w *WesolowskiFrameProver) VerifyDataClockFrame(
 	input = append(input, frame.Input...)
 
 	if len(frame.Input) < 516 {
-		return errors.Wrap(
-			errors.New("invalid input"),
+		return b, errors.Wrap(
+			errors.Wrap(ErrInvalidFrame, "invalid input"),
 			"verify clock frame",
 		)
 	}
 
 	if len(frame.Output) != 516 {
-		return errors.Wrap(
-			errors.New("invalid output"),
+		return b, errors.Wrap(
+			errors.Wrap(ErrInvalidFrame, "invalid output"),
 			"verify clock frame",
 		)
 	}
 
-	b := sha3.Sum256(input)
-	proof := [516]byte{}
-	copy(proof[:], frame.Output)
+	return sha3.Sum256(input), nil
+}
+
+func (w *WesolowskiFrameProver) verifyDataClockFrameSignature(
+	frame *protobufs.ClockFrame,
+) ([32]byte, error) {
+	b, err := dataClockFrameChallenge(frame)
+	if err != nil {
+		return b, err
+	}
+
+	pubkeyType := keys.KeyTypeEd448
+	pubkey := frame.GetPublicKeySignatureEd448().PublicKey.KeyValue
+	signature := frame.GetPublicKeySignatureEd448().Signature
 
 	// TODO: make this configurable for signing algorithms that allow
 	// user-supplied hash functions
@@ -436,36 +489,30 @@ func (w *WesolowskiFrameProver) VerifyDataClockFrame(
 			signature,
 			crypto.Hash(0),
 		) {
-			return errors.Wrap(
-				errors.New("invalid signature for issuer"),
+			return b, errors.Wrap(
+				errors.Wrap(ErrInvalidFrame, "invalid signature for issuer"),
 				"verify clock frame",
 			)
 		}
 	}
-	if !vdf.WesolowskiVerify(b, frame.Difficulty, proof) {
-		return errors.Wrap(
-			errors.New("invalid proof"),
-			"verify clock frame",
-		)
-	}
 
 	previousSelectorBytes := [516]byte{}
 	copy(previousSelectorBytes[:], frame.Input[:516])
 
 	parent, err := poseidon.HashBytes(previousSelectorBytes[:])
 	if err != nil {
-		return errors.Wrap(err, "verify clock frame")
+		return b, errors.Wrap(err, "verify clock frame")
 	}
 
 	selector := new(big.Int).SetBytes(frame.ParentSelector)
 	if parent.Cmp(selector) != 0 {
-		return errors.Wrap(
-			errors.New("selector did not match input"),
+		return b, errors.Wrap(
+			errors.Wrap(ErrInvalidFrame, "selector did not match input"),
 			"verify clock frame",
 		)
 	}
 
-	return nil
+	return b, nil
 }
 
 func (w *WesolowskiFrameProver) GenerateWeakRecursiveProofIndex(
@@ -1,6 +1,7 @@
 package execution
 
 import (
+	"context"
 	"math/big"
 
 	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
@@ -17,8 +18,18 @@ type ExecutionEngine interface {
 	) ([]*protobufs.Message, error)
 	GetPeerInfo() *protobufs.PeerInfoResponse
 	GetFrame() *protobufs.ClockFrame
+	GetFinalizedFrameNumber() uint64
+	SubscribeHead() (<-chan struct{}, func())
+	GetNetworkHeadEstimate() uint64
 	GetSeniority() *big.Int
 	GetRingPosition() int
 	AnnounceProverJoin()
 	GetWorkerCount() uint32
+	EnterMaintenance(ctx context.Context) error
+	ExitMaintenance()
+	GetSyncClients(limit int) []*protobufs.SyncClient
+	GetServingLatency() *protobufs.ServingLatencyResponse
+	GetProverStats() *protobufs.ProverStats
+	VerifyStateRoot(depth uint64) (*protobufs.VerifyStateRootResponse, error)
+	SubmitFrame(frame *protobufs.ClockFrame) error
 }
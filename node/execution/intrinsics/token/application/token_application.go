@@ -29,14 +29,21 @@ var TOKEN_ADDRESS = []byte{
 }
 
 type TokenApplication struct {
-	Beacon       []byte
-	TokenOutputs *protobufs.TokenOutputs
-	Tries        []*tries.RollingFrecencyCritbitTrie
-	CoinStore    store.CoinStore
-	ClockStore   store.ClockStore
-	PubSub       p2p.PubSub
-	Logger       *zap.Logger
-	Difficulty   uint32
+	Beacon []byte
+	// Requests included in the frame the application was materialized from.
+	TokenRequests *protobufs.TokenRequests
+	TokenOutputs  *protobufs.TokenOutputs
+	Tries         []*tries.RollingFrecencyCritbitTrie
+	CoinStore     store.CoinStore
+	ClockStore    store.ClockStore
+	PubSub        p2p.PubSub
+	Logger        *zap.Logger
+	Difficulty    uint32
+	// Network is the network the node runs on, zero being mainnet.
+	Network uint
+	// Events of the transitions last applied, in the order of the applied
+	// requests.
+	Events []*protobufs.ExecutionEvent
 }
 
 func GetOutputsFromClockFrame(
@@ -87,22 +94,29 @@ func MaterializeApplicationFromFrame(
 	pubSub p2p.PubSub,
 	logger *zap.Logger,
 ) (*TokenApplication, error) {
-	_, tokenOutputs, err := GetOutputsFromClockFrame(frame)
+	tokenRequests, tokenOutputs, err := GetOutputsFromClockFrame(frame)
 	if err != nil {
 		return nil, errors.Wrap(err, "materialize application from frame")
 	}
 
 	genesis := config.GetGenesis()
 
+	var network uint
+	if pubSub != nil {
+		network = pubSub.GetNetwork()
+	}
+
 	return &TokenApplication{
-		Beacon:       genesis.Beacon,
-		TokenOutputs: tokenOutputs,
-		Tries:        tries,
-		CoinStore:    coinStore,
-		ClockStore:   clockStore,
-		Logger:       logger,
-		PubSub:       pubSub,
-		Difficulty:   frame.Difficulty,
+		Beacon:        genesis.Beacon,
+		TokenRequests: tokenRequests,
+		TokenOutputs:  tokenOutputs,
+		Tries:         tries,
+		CoinStore:     coinStore,
+		ClockStore:    clockStore,
+		Logger:        logger,
+		PubSub:        pubSub,
+		Difficulty:    frame.Difficulty,
+		Network:       network,
 	}, nil
 }
 
@@ -159,6 +173,10 @@ func (a *TokenApplication) ApplyTransitions(
 
 	for i, transition := range requests {
 		i := i
+		if a.rejectsExpiry(currentFrameNumber, transition) {
+			fails[i] = transition
+			continue
+		}
 		switch t := transition.Request.(type) {
 		case *protobufs.TokenRequest_Mint:
 			if t == nil || t.Mint.Proofs == nil || t.Mint.Signature == nil {
@@ -321,7 +339,12 @@ func (a *TokenApplication) ApplyTransitions(
 			outputsSet[i] = success
 			successes[i] = transition
 		case *protobufs.TokenRequest_Merge:
-			success, err := a.handleMerge(currentFrameNumber, lockMap, t.Merge)
+			success, err := a.handleMerge(
+				currentFrameNumber,
+				lockMap,
+				t.Merge,
+				a.signedExpiry(currentFrameNumber, transition),
+			)
 			if err != nil {
 				if !skipFailures {
 					return nil, nil, nil, errors.Wrap(
@@ -335,7 +358,26 @@ func (a *TokenApplication) ApplyTransitions(
 			outputsSet[i] = success
 			successes[i] = transition
 		case *protobufs.TokenRequest_Split:
-			success, err := a.handleSplit(currentFrameNumber, lockMap, t.Split)
+			success, err := a.handleSplit(
+				currentFrameNumber,
+				lockMap,
+				t.Split,
+				a.signedExpiry(currentFrameNumber, transition),
+			)
+			if err != nil {
+				if !skipFailures {
+					return nil, nil, nil, errors.Wrap(
+						err,
+						"apply transitions",
+					)
+				}
+				fails[i] = transition
+				break req
+			}
+			outputsSet[i] = success
+			successes[i] = transition
+		case *protobufs.TokenRequest_Faucet:
+			success, err := a.handleFaucet(currentFrameNumber, lockMap, t.Faucet)
 			if err != nil {
 				if !skipFailures {
 					return nil, nil, nil, errors.Wrap(
@@ -349,7 +391,12 @@ func (a *TokenApplication) ApplyTransitions(
 			outputsSet[i] = success
 			successes[i] = transition
 		case *protobufs.TokenRequest_Transfer:
-			success, err := a.handleTransfer(currentFrameNumber, lockMap, t.Transfer)
+			success, err := a.handleTransfer(
+				currentFrameNumber,
+				lockMap,
+				t.Transfer,
+				a.signedExpiry(currentFrameNumber, transition),
+			)
 			if err != nil {
 				if !skipFailures {
 					return nil, nil, nil, errors.Wrap(
@@ -416,7 +463,25 @@ func (a *TokenApplication) ApplyTransitions(
 	}
 
 	outputs.Outputs = []*protobufs.TokenOutput{}
-	for _, out := range outputsSet {
+	events := []*protobufs.ExecutionEvent{}
+	requestIndex := 0
+	for i, out := range outputsSet {
+		if successes[i] != nil {
+			event, err := tokenEvent(
+				successes[i],
+				requestIndex,
+				out,
+				currentFrameNumber,
+				len(outputs.Outputs),
+			)
+			if err != nil {
+				return nil, nil, nil, errors.Wrap(err, "apply transitions")
+			}
+			if event != nil {
+				events = append(events, event)
+			}
+			requestIndex++
+		}
 		if out != nil {
 			for _, o := range out {
 				outputs.Outputs = append(outputs.Outputs, o)
@@ -425,6 +490,7 @@ func (a *TokenApplication) ApplyTransitions(
 	}
 
 	a.TokenOutputs = outputs
+	a.Events = events
 
 	finalizedTransitions.Requests = finalSuccesses
 	failedTransitions.Requests = finalFails
@@ -0,0 +1,149 @@
+package application
+
+import (
+	"encoding/binary"
+
+	"github.com/iden3/go-iden3-crypto/poseidon"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+// Types of the events emitted by the token application.
+const (
+	EventTypeTransfer = "transfer"
+	EventTypeMint     = "mint"
+	EventTypeMerge    = "merge"
+	EventTypeSplit    = "split"
+	EventTypeFaucet   = "faucet"
+)
+
+// EVENTS_FRAME_ACTIVATION is the first frame whose events must match the
+// execution of its requests. Earlier frames are verified without them, as
+// nodes of earlier versions prove frames without events.
+const EVENTS_FRAME_ACTIVATION = 100000
+
+// GetAddressOfCoin derives the address of a coin created as the seqno-th
+// output of the given frame.
+func GetAddressOfCoin(
+	coin *protobufs.Coin,
+	frameNumber uint64,
+	seqno uint64,
+) ([]byte, error) {
+	eval := []byte{}
+	eval = append(eval, TOKEN_ADDRESS...)
+	eval = binary.BigEndian.AppendUint64(eval, frameNumber)
+	if frameNumber != 0 {
+		eval = binary.BigEndian.AppendUint64(eval, seqno)
+	}
+	eval = append(eval, coin.Amount...)
+	eval = append(eval, coin.Intersection...)
+	eval = binary.BigEndian.AppendUint32(eval, 0)
+	eval = append(eval, coin.Owner.GetImplicitAccount().Address...)
+	addressBI, err := poseidon.HashBytes(eval)
+	if err != nil {
+		return nil, err
+	}
+
+	return addressBI.FillBytes(make([]byte, 32)), nil
+}
+
+func eventType(request *protobufs.TokenRequest) string {
+	switch request.Request.(type) {
+	case *protobufs.TokenRequest_Transfer:
+		return EventTypeTransfer
+	case *protobufs.TokenRequest_Mint:
+		return EventTypeMint
+	case *protobufs.TokenRequest_Merge:
+		return EventTypeMerge
+	case *protobufs.TokenRequest_Split:
+		return EventTypeSplit
+	case *protobufs.TokenRequest_Faucet:
+		return EventTypeFaucet
+	default:
+		return ""
+	}
+}
+
+// tokenEvent builds the event of a request applied in frameNumber, whose
+// outputs start at firstOutput among the outputs of the frame. Requests that
+// do not move coins have no event.
+func tokenEvent(
+	request *protobufs.TokenRequest,
+	requestIndex int,
+	outputs []*protobufs.TokenOutput,
+	frameNumber uint64,
+	firstOutput int,
+) (*protobufs.ExecutionEvent, error) {
+	typ := eventType(request)
+	if typ == "" {
+		return nil, nil
+	}
+
+	body := &protobufs.TokenEvent{}
+	for i, output := range outputs {
+		switch o := output.Output.(type) {
+		case *protobufs.TokenOutput_Coin:
+			address, err := GetAddressOfCoin(
+				o.Coin,
+				frameNumber,
+				uint64(firstOutput+i),
+			)
+			if err != nil {
+				return nil, errors.Wrap(err, "token event")
+			}
+			body.CreatedCoins = append(body.CreatedCoins, address)
+			body.Owners = append(
+				body.Owners,
+				o.Coin.Owner.GetImplicitAccount().GetAddress(),
+			)
+		case *protobufs.TokenOutput_DeletedCoin:
+			body.ConsumedCoins = append(
+				body.ConsumedCoins,
+				o.DeletedCoin.Address,
+			)
+		}
+	}
+
+	if len(body.CreatedCoins) == 0 && len(body.ConsumedCoins) == 0 {
+		return nil, nil
+	}
+
+	data, err := proto.Marshal(body)
+	if err != nil {
+		return nil, errors.Wrap(err, "token event")
+	}
+
+	topics := append([][]byte{}, body.ConsumedCoins...)
+	topics = append(topics, body.CreatedCoins...)
+	topics = append(topics, body.Owners...)
+
+	return &protobufs.ExecutionEvent{
+		Type:         typ,
+		Topics:       topics,
+		RequestIndex: uint32(requestIndex),
+		Data:         data,
+	}, nil
+}
+
+// GetEventsFromClockFrame returns the events carried by the execution output
+// of the frame, if any.
+func GetEventsFromClockFrame(
+	frame *protobufs.ClockFrame,
+) ([]*protobufs.ExecutionEvent, error) {
+	for _, proofs := range frame.AggregateProofs {
+		for _, inclusion := range proofs.InclusionCommitments {
+			if inclusion.TypeUrl != protobufs.IntrinsicExecutionOutputType {
+				continue
+			}
+
+			output := &protobufs.IntrinsicExecutionOutput{}
+			if err := proto.Unmarshal(inclusion.Data, output); err != nil {
+				return nil, errors.Wrap(err, "get events from clock frame")
+			}
+			return output.Events, nil
+		}
+	}
+
+	return nil, nil
+}
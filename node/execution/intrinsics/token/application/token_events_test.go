@@ -0,0 +1,59 @@
+package application
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+func TestTokenEvent(t *testing.T) {
+	owner := bytes.Repeat([]byte{0x01}, 32)
+	consumed := bytes.Repeat([]byte{0x02}, 32)
+	coin := &protobufs.Coin{
+		Amount:       []byte{0x05},
+		Intersection: make([]byte, 1024),
+		Owner: &protobufs.AccountRef{
+			Account: &protobufs.AccountRef_ImplicitAccount{
+				ImplicitAccount: &protobufs.ImplicitAccount{Address: owner},
+			},
+		},
+	}
+	outputs := []*protobufs.TokenOutput{
+		{Output: &protobufs.TokenOutput_Coin{Coin: coin}},
+		{Output: &protobufs.TokenOutput_DeletedCoin{
+			DeletedCoin: &protobufs.CoinRef{Address: consumed},
+		}},
+	}
+	transfer := &protobufs.TokenRequest{
+		Request: &protobufs.TokenRequest_Transfer{
+			Transfer: &protobufs.TransferCoinRequest{},
+		},
+	}
+
+	event, err := tokenEvent(transfer, 2, outputs, 100, 7)
+	assert.NoError(t, err)
+	assert.Equal(t, EventTypeTransfer, event.Type)
+	assert.Equal(t, uint32(2), event.RequestIndex)
+
+	created, err := GetAddressOfCoin(coin, 100, 7)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]byte{consumed, created, owner}, event.Topics)
+
+	body := &protobufs.TokenEvent{}
+	assert.NoError(t, proto.Unmarshal(event.Data, body))
+	assert.Equal(t, [][]byte{consumed}, body.ConsumedCoins)
+	assert.Equal(t, [][]byte{created}, body.CreatedCoins)
+	assert.Equal(t, [][]byte{owner}, body.Owners)
+
+	join := &protobufs.TokenRequest{
+		Request: &protobufs.TokenRequest_Join{
+			Join: &protobufs.AnnounceProverJoin{},
+		},
+	}
+	event, err = tokenEvent(join, 0, nil, 100, 0)
+	assert.NoError(t, err)
+	assert.Nil(t, event)
+}
@@ -0,0 +1,50 @@
+package application
+
+import "source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+
+// EXPIRY_FRAME_ACTIVATION is the first mainnet frame to honor the expiry frame
+// of token requests. Earlier frames apply requests regardless of it, as nodes
+// of earlier versions do. Test networks honor it from genesis.
+const EXPIRY_FRAME_ACTIVATION = 100000
+
+func (a *TokenApplication) honorsExpiry(frameNumber uint64) bool {
+	return a.Network != 0 || frameNumber >= EXPIRY_FRAME_ACTIVATION
+}
+
+// rejectsExpiry returns whether the request cannot be applied in the frame for
+// its expiry frame, either because it has passed or because the signature of
+// the request cannot cover it.
+func (a *TokenApplication) rejectsExpiry(
+	frameNumber uint64,
+	request *protobufs.TokenRequest,
+) bool {
+	if request.ExpiryFrame == 0 || !a.honorsExpiry(frameNumber) {
+		return false
+	}
+	return request.ExpiredAt(frameNumber) || !expirySigned(request)
+}
+
+// signedExpiry returns the expiry frame the signature of the request covers in
+// the frame, zero until expiry is honored.
+func (a *TokenApplication) signedExpiry(
+	frameNumber uint64,
+	request *protobufs.TokenRequest,
+) uint64 {
+	if !a.honorsExpiry(frameNumber) {
+		return 0
+	}
+	return request.ExpiryFrame
+}
+
+// expirySigned returns whether the signature of the request may cover an
+// expiry frame.
+func expirySigned(request *protobufs.TokenRequest) bool {
+	switch request.Request.(type) {
+	case *protobufs.TokenRequest_Transfer,
+		*protobufs.TokenRequest_Split,
+		*protobufs.TokenRequest_Merge:
+		return true
+	default:
+		return false
+	}
+}
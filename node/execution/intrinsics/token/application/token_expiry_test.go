@@ -0,0 +1,112 @@
+package application
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/cloudflare/circl/sign/ed448"
+	"github.com/iden3/go-iden3-crypto/poseidon"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+func TestRejectsExpiry(t *testing.T) {
+	app := &TokenApplication{}
+	transfer := &protobufs.TokenRequest{
+		Request:     &protobufs.TokenRequest_Transfer{},
+		ExpiryFrame: 10,
+	}
+	mint := &protobufs.TokenRequest{
+		Request:     &protobufs.TokenRequest_Mint{},
+		ExpiryFrame: EXPIRY_FRAME_ACTIVATION + 10,
+	}
+
+	// Before activation, mainnet applies requests regardless of expiry, as
+	// nodes of earlier versions do.
+	assert.False(t, app.rejectsExpiry(EXPIRY_FRAME_ACTIVATION-1, transfer))
+	assert.Zero(t, app.signedExpiry(EXPIRY_FRAME_ACTIVATION-1, transfer))
+
+	assert.True(t, app.rejectsExpiry(EXPIRY_FRAME_ACTIVATION, transfer))
+	assert.Equal(
+		t,
+		uint64(10),
+		app.signedExpiry(EXPIRY_FRAME_ACTIVATION, transfer),
+	)
+	// Requests whose signature cannot cover an expiry may not set one.
+	assert.True(t, app.rejectsExpiry(EXPIRY_FRAME_ACTIVATION, mint))
+	mint.ExpiryFrame = 0
+	assert.False(t, app.rejectsExpiry(EXPIRY_FRAME_ACTIVATION, mint))
+
+	// Test networks honor expiry from genesis.
+	app.Network = 1
+	assert.False(t, app.rejectsExpiry(10, transfer))
+	assert.True(t, app.rejectsExpiry(11, transfer))
+}
+
+func TestHandleTransferExpirySigned(t *testing.T) {
+	log, _ := zap.NewDevelopment()
+	coinStore := store.NewPebbleCoinStore(store.NewInMemKVDB(), log)
+	app := &TokenApplication{CoinStore: coinStore, Logger: log}
+
+	pub, priv, _ := ed448.GenerateKey(rand.Reader)
+	owner, err := poseidon.HashBytes(pub)
+	assert.NoError(t, err)
+	coinAddress := bytes.Repeat([]byte{0x01}, 32)
+	txn, _ := coinStore.NewTransaction(false)
+	assert.NoError(t, coinStore.PutCoin(txn, 1, coinAddress, &protobufs.Coin{
+		Amount:       make([]byte, 32),
+		Intersection: make([]byte, 1024),
+		Owner: &protobufs.AccountRef{
+			Account: &protobufs.AccountRef_ImplicitAccount{
+				ImplicitAccount: &protobufs.ImplicitAccount{
+					Address: owner.FillBytes(make([]byte, 32)),
+				},
+			},
+		},
+	}))
+	assert.NoError(t, txn.Commit())
+
+	toAddress := bytes.Repeat([]byte{0x02}, 32)
+	transfer := func(expiryFrame uint64) *protobufs.TransferCoinRequest {
+		payload := []byte("transfer")
+		payload = append(payload, coinAddress...)
+		payload = append(payload, toAddress...)
+		payload = protobufs.AppendExpiryFrame(payload, expiryFrame)
+		return &protobufs.TransferCoinRequest{
+			OfCoin: &protobufs.CoinRef{Address: coinAddress},
+			ToAccount: &protobufs.AccountRef{
+				Account: &protobufs.AccountRef_ImplicitAccount{
+					ImplicitAccount: &protobufs.ImplicitAccount{
+						Address: toAddress,
+					},
+				},
+			},
+			Signature: &protobufs.Ed448Signature{
+				PublicKey: &protobufs.Ed448PublicKey{KeyValue: pub},
+				Signature: ed448.Sign(priv, payload, ""),
+			},
+		}
+	}
+
+	signed := transfer(20)
+	// Adding, changing or stripping the expiry breaks the signature.
+	for _, expiryFrame := range []uint64{0, 21} {
+		_, err := app.handleTransfer(
+			10,
+			map[string]struct{}{},
+			signed,
+			expiryFrame,
+		)
+		assert.ErrorIs(t, err, ErrInvalidStateTransition)
+	}
+	_, err = app.handleTransfer(10, map[string]struct{}{}, transfer(0), 20)
+	assert.ErrorIs(t, err, ErrInvalidStateTransition)
+
+	_, err = app.handleTransfer(10, map[string]struct{}{}, signed, 20)
+	assert.NoError(t, err)
+	_, err = app.handleTransfer(10, map[string]struct{}{}, transfer(0), 0)
+	assert.NoError(t, err)
+}
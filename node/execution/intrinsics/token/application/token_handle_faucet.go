@@ -0,0 +1,113 @@
+package application
+
+import (
+	"math/big"
+
+	"github.com/iden3/go-iden3-crypto/poseidon"
+	pcrypto "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+// FAUCET_AMOUNT is the amount a faucet claim credits, 100 QUIL.
+var FAUCET_AMOUNT = new(big.Int).Mul(
+	big.NewInt(100),
+	big.NewInt(0x1DCD65000),
+)
+
+// FAUCET_CLAIM_INTERVAL is the number of frames an account waits between two
+// faucet claims.
+const FAUCET_CLAIM_INTERVAL = 360
+
+func (a *TokenApplication) handleFaucet(
+	currentFrameNumber uint64,
+	lockMap map[string]struct{},
+	t *protobufs.FaucetRequest,
+) ([]*protobufs.TokenOutput, error) {
+	// Funds out of thin air are only for test networks.
+	if a.Network == 0 {
+		return nil, errors.Wrap(ErrInvalidStateTransition, "handle faucet")
+	}
+
+	if t.Signature == nil {
+		return nil, errors.Wrap(ErrInvalidStateTransition, "handle faucet")
+	}
+
+	// A claim made at a frame that could precede the last claim of the account
+	// by the interval is refused, so a signature is never applied twice.
+	if t.FrameNumber > currentFrameNumber ||
+		currentFrameNumber-t.FrameNumber >= FAUCET_CLAIM_INTERVAL {
+		return nil, errors.Wrap(ErrInvalidStateTransition, "handle faucet")
+	}
+
+	if err := t.Signature.Verify(
+		protobufs.FaucetPayload(uint8(a.Network), t.FrameNumber),
+	); err != nil {
+		return nil, errors.Wrap(ErrInvalidStateTransition, "handle faucet")
+	}
+
+	// Claims are credited to the peer ID address, as mint rewards are.
+	pk, err := pcrypto.UnmarshalEd448PublicKey(
+		t.Signature.PublicKey.KeyValue,
+	)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidStateTransition, "handle faucet")
+	}
+
+	peerId, err := peer.IDFromPublicKey(pk)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidStateTransition, "handle faucet")
+	}
+
+	addrBI, err := poseidon.HashBytes([]byte(peerId))
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidStateTransition, "handle faucet")
+	}
+	addr := addrBI.FillBytes(make([]byte, 32))
+
+	if _, touched := lockMap["faucet"+string(addr)]; touched {
+		return nil, errors.Wrap(ErrInvalidStateTransition, "handle faucet")
+	}
+
+	last, err := a.CoinStore.GetFaucetClaimFrame(addr)
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		return nil, errors.Wrap(err, "handle faucet")
+	}
+	if err == nil && currentFrameNumber < last+FAUCET_CLAIM_INTERVAL {
+		return nil, errors.Wrap(ErrInvalidStateTransition, "handle faucet")
+	}
+
+	account := &protobufs.AccountRef{
+		Account: &protobufs.AccountRef_ImplicitAccount{
+			ImplicitAccount: &protobufs.ImplicitAccount{
+				ImplicitType: 0,
+				Address:      addr,
+			},
+		},
+	}
+	outputs := []*protobufs.TokenOutput{
+		{
+			Output: &protobufs.TokenOutput_Coin{
+				Coin: &protobufs.Coin{
+					Amount:       FAUCET_AMOUNT.FillBytes(make([]byte, 32)),
+					Intersection: make([]byte, 1024),
+					Owner:        account,
+				},
+			},
+		},
+		{
+			Output: &protobufs.TokenOutput_FaucetClaim{
+				FaucetClaim: &protobufs.FaucetClaim{
+					Account:     account,
+					FrameNumber: currentFrameNumber,
+				},
+			},
+		},
+	}
+
+	lockMap["faucet"+string(addr)] = struct{}{}
+
+	return outputs, nil
+}
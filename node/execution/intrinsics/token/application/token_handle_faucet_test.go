@@ -0,0 +1,108 @@
+package application
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/cloudflare/circl/sign/ed448"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+func TestHandleFaucet(t *testing.T) {
+	log, _ := zap.NewDevelopment()
+	coinStore := store.NewPebbleCoinStore(store.NewInMemKVDB(), log)
+	app := &TokenApplication{
+		CoinStore: coinStore,
+		Logger:    log,
+	}
+
+	pub, priv, _ := ed448.GenerateKey(rand.Reader)
+	claim := func(network uint8, frameNumber uint64) *protobufs.FaucetRequest {
+		return &protobufs.FaucetRequest{
+			Signature: &protobufs.Ed448Signature{
+				PublicKey: &protobufs.Ed448PublicKey{KeyValue: pub},
+				Signature: ed448.Sign(
+					priv,
+					protobufs.FaucetPayload(network, frameNumber),
+					"",
+				),
+			},
+			FrameNumber: frameNumber,
+		}
+	}
+	request := claim(1, 10)
+
+	// Mainnet has no faucet.
+	_, err := app.handleFaucet(10, map[string]struct{}{}, claim(0, 10))
+	assert.ErrorIs(t, err, ErrInvalidStateTransition)
+
+	app.Network = 1
+	_, err = app.handleFaucet(
+		10,
+		map[string]struct{}{},
+		&protobufs.FaucetRequest{
+			Signature: &protobufs.Ed448Signature{
+				PublicKey: &protobufs.Ed448PublicKey{KeyValue: pub},
+				Signature: ed448.Sign(priv, []byte("faucet"), ""),
+			},
+			FrameNumber: 10,
+		},
+	)
+	assert.ErrorIs(t, err, ErrInvalidStateTransition)
+
+	// Claims signed for another network, or for a frame other than the one
+	// they name, are refused.
+	_, err = app.handleFaucet(10, map[string]struct{}{}, claim(2, 10))
+	assert.ErrorIs(t, err, ErrInvalidStateTransition)
+	forged := claim(1, 10)
+	forged.FrameNumber = 9
+	_, err = app.handleFaucet(10, map[string]struct{}{}, forged)
+	assert.ErrorIs(t, err, ErrInvalidStateTransition)
+
+	// Claims made at a later frame, or one older than the claim interval, are
+	// refused.
+	_, err = app.handleFaucet(10, map[string]struct{}{}, claim(1, 11))
+	assert.ErrorIs(t, err, ErrInvalidStateTransition)
+	_, err = app.handleFaucet(
+		10+FAUCET_CLAIM_INTERVAL,
+		map[string]struct{}{},
+		request,
+	)
+	assert.ErrorIs(t, err, ErrInvalidStateTransition)
+
+	lockMap := map[string]struct{}{}
+	outputs, err := app.handleFaucet(10, lockMap, request)
+	assert.NoError(t, err)
+	assert.Len(t, outputs, 2)
+	coin := outputs[0].GetCoin()
+	assert.Equal(t, FAUCET_AMOUNT.FillBytes(make([]byte, 32)), coin.Amount)
+	claimed := outputs[1].GetFaucetClaim()
+	addr := claimed.Account.GetImplicitAccount().Address
+	assert.Equal(t, coin.Owner.GetImplicitAccount().Address, addr)
+	assert.Equal(t, uint64(10), claimed.FrameNumber)
+
+	// A second claim in the same frame is refused.
+	_, err = app.handleFaucet(10, lockMap, request)
+	assert.ErrorIs(t, err, ErrInvalidStateTransition)
+
+	txn, _ := coinStore.NewTransaction(false)
+	assert.NoError(t, coinStore.PutFaucetClaim(txn, addr, claimed.FrameNumber))
+	assert.NoError(t, txn.Commit())
+
+	// Claims are refused until the interval since the last one has elapsed.
+	_, err = app.handleFaucet(
+		10+FAUCET_CLAIM_INTERVAL-1,
+		map[string]struct{}{},
+		claim(1, 10+FAUCET_CLAIM_INTERVAL-1),
+	)
+	assert.ErrorIs(t, err, ErrInvalidStateTransition)
+	_, err = app.handleFaucet(
+		10+FAUCET_CLAIM_INTERVAL,
+		map[string]struct{}{},
+		claim(1, 10+FAUCET_CLAIM_INTERVAL),
+	)
+	assert.NoError(t, err)
+}
@@ -15,6 +15,7 @@ func (a *TokenApplication) handleMerge(
 	currentFrameNumber uint64,
 	lockMap map[string]struct{},
 	t *protobufs.MergeCoinRequest,
+	expiryFrame uint64,
 ) ([]*protobufs.TokenOutput, error) {
 	newCoin := &protobufs.Coin{}
 	newTotal := new(big.Int)
@@ -46,6 +47,7 @@ func (a *TokenApplication) handleMerge(
 		t.Signature.Signature == nil {
 		return nil, errors.Wrap(ErrInvalidStateTransition, "handle merge")
 	}
+	payload = protobufs.AppendExpiryFrame(payload, expiryFrame)
 	if err := t.Signature.Verify(payload); err != nil {
 		return nil, errors.Wrap(ErrInvalidStateTransition, "handle merge")
 	}
@@ -15,6 +15,7 @@ func (a *TokenApplication) handleSplit(
 	currentFrameNumber uint64,
 	lockMap map[string]struct{},
 	t *protobufs.SplitCoinRequest,
+	expiryFrame uint64,
 ) ([]*protobufs.TokenOutput, error) {
 	newCoins := []*protobufs.Coin{}
 	newAmounts := []*big.Int{}
@@ -46,6 +47,7 @@ func (a *TokenApplication) handleSplit(
 		payload = append(payload, a...)
 	}
 
+	payload = protobufs.AppendExpiryFrame(payload, expiryFrame)
 	if err := t.Signature.Verify(payload); err != nil {
 		return nil, errors.Wrap(ErrInvalidStateTransition, "handle split")
 	}
@@ -15,6 +15,7 @@ func (a *TokenApplication) handleTransfer(
 	currentFrameNumber uint64,
 	lockMap map[string]struct{},
 	t *protobufs.TransferCoinRequest,
+	expiryFrame uint64,
 ) ([]*protobufs.TokenOutput, error) {
 	payload := []byte("transfer")
 	if t == nil || t.Signature == nil || t.OfCoin == nil ||
@@ -40,6 +41,7 @@ func (a *TokenApplication) handleTransfer(
 		t.ToAccount.GetImplicitAccount().Address...,
 	)
 
+	payload = protobufs.AppendExpiryFrame(payload, expiryFrame)
 	if err := t.Signature.Verify(payload); err != nil {
 		return nil, errors.Wrap(ErrInvalidStateTransition, "handle transfer")
 	}
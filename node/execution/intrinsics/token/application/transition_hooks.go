@@ -0,0 +1,195 @@
+package application
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/sha3"
+	"google.golang.org/protobuf/proto"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+// ErrTransitionRejected is returned when a transition hook rejects a locally
+// submitted request.
+var ErrTransitionRejected = errors.New("transition rejected")
+
+// Maximum number of locally submitted requests awaiting inclusion in a frame
+// that are remembered for the post-transition hooks. The oldest are forgotten
+// first.
+const maxPendingLocalTransitions = 10000
+
+// TransitionHook adds deployment specific validation to the token requests
+// the node itself submits over RPC. Hooks never see gossiped requests and do
+// not take part in ApplyTransitions, so consensus rules are unaffected.
+type TransitionHook interface {
+	// PreTransition is called before a request is submitted. Returning an
+	// error rejects the request.
+	PreTransition(request *protobufs.TokenRequest) error
+	// PostTransition is called once a submitted request has been applied in a
+	// frame, with the events it produced.
+	PostTransition(
+		frameNumber uint64,
+		request *protobufs.TokenRequest,
+		events []*protobufs.ExecutionEvent,
+	)
+}
+
+var (
+	transitionHooksMx sync.RWMutex
+	transitionHooks   = map[string]TransitionHook{}
+
+	pendingLocalMx    sync.Mutex
+	pendingLocal      = map[[32]byte]struct{}{}
+	pendingLocalOrder [][32]byte
+)
+
+// RegisterTransitionHook registers a hook under a unique name. Hooks are run
+// in name order. Registering a name twice panics.
+func RegisterTransitionHook(name string, hook TransitionHook) {
+	transitionHooksMx.Lock()
+	defer transitionHooksMx.Unlock()
+
+	if _, ok := transitionHooks[name]; ok {
+		panic("transition hook already registered: " + name)
+	}
+
+	transitionHooks[name] = hook
+}
+
+// UnregisterTransitionHook removes the hook registered under the name, if
+// any.
+func UnregisterTransitionHook(name string) {
+	transitionHooksMx.Lock()
+	defer transitionHooksMx.Unlock()
+
+	delete(transitionHooks, name)
+}
+
+func sortedTransitionHooks() []TransitionHook {
+	transitionHooksMx.RLock()
+	defer transitionHooksMx.RUnlock()
+
+	names := make([]string, 0, len(transitionHooks))
+	for name := range transitionHooks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	hooks := make([]TransitionHook, 0, len(names))
+	for _, name := range names {
+		hooks = append(hooks, transitionHooks[name])
+	}
+
+	return hooks
+}
+
+func requestKey(request *protobufs.TokenRequest) ([32]byte, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(request)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	return sha3.Sum256(data), nil
+}
+
+// PreTransition runs the registered hooks over a request about to be
+// submitted locally, and remembers the request for PostTransitions once every
+// hook accepted it.
+func PreTransition(request *protobufs.TokenRequest) error {
+	hooks := sortedTransitionHooks()
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	for _, hook := range hooks {
+		if err := hook.PreTransition(request); err != nil {
+			return errors.Wrap(
+				errors.Wrap(ErrTransitionRejected, err.Error()),
+				"pre transition",
+			)
+		}
+	}
+
+	key, err := requestKey(request)
+	if err != nil {
+		return errors.Wrap(err, "pre transition")
+	}
+
+	pendingLocalMx.Lock()
+	defer pendingLocalMx.Unlock()
+
+	if _, ok := pendingLocal[key]; ok {
+		return nil
+	}
+	if len(pendingLocalOrder) >= maxPendingLocalTransitions {
+		delete(pendingLocal, pendingLocalOrder[0])
+		pendingLocalOrder = pendingLocalOrder[1:]
+	}
+	pendingLocal[key] = struct{}{}
+	pendingLocalOrder = append(pendingLocalOrder, key)
+
+	return nil
+}
+
+// PostTransitions runs the registered hooks over the locally submitted
+// requests applied in the frame.
+func PostTransitions(frame *protobufs.ClockFrame) error {
+	pendingLocalMx.Lock()
+	pending := len(pendingLocal)
+	pendingLocalMx.Unlock()
+	if pending == 0 {
+		return nil
+	}
+
+	requests, _, err := GetOutputsFromClockFrame(frame)
+	if err != nil {
+		return errors.Wrap(err, "post transitions")
+	}
+
+	events, err := GetEventsFromClockFrame(frame)
+	if err != nil {
+		return errors.Wrap(err, "post transitions")
+	}
+
+	hooks := sortedTransitionHooks()
+	for i, request := range requests.Requests {
+		key, err := requestKey(request)
+		if err != nil {
+			return errors.Wrap(err, "post transitions")
+		}
+
+		pendingLocalMx.Lock()
+		_, ok := pendingLocal[key]
+		if ok {
+			delete(pendingLocal, key)
+			pendingLocalOrder = removeKey(pendingLocalOrder, key)
+		}
+		pendingLocalMx.Unlock()
+		if !ok {
+			continue
+		}
+
+		requestEvents := []*protobufs.ExecutionEvent{}
+		for _, event := range events {
+			if event.RequestIndex == uint32(i) {
+				requestEvents = append(requestEvents, event)
+			}
+		}
+
+		for _, hook := range hooks {
+			hook.PostTransition(frame.FrameNumber, request, requestEvents)
+		}
+	}
+
+	return nil
+}
+
+func removeKey(keys [][32]byte, key [32]byte) [][32]byte {
+	for i := range keys {
+		if keys[i] == key {
+			return append(keys[:i], keys[i+1:]...)
+		}
+	}
+	return keys
+}
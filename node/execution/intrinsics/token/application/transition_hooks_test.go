@@ -0,0 +1,119 @@
+package application
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+type recordingHook struct {
+	applied []uint64
+	events  [][]*protobufs.ExecutionEvent
+}
+
+func (h *recordingHook) PreTransition(request *protobufs.TokenRequest) error {
+	if request.GetSplit() != nil {
+		return errors.New("splits are not allowed")
+	}
+	return nil
+}
+
+func (h *recordingHook) PostTransition(
+	frameNumber uint64,
+	request *protobufs.TokenRequest,
+	events []*protobufs.ExecutionEvent,
+) {
+	h.applied = append(h.applied, frameNumber)
+	h.events = append(h.events, events)
+}
+
+func TestTransitionHooks(t *testing.T) {
+	hook := &recordingHook{}
+	RegisterTransitionHook("test", hook)
+	t.Cleanup(func() { UnregisterTransitionHook("test") })
+	assert.Panics(t, func() { RegisterTransitionHook("test", hook) })
+
+	split := &protobufs.TokenRequest{
+		Request: &protobufs.TokenRequest_Split{
+			Split: &protobufs.SplitCoinRequest{},
+		},
+	}
+	err := PreTransition(split)
+	assert.ErrorIs(t, err, ErrTransitionRejected)
+
+	local := &protobufs.TokenRequest{
+		Request: &protobufs.TokenRequest_Transfer{
+			Transfer: &protobufs.TransferCoinRequest{},
+		},
+		Timestamp: 1,
+	}
+	assert.NoError(t, PreTransition(local))
+
+	gossiped := &protobufs.TokenRequest{
+		Request: &protobufs.TokenRequest_Transfer{
+			Transfer: &protobufs.TransferCoinRequest{},
+		},
+		Timestamp: 2,
+	}
+	event := &protobufs.ExecutionEvent{
+		Type:         EventTypeTransfer,
+		RequestIndex: 1,
+	}
+	frame := executionOutputFrame(
+		t,
+		7,
+		[]*protobufs.TokenRequest{gossiped, local},
+		[]*protobufs.ExecutionEvent{event},
+	)
+
+	assert.NoError(t, PostTransitions(frame))
+	assert.Equal(t, []uint64{7}, hook.applied)
+	assert.Len(t, hook.events[0], 1)
+	assert.True(t, proto.Equal(event, hook.events[0][0]))
+
+	// A request only completes once.
+	assert.NoError(t, PostTransitions(frame))
+	assert.Equal(t, []uint64{7}, hook.applied)
+
+	// Unregistered hooks no longer run, and the name can be reused.
+	UnregisterTransitionHook("test")
+	assert.NoError(t, PreTransition(split))
+	RegisterTransitionHook("test", hook)
+}
+
+func executionOutputFrame(
+	t *testing.T,
+	frameNumber uint64,
+	requests []*protobufs.TokenRequest,
+	events []*protobufs.ExecutionEvent,
+) *protobufs.ClockFrame {
+	outputs, err := proto.Marshal(&protobufs.TokenOutputs{})
+	assert.NoError(t, err)
+	transitions, err := proto.Marshal(
+		&protobufs.TokenRequests{Requests: requests},
+	)
+	assert.NoError(t, err)
+	data, err := proto.Marshal(&protobufs.IntrinsicExecutionOutput{
+		Output: outputs,
+		Proof:  transitions,
+		Events: events,
+	})
+	assert.NoError(t, err)
+
+	return &protobufs.ClockFrame{
+		FrameNumber: frameNumber,
+		AggregateProofs: []*protobufs.InclusionAggregateProof{
+			{
+				InclusionCommitments: []*protobufs.InclusionCommitment{
+					{
+						TypeUrl: protobufs.IntrinsicExecutionOutputType,
+						Data:    data,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,212 @@
+package token
+
+import (
+	"bytes"
+	"slices"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/execution/intrinsics/token/application"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+	"source.quilibrium.com/quilibrium/monorepo/node/tries"
+)
+
+// The number of frames up to the head VerifyStateRoot re-derives when no
+// depth is given.
+const defaultStateRootDepth = 16
+
+// VerifyStateRoot re-runs the processing of the most recent depth frames, at
+// most store.AppStateUndoDepth, and compares the result against what was
+// stored for each frame: its prover tries, the application state it wrote and
+// the seniority map after it. Every frame is re-run over the state before it,
+// rebuilt from the undo records of the frames since, so a divergence points at
+// the frame whose processing went wrong.
+//
+// Ring resets and the seniority repair rebuild seniority from outside the
+// store, so the seniority after those frames is not compared.
+func (e *TokenExecutionEngine) VerifyStateRoot(
+	depth uint64,
+) (*protobufs.VerifyStateRootResponse, error) {
+	if depth == 0 {
+		depth = defaultStateRootDepth
+	}
+	if depth > store.AppStateUndoDepth {
+		depth = store.AppStateUndoDepth
+	}
+
+	view, err := e.coinStore.NewAppStateView(e.intrinsicFilter)
+	if err != nil {
+		return nil, errors.Wrap(err, "verify state root")
+	}
+
+	head := view.FrameNumber()
+	from := uint64(1)
+	if head > depth {
+		from = head - depth + 1
+	}
+
+	resp := &protobufs.VerifyStateRootResponse{
+		HeadFrameNumber: head,
+		FromFrameNumber: from,
+	}
+	for frameNumber := head; frameNumber >= from; frameNumber-- {
+		seniority := view.PeerSeniority()
+		written, err := view.Revert()
+		if err != nil {
+			if errors.Is(err, store.ErrNotFound) {
+				resp.FromFrameNumber = frameNumber + 1
+				break
+			}
+
+			return nil, errors.Wrap(err, "verify state root")
+		}
+
+		divergence, err := e.verifyFrame(view, frameNumber, written, seniority)
+		if err != nil {
+			return nil, errors.Wrapf(err, "verify state root: frame %d", frameNumber)
+		}
+		if divergence == nil {
+			continue
+		}
+
+		e.logger.Warn(
+			"stored state diverges from re-derived state",
+			zap.Uint64("frame_number", frameNumber),
+			zap.Int("rings", len(divergence.Rings)),
+			zap.Int("keys", len(divergence.Keys)),
+			zap.Int("seniority_addresses", len(divergence.SeniorityAddresses)),
+		)
+		resp.Divergences = append(resp.Divergences, divergence)
+	}
+	slices.Reverse(resp.Divergences)
+
+	return resp, nil
+}
+
+// verifyFrame re-runs the processing of the frame over view, which holds the
+// state before it, and compares the result with the values the frame wrote
+// and the seniority map after it. Returns nil if they match.
+func (e *TokenExecutionEngine) verifyFrame(
+	view *store.AppStateView,
+	frameNumber uint64,
+	written map[string][]byte,
+	seniority map[string]uint64,
+) (*protobufs.StateRootDivergence, error) {
+	_, parentTries, err := e.clockStore.GetDataClockFrame(
+		e.intrinsicFilter,
+		frameNumber-1,
+		false,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "verify frame")
+	}
+
+	frame, storedTries, err := e.clockStore.GetDataClockFrame(
+		e.intrinsicFilter,
+		frameNumber,
+		false,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "verify frame")
+	}
+
+	coinStore := store.NewPebbleCoinStore(view, e.logger)
+	app, err := application.MaterializeApplicationFromFrame(
+		e.provingKey,
+		frame,
+		parentTries,
+		coinStore,
+		e.clockStore,
+		e.pubSub,
+		e.logger,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "verify frame")
+	}
+
+	capture := view.Capture()
+	derived, err := e.applyFrameOutputs(
+		capture,
+		coinStore,
+		app,
+		frame,
+		NewFromMap(view.PeerSeniority()),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "verify frame")
+	}
+
+	reseeded := frame.FrameNumber == application.PROOF_FRAME_SENIORITY_REPAIR
+	if frame.FrameNumber == application.PROOF_FRAME_RING_RESET ||
+		frame.FrameNumber == application.PROOF_FRAME_RING_RESET_2 ||
+		frame.FrameNumber == application.PROOF_FRAME_RING_RESET_3 {
+		app.Tries = []*tries.RollingFrecencyCritbitTrie{
+			app.Tries[0],
+		}
+		reseeded = true
+	}
+
+	divergence := &protobufs.StateRootDivergence{FrameNumber: frameNumber}
+	rings := tries.DiffRings(storedTries, app.Tries)
+	for _, ring := range rings {
+		if len(ring.Added) != 0 || len(ring.Removed) != 0 ||
+			len(ring.Changed) != 0 {
+			divergence.Rings = rings
+			break
+		}
+	}
+
+	divergence.Keys = diffWrites(written, capture.Writes())
+	if !reseeded {
+		divergence.SeniorityAddresses = diffSeniority(
+			seniority,
+			ToSerializedMap(derived),
+		)
+	}
+
+	if len(divergence.Rings) == 0 && len(divergence.Keys) == 0 &&
+		len(divergence.SeniorityAddresses) == 0 {
+		return nil, nil
+	}
+
+	return divergence, nil
+}
+
+// diffWrites returns the keys, in order, that only one of the writes has or
+// that they give different values, nil meaning deleted.
+func diffWrites(stored, derived map[string][]byte) [][]byte {
+	keys := [][]byte{}
+	for key, value := range stored {
+		d, ok := derived[key]
+		if !ok || (value == nil) != (d == nil) || !bytes.Equal(value, d) {
+			keys = append(keys, []byte(key))
+		}
+	}
+	for key := range derived {
+		if _, ok := stored[key]; !ok {
+			keys = append(keys, []byte(key))
+		}
+	}
+	slices.SortFunc(keys, bytes.Compare)
+
+	return keys
+}
+
+// diffSeniority returns the addresses, in order, whose seniority differs.
+func diffSeniority(stored, derived map[string]uint64) [][]byte {
+	addresses := [][]byte{}
+	for addr, s := range stored {
+		if d, ok := derived[addr]; !ok || d != s {
+			addresses = append(addresses, []byte(addr))
+		}
+	}
+	for addr := range derived {
+		if _, ok := stored[addr]; !ok {
+			addresses = append(addresses, []byte(addr))
+		}
+	}
+	slices.SortFunc(addresses, bytes.Compare)
+
+	return addresses
+}
@@ -0,0 +1,202 @@
+package token
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+	"source.quilibrium.com/quilibrium/monorepo/node/tries"
+)
+
+func testOutputFrame(
+	t *testing.T,
+	filter []byte,
+	frameNumber uint64,
+	parent []byte,
+	outputs ...*protobufs.TokenOutput,
+) *protobufs.ClockFrame {
+	requests, err := proto.Marshal(&protobufs.TokenRequests{})
+	assert.NoError(t, err)
+	tokenOutputs, err := proto.Marshal(&protobufs.TokenOutputs{Outputs: outputs})
+	assert.NoError(t, err)
+	output, err := proto.Marshal(&protobufs.IntrinsicExecutionOutput{
+		Address: filter,
+		Output:  tokenOutputs,
+		Proof:   requests,
+	})
+	assert.NoError(t, err)
+
+	// Stored frames find their aggregate proofs by the commitments following
+	// the first 516 bytes of the input.
+	commitment := bytes.Repeat([]byte{byte(frameNumber + 1)}, 74)
+	return &protobufs.ClockFrame{
+		Filter:         filter,
+		FrameNumber:    frameNumber,
+		ParentSelector: parent,
+		Input:          append(make([]byte, 516), commitment...),
+		Output:         bytes.Repeat([]byte{byte(frameNumber + 1)}, 516),
+		AggregateProofs: []*protobufs.InclusionAggregateProof{{
+			Filter:      filter,
+			FrameNumber: frameNumber,
+			InclusionCommitments: []*protobufs.InclusionCommitment{{
+				Commitment: commitment,
+				TypeUrl:    protobufs.IntrinsicExecutionOutputType,
+				Data:       output,
+			}},
+		}},
+	}
+}
+
+// processTestFrames processes the frames as the time reel does, committing
+// each with the tries its processing produced.
+func processTestFrames(
+	t *testing.T,
+	e *TokenExecutionEngine,
+	outputs [][]*protobufs.TokenOutput,
+) {
+	trie := &tries.RollingFrecencyCritbitTrie{}
+	trie.Add(bytes.Repeat([]byte{0xaa}, 32), 0)
+	proverTries := []*tries.RollingFrecencyCritbitTrie{trie}
+	parent := make([]byte, 32)
+	for n := range outputs {
+		frame := testOutputFrame(
+			t,
+			e.intrinsicFilter,
+			uint64(n),
+			parent,
+			outputs[n]...,
+		)
+		selector, err := frame.GetSelector()
+		assert.NoError(t, err)
+		parent = selector.FillBytes(make([]byte, 32))
+
+		txn, err := e.clockStore.NewTransaction(false)
+		assert.NoError(t, err)
+		if n != 0 {
+			proverTries, err = e.ProcessFrame(txn, frame, proverTries)
+			assert.NoError(t, err)
+		}
+		assert.NoError(t, e.clockStore.StageDataClockFrame(parent, frame, txn))
+		assert.NoError(t, e.clockStore.CommitDataClockFrame(
+			e.intrinsicFilter,
+			frame.FrameNumber,
+			parent,
+			proverTries,
+			txn,
+			false,
+		))
+		assert.NoError(t, txn.Commit())
+	}
+}
+
+func TestVerifyStateRoot(t *testing.T) {
+	// Materializing an application reads the genesis, which outside the main
+	// network is not downloaded.
+	_, err := config.DownloadAndVerifyGenesis(1)
+	assert.NoError(t, err)
+
+	db := store.NewInMemKVDB()
+	e := &TokenExecutionEngine{
+		logger:          zap.NewNop(),
+		clockStore:      store.NewPebbleClockStore(db, zap.NewNop()),
+		coinStore:       store.NewPebbleCoinStore(db, zap.NewNop()),
+		intrinsicFilter: bytes.Repeat([]byte{0x01}, 32),
+		peerSeniority:   NewFromMap(map[string]uint64{}),
+	}
+
+	coin := &protobufs.Coin{
+		Amount: []byte{0x01},
+		Owner: &protobufs.AccountRef{
+			Account: &protobufs.AccountRef_ImplicitAccount{
+				ImplicitAccount: &protobufs.ImplicitAccount{
+					Address: bytes.Repeat([]byte{0xbb}, 32),
+				},
+			},
+		},
+	}
+	spent, err := GetAddressOfCoin(coin, 1, 0)
+	assert.NoError(t, err)
+	kept, err := GetAddressOfCoin(coin, 3, 0)
+	assert.NoError(t, err)
+	penalized := bytes.Repeat([]byte{0xcc}, 32)
+
+	processTestFrames(t, e, [][]*protobufs.TokenOutput{
+		{},
+		{{Output: &protobufs.TokenOutput_Coin{Coin: coin}}},
+		// Spending a coin reads it, so frame 2 only re-derives over the state
+		// before it.
+		{{Output: &protobufs.TokenOutput_DeletedCoin{
+			DeletedCoin: &protobufs.CoinRef{Address: spent},
+		}}},
+		{
+			{Output: &protobufs.TokenOutput_Coin{Coin: coin}},
+			{Output: &protobufs.TokenOutput_Penalty{Penalty: &protobufs.ProverPenalty{
+				Quantity: 5,
+				Account: &protobufs.AccountRef{
+					Account: &protobufs.AccountRef_ImplicitAccount{
+						ImplicitAccount: &protobufs.ImplicitAccount{
+							Address: penalized,
+						},
+					},
+				},
+			}}},
+		},
+	})
+
+	resp, err := e.VerifyStateRoot(0)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), resp.HeadFrameNumber)
+	assert.Equal(t, uint64(1), resp.FromFrameNumber)
+	assert.Empty(t, resp.Divergences)
+
+	// A coin changed after the fact and a seniority the frames never gave are
+	// both attributed to the last frame that wrote them.
+	txn := db.NewBatch(false)
+	assert.NoError(t, txn.Set(
+		append([]byte{store.COIN, store.COIN_BY_ADDRESS}, kept...),
+		[]byte{0xff},
+	))
+	assert.NoError(t, txn.Commit())
+	seniority, err := e.clockStore.GetPeerSeniorityMap(e.intrinsicFilter)
+	assert.NoError(t, err)
+	seniority[string(penalized)] = 7
+	txn = db.NewBatch(false)
+	assert.NoError(t, e.clockStore.PutPeerSeniorityMap(
+		txn,
+		e.intrinsicFilter,
+		seniority,
+	))
+	assert.NoError(t, txn.Commit())
+
+	resp, err = e.VerifyStateRoot(0)
+	assert.NoError(t, err)
+	if assert.Len(t, resp.Divergences, 1) {
+		d := resp.Divergences[0]
+		assert.Equal(t, uint64(3), d.FrameNumber)
+		assert.Empty(t, d.Rings)
+		assert.Equal(
+			t,
+			[][]byte{append([]byte{store.COIN, store.COIN_BY_ADDRESS}, kept...)},
+			d.Keys,
+		)
+		assert.Equal(t, [][]byte{penalized}, d.SeniorityAddresses)
+	}
+
+	// Only frames processed with their changes recorded can be re-derived.
+	assert.NoError(t, db.Delete(
+		binary.BigEndian.AppendUint64(
+			[]byte{store.COIN, store.APP_STATE_UNDO},
+			2,
+		),
+	))
+	resp, err = e.VerifyStateRoot(0)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), resp.FromFrameNumber)
+	assert.Len(t, resp.Divergences, 1)
+}
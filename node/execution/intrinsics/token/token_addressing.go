@@ -13,22 +13,7 @@ func GetAddressOfCoin(
 	frameNumber uint64,
 	seqno uint64,
 ) ([]byte, error) {
-	eval := []byte{}
-	eval = append(eval, application.TOKEN_ADDRESS...)
-	eval = binary.BigEndian.AppendUint64(eval, frameNumber)
-	if frameNumber != 0 {
-		eval = binary.BigEndian.AppendUint64(eval, seqno)
-	}
-	eval = append(eval, coin.Amount...)
-	eval = append(eval, coin.Intersection...)
-	eval = binary.BigEndian.AppendUint32(eval, 0)
-	eval = append(eval, coin.Owner.GetImplicitAccount().Address...)
-	addressBI, err := poseidon.HashBytes(eval)
-	if err != nil {
-		return nil, err
-	}
-
-	return addressBI.FillBytes(make([]byte, 32)), nil
+	return application.GetAddressOfCoin(coin, frameNumber, seqno)
 }
 
 func GetAddressOfPreCoinProof(
@@ -2,6 +2,7 @@ package token
 
 import (
 	"bytes"
+	"context"
 	"crypto"
 	"encoding/binary"
 	"encoding/hex"
@@ -16,16 +17,19 @@ import (
 	pcrypto "github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 
 	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	"source.quilibrium.com/quilibrium/monorepo/node/consensus"
 	"source.quilibrium.com/quilibrium/monorepo/node/consensus/data"
 	"source.quilibrium.com/quilibrium/monorepo/node/consensus/time"
 	qcrypto "source.quilibrium.com/quilibrium/monorepo/node/crypto"
 	"source.quilibrium.com/quilibrium/monorepo/node/execution"
 	"source.quilibrium.com/quilibrium/monorepo/node/execution/intrinsics/token/application"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
 	"source.quilibrium.com/quilibrium/monorepo/node/internal/frametime"
 	"source.quilibrium.com/quilibrium/monorepo/node/keys"
 	"source.quilibrium.com/quilibrium/monorepo/node/p2p"
@@ -34,6 +38,17 @@ import (
 	"source.quilibrium.com/quilibrium/monorepo/node/tries"
 )
 
+var mismatchedEventsTotal = prometheus.NewCounter(prometheus.CounterOpts{
+	Namespace: "token",
+	Name:      "mismatched_events_total",
+	Help: "Frames verified before events activation whose events did not " +
+		"match their execution.",
+})
+
+func init() {
+	prometheus.MustRegister(mismatchedEventsTotal)
+}
+
 type PeerSeniorityItem struct {
 	seniority uint64
 	addr      string
@@ -81,7 +96,7 @@ func (p PeerSeniorityItem) Priority() uint64 {
 
 type TokenExecutionEngine struct {
 	logger                *zap.Logger
-	clock                 *data.DataClockConsensusEngine
+	clock                 consensus.DataConsensusEngine
 	clockStore            store.ClockStore
 	coinStore             store.CoinStore
 	keyStore              store.KeyStore
@@ -104,6 +119,7 @@ type TokenExecutionEngine struct {
 
 func NewTokenExecutionEngine(
 	logger *zap.Logger,
+	clk clock.Clock,
 	cfg *config.Config,
 	keyManager keys.KeyManager,
 	pubSub p2p.PubSub,
@@ -116,6 +132,7 @@ func NewTokenExecutionEngine(
 	peerInfoManager p2p.PeerInfoManager,
 	keyStore store.KeyStore,
 	report *protobufs.SelfTestReport,
+	newDataEngine data.EngineFactory,
 ) *TokenExecutionEngine {
 	if logger == nil {
 		panic(errors.New("logger is nil"))
@@ -236,7 +253,10 @@ func NewTokenExecutionEngine(
 		tries := []*tries.RollingFrecencyCritbitTrie{
 			&tries.RollingFrecencyCritbitTrie{},
 		}
-		proverKeys = [][]byte{config.GetGenesis().Beacon}
+		proverKeys = append(
+			[][]byte{config.GetGenesis().Beacon},
+			config.GetGenesis().Provers...,
+		)
 		for _, key := range proverKeys {
 			addr, _ := poseidon.HashBytes(key)
 			tries[0].Add(addr.FillBytes(make([]byte, 32)), 0)
@@ -294,6 +314,7 @@ func NewTokenExecutionEngine(
 
 			return tries, nil
 		},
+		e.rollbackAppState,
 		origin,
 		inclusionProof,
 		proverKeys,
@@ -301,24 +322,28 @@ func NewTokenExecutionEngine(
 		restore,
 	)
 
-	e.clock = data.NewDataClockConsensusEngine(
-		cfg,
-		logger,
-		keyManager,
-		clockStore,
-		coinStore,
-		dataProofStore,
-		keyStore,
-		pubSub,
-		frameProver,
-		inclusionProver,
-		masterTimeReel,
-		dataTimeReel,
-		peerInfoManager,
-		report,
-		intrinsicFilter,
-		seed,
-	)
+	e.clock, err = newDataEngine(&data.EngineParams{
+		Config:          cfg,
+		Logger:          logger,
+		Clock:           clk,
+		KeyManager:      keyManager,
+		ClockStore:      clockStore,
+		CoinStore:       coinStore,
+		DataProofStore:  dataProofStore,
+		KeyStore:        keyStore,
+		PubSub:          pubSub,
+		FrameProver:     frameProver,
+		InclusionProver: inclusionProver,
+		MasterTimeReel:  masterTimeReel,
+		DataTimeReel:    dataTimeReel,
+		PeerInfoManager: peerInfoManager,
+		Report:          report,
+		Filter:          intrinsicFilter,
+		Seed:            seed,
+	})
+	if err != nil {
+		panic(err)
+	}
 
 	peerId := e.pubSub.GetPeerID()
 	addr, err := poseidon.HashBytes(peerId)
@@ -401,6 +426,8 @@ func NewTokenExecutionEngine(
 
 var _ execution.ExecutionEngine = (*TokenExecutionEngine)(nil)
 
+const coinIndexBackfillBatchSize = 10000
+
 // GetName implements ExecutionEngine
 func (*TokenExecutionEngine) GetName() string {
 	return "Token"
@@ -422,6 +449,13 @@ func (
 func (e *TokenExecutionEngine) Start() <-chan error {
 	errChan := make(chan error)
 
+	go func() {
+		err := e.coinStore.BackfillCoinIndexes(coinIndexBackfillBatchSize)
+		if err != nil {
+			e.logger.Error("could not backfill coin indexes", zap.Error(err))
+		}
+	}()
+
 	go func() {
 		err := <-e.clock.Start()
 		if err != nil {
@@ -494,6 +528,25 @@ func (e *TokenExecutionEngine) ProcessMessage(
 	return nil, nil
 }
 
+// rollbackAppState undoes the frames processed after frameNumber. It runs on
+// the time reel's loop, as ProcessFrame does.
+func (e *TokenExecutionEngine) rollbackAppState(frameNumber uint64) error {
+	if err := e.coinStore.RollbackAppState(
+		e.intrinsicFilter,
+		frameNumber,
+	); err != nil {
+		return errors.Wrap(err, "rollback app state")
+	}
+
+	seniorityMap, err := e.clockStore.GetPeerSeniorityMap(e.intrinsicFilter)
+	if err != nil {
+		return errors.Wrap(err, "rollback app state")
+	}
+
+	e.peerSeniority = NewFromMap(seniorityMap)
+	return nil
+}
+
 func (e *TokenExecutionEngine) ProcessFrame(
 	txn store.Transaction,
 	frame *protobufs.ClockFrame,
@@ -504,6 +557,13 @@ func (e *TokenExecutionEngine) ProcessFrame(
 		return nil, errors.Wrap(err, "process frame")
 	}
 
+	journal := e.coinStore.JournalAppState(
+		txn,
+		e.intrinsicFilter,
+		frame.FrameNumber,
+	)
+	txn = journal
+
 	e.activeClockFrame = frame
 	e.logger.Info(
 		"evaluating next frame",
@@ -535,59 +595,151 @@ func (e *TokenExecutionEngine) ProcessFrame(
 		zap.Int("outputs", len(app.TokenOutputs.Outputs)),
 	)
 
+	activeMap, err := e.applyFrameOutputs(
+		txn,
+		e.coinStore,
+		app,
+		frame,
+		e.peerSeniority,
+	)
+	if err != nil {
+		txn.Abort()
+		return nil, errors.Wrap(err, "process frame")
+	}
+
+	if frame.FrameNumber == application.PROOF_FRAME_SENIORITY_REPAIR {
+		e.performSeniorityMapRepair(activeMap, frame)
+	}
+
+	err = e.clockStore.PutPeerSeniorityMap(
+		txn,
+		e.intrinsicFilter,
+		ToSerializedMap(activeMap),
+	)
+	if err != nil {
+		txn.Abort()
+		return nil, errors.Wrap(err, "process frame")
+	}
+
+	err = e.coinStore.SetLatestFrameProcessed(txn, frame.FrameNumber)
+	if err != nil {
+		txn.Abort()
+		return nil, errors.Wrap(err, "process frame")
+	}
+
+	e.peerSeniority = activeMap
+
+	if frame.FrameNumber == application.PROOF_FRAME_RING_RESET ||
+		frame.FrameNumber == application.PROOF_FRAME_RING_RESET_2 ||
+		frame.FrameNumber == application.PROOF_FRAME_RING_RESET_3 {
+		e.logger.Info("performing ring reset")
+		seniorityMap, err := RebuildPeerSeniority(e.pubSub.GetNetwork())
+		if err != nil {
+			return nil, errors.Wrap(err, "process frame")
+		}
+		e.peerSeniority = NewFromMap(seniorityMap)
+
+		app.Tries = []*tries.RollingFrecencyCritbitTrie{
+			app.Tries[0],
+		}
+
+		err = e.clockStore.PutPeerSeniorityMap(
+			txn,
+			e.intrinsicFilter,
+			ToSerializedMap(e.peerSeniority),
+		)
+		if err != nil {
+			txn.Abort()
+			return nil, errors.Wrap(err, "process frame")
+		}
+	}
+
+	if err := journal.Finish(); err != nil {
+		txn.Abort()
+		return nil, errors.Wrap(err, "process frame")
+	}
+
+	if err := application.PostTransitions(frame); err != nil {
+		e.logger.Error("error running post-transition hooks", zap.Error(err))
+	}
+
+	return app.Tries, nil
+}
+
+// applyFrameOutputs writes the outputs of the frame's application to txn and
+// applies their prover ring changes to the application's tries, returning the
+// seniority map after the frame. seniority is the map before the frame and is
+// not modified; coinStore is read for the state before the frame.
+func (e *TokenExecutionEngine) applyFrameOutputs(
+	txn store.Transaction,
+	coinStore store.CoinStore,
+	app *application.TokenApplication,
+	frame *protobufs.ClockFrame,
+	seniority *PeerSeniority,
+) (*PeerSeniority, error) {
+	if err := e.putTransactionBloom(
+		txn,
+		frame,
+		app.TokenRequests,
+	); err != nil {
+		return nil, errors.Wrap(err, "apply frame outputs")
+	}
+
 	proverTrieJoinRequests := [][]byte{}
 	proverTrieLeaveRequests := [][]byte{}
-	mapSnapshot := ToSerializedMap(e.peerSeniority)
-	activeMap := NewFromMap(mapSnapshot)
+	activeMap := NewFromMap(ToSerializedMap(seniority))
 
 	for i, output := range app.TokenOutputs.Outputs {
 		switch o := output.Output.(type) {
 		case *protobufs.TokenOutput_Coin:
 			address, err := GetAddressOfCoin(o.Coin, frame.FrameNumber, uint64(i))
 			if err != nil {
-				txn.Abort()
-				return nil, errors.Wrap(err, "process frame")
+				return nil, errors.Wrap(err, "apply frame outputs")
 			}
-			err = e.coinStore.PutCoin(
+			err = coinStore.PutCoin(
 				txn,
 				frame.FrameNumber,
 				address,
 				o.Coin,
 			)
 			if err != nil {
-				txn.Abort()
-				return nil, errors.Wrap(err, "process frame")
+				return nil, errors.Wrap(err, "apply frame outputs")
+			}
+		case *protobufs.TokenOutput_FaucetClaim:
+			err := coinStore.PutFaucetClaim(
+				txn,
+				o.FaucetClaim.Account.GetImplicitAccount().Address,
+				o.FaucetClaim.FrameNumber,
+			)
+			if err != nil {
+				return nil, errors.Wrap(err, "apply frame outputs")
 			}
 		case *protobufs.TokenOutput_DeletedCoin:
-			coin, err := e.coinStore.GetCoinByAddress(nil, o.DeletedCoin.Address)
+			coin, err := coinStore.GetCoinByAddress(nil, o.DeletedCoin.Address)
 			if err != nil {
-				txn.Abort()
-				return nil, errors.Wrap(err, "process frame")
+				return nil, errors.Wrap(err, "apply frame outputs")
 			}
-			err = e.coinStore.DeleteCoin(
+			err = coinStore.DeleteCoin(
 				txn,
 				o.DeletedCoin.Address,
 				coin,
 			)
 			if err != nil {
-				txn.Abort()
-				return nil, errors.Wrap(err, "process frame")
+				return nil, errors.Wrap(err, "apply frame outputs")
 			}
 		case *protobufs.TokenOutput_Proof:
 			address, err := GetAddressOfPreCoinProof(o.Proof)
 			if err != nil {
-				txn.Abort()
-				return nil, errors.Wrap(err, "process frame")
+				return nil, errors.Wrap(err, "apply frame outputs")
 			}
-			err = e.coinStore.PutPreCoinProof(
+			err = coinStore.PutPreCoinProof(
 				txn,
 				frame.FrameNumber,
 				address,
 				o.Proof,
 			)
 			if err != nil {
-				txn.Abort()
-				return nil, errors.Wrap(err, "process frame")
+				return nil, errors.Wrap(err, "apply frame outputs")
 			}
 			if len(o.Proof.Amount) == 32 &&
 				!bytes.Equal(o.Proof.Amount, make([]byte, 32)) &&
@@ -614,25 +766,22 @@ func (e *TokenExecutionEngine) ProcessFrame(
 		case *protobufs.TokenOutput_DeletedProof:
 			address, err := GetAddressOfPreCoinProof(o.DeletedProof)
 			if err != nil {
-				txn.Abort()
-				return nil, errors.Wrap(err, "process frame")
+				return nil, errors.Wrap(err, "apply frame outputs")
 			}
-			err = e.coinStore.DeletePreCoinProof(
+			err = coinStore.DeletePreCoinProof(
 				txn,
 				address,
 				o.DeletedProof,
 			)
 			if err != nil {
-				txn.Abort()
-				return nil, errors.Wrap(err, "process frame")
+				return nil, errors.Wrap(err, "apply frame outputs")
 			}
 		case *protobufs.TokenOutput_Announce:
 			peerIds := []string{}
 			for _, sig := range o.Announce.PublicKeySignaturesEd448 {
 				peerId, err := e.getPeerIdFromSignature(sig)
 				if err != nil {
-					txn.Abort()
-					return nil, errors.Wrap(err, "process frame")
+					return nil, errors.Wrap(err, "apply frame outputs")
 				}
 
 				peerIds = append(peerIds, peerId.String())
@@ -648,8 +797,7 @@ func (e *TokenExecutionEngine) ProcessFrame(
 					o.Announce.PublicKeySignaturesEd448[i],
 				)
 				if err != nil {
-					txn.Abort()
-					return nil, errors.Wrap(err, "process frame")
+					return nil, errors.Wrap(err, "apply frame outputs")
 				}
 
 				sen, ok := (*activeMap)[string(addr)]
@@ -678,15 +826,13 @@ func (e *TokenExecutionEngine) ProcessFrame(
 					o.Announce.PublicKeySignaturesEd448[0],
 				)
 				if err != nil {
-					txn.Abort()
-					return nil, errors.Wrap(err, "process frame")
+					return nil, errors.Wrap(err, "apply frame outputs")
 				}
 
 				additional := uint64(0)
-				_, prfs, err := e.coinStore.GetPreCoinProofsForOwner(addr)
+				_, prfs, err := coinStore.GetPreCoinProofsForOwner(addr)
 				if err != nil && !errors.Is(err, store.ErrNotFound) {
-					txn.Abort()
-					return nil, errors.Wrap(err, "process frame")
+					return nil, errors.Wrap(err, "apply frame outputs")
 				}
 
 				aggregated := GetAggregatedSeniority(peerIds).Uint64()
@@ -719,8 +865,7 @@ func (e *TokenExecutionEngine) ProcessFrame(
 						sig,
 					)
 					if err != nil {
-						txn.Abort()
-						return nil, errors.Wrap(err, "process frame")
+						return nil, errors.Wrap(err, "apply frame outputs")
 					}
 
 					(*activeMap)[string(addr)] = PeerSeniorityItem{
@@ -733,8 +878,7 @@ func (e *TokenExecutionEngine) ProcessFrame(
 					o.Announce.PublicKeySignaturesEd448[0],
 				)
 				if err != nil {
-					txn.Abort()
-					return nil, errors.Wrap(err, "process frame")
+					return nil, errors.Wrap(err, "apply frame outputs")
 				}
 
 				sen, ok := (*activeMap)[string(addr)]
@@ -757,10 +901,9 @@ func (e *TokenExecutionEngine) ProcessFrame(
 				}
 
 				additional := uint64(0)
-				_, prfs, err := e.coinStore.GetPreCoinProofsForOwner(addr)
+				_, prfs, err := coinStore.GetPreCoinProofsForOwner(addr)
 				if err != nil && !errors.Is(err, store.ErrNotFound) {
-					txn.Abort()
-					return nil, errors.Wrap(err, "process frame")
+					return nil, errors.Wrap(err, "apply frame outputs")
 				}
 
 				aggregated := GetAggregatedSeniority(peerIds).Uint64()
@@ -788,8 +931,7 @@ func (e *TokenExecutionEngine) ProcessFrame(
 		case *protobufs.TokenOutput_Join:
 			addr, err := e.getAddressFromSignature(o.Join.PublicKeySignatureEd448)
 			if err != nil {
-				txn.Abort()
-				return nil, errors.Wrap(err, "process frame")
+				return nil, errors.Wrap(err, "apply frame outputs")
 			}
 
 			if _, ok := (*activeMap)[string(addr)]; !ok {
@@ -807,21 +949,18 @@ func (e *TokenExecutionEngine) ProcessFrame(
 		case *protobufs.TokenOutput_Leave:
 			addr, err := e.getAddressFromSignature(o.Leave.PublicKeySignatureEd448)
 			if err != nil {
-				txn.Abort()
-				return nil, errors.Wrap(err, "process frame")
+				return nil, errors.Wrap(err, "apply frame outputs")
 			}
 			proverTrieLeaveRequests = append(proverTrieLeaveRequests, addr)
 		case *protobufs.TokenOutput_Pause:
 			_, err := e.getAddressFromSignature(o.Pause.PublicKeySignatureEd448)
 			if err != nil {
-				txn.Abort()
-				return nil, errors.Wrap(err, "process frame")
+				return nil, errors.Wrap(err, "apply frame outputs")
 			}
 		case *protobufs.TokenOutput_Resume:
 			_, err := e.getAddressFromSignature(o.Resume.PublicKeySignatureEd448)
 			if err != nil {
-				txn.Abort()
-				return nil, errors.Wrap(err, "process frame")
+				return nil, errors.Wrap(err, "apply frame outputs")
 			}
 		case *protobufs.TokenOutput_Penalty:
 			addr := string(o.Penalty.Account.GetImplicitAccount().Address)
@@ -858,86 +997,15 @@ func (e *TokenExecutionEngine) ProcessFrame(
 		}
 	}
 
-	joinAddrs := tries.NewMinHeap[PeerSeniorityItem]()
-	leaveAddrs := tries.NewMinHeap[PeerSeniorityItem]()
-	for _, addr := range proverTrieJoinRequests {
-		if _, ok := (*activeMap)[string(addr)]; !ok {
-			joinAddrs.Push(PeerSeniorityItem{
-				addr:      string(addr),
-				seniority: 0,
-			})
-		} else {
-			joinAddrs.Push((*activeMap)[string(addr)])
-		}
-	}
-	for _, addr := range proverTrieLeaveRequests {
-		if _, ok := (*activeMap)[string(addr)]; !ok {
-			leaveAddrs.Push(PeerSeniorityItem{
-				addr:      string(addr),
-				seniority: 0,
-			})
-		} else {
-			leaveAddrs.Push((*activeMap)[string(addr)])
-		}
-	}
-
-	joinReqs := make([]PeerSeniorityItem, len(joinAddrs.All()))
-	copy(joinReqs, joinAddrs.All())
-	slices.Reverse(joinReqs)
-	leaveReqs := make([]PeerSeniorityItem, len(leaveAddrs.All()))
-	copy(leaveReqs, leaveAddrs.All())
-	slices.Reverse(leaveReqs)
-
-	ProcessJoinsAndLeaves(joinReqs, leaveReqs, app, e.peerSeniority, frame)
-
-	if frame.FrameNumber == application.PROOF_FRAME_SENIORITY_REPAIR {
-		e.performSeniorityMapRepair(activeMap, frame)
-	}
-
-	err = e.clockStore.PutPeerSeniorityMap(
-		txn,
-		e.intrinsicFilter,
-		ToSerializedMap(activeMap),
+	joinReqs, leaveReqs := OrderJoinsAndLeaves(
+		proverTrieJoinRequests,
+		proverTrieLeaveRequests,
+		activeMap,
 	)
-	if err != nil {
-		txn.Abort()
-		return nil, errors.Wrap(err, "process frame")
-	}
 
-	err = e.coinStore.SetLatestFrameProcessed(txn, frame.FrameNumber)
-	if err != nil {
-		txn.Abort()
-		return nil, errors.Wrap(err, "process frame")
-	}
-
-	e.peerSeniority = activeMap
-
-	if frame.FrameNumber == application.PROOF_FRAME_RING_RESET ||
-		frame.FrameNumber == application.PROOF_FRAME_RING_RESET_2 ||
-		frame.FrameNumber == application.PROOF_FRAME_RING_RESET_3 {
-		e.logger.Info("performing ring reset")
-		seniorityMap, err := RebuildPeerSeniority(e.pubSub.GetNetwork())
-		if err != nil {
-			return nil, errors.Wrap(err, "process frame")
-		}
-		e.peerSeniority = NewFromMap(seniorityMap)
-
-		app.Tries = []*tries.RollingFrecencyCritbitTrie{
-			app.Tries[0],
-		}
-
-		err = e.clockStore.PutPeerSeniorityMap(
-			txn,
-			e.intrinsicFilter,
-			ToSerializedMap(e.peerSeniority),
-		)
-		if err != nil {
-			txn.Abort()
-			return nil, errors.Wrap(err, "process frame")
-		}
-	}
+	ProcessJoinsAndLeaves(joinReqs, leaveReqs, app, seniority, frame)
 
-	return app.Tries, nil
+	return activeMap, nil
 }
 
 func (e *TokenExecutionEngine) performSeniorityMapRepair(
@@ -1029,6 +1097,46 @@ func (e *TokenExecutionEngine) performSeniorityMapRepair(
 	}
 }
 
+// OrderJoinsAndLeaves orders the addresses requesting to join or leave the
+// prover rings by descending seniority.
+func OrderJoinsAndLeaves(
+	joins [][]byte,
+	leaves [][]byte,
+	seniority *PeerSeniority,
+) ([]PeerSeniorityItem, []PeerSeniorityItem) {
+	joinAddrs := tries.NewMinHeap[PeerSeniorityItem]()
+	leaveAddrs := tries.NewMinHeap[PeerSeniorityItem]()
+	for _, addr := range joins {
+		if _, ok := (*seniority)[string(addr)]; !ok {
+			joinAddrs.Push(PeerSeniorityItem{
+				addr:      string(addr),
+				seniority: 0,
+			})
+		} else {
+			joinAddrs.Push((*seniority)[string(addr)])
+		}
+	}
+	for _, addr := range leaves {
+		if _, ok := (*seniority)[string(addr)]; !ok {
+			leaveAddrs.Push(PeerSeniorityItem{
+				addr:      string(addr),
+				seniority: 0,
+			})
+		} else {
+			leaveAddrs.Push((*seniority)[string(addr)])
+		}
+	}
+
+	joinReqs := make([]PeerSeniorityItem, len(joinAddrs.All()))
+	copy(joinReqs, joinAddrs.All())
+	slices.Reverse(joinReqs)
+	leaveReqs := make([]PeerSeniorityItem, len(leaveAddrs.All()))
+	copy(leaveReqs, leaveAddrs.All())
+	slices.Reverse(leaveReqs)
+
+	return joinReqs, leaveReqs
+}
+
 func ProcessJoinsAndLeaves(
 	joinReqs []PeerSeniorityItem,
 	leaveReqs []PeerSeniorityItem,
@@ -1213,6 +1321,27 @@ func (e *TokenExecutionEngine) VerifyExecution(
 						}
 					}
 
+					events, err := application.GetEventsFromClockFrame(frame)
+					if err != nil {
+						return errors.Wrap(err, "verify execution")
+					}
+					if !eventsEqual(events, a.Events) {
+						if frame.FrameNumber >= application.EVENTS_FRAME_ACTIVATION {
+							return errors.Wrap(
+								errors.New("mismatched events"),
+								"verify execution",
+							)
+						}
+						// Frames proven by earlier versions carry no events.
+						if len(events) != 0 {
+							mismatchedEventsTotal.Inc()
+							e.logger.Warn(
+								"frame events do not match its execution",
+								zap.Uint64("frame_number", frame.FrameNumber),
+							)
+						}
+					}
+
 					return nil
 				}
 			}
@@ -1222,6 +1351,18 @@ func (e *TokenExecutionEngine) VerifyExecution(
 	return nil
 }
 
+func eventsEqual(a, b []*protobufs.ExecutionEvent) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !proto.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 func (e *TokenExecutionEngine) GetPeerInfo() *protobufs.PeerInfoResponse {
 	return e.clock.GetPeerInfo()
 }
@@ -1230,6 +1371,18 @@ func (e *TokenExecutionEngine) GetFrame() *protobufs.ClockFrame {
 	return e.clock.GetFrame()
 }
 
+func (e *TokenExecutionEngine) GetFinalizedFrameNumber() uint64 {
+	return e.clock.GetFinalizedFrameNumber()
+}
+
+func (e *TokenExecutionEngine) SubscribeHead() (<-chan struct{}, func()) {
+	return e.clock.SubscribeHead()
+}
+
+func (e *TokenExecutionEngine) GetNetworkHeadEstimate() uint64 {
+	return e.clock.GetNetworkHeadEstimate()
+}
+
 func (e *TokenExecutionEngine) GetSeniority() *big.Int {
 	altAddr, err := poseidon.HashBytes(e.pubSub.GetPeerID())
 	if err != nil {
@@ -1544,3 +1697,29 @@ func (e *TokenExecutionEngine) getAddressFromSignature(
 func (e *TokenExecutionEngine) GetWorkerCount() uint32 {
 	return e.clock.GetWorkerCount()
 }
+
+func (e *TokenExecutionEngine) EnterMaintenance(ctx context.Context) error {
+	return e.clock.EnterMaintenance(ctx)
+}
+
+func (e *TokenExecutionEngine) ExitMaintenance() {
+	e.clock.ExitMaintenance()
+}
+
+func (e *TokenExecutionEngine) GetSyncClients(
+	limit int,
+) []*protobufs.SyncClient {
+	return e.clock.GetSyncClients(limit)
+}
+
+func (e *TokenExecutionEngine) GetServingLatency() *protobufs.ServingLatencyResponse {
+	return e.clock.GetServingLatency()
+}
+
+func (e *TokenExecutionEngine) GetProverStats() *protobufs.ProverStats {
+	return e.clock.GetProverStats()
+}
+
+func (e *TokenExecutionEngine) SubmitFrame(frame *protobufs.ClockFrame) error {
+	return e.clock.SubmitFrame(frame)
+}
@@ -1078,6 +1078,6 @@ func CreateGenesisState(
 			},
 			AggregateCommitment: commitment,
 			Proof:               proof,
-		}, [][]byte{genesis.Beacon}, m
+		}, append([][]byte{genesis.Beacon}, genesis.Provers...), m
 	}
 }
@@ -0,0 +1,38 @@
+package token
+
+import (
+	"github.com/pkg/errors"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+// putTransactionBloom stores the bloom filter of the token requests included
+// in the frame, so that wallets can cheaply rule out frames when looking for
+// their transactions. Frames without requests get no bloom.
+func (e *TokenExecutionEngine) putTransactionBloom(
+	txn store.Transaction,
+	frame *protobufs.ClockFrame,
+	requests *protobufs.TokenRequests,
+) error {
+	if len(requests.GetRequests()) == 0 {
+		return nil
+	}
+
+	hashes := make([][]byte, 0, len(requests.Requests))
+	for _, request := range requests.Requests {
+		hash, err := protobufs.TransactionHash(request)
+		if err != nil {
+			return errors.Wrap(err, "put transaction bloom")
+		}
+		hashes = append(hashes, hash)
+	}
+
+	return errors.Wrap(
+		e.clockStore.PutTransactionBloom(
+			txn,
+			frame.Filter,
+			protobufs.NewTransactionBloom(frame.FrameNumber, hashes),
+		),
+		"put transaction bloom",
+	)
+}
@@ -0,0 +1,222 @@
+// Package clock abstracts the passage of time, so that code which sleeps,
+// waits on timers or stamps records with the current time can be driven by a
+// FakeClock in tests instead of the wall clock.
+package clock
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Clock provides the current time and timers.
+type Clock interface {
+	Now() time.Time
+	Since(t time.Time) time.Duration
+	Sleep(d time.Duration)
+	After(d time.Duration) <-chan time.Time
+	NewTicker(d time.Duration) Ticker
+}
+
+// Ticker delivers ticks on Chan at the period it was created with.
+type Ticker interface {
+	Chan() <-chan time.Time
+	Stop()
+}
+
+// NewRealClock returns a Clock backed by the time package.
+func NewRealClock() Clock {
+	return realClock{}
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time                         { return time.Now() }
+func (realClock) Since(t time.Time) time.Duration        { return time.Since(t) }
+func (realClock) Sleep(d time.Duration)                  { time.Sleep(d) }
+func (realClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+func (realClock) NewTicker(d time.Duration) Ticker {
+	return realTicker{time.NewTicker(d)}
+}
+
+type realTicker struct {
+	*time.Ticker
+}
+
+func (t realTicker) Chan() <-chan time.Time {
+	return t.C
+}
+
+// WithTimeout is context.WithTimeout measured on the given clock. Contexts
+// timed by a FakeClock are canceled once the clock is advanced past the
+// timeout.
+func WithTimeout(
+	parent context.Context,
+	c Clock,
+	timeout time.Duration,
+) (context.Context, context.CancelFunc) {
+	if _, ok := c.(realClock); ok {
+		return context.WithTimeout(parent, timeout)
+	}
+
+	ctx, cancel := context.WithCancel(parent)
+	var fired <-chan time.Time
+	stop := func() {}
+	if fc, ok := c.(*FakeClock); ok {
+		// Forget the timer once the context is done, so it is not left pending
+		// for BlockUntil.
+		w := fc.addWaiter(timeout, 0)
+		fired = w.ch
+		stop = func() { fc.removeWaiter(w) }
+	} else {
+		fired = c.After(timeout)
+	}
+	go func() {
+		defer stop()
+		select {
+		case <-ctx.Done():
+		case <-fired:
+			cancel()
+		}
+	}()
+
+	return ctx, cancel
+}
+
+// FakeClock is a Clock that only moves when advanced. Sleepers, timers and
+// tickers fire as Advance moves the clock past their deadline.
+type FakeClock struct {
+	mx      sync.Mutex
+	now     time.Time
+	waiters []*fakeWaiter
+	blocked *sync.Cond
+}
+
+type fakeWaiter struct {
+	deadline time.Time
+	period   time.Duration
+	ch       chan time.Time
+}
+
+var _ Clock = (*FakeClock)(nil)
+
+// NewFakeClock returns a FakeClock set to the given time.
+func NewFakeClock(now time.Time) *FakeClock {
+	c := &FakeClock{now: now}
+	c.blocked = sync.NewCond(&c.mx)
+	return c
+}
+
+func (c *FakeClock) Now() time.Time {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	return c.now
+}
+
+func (c *FakeClock) Since(t time.Time) time.Duration {
+	return c.Now().Sub(t)
+}
+
+func (c *FakeClock) Sleep(d time.Duration) {
+	<-c.After(d)
+}
+
+func (c *FakeClock) After(d time.Duration) <-chan time.Time {
+	return c.addWaiter(d, 0).ch
+}
+
+func (c *FakeClock) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("non-positive interval for NewTicker")
+	}
+	return &fakeTicker{clock: c, waiter: c.addWaiter(d, d)}
+}
+
+func (c *FakeClock) addWaiter(d, period time.Duration) *fakeWaiter {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	w := &fakeWaiter{
+		deadline: c.now.Add(d),
+		period:   period,
+		ch:       make(chan time.Time, 1),
+	}
+	if d <= 0 {
+		w.ch <- c.now
+		return w
+	}
+
+	c.waiters = append(c.waiters, w)
+	c.blocked.Broadcast()
+	return w
+}
+
+func (c *FakeClock) removeWaiter(w *fakeWaiter) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	for i := range c.waiters {
+		if c.waiters[i] == w {
+			c.waiters = append(c.waiters[:i], c.waiters[i+1:]...)
+			return
+		}
+	}
+}
+
+// Advance moves the clock forward, firing every sleeper, timer and ticker
+// whose deadline is reached, in deadline order. A ticker whose consumer is
+// behind drops ticks, like time.Ticker.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	end := c.now.Add(d)
+	for {
+		sort.SliceStable(c.waiters, func(i, j int) bool {
+			return c.waiters[i].deadline.Before(c.waiters[j].deadline)
+		})
+		if len(c.waiters) == 0 || c.waiters[0].deadline.After(end) {
+			break
+		}
+
+		w := c.waiters[0]
+		c.now = w.deadline
+		select {
+		case w.ch <- c.now:
+		default:
+		}
+
+		if w.period > 0 {
+			w.deadline = w.deadline.Add(w.period)
+		} else {
+			c.waiters = c.waiters[1:]
+		}
+	}
+	c.now = end
+}
+
+// BlockUntil waits until at least n sleepers, timers or tickers are pending,
+// so that a test can advance the clock once the code under test is waiting.
+func (c *FakeClock) BlockUntil(n int) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	for len(c.waiters) < n {
+		c.blocked.Wait()
+	}
+}
+
+type fakeTicker struct {
+	clock  *FakeClock
+	waiter *fakeWaiter
+}
+
+func (t *fakeTicker) Chan() <-chan time.Time {
+	return t.waiter.ch
+}
+
+func (t *fakeTicker) Stop() {
+	t.clock.removeWaiter(t.waiter)
+}
@@ -0,0 +1,58 @@
+package clock_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+)
+
+func TestFakeClock(t *testing.T) {
+	start := time.UnixMilli(1700000000000)
+	c := clock.NewFakeClock(start)
+
+	woke := make(chan time.Time)
+	go func() {
+		c.Sleep(10 * time.Second)
+		woke <- c.Now()
+	}()
+
+	c.BlockUntil(1)
+	c.Advance(9 * time.Second)
+	select {
+	case <-woke:
+		t.Fatal("sleeper woke early")
+	default:
+	}
+
+	c.Advance(time.Second)
+	assert.Equal(t, start.Add(10*time.Second), <-woke)
+	assert.Equal(t, 10*time.Second, c.Since(start))
+
+	ticker := c.NewTicker(time.Minute)
+	c.Advance(time.Minute)
+	assert.Equal(t, start.Add(70*time.Second), <-ticker.Chan())
+	ticker.Stop()
+	c.Advance(time.Minute)
+	select {
+	case <-ticker.Chan():
+		t.Fatal("stopped ticker ticked")
+	default:
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	c := clock.NewFakeClock(time.UnixMilli(1700000000000))
+
+	ctx, cancel := clock.WithTimeout(context.Background(), c, time.Second)
+	defer cancel()
+
+	c.Advance(999 * time.Millisecond)
+	assert.NoError(t, ctx.Err())
+
+	c.Advance(time.Millisecond)
+	<-ctx.Done()
+	assert.ErrorIs(t, ctx.Err(), context.Canceled)
+}
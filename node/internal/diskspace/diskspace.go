@@ -0,0 +1,122 @@
+// Package diskspace watches the free space on the store volume, so that the
+// node can stop writing frames before the store runs out of space and is
+// left corrupted.
+package diskspace
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/utils"
+)
+
+var (
+	freeBytesGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "node",
+		Name:      "store_free_bytes",
+		Help:      "Free space on the store volume.",
+	})
+	lowGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "node",
+		Name:      "store_disk_space_low",
+		Help:      "1 if free space on the store volume is below the threshold.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(freeBytesGauge, lowGauge)
+}
+
+// Watcher periodically checks the free space on the volume holding a path.
+// Once it drops below the threshold the watcher reports low space until it
+// climbs a tenth above the threshold again, so that it does not flap around
+// the threshold.
+type Watcher struct {
+	logger    *zap.Logger
+	clock     clock.Clock
+	path      string
+	threshold uint64
+	period    time.Duration
+	low       atomic.Bool
+	free      func(string) (uint64, error)
+}
+
+// NewWatcher creates a watcher for the volume holding path. A threshold of
+// zero disables the watcher.
+func NewWatcher(
+	logger *zap.Logger,
+	clk clock.Clock,
+	path string,
+	threshold uint64,
+	period time.Duration,
+) *Watcher {
+	return &Watcher{
+		logger:    logger,
+		clock:     clk,
+		path:      path,
+		threshold: threshold,
+		period:    period,
+		free:      utils.GetFreeDiskSpace,
+	}
+}
+
+// Low reports whether free space is currently below the threshold. A nil
+// watcher never reports low space.
+func (w *Watcher) Low() bool {
+	return w != nil && w.low.Load()
+}
+
+// Run checks free space immediately and then every period until ctx is done.
+func (w *Watcher) Run(ctx context.Context) {
+	if w.threshold == 0 {
+		return
+	}
+
+	for {
+		w.check()
+		select {
+		case <-ctx.Done():
+			return
+		case <-w.clock.After(w.period):
+		}
+	}
+}
+
+func (w *Watcher) check() {
+	free, err := w.free(w.path)
+	if err != nil {
+		w.logger.Warn("could not check free disk space", zap.Error(err))
+		return
+	}
+	freeBytesGauge.Set(float64(free))
+
+	switch {
+	case !w.low.Load() && free < w.threshold:
+		w.low.Store(true)
+		lowGauge.Set(1)
+		w.logger.Error(
+			"free disk space below threshold, pausing frame sync and acceptance",
+			zap.String("path", w.path),
+			zap.Uint64("free_bytes", free),
+			zap.Uint64("threshold_bytes", w.threshold),
+		)
+	case w.low.Load() && free >= w.threshold+w.threshold/10:
+		w.low.Store(false)
+		lowGauge.Set(0)
+		w.logger.Info(
+			"free disk space recovered, resuming frame sync and acceptance",
+			zap.String("path", w.path),
+			zap.Uint64("free_bytes", free),
+		)
+	case w.low.Load():
+		w.logger.Warn(
+			"free disk space still below threshold",
+			zap.Uint64("free_bytes", free),
+			zap.Uint64("threshold_bytes", w.threshold),
+		)
+	}
+}
@@ -0,0 +1,103 @@
+package diskspace
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+)
+
+func newTestWatcher(
+	threshold uint64,
+	free *atomic.Uint64,
+	checks *atomic.Int32,
+) (*Watcher, *clock.FakeClock) {
+	clk := clock.NewFakeClock(time.Unix(1700000000, 0))
+	w := NewWatcher(zap.NewNop(), clk, "/store", threshold, time.Minute)
+	w.free = func(path string) (uint64, error) {
+		checks.Add(1)
+		if path != "/store" {
+			return 0, errors.New("unexpected path")
+		}
+		return free.Load(), nil
+	}
+	return w, clk
+}
+
+func TestWatcherThreshold(t *testing.T) {
+	var free atomic.Uint64
+	var checks atomic.Int32
+	w, _ := newTestWatcher(1000, &free, &checks)
+
+	free.Store(1000)
+	w.check()
+	assert.False(t, w.Low())
+
+	free.Store(999)
+	w.check()
+	assert.True(t, w.Low())
+
+	// Space must climb a tenth above the threshold before it counts as
+	// recovered.
+	free.Store(1099)
+	w.check()
+	assert.True(t, w.Low())
+	free.Store(1100)
+	w.check()
+	assert.False(t, w.Low())
+
+	// A failed check leaves the state as it was.
+	free.Store(10)
+	w.check()
+	w.free = func(string) (uint64, error) {
+		return 0, errors.New("statfs failed")
+	}
+	w.check()
+	assert.True(t, w.Low())
+
+	var nilWatcher *Watcher
+	assert.False(t, nilWatcher.Low())
+}
+
+func TestWatcherRun(t *testing.T) {
+	var free atomic.Uint64
+	var checks atomic.Int32
+	w, clk := newTestWatcher(1000, &free, &checks)
+	free.Store(10)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		w.Run(ctx)
+		close(done)
+	}()
+
+	// Space is checked right away and then once every period.
+	clk.BlockUntil(1)
+	assert.Equal(t, int32(1), checks.Load())
+	assert.True(t, w.Low())
+	free.Store(2000)
+	clk.Advance(time.Minute)
+	clk.BlockUntil(1)
+	assert.Equal(t, int32(2), checks.Load())
+	assert.False(t, w.Low())
+
+	cancel()
+	<-done
+}
+
+func TestWatcherDisabled(t *testing.T) {
+	var free atomic.Uint64
+	var checks atomic.Int32
+	w, _ := newTestWatcher(0, &free, &checks)
+
+	// Without a threshold the watcher never checks.
+	w.Run(context.Background())
+	assert.Equal(t, int32(0), checks.Load())
+	assert.False(t, w.Low())
+}
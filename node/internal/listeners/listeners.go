@@ -0,0 +1,103 @@
+// Package listeners obtains listeners handed down to the process, either by
+// systemd socket activation or by a parent process, so that sockets can be
+// bound before the processes serving them start.
+package listeners
+
+import (
+	"net"
+	"os"
+	"strconv"
+
+	"github.com/multiformats/go-multiaddr"
+	mn "github.com/multiformats/go-multiaddr/net"
+	"github.com/pkg/errors"
+)
+
+// The first file descriptor passed by socket activation, after stdin, stdout
+// and stderr. Files passed to a child with exec.Cmd.ExtraFiles start here as
+// well.
+const FirstFD = 3
+
+// Systemd returns the listeners systemd passed to the process by socket
+// activation, in the order of the socket unit, or nil if none were passed to
+// this process. The activation variables are unset, so that processes it
+// starts do not mistake the listeners for their own.
+func Systemd() ([]net.Listener, error) {
+	pid, err := strconv.Atoi(os.Getenv("LISTEN_PID"))
+	if err != nil || pid != os.Getpid() {
+		return nil, nil
+	}
+
+	n, err := strconv.Atoi(os.Getenv("LISTEN_FDS"))
+	os.Unsetenv("LISTEN_PID")
+	os.Unsetenv("LISTEN_FDS")
+	os.Unsetenv("LISTEN_FDNAMES")
+	if err != nil {
+		return nil, errors.Wrap(err, "systemd listeners")
+	}
+
+	listeners := make([]net.Listener, 0, n)
+	for fd := FirstFD; fd < FirstFD+n; fd++ {
+		l, err := FromFD(fd)
+		if err != nil {
+			for _, l := range listeners {
+				l.Close()
+			}
+			return nil, errors.Wrap(err, "systemd listeners")
+		}
+		listeners = append(listeners, l)
+	}
+	return listeners, nil
+}
+
+// FromFD returns a listener on the socket at the inherited file descriptor,
+// which it takes ownership of.
+func FromFD(fd int) (net.Listener, error) {
+	f := os.NewFile(uintptr(fd), "listener-"+strconv.Itoa(fd))
+	if f == nil {
+		return nil, errors.Wrap(
+			errors.Errorf("invalid file descriptor %d", fd),
+			"listener from fd",
+		)
+	}
+	defer f.Close()
+
+	l, err := net.FileListener(f)
+	if err != nil {
+		return nil, errors.Wrap(err, "listener from fd")
+	}
+	return l, nil
+}
+
+// Listen binds a listener on the address, which unlike the listeners of
+// go-multiaddr/net can be passed to a child process with File.
+func Listen(addr multiaddr.Multiaddr) (net.Listener, error) {
+	network, host, err := mn.DialArgs(addr)
+	if err != nil {
+		return nil, errors.Wrap(err, "listen")
+	}
+
+	l, err := net.Listen(network, host)
+	if err != nil {
+		return nil, errors.Wrap(err, "listen")
+	}
+	return l, nil
+}
+
+// File returns a duplicate of the socket of the listener, to be passed to a
+// child process.
+func File(l net.Listener) (*os.File, error) {
+	filer, ok := l.(interface{ File() (*os.File, error) })
+	if !ok {
+		return nil, errors.Wrap(
+			errors.Errorf("unsupported listener %T", l),
+			"listener file",
+		)
+	}
+
+	f, err := filer.File()
+	if err != nil {
+		return nil, errors.Wrap(err, "listener file")
+	}
+	return f, nil
+}
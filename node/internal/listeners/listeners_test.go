@@ -0,0 +1,53 @@
+package listeners_test
+
+import (
+	"io"
+	"net"
+	"testing"
+
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/listeners"
+)
+
+func TestPreBoundListenerFromFD(t *testing.T) {
+	addr, err := multiaddr.NewMultiaddr("/ip4/127.0.0.1/tcp/0")
+	assert.NoError(t, err)
+	l, err := listeners.Listen(addr)
+	assert.NoError(t, err)
+	bound := l.Addr().String()
+
+	// Hand the socket down as a launcher does, closing the original.
+	f, err := listeners.File(l)
+	assert.NoError(t, err)
+	assert.NoError(t, l.Close())
+
+	inherited, err := listeners.FromFD(int(f.Fd()))
+	assert.NoError(t, err)
+	defer inherited.Close()
+	assert.Equal(t, bound, inherited.Addr().String())
+
+	go func() {
+		conn, err := net.Dial("tcp", bound)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		conn.Write([]byte("ping"))
+	}()
+
+	conn, err := inherited.Accept()
+	assert.NoError(t, err)
+	defer conn.Close()
+	buf := make([]byte, 4)
+	_, err = io.ReadFull(conn, buf)
+	assert.NoError(t, err)
+	assert.Equal(t, "ping", string(buf))
+}
+
+func TestFileUnsupportedListener(t *testing.T) {
+	_, err := listeners.File(unsupportedListener{})
+	assert.Error(t, err)
+}
+
+type unsupportedListener struct{ net.Listener }
@@ -2,17 +2,52 @@ package observability
 
 import (
 	"encoding/base64"
+	"sync"
+	"sync/atomic"
 
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/core/protocol"
 	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/protobuf/proto"
 	blossomsub "source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub"
 )
 
 const blossomSubNamespace = "blossomsub"
 
+// The number of leading bytes of a bitmask used as its label by default,
+// enough to tell the application filters apart.
+const defaultBitmaskLabelPrefix = 8
+
+// The label of messages received on bitmasks the node is not subscribed to,
+// which any peer can make up.
+const otherBitmaskLabel = "other"
+
 var binaryEncoding = base64.RawStdEncoding
 
+var bitmaskLabelPrefix atomic.Int64
+
+// SetBitmaskLabelPrefix limits the bitmask label of the BlossomSub metrics to
+// the first n bytes of the bitmask, bounding the number of series on nodes
+// that see many bitmasks. Zero uses the first 8 bytes, and a negative n drops
+// the bitmask from the labels.
+func SetBitmaskLabelPrefix(n int) {
+	bitmaskLabelPrefix.Store(int64(n))
+}
+
+func bitmaskLabel(bitmask []byte) string {
+	n := bitmaskLabelPrefix.Load()
+	switch {
+	case n < 0:
+		return ""
+	case n == 0:
+		n = defaultBitmaskLabelPrefix
+	}
+	if int64(len(bitmask)) > n {
+		bitmask = bitmask[:n]
+	}
+	return binaryEncoding.EncodeToString(bitmask)
+}
+
 type blossomSubRawTracer struct {
 	addPeerTotal              *prometheus.CounterVec
 	removePeerTotal           prometheus.Counter
@@ -29,6 +64,16 @@ type blossomSubRawTracer struct {
 	sendRPCTotal              prometheus.Counter
 	dropRPCTotal              prometheus.Counter
 	undeliverableMessageTotal *prometheus.CounterVec
+	validateQueueLimit        prometheus.GaugeFunc
+	recvMessageTotal          *prometheus.CounterVec
+	recvMessageBytesTotal     *prometheus.CounterVec
+	sendMessageTotal          *prometheus.CounterVec
+	sendMessageBytesTotal     *prometheus.CounterVec
+
+	// The bitmasks joined, so that received messages are only labeled with
+	// bitmasks the node subscribes to.
+	joinedMx sync.RWMutex
+	joined   map[string]struct{}
 }
 
 var _ blossomsub.RawTracer = (*blossomSubRawTracer)(nil)
@@ -45,42 +90,60 @@ func (b *blossomSubRawTracer) RemovePeer(p peer.ID) {
 
 // Join implements blossomsub.RawTracer.
 func (b *blossomSubRawTracer) Join(bitmask []byte) {
-	b.joinTotal.WithLabelValues(binaryEncoding.EncodeToString(bitmask)).Inc()
+	b.joinTotal.WithLabelValues(bitmaskLabel(bitmask)).Inc()
+	b.joinedMx.Lock()
+	b.joined[string(bitmask)] = struct{}{}
+	b.joinedMx.Unlock()
 }
 
 // Leave implements blossomsub.RawTracer.
 func (b *blossomSubRawTracer) Leave(bitmask []byte) {
-	b.leaveTotal.WithLabelValues(binaryEncoding.EncodeToString(bitmask)).Inc()
+	b.leaveTotal.WithLabelValues(bitmaskLabel(bitmask)).Inc()
+	b.joinedMx.Lock()
+	delete(b.joined, string(bitmask))
+	b.joinedMx.Unlock()
+}
+
+// joinedBitmaskLabel labels a received bitmask, lumping together those the
+// node has not joined so that peers cannot create series at will.
+func (b *blossomSubRawTracer) joinedBitmaskLabel(bitmask []byte) string {
+	b.joinedMx.RLock()
+	_, ok := b.joined[string(bitmask)]
+	b.joinedMx.RUnlock()
+	if !ok {
+		return otherBitmaskLabel
+	}
+	return bitmaskLabel(bitmask)
 }
 
 // Graft implements blossomsub.RawTracer.
 func (b *blossomSubRawTracer) Graft(p peer.ID, bitmask []byte) {
-	b.graftTotal.WithLabelValues(binaryEncoding.EncodeToString(bitmask)).Inc()
+	b.graftTotal.WithLabelValues(bitmaskLabel(bitmask)).Inc()
 }
 
 // Prune implements blossomsub.RawTracer.
 func (b *blossomSubRawTracer) Prune(p peer.ID, bitmask []byte) {
-	b.pruneTotal.WithLabelValues(binaryEncoding.EncodeToString(bitmask)).Inc()
+	b.pruneTotal.WithLabelValues(bitmaskLabel(bitmask)).Inc()
 }
 
 // ValidateMessage implements blossomsub.RawTracer.
 func (b *blossomSubRawTracer) ValidateMessage(msg *blossomsub.Message) {
-	b.validateMessageTotal.WithLabelValues(binaryEncoding.EncodeToString(msg.GetBitmask())).Inc()
+	b.validateMessageTotal.WithLabelValues(bitmaskLabel(msg.GetBitmask())).Inc()
 }
 
 // SignMessage implements blossomsub.RawTracer.
 func (b *blossomSubRawTracer) DeliverMessage(msg *blossomsub.Message) {
-	b.deliverMessageTotal.WithLabelValues(binaryEncoding.EncodeToString(msg.GetBitmask())).Inc()
+	b.deliverMessageTotal.WithLabelValues(bitmaskLabel(msg.GetBitmask())).Inc()
 }
 
 // RejectMessage implements blossomsub.RawTracer.
 func (b *blossomSubRawTracer) RejectMessage(msg *blossomsub.Message, reason string) {
-	b.rejectMessageTotal.WithLabelValues(binaryEncoding.EncodeToString(msg.GetBitmask()), reason).Inc()
+	b.rejectMessageTotal.WithLabelValues(bitmaskLabel(msg.GetBitmask()), reason).Inc()
 }
 
 // DuplicateMessage implements blossomsub.RawTracer.
 func (b *blossomSubRawTracer) DuplicateMessage(msg *blossomsub.Message) {
-	b.duplicateMessageTotal.WithLabelValues(binaryEncoding.EncodeToString(msg.GetBitmask())).Inc()
+	b.duplicateMessageTotal.WithLabelValues(bitmaskLabel(msg.GetBitmask())).Inc()
 }
 
 // ThrottlePeer implements blossomsub.RawTracer.
@@ -91,11 +154,25 @@ func (b *blossomSubRawTracer) ThrottlePeer(p peer.ID) {
 // RecvRPC implements blossomsub.RawTracer.
 func (b *blossomSubRawTracer) RecvRPC(rpc *blossomsub.RPC) {
 	b.recvRPCTotal.Inc()
+	for _, msg := range rpc.GetPublish() {
+		bitmask := b.joinedBitmaskLabel(msg.GetBitmask())
+		b.recvMessageTotal.WithLabelValues(bitmask).Inc()
+		b.recvMessageBytesTotal.WithLabelValues(bitmask).Add(
+			float64(proto.Size(msg)),
+		)
+	}
 }
 
 // SendRPC implements blossomsub.RawTracer.
 func (b *blossomSubRawTracer) SendRPC(rpc *blossomsub.RPC, p peer.ID) {
 	b.sendRPCTotal.Inc()
+	for _, msg := range rpc.GetPublish() {
+		bitmask := bitmaskLabel(msg.GetBitmask())
+		b.sendMessageTotal.WithLabelValues(bitmask).Inc()
+		b.sendMessageBytesTotal.WithLabelValues(bitmask).Add(
+			float64(proto.Size(msg)),
+		)
+	}
 }
 
 // DropRPC implements blossomsub.RawTracer.
@@ -105,7 +182,7 @@ func (b *blossomSubRawTracer) DropRPC(rpc *blossomsub.RPC, p peer.ID) {
 
 // UndeliverableMessage implements blossomsub.RawTracer.
 func (b *blossomSubRawTracer) UndeliverableMessage(msg *blossomsub.Message) {
-	b.undeliverableMessageTotal.WithLabelValues(binaryEncoding.EncodeToString(msg.GetBitmask())).Inc()
+	b.undeliverableMessageTotal.WithLabelValues(bitmaskLabel(msg.GetBitmask())).Inc()
 }
 
 var _ prometheus.Collector = (*blossomSubRawTracer)(nil)
@@ -127,6 +204,11 @@ func (b *blossomSubRawTracer) Describe(ch chan<- *prometheus.Desc) {
 	b.sendRPCTotal.Describe(ch)
 	b.dropRPCTotal.Describe(ch)
 	b.undeliverableMessageTotal.Describe(ch)
+	b.validateQueueLimit.Describe(ch)
+	b.recvMessageTotal.Describe(ch)
+	b.recvMessageBytesTotal.Describe(ch)
+	b.sendMessageTotal.Describe(ch)
+	b.sendMessageBytesTotal.Describe(ch)
 }
 
 // Collect implements prometheus.Collector.
@@ -146,6 +228,11 @@ func (b *blossomSubRawTracer) Collect(ch chan<- prometheus.Metric) {
 	b.sendRPCTotal.Collect(ch)
 	b.dropRPCTotal.Collect(ch)
 	b.undeliverableMessageTotal.Collect(ch)
+	b.validateQueueLimit.Collect(ch)
+	b.recvMessageTotal.Collect(ch)
+	b.recvMessageBytesTotal.Collect(ch)
+	b.sendMessageTotal.Collect(ch)
+	b.sendMessageBytesTotal.Collect(ch)
 }
 
 type BlossomSubRawTracer interface {
@@ -270,12 +357,66 @@ func NewBlossomSubRawTracer() BlossomSubRawTracer {
 			},
 			[]string{"bitmask"},
 		),
+		validateQueueLimit: prometheus.NewGaugeFunc(
+			prometheus.GaugeOpts{
+				Namespace: blossomSubNamespace,
+				Name:      "validate_queue_limit",
+				Help:      "Current number of messages admitted to the validate queue.",
+			},
+			func() float64 {
+				if f := validateQueueLimitSource.Load(); f != nil {
+					return float64((*f)())
+				}
+				return 0
+			},
+		),
+		recvMessageTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: blossomSubNamespace,
+				Name:      "recv_message_total",
+				Help:      "Total number of messages received.",
+			},
+			[]string{"bitmask"},
+		),
+		recvMessageBytesTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: blossomSubNamespace,
+				Name:      "recv_message_bytes_total",
+				Help:      "Total size of messages received, in bytes.",
+			},
+			[]string{"bitmask"},
+		),
+		sendMessageTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: blossomSubNamespace,
+				Name:      "send_message_total",
+				Help:      "Total number of messages sent, counted once per peer.",
+			},
+			[]string{"bitmask"},
+		),
+		sendMessageBytesTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: blossomSubNamespace,
+				Name:      "send_message_bytes_total",
+				Help:      "Total size of messages sent, in bytes, counted once per peer.",
+			},
+			[]string{"bitmask"},
+		),
+		joined: map[string]struct{}{},
 	}
 	return b
 }
 
 var globalBlossomSubRawTracer = NewBlossomSubRawTracer()
 
+var validateQueueLimitSource atomic.Pointer[func() int]
+
+// ObserveValidateQueueLimit sets the function reporting the current validate
+// queue limit for the validate_queue_limit gauge.
+func ObserveValidateQueueLimit(f func() int) {
+	validateQueueLimitSource.Store(&f)
+}
+
 func init() {
 	prometheus.MustRegister(globalBlossomSubRawTracer)
 }
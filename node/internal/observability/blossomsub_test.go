@@ -0,0 +1,55 @@
+package observability
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	blossomsub "source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub"
+	"source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub/pb"
+)
+
+func TestRecvRPCBitmaskLabels(t *testing.T) {
+	b := NewBlossomSubRawTracer().(*blossomSubRawTracer)
+	joined := bytes.Repeat([]byte{0x01}, 32)
+	other := bytes.Repeat([]byte{0x02}, 32)
+
+	b.Join(joined)
+	b.RecvRPC(&blossomsub.RPC{RPC: &pb.RPC{Publish: []*pb.Message{
+		{Bitmask: joined},
+		{Bitmask: other},
+		{Bitmask: bytes.Repeat([]byte{0x03}, 32)},
+	}}})
+
+	// Joined bitmasks are labeled with a short prefix, the others lumped
+	// together.
+	label := binaryEncoding.EncodeToString(joined[:defaultBitmaskLabelPrefix])
+	assert.Equal(t, 1.0, testutil.ToFloat64(b.recvMessageTotal.WithLabelValues(label)))
+	assert.Equal(t, 2.0, testutil.ToFloat64(
+		b.recvMessageTotal.WithLabelValues(otherBitmaskLabel),
+	))
+
+	b.Leave(joined)
+	b.RecvRPC(&blossomsub.RPC{RPC: &pb.RPC{Publish: []*pb.Message{
+		{Bitmask: joined},
+	}}})
+	assert.Equal(t, 3.0, testutil.ToFloat64(
+		b.recvMessageTotal.WithLabelValues(otherBitmaskLabel),
+	))
+}
+
+func TestBitmaskLabelPrefix(t *testing.T) {
+	defer SetBitmaskLabelPrefix(0)
+	bitmask := bytes.Repeat([]byte{0x01}, 32)
+
+	assert.Equal(
+		t,
+		binaryEncoding.EncodeToString(bitmask[:defaultBitmaskLabelPrefix]),
+		bitmaskLabel(bitmask),
+	)
+	SetBitmaskLabelPrefix(2)
+	assert.Equal(t, binaryEncoding.EncodeToString(bitmask[:2]), bitmaskLabel(bitmask))
+	SetBitmaskLabelPrefix(-1)
+	assert.Empty(t, bitmaskLabel(bitmask))
+}
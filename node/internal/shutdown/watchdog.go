@@ -0,0 +1,91 @@
+// Package shutdown bounds how long the subsystems of the node may take to
+// stop, so that a hung subsystem cannot keep the process from exiting.
+package shutdown
+
+import (
+	"io"
+	"runtime/pprof"
+	"time"
+
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+)
+
+// ExitCode is the exit status of a process whose shutdown was cut short by
+// the watchdog, so that supervisors can tell it from a crash or a clean stop.
+const ExitCode = 124
+
+const defaultGracePeriod = 30 * time.Second
+
+// Watchdog stops the subsystems of the node one at a time, and exits the
+// process if one of them does not stop within the grace period.
+type Watchdog struct {
+	logger *zap.Logger
+	clock  clock.Clock
+	grace  time.Duration
+	// Receives the goroutine stacks when a subsystem is stuck.
+	stacks io.Writer
+	exit   func(code int)
+}
+
+// NewWatchdog creates a watchdog giving each subsystem grace to stop, 30s if
+// zero, or as long as it takes if negative. Stuck subsystems have the stacks
+// of every goroutine written to stacks before exit is called with ExitCode.
+func NewWatchdog(
+	logger *zap.Logger,
+	clk clock.Clock,
+	grace time.Duration,
+	stacks io.Writer,
+	exit func(code int),
+) *Watchdog {
+	if grace == 0 {
+		grace = defaultGracePeriod
+	}
+	return &Watchdog{
+		logger: logger,
+		clock:  clk,
+		grace:  grace,
+		stacks: stacks,
+		exit:   exit,
+	}
+}
+
+// Stop calls stop and waits for it to return within the grace period. If it
+// does not, the goroutine stacks are dumped, the process exits, and false is
+// returned should exit return.
+func (w *Watchdog) Stop(subsystem string, stop func()) bool {
+	if w.grace < 0 {
+		stop()
+		return true
+	}
+
+	start := w.clock.Now()
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		stop()
+	}()
+
+	select {
+	case <-stopped:
+		w.logger.Info(
+			"stopped subsystem",
+			zap.String("subsystem", subsystem),
+			zap.Duration("duration", w.clock.Since(start)),
+		)
+		return true
+	case <-w.clock.After(w.grace):
+	}
+
+	w.logger.Error(
+		"subsystem did not stop within its grace period, exiting",
+		zap.String("subsystem", subsystem),
+		zap.Duration("grace_period", w.grace),
+		zap.Int("exit_code", ExitCode),
+	)
+	if err := pprof.Lookup("goroutine").WriteTo(w.stacks, 2); err != nil {
+		w.logger.Error("could not dump goroutine stacks", zap.Error(err))
+	}
+	w.exit(ExitCode)
+	return false
+}
@@ -0,0 +1,70 @@
+package shutdown_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/shutdown"
+)
+
+func TestWatchdog(t *testing.T) {
+	clk := clock.NewFakeClock(time.UnixMilli(1700000000000))
+	stacks := &bytes.Buffer{}
+	exited := make(chan int, 1)
+	watchdog := shutdown.NewWatchdog(
+		zap.NewNop(),
+		clk,
+		time.Minute,
+		stacks,
+		func(code int) { exited <- code },
+	)
+
+	hung := make(chan struct{})
+	defer close(hung)
+	result := make(chan bool)
+	go func() {
+		result <- watchdog.Stop("hung", func() { <-hung })
+	}()
+	clk.BlockUntil(1)
+	clk.Advance(time.Minute)
+
+	assert.Equal(t, shutdown.ExitCode, <-exited)
+	assert.False(t, <-result)
+	assert.Contains(t, stacks.String(), "goroutine")
+}
+
+func TestWatchdogStopped(t *testing.T) {
+	exited := false
+	watchdog := shutdown.NewWatchdog(
+		zap.NewNop(),
+		clock.NewFakeClock(time.UnixMilli(1700000000000)),
+		time.Minute,
+		&bytes.Buffer{},
+		func(int) { exited = true },
+	)
+
+	stopped := false
+	assert.True(t, watchdog.Stop("quick", func() { stopped = true }))
+	assert.True(t, stopped)
+	assert.False(t, exited)
+}
+
+func TestWatchdogDisabled(t *testing.T) {
+	exited := false
+	watchdog := shutdown.NewWatchdog(
+		zap.NewNop(),
+		clock.NewFakeClock(time.UnixMilli(1700000000000)),
+		-1,
+		&bytes.Buffer{},
+		func(int) { exited = true },
+	)
+
+	stopped := false
+	assert.True(t, watchdog.Stop("slow", func() { stopped = true }))
+	assert.True(t, stopped)
+	assert.False(t, exited)
+}
@@ -0,0 +1,101 @@
+// Package startup tracks how far the node has progressed through startup, so
+// that operators and process managers can tell a slow restart from a stuck
+// one.
+package startup
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+)
+
+type Phase int32
+
+const (
+	PhaseLoadingStore Phase = iota
+	PhaseBootstrappingP2P
+	PhaseSyncing
+	PhaseReady
+	PhaseProving
+)
+
+func (p Phase) String() string {
+	switch p {
+	case PhaseLoadingStore:
+		return "loading_store"
+	case PhaseBootstrappingP2P:
+		return "bootstrapping_p2p"
+	case PhaseSyncing:
+		return "syncing"
+	case PhaseReady:
+		return "ready"
+	case PhaseProving:
+		return "proving"
+	default:
+		return "unknown"
+	}
+}
+
+var (
+	current atomic.Int32
+	since   atomic.Int64
+	// The furthest phase reached, past which a change is logged.
+	furthest atomic.Int32
+
+	phaseGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "node",
+		Name:      "startup_phase",
+		Help: "Current startup phase: 0 loading store, 1 bootstrapping p2p, " +
+			"2 syncing, 3 ready, 4 proving.",
+	})
+)
+
+func init() {
+	since.Store(time.Now().UnixMilli())
+	prometheus.MustRegister(phaseGauge)
+}
+
+// Set moves the node into the given phase, exporting the change. The change is
+// only logged when the node gets further than it has been before, as a running
+// node moves back and forth between syncing, ready and proving. Setting the
+// current phase again is a no-op, so callers on hot paths may call it
+// unconditionally.
+func Set(logger *zap.Logger, phase Phase) {
+	previous := Phase(current.Swap(int32(phase)))
+	if previous == phase {
+		return
+	}
+
+	now := time.Now().UnixMilli()
+	elapsed := time.Duration(now-since.Swap(now)) * time.Millisecond
+	phaseGauge.Set(float64(phase))
+	if logger != nil && advance(phase) {
+		logger.Info(
+			"startup phase changed",
+			zap.Stringer("phase", phase),
+			zap.Stringer("previous_phase", previous),
+			zap.Duration("previous_phase_duration", elapsed),
+		)
+	}
+}
+
+// advance records phase as the furthest reached, reporting whether it is
+// further than any phase before.
+func advance(phase Phase) bool {
+	for {
+		reached := furthest.Load()
+		if int32(phase) <= reached {
+			return false
+		}
+		if furthest.CompareAndSwap(reached, int32(phase)) {
+			return true
+		}
+	}
+}
+
+// Current returns the current phase and when it was entered.
+func Current() (Phase, time.Time) {
+	return Phase(current.Load()), time.UnixMilli(since.Load())
+}
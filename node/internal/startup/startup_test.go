@@ -0,0 +1,45 @@
+package startup_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/startup"
+)
+
+func TestSet(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	logger := zap.New(core)
+
+	phase, _ := startup.Current()
+	assert.Equal(t, startup.PhaseLoadingStore, phase)
+
+	for _, phase := range []startup.Phase{
+		startup.PhaseBootstrappingP2P,
+		startup.PhaseSyncing,
+		startup.PhaseSyncing,
+		startup.PhaseReady,
+		startup.PhaseProving,
+		// A running node falls back and catches up again without logging.
+		startup.PhaseSyncing,
+		startup.PhaseProving,
+		startup.PhaseReady,
+	} {
+		startup.Set(logger, phase)
+		current, _ := startup.Current()
+		assert.Equal(t, phase, current)
+	}
+
+	var logged []string
+	for _, entry := range logs.All() {
+		logged = append(logged, entry.ContextMap()["phase"].(string))
+	}
+	assert.Equal(
+		t,
+		[]string{"bootstrapping_p2p", "syncing", "ready", "proving"},
+		logged,
+	)
+}
@@ -4,6 +4,7 @@ package main
 
 import (
 	"bytes"
+	"context"
 	_ "embed"
 	"encoding/binary"
 	"encoding/hex"
@@ -13,6 +14,7 @@ import (
 	"io/fs"
 	"log"
 	"math/big"
+	"net"
 	"net/http"
 	npprof "net/http/pprof"
 	"os"
@@ -32,6 +34,7 @@ import (
 	"github.com/iden3/go-iden3-crypto/poseidon"
 	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
 	"github.com/pbnjay/memory"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -44,7 +47,12 @@ import (
 	"source.quilibrium.com/quilibrium/monorepo/node/crypto/kzg"
 	"source.quilibrium.com/quilibrium/monorepo/node/execution/intrinsics/token"
 	"source.quilibrium.com/quilibrium/monorepo/node/execution/intrinsics/token/application"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/listeners"
 	qruntime "source.quilibrium.com/quilibrium/monorepo/node/internal/runtime"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/shutdown"
+	"source.quilibrium.com/quilibrium/monorepo/node/keys"
+	"source.quilibrium.com/quilibrium/monorepo/node/maintenance"
 	"source.quilibrium.com/quilibrium/monorepo/node/p2p"
 	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
 	"source.quilibrium.com/quilibrium/monorepo/node/rpc"
@@ -134,16 +142,31 @@ var (
 		0,
 		"specifies the parent process pid for a data worker",
 	)
+	listenFD = flag.Int(
+		"listen-fd",
+		0,
+		"specifies the file descriptor of a listener bound for a data worker by its launcher",
+	)
 	integrityCheck = flag.Bool(
 		"integrity-check",
 		false,
 		"runs an integrity check on the store, helpful for confirming backups are not corrupted (defaults to false)",
 	)
+	verifyStateRoot = flag.Bool(
+		"verify-state-root",
+		false,
+		"asks the running node to re-run the processing of its most recent frames and compare the prover tries, application state and seniority with what it stored, useful when frames are rejected",
+	)
 	lightProver = flag.Bool(
 		"light-prover",
 		true,
 		"when enabled, frame execution validation is skipped",
 	)
+	privateNetworkSpec = flag.String(
+		"generate-private-network",
+		"",
+		"generates configs for a private network from the given spec file into node-<n> directories under the config directory, then exits",
+	)
 )
 
 func signatureCheckDefault() bool {
@@ -277,6 +300,11 @@ func main() {
 		}()
 	}
 
+	if *privateNetworkSpec != "" {
+		generatePrivateNetwork(*privateNetworkSpec, *configDirectory)
+		return
+	}
+
 	if *balance {
 		config, err := config.LoadConfig(*configDirectory, "", false)
 		if err != nil {
@@ -294,7 +322,7 @@ func main() {
 			panic(err)
 		}
 
-		printPeerID(config.P2P)
+		printPeerID(config)
 		return
 	}
 
@@ -304,7 +332,7 @@ func main() {
 			panic(err)
 		}
 
-		printPeerID(config.P2P)
+		printPeerID(config)
 		fmt.Println("Import completed, you are ready for the launch.")
 		return
 	}
@@ -319,6 +347,16 @@ func main() {
 		return
 	}
 
+	if *verifyStateRoot {
+		config, err := config.LoadConfig(*configDirectory, "", false)
+		if err != nil {
+			panic(err)
+		}
+
+		printStateRootVerification(config)
+		return
+	}
+
 	if !*dbConsole && *core == 0 {
 		config.PrintLogo()
 		config.PrintVersion(uint8(*network))
@@ -372,6 +410,7 @@ func main() {
 		signal.Notify(done, syscall.SIGINT, syscall.SIGTERM)
 		dht, err := app.NewDHTNode(nodeConfig)
 		if err != nil {
+			exitOnInvalidP2PConfig(err)
 			panic(err)
 		}
 
@@ -427,11 +466,16 @@ func main() {
 			rpcMultiaddr = nodeConfig.Engine.DataWorkerMultiaddrs[*core-1]
 		}
 
+		prover, err := qcrypto.NewFrameProver(l, nodeConfig.Engine)
+		if err != nil {
+			panic(err)
+		}
+
 		srv, err := rpc.NewDataWorkerIPCServer(
 			rpcMultiaddr,
 			l,
 			uint32(*core)-1,
-			qcrypto.NewWesolowskiFrameProver(l),
+			prover,
 			nodeConfig,
 			*parentProcess,
 		)
@@ -439,7 +483,15 @@ func main() {
 			panic(err)
 		}
 
-		err = srv.Start()
+		lis, err := dataWorkerListener(*core)
+		if err != nil {
+			panic(err)
+		}
+		if lis != nil {
+			err = srv.Serve(lis)
+		} else {
+			err = srv.Start()
+		}
 		if err != nil {
 			panic(err)
 		}
@@ -490,7 +542,19 @@ func main() {
 		}
 	}
 
+	if _, err := p2p.DeriveIdentity(nodeConfig.P2P, nil, ""); err != nil {
+		fmt.Println("Invalid peer key in config: " + err.Error())
+		os.Exit(1)
+	}
+
 	RunForkRepairIfNeeded(nodeConfig)
+	consistent := RunIntegrityRepairIfNeeded(nodeConfig)
+
+	// A marker left by an unrepaired store is kept, so that the next start
+	// checks it again.
+	if err := store.MarkRunning(nodeConfig.DB.Path); err != nil {
+		panic(err)
+	}
 
 	done := make(chan os.Signal, 1)
 	signal.Notify(done, syscall.SIGINT, syscall.SIGTERM)
@@ -502,6 +566,13 @@ func main() {
 	}
 
 	if err != nil {
+		// The p2p config is checked before anything is written to the store.
+		if errors.Is(err, p2p.ErrInvalidP2PConfig) && consistent {
+			if err := store.MarkStopped(nodeConfig.DB.Path); err != nil {
+				fmt.Println(err)
+			}
+		}
+		exitOnInvalidP2PConfig(err)
 		panic(err)
 	}
 
@@ -509,11 +580,26 @@ func main() {
 		fmt.Println("Running integrity check...")
 		node.VerifyProofIntegrity()
 		fmt.Println("Integrity check passed!")
+		if consistent {
+			if err := store.MarkStopped(nodeConfig.DB.Path); err != nil {
+				fmt.Println(err)
+			}
+		}
 		return
 	}
 
 	// runtime.GOMAXPROCS(1)
 
+	scheduler, err := maintenance.NewScheduler(
+		node.GetLogger().Named("maintenance"),
+		nodeConfig.DB,
+		node.GetKVDB(),
+		node.GetExecutionEngines(),
+	)
+	if err != nil {
+		panic(err)
+	}
+
 	if nodeConfig.ListenGRPCMultiaddr != "" {
 		srv, err := rpc.NewRPCServer(
 			nodeConfig.ListenGRPCMultiaddr,
@@ -522,10 +608,13 @@ func main() {
 			node.GetDataProofStore(),
 			node.GetClockStore(),
 			node.GetCoinStore(),
+			node.GetMetadataStore(),
 			node.GetKeyManager(),
 			node.GetPubSub(),
 			node.GetMasterClock(),
 			node.GetExecutionEngines(),
+			scheduler,
+			nodeConfig.EnableExplorer,
 		)
 		if err != nil {
 			panic(err)
@@ -540,10 +629,48 @@ func main() {
 	}
 
 	node.Start()
+	scheduler.Start()
+
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+	go reloadPeersOnHangup(hangup, *configDirectory, node)
 
 	<-done
-	stopDataWorkers()
-	node.Stop()
+	watchdog := shutdown.NewWatchdog(
+		node.GetLogger().Named("shutdown"),
+		clock.NewRealClock(),
+		nodeConfig.Engine.ShutdownGracePeriod,
+		os.Stderr,
+		os.Exit,
+	)
+	watchdog.Stop("maintenance", scheduler.Stop)
+	watchdog.Stop("data workers", stopDataWorkers)
+	if node.Stop(watchdog) && consistent {
+		if err := store.MarkStopped(nodeConfig.DB.Path); err != nil {
+			fmt.Println(err)
+		}
+	}
+}
+
+// reloadPeersOnHangup reads the config again on every SIGHUP and applies its
+// direct and bootstrap peers to the running node.
+func reloadPeersOnHangup(
+	hangup <-chan os.Signal,
+	configDirectory string,
+	node *app.Node,
+) {
+	logger := node.GetLogger()
+	for range hangup {
+		logger.Info("reloading peers from config")
+		cfg, err := config.LoadConfig(configDirectory, "", true)
+		if err != nil {
+			logger.Error("could not load config", zap.Error(err))
+			continue
+		}
+		if err := node.GetPubSub().ReloadPeers(cfg.P2P); err != nil {
+			logger.Error("could not reload peers", zap.Error(err))
+		}
+	}
 }
 
 var dataWorkers []*exec.Cmd
@@ -561,6 +688,11 @@ func spawnDataWorkers(nodeConfig *config.Config) {
 		panic(err)
 	}
 
+	listenerFiles, err := dataWorkerListenerFiles(nodeConfig)
+	if err != nil {
+		panic(err)
+	}
+
 	dataWorkers = make([]*exec.Cmd, nodeConfig.Engine.DataWorkerCount)
 	fmt.Printf("Spawning %d data workers...\n", nodeConfig.Engine.DataWorkerCount)
 
@@ -572,10 +704,19 @@ func spawnDataWorkers(nodeConfig *config.Config) {
 					fmt.Sprintf("--core=%d", i),
 					fmt.Sprintf("--parent-process=%d", os.Getpid()),
 				}
+				if listenerFiles != nil {
+					args = append(
+						args,
+						fmt.Sprintf("--listen-fd=%d", listeners.FirstFD),
+					)
+				}
 				args = append(args, os.Args[1:]...)
 				cmd := exec.Command(process, args...)
 				cmd.Stdout = os.Stdout
 				cmd.Stderr = os.Stdout
+				if listenerFiles != nil {
+					cmd.ExtraFiles = []*os.File{listenerFiles[i-1]}
+				}
 				err := cmd.Start()
 				if err != nil {
 					panic(err)
@@ -590,6 +731,91 @@ func spawnDataWorkers(nodeConfig *config.Config) {
 	}
 }
 
+// dataWorkerListenerFiles returns the sockets to hand down to the data
+// workers, one per worker, either passed to the node by systemd socket
+// activation or bound here if DataWorkerPreBind is set, or nil if the workers
+// bind their own. The sockets stay open for the lifetime of the node, so that
+// a restarted worker serves the same socket.
+func dataWorkerListenerFiles(nodeConfig *config.Config) ([]*os.File, error) {
+	count := nodeConfig.Engine.DataWorkerCount
+	workerListeners, err := listeners.Systemd()
+	if err != nil {
+		return nil, errors.Wrap(err, "data worker listener files")
+	}
+	if workerListeners != nil && len(workerListeners) != count {
+		return nil, errors.Wrap(
+			errors.Errorf(
+				"systemd passed %d sockets for %d data workers",
+				len(workerListeners),
+				count,
+			),
+			"data worker listener files",
+		)
+	}
+
+	if workerListeners == nil && nodeConfig.Engine.DataWorkerPreBind {
+		for i := 1; i <= count; i++ {
+			addr, err := multiaddr.NewMultiaddr(fmt.Sprintf(
+				nodeConfig.Engine.DataWorkerBaseListenMultiaddr,
+				int(nodeConfig.Engine.DataWorkerBaseListenPort)+i-1,
+			))
+			if err != nil {
+				return nil, errors.Wrap(err, "data worker listener files")
+			}
+			l, err := listeners.Listen(addr)
+			if err != nil {
+				for _, l := range workerListeners {
+					l.Close()
+				}
+				return nil, errors.Wrap(err, "data worker listener files")
+			}
+			workerListeners = append(workerListeners, l)
+		}
+	}
+
+	if workerListeners == nil {
+		return nil, nil
+	}
+
+	files := make([]*os.File, 0, count)
+	for _, l := range workerListeners {
+		f, err := listeners.File(l)
+		if err != nil {
+			return nil, errors.Wrap(err, "data worker listener files")
+		}
+		// The worker serves the duplicate, the node never accepts.
+		l.Close()
+		files = append(files, f)
+	}
+	return files, nil
+}
+
+// dataWorkerListener returns the listener handed down to a data worker by
+// its launcher or by systemd socket activation, or nil if the worker binds
+// its own.
+func dataWorkerListener(core int) (net.Listener, error) {
+	if *listenFD != 0 {
+		return listeners.FromFD(*listenFD)
+	}
+
+	workerListeners, err := listeners.Systemd()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case len(workerListeners) == 0:
+		return nil, nil
+	case len(workerListeners) == 1:
+		return workerListeners[0], nil
+	default:
+		return nil, errors.Errorf(
+			"systemd passed %d sockets to data worker %d",
+			len(workerListeners),
+			core,
+		)
+	}
+}
+
 func stopDataWorkers() {
 	for i := 0; i < len(dataWorkers); i++ {
 		err := dataWorkers[i].Process.Signal(os.Kill)
@@ -709,6 +935,58 @@ func RunForkRepairIfNeeded(
 	}
 }
 
+// RunIntegrityRepairIfNeeded checks for the unclean shutdown marker left by a
+// previous run, and if present rolls the data clock and the application state
+// back to the last consistent frame within the configured scan depth. Returns
+// false if the store could not be repaired, in which case the marker must be
+// kept so that the next start checks it again.
+func RunIntegrityRepairIfNeeded(
+	nodeConfig *config.Config,
+) bool {
+	unclean, err := store.UncleanShutdown(nodeConfig.DB.Path)
+	if err != nil {
+		panic(err)
+	}
+
+	depth := nodeConfig.DB.IntegrityScanDepth
+	if depth == 0 {
+		depth = 100
+	}
+	if !unclean || depth < 0 {
+		return true
+	}
+
+	logger, _ := zap.NewDevelopment()
+	logger.Info(
+		"unclean shutdown detected, checking store integrity",
+		zap.Int("depth", depth),
+	)
+
+	db := store.NewPebbleDB(&config.DBConfig{Path: nodeConfig.DB.Path})
+	defer db.Close()
+	clockStore := store.NewPebbleClockStore(db, logger)
+	coinStore := store.NewPebbleCoinStore(db, logger)
+	filter := p2p.GetBloomFilter(application.TOKEN_ADDRESS, 256, 3)
+
+	head, err := clockStore.RepairDataClockFrames(
+		filter,
+		uint64(depth),
+		func(frameNumber uint64) error {
+			return coinStore.RollbackAppState(filter, frameNumber)
+		},
+	)
+	if err != nil {
+		logger.Error(
+			"store integrity could not be repaired, a resync may be required",
+			zap.Error(err),
+		)
+		return false
+	}
+
+	logger.Info("store integrity check complete", zap.Uint64("head", head))
+	return true
+}
+
 func overrideHead(
 	txn store.Transaction,
 	clockStore store.ClockStore,
@@ -832,6 +1110,16 @@ func processFrame(
 				txn.Abort()
 				return nil, errors.Wrap(err, "process frame")
 			}
+		case *protobufs.TokenOutput_FaucetClaim:
+			err := coinStore.PutFaucetClaim(
+				txn,
+				o.FaucetClaim.Account.GetImplicitAccount().Address,
+				o.FaucetClaim.FrameNumber,
+			)
+			if err != nil {
+				txn.Abort()
+				return nil, errors.Wrap(err, "process frame")
+			}
 		case *protobufs.TokenOutput_DeletedCoin:
 			coin, err := coinStore.GetCoinByAddress(txn, o.DeletedCoin.Address)
 			if err != nil {
@@ -1241,30 +1529,61 @@ func printBalance(config *config.Config) {
 	fmt.Println("Note: bridged balance is not reflected here, you must bridge back to QUIL to use QUIL on mainnet.")
 }
 
-func getPeerID(p2pConfig *config.P2PConfig) peer.ID {
-	peerPrivKey, err := hex.DecodeString(p2pConfig.PeerPrivKey)
+func generatePrivateNetwork(specPath string, baseDir string) {
+	spec, err := config.LoadPrivateNetworkSpec(specPath)
 	if err != nil {
-		panic(errors.Wrap(err, "error unmarshaling peerkey"))
+		panic(err)
 	}
 
-	privKey, err := crypto.UnmarshalEd448PrivateKey(peerPrivKey)
+	baseDir, err = filepath.Abs(baseDir)
 	if err != nil {
-		panic(errors.Wrap(err, "error unmarshaling peerkey"))
+		panic(err)
 	}
 
-	pub := privKey.GetPublic()
-	id, err := peer.IDFromPublicKey(pub)
+	network, err := config.GeneratePrivateNetwork(spec, baseDir)
 	if err != nil {
-		panic(errors.Wrap(err, "error getting peer id"))
+		panic(err)
+	}
+
+	if err := config.WritePrivateNetwork(baseDir, network); err != nil {
+		panic(err)
 	}
 
-	return id
+	fmt.Printf("Generated private network %d\n", spec.Network)
+	fmt.Println("Beacon: " + network.PeerIDs[0].String())
+	for i, id := range network.PeerIDs {
+		fmt.Printf(
+			"node-%d: %s (--config %s)\n",
+			i,
+			id.String(),
+			filepath.Join(baseDir, fmt.Sprintf("node-%d", i)),
+		)
+	}
 }
 
-func printPeerID(p2pConfig *config.P2PConfig) {
-	id := getPeerID(p2pConfig)
+func printPeerID(cfg *config.Config) {
+	// Only read an existing key store, printing the peer ID must not create
+	// one.
+	var keyManager keys.KeyManager
+	if cfg.Key != nil && cfg.Key.KeyStoreFile != nil {
+		if _, err := os.Stat(cfg.Key.KeyStoreFile.Path); err == nil {
+			keyManager = keys.NewFileKeyManager(cfg.Key, zap.NewNop())
+		}
+	}
+
+	identity, err := p2p.DeriveIdentity(
+		cfg.P2P,
+		keyManager,
+		cfg.Engine.ProvingKeyId,
+	)
+	if err != nil {
+		panic(err)
+	}
 
-	fmt.Println("Peer ID: " + id.String())
+	fmt.Println("Peer ID: " + identity.PeerID.String())
+	if identity.ProverAddress != nil {
+		fmt.Printf("Prover Address: 0x%x\n", identity.ProverAddress)
+	}
 }
 
 func printNodeInfo(cfg *config.Config) {
@@ -1273,7 +1592,7 @@ func printNodeInfo(cfg *config.Config) {
 		os.Exit(1)
 	}
 
-	printPeerID(cfg.P2P)
+	printPeerID(cfg)
 
 	conn, err := app.ConnectToNode(cfg)
 	if err != nil {
@@ -1291,6 +1610,10 @@ func printNodeInfo(cfg *config.Config) {
 
 	fmt.Println("Version: " + config.FormatVersion(nodeInfo.Version))
 	fmt.Println("Max Frame: " + strconv.FormatUint(nodeInfo.GetMaxFrame(), 10))
+	fmt.Println("Network Head Frame: " + strconv.FormatUint(
+		nodeInfo.GetNetworkHeadFrame(),
+		10,
+	))
 	if nodeInfo.ProverRing == -1 {
 		fmt.Println("Not in Prover Ring")
 	} else {
@@ -1303,5 +1626,84 @@ func printNodeInfo(cfg *config.Config) {
 		nodeInfo.PeerSeniority,
 	).String())
 	fmt.Println("Active Workers:", nodeInfo.Workers)
+	fmt.Println("Startup Phase: " + nodeInfo.StartupPhase)
 	printBalance(cfg)
 }
+
+func printStateRootVerification(cfg *config.Config) {
+	if cfg.ListenGRPCMultiaddr == "" {
+		_, _ = fmt.Fprintf(os.Stderr, "gRPC Not Enabled, Please Configure\n")
+		os.Exit(1)
+	}
+
+	conn, err := app.ConnectToNode(cfg)
+	if err != nil {
+		fmt.Println("Could not connect to node. If it is still booting, please wait.")
+		os.Exit(1)
+	}
+	defer conn.Close()
+
+	client := protobufs.NewNodeServiceClient(conn)
+
+	resp, err := client.VerifyStateRoot(
+		context.Background(),
+		&protobufs.VerifyStateRootRequest{},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	if resp.FromFrameNumber > resp.HeadFrameNumber {
+		fmt.Println("No frames were processed with their changes recorded yet.")
+		return
+	}
+
+	fmt.Printf(
+		"Verified frames %d to %d\n",
+		resp.FromFrameNumber,
+		resp.HeadFrameNumber,
+	)
+	if len(resp.Divergences) == 0 {
+		fmt.Println("Stored state matches the re-derived state.")
+		return
+	}
+
+	for _, d := range resp.Divergences {
+		fmt.Printf("Frame %d diverges:\n", d.FrameNumber)
+		for _, ring := range d.Rings {
+			for _, v := range ring.Added {
+				fmt.Printf("  ring %d: missing %x\n", ring.Ring, v.ProverAddress)
+			}
+			for _, v := range ring.Removed {
+				fmt.Printf("  ring %d: unexpected %x\n", ring.Ring, v.ProverAddress)
+			}
+			for _, c := range ring.Changed {
+				fmt.Printf(
+					"  ring %d: %x stored latest frame %d count %d, expected %d count %d\n",
+					ring.Ring,
+					c.From.ProverAddress,
+					c.From.LatestFrame,
+					c.From.Count,
+					c.To.LatestFrame,
+					c.To.Count,
+				)
+			}
+		}
+		for _, key := range d.Keys {
+			fmt.Printf("  state key %x written differently\n", key)
+		}
+		for _, addr := range d.SeniorityAddresses {
+			fmt.Printf("  seniority of %x differs\n", addr)
+		}
+	}
+	os.Exit(1)
+}
+
+// exitOnInvalidP2PConfig exits without a stack trace when err is caused by
+// the p2p config, since restarting with the same config cannot succeed.
+func exitOnInvalidP2PConfig(err error) {
+	if errors.Is(err, p2p.ErrInvalidP2PConfig) {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
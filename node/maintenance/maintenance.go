@@ -0,0 +1,270 @@
+// Package maintenance runs scheduled maintenance windows, in which the node
+// stops proving and serving sync, compacts and backs up its store, and then
+// resumes, so that operators can do disk maintenance without having to time
+// a shutdown by hand.
+package maintenance
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	"source.quilibrium.com/quilibrium/monorepo/node/execution"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+// How long a maintenance window waits for in-flight sync requests to finish
+// before going ahead regardless.
+const drainTimeout = 2 * time.Minute
+
+type Phase string
+
+const (
+	PhaseIdle       Phase = "idle"
+	PhaseScheduled  Phase = "scheduled"
+	PhaseDraining   Phase = "draining"
+	PhaseCompacting Phase = "compacting"
+	PhaseBackingUp  Phase = "backing_up"
+)
+
+// Plan describes a maintenance window.
+type Plan struct {
+	Start time.Time
+	// Compact the whole store.
+	Compact bool
+	// Directory to write a store checkpoint into, empty skips the backup.
+	BackupDir string
+}
+
+// Status reports the current phase, the next scheduled window and the outcome
+// of the last completed one.
+type Status struct {
+	Phase         Phase
+	Scheduled     time.Time
+	LastCompleted time.Time
+	LastError     error
+}
+
+// Scheduler runs maintenance windows, either daily at the configured time or
+// once when scheduled explicitly.
+type Scheduler struct {
+	logger    *zap.Logger
+	db        store.KVDB
+	engines   []execution.ExecutionEngine
+	daily     time.Duration
+	hasDaily  bool
+	backupDir string
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mx      sync.Mutex
+	status  Status
+	plan    *Plan
+	timer   *time.Timer
+	running bool
+}
+
+// NewScheduler creates a scheduler for the given store and the execution
+// engines whose proving and sync serving it pauses.
+func NewScheduler(
+	logger *zap.Logger,
+	dbConfig *config.DBConfig,
+	db store.KVDB,
+	engines []execution.ExecutionEngine,
+) (*Scheduler, error) {
+	s := &Scheduler{
+		logger:    logger,
+		db:        db,
+		engines:   engines,
+		backupDir: dbConfig.MaintenanceBackupDir,
+		status:    Status{Phase: PhaseIdle},
+	}
+
+	if dbConfig.MaintenanceTime != "" {
+		t, err := time.Parse("15:04", dbConfig.MaintenanceTime)
+		if err != nil {
+			return nil, errors.Wrap(err, "new scheduler")
+		}
+		s.daily = time.Duration(t.Hour())*time.Hour +
+			time.Duration(t.Minute())*time.Minute
+		s.hasDaily = true
+	}
+
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	return s, nil
+}
+
+// Start schedules the daily maintenance window, if one is configured.
+func (s *Scheduler) Start() {
+	if !s.hasDaily {
+		return
+	}
+
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	s.scheduleLocked(s.nextDaily(time.Now()))
+}
+
+// Stop cancels any scheduled window and waits for a running one to finish
+// resuming the engines.
+func (s *Scheduler) Stop() {
+	s.mx.Lock()
+	if s.timer != nil {
+		s.timer.Stop()
+	}
+	s.cancel()
+	s.mx.Unlock()
+
+	s.wg.Wait()
+}
+
+// Schedule replaces the next maintenance window with plan. A zero start time
+// starts maintenance immediately, an empty backup directory uses the
+// configured one.
+func (s *Scheduler) Schedule(plan Plan) error {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	if s.running {
+		return errors.Wrap(
+			errors.New("maintenance already running"),
+			"schedule",
+		)
+	}
+
+	if plan.Start.IsZero() {
+		plan.Start = time.Now()
+	}
+	if plan.BackupDir == "" {
+		plan.BackupDir = s.backupDir
+	}
+	s.scheduleLocked(plan)
+	return nil
+}
+
+// Status returns the current maintenance status.
+func (s *Scheduler) Status() Status {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	return s.status
+}
+
+func (s *Scheduler) nextDaily(now time.Time) Plan {
+	now = now.UTC()
+	start := time.Date(
+		now.Year(),
+		now.Month(),
+		now.Day(),
+		0, 0, 0, 0,
+		time.UTC,
+	).Add(s.daily)
+	if !start.After(now) {
+		start = start.Add(24 * time.Hour)
+	}
+
+	return Plan{Start: start, Compact: true, BackupDir: s.backupDir}
+}
+
+func (s *Scheduler) scheduleLocked(plan Plan) {
+	if s.timer != nil {
+		s.timer.Stop()
+	}
+
+	s.plan = &plan
+	s.status.Phase = PhaseScheduled
+	s.status.Scheduled = plan.Start
+	s.timer = time.AfterFunc(time.Until(plan.Start), func() {
+		s.mx.Lock()
+		if s.running || s.plan == nil || s.ctx.Err() != nil {
+			s.mx.Unlock()
+			return
+		}
+		plan := *s.plan
+		s.plan = nil
+		s.running = true
+		s.wg.Add(1)
+		s.mx.Unlock()
+
+		defer s.wg.Done()
+		s.run(plan)
+	})
+
+	s.logger.Info("maintenance scheduled", zap.Time("start", plan.Start))
+}
+
+func (s *Scheduler) setPhase(phase Phase) {
+	s.mx.Lock()
+	s.status.Phase = phase
+	s.mx.Unlock()
+}
+
+func (s *Scheduler) run(plan Plan) {
+	s.logger.Info("maintenance starting")
+	s.setPhase(PhaseDraining)
+
+	ctx, cancel := context.WithTimeout(s.ctx, drainTimeout)
+	for _, e := range s.engines {
+		if err := e.EnterMaintenance(ctx); err != nil {
+			s.logger.Warn("could not drain sync serving", zap.Error(err))
+		}
+	}
+	cancel()
+
+	err := s.maintain(plan)
+
+	for _, e := range s.engines {
+		e.ExitMaintenance()
+	}
+
+	if err != nil {
+		s.logger.Error("maintenance failed", zap.Error(err))
+	} else {
+		s.logger.Info("maintenance completed")
+	}
+
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	s.running = false
+	s.status.Phase = PhaseIdle
+	s.status.Scheduled = time.Time{}
+	s.status.LastCompleted = time.Now()
+	s.status.LastError = err
+	if s.hasDaily && s.ctx.Err() == nil {
+		s.scheduleLocked(s.nextDaily(time.Now()))
+	}
+}
+
+func (s *Scheduler) maintain(plan Plan) error {
+	if plan.Compact {
+		if err := s.ctx.Err(); err != nil {
+			return errors.Wrap(err, "maintain")
+		}
+		s.setPhase(PhaseCompacting)
+		if err := s.db.CompactAll(); err != nil {
+			return errors.Wrap(err, "maintain")
+		}
+	}
+
+	if plan.BackupDir != "" {
+		if err := s.ctx.Err(); err != nil {
+			return errors.Wrap(err, "maintain")
+		}
+		s.setPhase(PhaseBackingUp)
+		dir := filepath.Join(
+			plan.BackupDir,
+			time.Now().UTC().Format("20060102T150405Z"),
+		)
+		if err := s.db.Checkpoint(dir); err != nil {
+			return errors.Wrap(err, "maintain")
+		}
+		s.logger.Info("store backed up", zap.String("dir", dir))
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package maintenance_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	"source.quilibrium.com/quilibrium/monorepo/node/execution"
+	"source.quilibrium.com/quilibrium/monorepo/node/maintenance"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+type engine struct {
+	execution.ExecutionEngine
+	entered chan struct{}
+	exited  chan struct{}
+}
+
+func (e *engine) EnterMaintenance(ctx context.Context) error {
+	e.entered <- struct{}{}
+	return nil
+}
+
+func (e *engine) ExitMaintenance() {
+	e.exited <- struct{}{}
+}
+
+func TestScheduleRunsAndResumes(t *testing.T) {
+	e := &engine{
+		entered: make(chan struct{}, 1),
+		exited:  make(chan struct{}, 1),
+	}
+	s, err := maintenance.NewScheduler(
+		zap.NewNop(),
+		&config.DBConfig{},
+		store.NewInMemKVDB(),
+		[]execution.ExecutionEngine{e},
+	)
+	require.NoError(t, err)
+	defer s.Stop()
+
+	require.NoError(t, s.Schedule(maintenance.Plan{
+		Start:   time.Now().Add(50 * time.Millisecond),
+		Compact: true,
+	}))
+	require.Equal(t, maintenance.PhaseScheduled, s.Status().Phase)
+
+	<-e.entered
+	<-e.exited
+	require.Eventually(t, func() bool {
+		status := s.Status()
+		return status.Phase == maintenance.PhaseIdle &&
+			!status.LastCompleted.IsZero() &&
+			status.LastError == nil
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestInvalidMaintenanceTime(t *testing.T) {
+	_, err := maintenance.NewScheduler(
+		zap.NewNop(),
+		&config.DBConfig{MaintenanceTime: "25:00"},
+		store.NewInMemKVDB(),
+		nil,
+	)
+	require.Error(t, err)
+}
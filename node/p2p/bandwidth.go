@@ -0,0 +1,39 @@
+package p2p
+
+import (
+	"github.com/libp2p/go-libp2p/core/metrics"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+func bandwidthStats(stats metrics.Stats) *protobufs.BandwidthStats {
+	return &protobufs.BandwidthStats{
+		TotalIn:  uint64(stats.TotalIn),
+		TotalOut: uint64(stats.TotalOut),
+		RateIn:   stats.RateIn,
+		RateOut:  stats.RateOut,
+	}
+}
+
+// GetBandwidthStats reports the traffic of the host in total, by protocol and
+// by peer for up to peerLimit of the busiest peers. A peerLimit of zero
+// reports every peer.
+func (b *BlossomSub) GetBandwidthStats(
+	peerLimit int,
+) *protobufs.BandwidthStatsResponse {
+	resp := &protobufs.BandwidthStatsResponse{
+		Total: bandwidthStats(b.bandwidth.GetBandwidthTotals()),
+	}
+	for _, p := range b.bandwidth.Peers(peerLimit) {
+		resp.Peers = append(resp.Peers, &protobufs.PeerBandwidth{
+			PeerId: []byte(p.Peer),
+			Stats:  bandwidthStats(p.Stats),
+		})
+	}
+	for _, p := range b.bandwidth.Protocols() {
+		resp.Protocols = append(resp.Protocols, &protobufs.ProtocolBandwidth{
+			Protocol: string(p.Protocol),
+			Stats:    bandwidthStats(p.Stats),
+		})
+	}
+	return resp
+}
@@ -0,0 +1,83 @@
+package p2p
+
+import (
+	"bytes"
+	"sort"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+var errBansUnavailable = errors.New("peer bans unavailable")
+
+// BanPeer refuses connections to and from the peer for ttl, or until it is
+// unbanned if ttl is zero or less, and closes its open connections. Bans do
+// not survive a restart.
+func (b *BlossomSub) BanPeer(
+	peerId []byte,
+	ttl time.Duration,
+) (*protobufs.PeerBan, error) {
+	if b.bans == nil {
+		return nil, errors.Wrap(errBansUnavailable, "ban peer")
+	}
+
+	p := peer.ID(peerId)
+	if p == b.peerID {
+		return nil, errors.Wrap(errors.New("cannot ban self"), "ban peer")
+	}
+
+	until := b.bans.Ban(p, ttl)
+	if err := b.h.Network().ClosePeer(p); err != nil {
+		b.logger.Debug(
+			"could not close connections of banned peer",
+			zap.String("peer_id", p.String()),
+			zap.Error(err),
+		)
+	}
+
+	return peerBan(p, until), nil
+}
+
+// UnbanPeer lifts the ban of the peer and returns the remaining bans.
+func (b *BlossomSub) UnbanPeer(
+	peerId []byte,
+) (*protobufs.PeerBansResponse, error) {
+	if b.bans == nil {
+		return nil, errors.Wrap(errBansUnavailable, "unban peer")
+	}
+
+	b.bans.Unban(peer.ID(peerId))
+	return b.listBans(), nil
+}
+
+// ListBans returns the banned peers.
+func (b *BlossomSub) ListBans() (*protobufs.PeerBansResponse, error) {
+	if b.bans == nil {
+		return nil, errors.Wrap(errBansUnavailable, "list bans")
+	}
+
+	return b.listBans(), nil
+}
+
+func (b *BlossomSub) listBans() *protobufs.PeerBansResponse {
+	resp := &protobufs.PeerBansResponse{}
+	for p, until := range b.bans.Bans() {
+		resp.Bans = append(resp.Bans, peerBan(p, until))
+	}
+	sort.Slice(resp.Bans, func(i, j int) bool {
+		return bytes.Compare(resp.Bans[i].PeerId, resp.Bans[j].PeerId) < 0
+	})
+
+	return resp
+}
+
+func peerBan(p peer.ID, until time.Time) *protobufs.PeerBan {
+	ban := &protobufs.PeerBan{PeerId: []byte(p)}
+	if !until.IsZero() {
+		ban.Until = until.UnixMilli()
+	}
+	return ban
+}
@@ -0,0 +1,61 @@
+package p2p
+
+import (
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	blossomsub "source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+)
+
+// newBitmaskScoreParams converts P2PConfig.BitmaskScoreParams to the scoring
+// parameters of blossomsub. Deliveries are scored against the full bitmask
+// while mesh membership is kept per bit, so the parameters are set on both.
+func newBitmaskScoreParams(
+	p2pConfig *config.P2PConfig,
+) (map[string]*blossomsub.BitmaskScoreParams, error) {
+	params := map[string]*blossomsub.BitmaskScoreParams{}
+	for _, c := range p2pConfig.BitmaskScoreParams {
+		bitmask, err := hex.DecodeString(strings.TrimPrefix(c.Bitmask, "0x"))
+		if err != nil {
+			return nil, errors.Wrap(err, "new bitmask score params")
+		}
+		if len(bitmask) == 0 {
+			return nil, errors.Wrap(
+				errors.New("score params without a bitmask"),
+				"new bitmask score params",
+			)
+		}
+
+		p := &blossomsub.BitmaskScoreParams{
+			SkipAtomicValidation:           true,
+			BitmaskWeight:                  c.BitmaskWeight,
+			TimeInMeshWeight:               c.TimeInMeshWeight,
+			TimeInMeshQuantum:              c.TimeInMeshQuantum,
+			TimeInMeshCap:                  c.TimeInMeshCap,
+			FirstMessageDeliveriesWeight:   c.FirstMessageDeliveriesWeight,
+			FirstMessageDeliveriesDecay:    c.FirstMessageDeliveriesDecay,
+			FirstMessageDeliveriesCap:      c.FirstMessageDeliveriesCap,
+			InvalidMessageDeliveriesWeight: c.InvalidMessageDeliveriesWeight,
+			InvalidMessageDeliveriesDecay:  c.InvalidMessageDeliveriesDecay,
+		}
+		if p.BitmaskWeight == 0 {
+			p.BitmaskWeight = 1
+		}
+		if p.TimeInMeshWeight != 0 && p.TimeInMeshQuantum == 0 {
+			p.TimeInMeshQuantum = time.Second
+		}
+		if err := p.Validate(); err != nil {
+			return nil, errors.Wrapf(err, "new bitmask score params: %s", c.Bitmask)
+		}
+
+		params[string(bitmask)] = p
+		for _, bit := range blossomsub.SliceBitmask(bitmask) {
+			params[string(bit)] = p
+		}
+	}
+
+	return params, nil
+}
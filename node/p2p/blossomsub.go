@@ -9,7 +9,10 @@ import (
 	"math/big"
 	"math/bits"
 	"net"
+	"slices"
+	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/libp2p/go-libp2p"
@@ -20,31 +23,38 @@ import (
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/peerstore"
 	"github.com/libp2p/go-libp2p/core/protocol"
 	"github.com/libp2p/go-libp2p/p2p/discovery/routing"
 	"github.com/libp2p/go-libp2p/p2p/discovery/util"
+	basichost "github.com/libp2p/go-libp2p/p2p/host/basic"
 	"github.com/libp2p/go-libp2p/p2p/host/eventbus"
 	rcmgr "github.com/libp2p/go-libp2p/p2p/host/resource-manager"
 	routedhost "github.com/libp2p/go-libp2p/p2p/host/routed"
 	"github.com/libp2p/go-libp2p/p2p/net/connmgr"
 	"github.com/libp2p/go-libp2p/p2p/net/gostream"
 	"github.com/libp2p/go-libp2p/p2p/net/swarm"
+	"github.com/libp2p/go-libp2p/p2p/protocol/holepunch"
+	"github.com/libp2p/go-libp2p/p2p/protocol/identify"
 	"github.com/mr-tron/base58"
 	ma "github.com/multiformats/go-multiaddr"
 	madns "github.com/multiformats/go-multiaddr-dns"
+	msmux "github.com/multiformats/go-multistream"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	blossomsub "source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub"
 	"source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub/pb"
 	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
 	qgrpc "source.quilibrium.com/quilibrium/monorepo/node/internal/grpc"
 	"source.quilibrium.com/quilibrium/monorepo/node/internal/observability"
 	qruntime "source.quilibrium.com/quilibrium/monorepo/node/internal/runtime"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/startup"
 	"source.quilibrium.com/quilibrium/monorepo/node/p2p/internal"
 	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
 )
 
 // The default watermarks are the defaults used by libp2p.DefaultConnectionManager.
@@ -60,10 +70,43 @@ const (
 	defaultPingTimeout              = 5 * time.Second
 	defaultPingPeriod               = 30 * time.Second
 	defaultPingAttempts             = 3
+	defaultValidateQueueMinSize     = 2048
+	defaultChurnWindow              = time.Minute
+	defaultChurnBackoff             = 10 * time.Minute
+	defaultHandlerPanicThreshold    = 5
+	defaultHandlerBreakerCooldown   = 30 * time.Second
+	defaultAddressFailureLimit      = 3
+	defaultAnnounceRotationPeriod   = time.Hour
+	defaultPeerstoreGCInterval      = 2 * time.Hour
+	defaultPeerScoreHalfLife        = 24 * time.Hour
+	defaultDirectPeerCheckPeriod    = time.Minute
+	defaultGossipLimitPenalty       = 10
+	defaultBootstrapResolveInterval = 10 * time.Minute
+	defaultMetricsBandwidthPeers    = 32
+	defaultTraceLogMaxSize          = 256 << 20
+	defaultTraceLogMaxAge           = 24 * time.Hour
+	defaultTraceLogMaxBackups       = 5
+	defaultTraceLogSampleRate       = 1
+	defaultDeadLetterMaxSize        = 64 << 10
+	defaultDirectChannelDialTimeout = 10 * time.Second
+	defaultDirectChannelDialRetries = 2
+	defaultDirectChannelDialBackoff = 250 * time.Millisecond
+)
+
+// How long a peer connected to by a peer connector must stay connected to be
+// credited to its source.
+const peerSourceRetentionWindow = 10 * time.Minute
+
+// Peers and protocols without traffic for bandwidthIdleTimeout are dropped
+// from the bandwidth accounting every bandwidthTrimPeriod.
+const (
+	bandwidthTrimPeriod  = 10 * time.Minute
+	bandwidthIdleTimeout = time.Hour
 )
 
 type BlossomSub struct {
 	ps          *blossomsub.PubSub
+	rt          *blossomsub.BlossomSubRouter
 	ctx         context.Context
 	logger      *zap.Logger
 	peerID      peer.ID
@@ -72,14 +115,174 @@ type BlossomSub struct {
 	signKey     crypto.PrivKey
 	peerScore   map[string]int64
 	peerScoreMx sync.Mutex
-	network     uint8
-	bootstrap   internal.PeerConnector
-	discovery   internal.PeerConnector
+	// The store the peer scores are saved to, and the stop of their periodic
+	// saving, set by PersistPeerScores.
+	peerScoreStore    store.PeerScoreStore
+	peerScoreStop     func()
+	peerScoreHalfLife time.Duration
+	network           uint8
+	clock             clock.Clock
+	bootstrap         internal.PeerConnector
+	discovery         internal.PeerConnector
+	plugins           []registeredPlugin
+	pluginsMx         sync.RWMutex
+	// Publish authorizers by bitmask.
+	publishAuth   map[string]PublishAuthorizer
+	publishAuthMx sync.RWMutex
+	// Consecutive handler panics after which delivery to the handler pauses
+	// for the cooldown. Zero or less only recovers panics.
+	handlerPanicThreshold  int
+	handlerBreakerCooldown time.Duration
+	// Direct channel purposes this node serves, nil serves all of them.
+	directChannelPurposes map[string]struct{}
+	addressQuality        *internal.AddressQuality
+	// Peers whose direct channels are accepted, nil accepts every peer, and
+	// whether provers are accepted as well, as decided by the authorizer.
+	directChannelAllowlist   map[peer.ID]struct{}
+	directChannelProversOnly bool
+	directChannelAuthorizer  atomic.Pointer[func(peerId []byte) bool]
+	// Set when hole punching is enabled.
+	holePunchTracer *internal.HolePunchTracer
+	// Which peer sources yield peers that stay connected.
+	peerSources *internal.PeerSourceStats
+	// Rounds forced by the operator, run whatever the peer count.
+	forcedBootstrap internal.PeerConnector
+	forcedDiscovery internal.PeerConnector
+	// Peers reloaded from the config by ReloadPeers.
+	bootstrapSource *internal.ResolvingPeerSource
+	directAllowlist *internal.AllowlistSync
+	reloadMx        sync.Mutex
+	// Peers exempt from graylisting until the given time.
+	graylistOverrides map[peer.ID]time.Time
+	graylistMx        sync.Mutex
+	// Listeners of the registered channel handlers by protocol suffix.
+	channelListeners   map[string]net.Listener
+	channelListenersMx sync.Mutex
+	// Subscriptions made with Subscribe by bitmask. Also guards bitmaskMap.
+	subscriptions   map[string][]*subscription
+	subscriptionsMx sync.Mutex
+	// Maximum message sizes by bitmask prefix.
+	messageSizes messageSizeLimits
+	private      privateBitmasks
+	// Peers banned by the operator.
+	bans *internal.BanGater
+	// Set when gossip limits are configured.
+	gossipLimiter      *internal.GossipRateLimiter
+	gossipLimitPenalty int64
+	// Set when the host maps ports on a NAT device.
+	natManager   basichost.NATManager
+	reachability atomic.Int32
+	// Unix milliseconds of the last reachability change.
+	reachabilityChanged atomic.Int64
+	// Reports the addresses of the host observed by its peers.
+	idService identify.IDService
+	// Traffic of the host by peer and protocol.
+	bandwidth *internal.Bandwidth
+	// Messages rejected by validation, nil unless capture is enabled.
+	deadLetters *internal.DeadLetters
+	// Timeout of each attempt at opening a direct channel, zero or less for
+	// none, and the retries after the first attempt, backing off from the
+	// given delay. Retries default to defaultDirectChannelDialRetries when
+	// unset, zero or less disables them.
+	directChannelDialTimeout time.Duration
+	directChannelDialRetries int
+	directChannelDialBackoff time.Duration
+	// Prover status and shard filters sent to peers with the node metadata.
+	peerMetadata peerMetadataState
+	// Score of the peers from the pings of the peer monitor.
+	peerHealth *internal.PeerHealth
+	// Trace log of the router events, unavailable to streamers.
+	traceLog traceLogState
 }
 
 var _ PubSub = (*BlossomSub)(nil)
 var ErrNoPeersAvailable = errors.New("no peers available")
 
+// ErrDirectChannelPurposeDisabled is returned when starting a direct channel
+// listener for a purpose the node is configured not to serve.
+var ErrDirectChannelPurposeDisabled = errors.New(
+	"direct channel purpose disabled",
+)
+
+// ErrPeerOffline is returned when opening a direct channel to a peer that
+// cannot be connected to.
+var ErrPeerOffline = errors.New("peer offline")
+
+// ErrProtocolNotSupported is returned when opening a direct channel to a peer
+// that is connected but does not serve the channel.
+var ErrProtocolNotSupported = errors.New("protocol not supported")
+
+// ErrInvalidP2PConfig is wrapped by construction failures caused by the p2p
+// config, which retrying with the same config will not fix.
+var ErrInvalidP2PConfig = errors.New("invalid p2p config")
+
+// ErrBackpressure is returned by PublishContext when the router's send queue
+// is full, so that the caller can retry or shed load.
+var ErrBackpressure = errors.New("publish backpressure")
+
+// ErrP2PUnavailable is wrapped by construction failures of the network stack,
+// which may succeed when retried.
+var ErrP2PUnavailable = errors.New("p2p unavailable")
+
+// classify wraps err in class, keeping both in the chain for errors.Is.
+func classify(class error, err error) error {
+	return fmt.Errorf("%w: %w", class, err)
+}
+
+func invalidP2PConfig(err error, context string) error {
+	return errors.Wrap(classify(ErrInvalidP2PConfig, err), context)
+}
+
+func p2pUnavailable(err error, context string) error {
+	return errors.Wrap(classify(ErrP2PUnavailable, err), context)
+}
+
+// Direct channel purposes as named in P2PConfig.DirectChannelPurposes. The
+// per proving key public channels are all configured as
+// DirectChannelPurposeProvingKey, and the channels of RegisterChannelHandler as
+// DirectChannelPurposeChannel.
+const (
+	DirectChannelPurposeSync       = "sync"
+	DirectChannelPurposeWorker     = "worker"
+	DirectChannelPurposeProvingKey = "proving-key"
+	DirectChannelPurposeChannel    = "channel"
+)
+
+func directChannelPurposeClass(purpose string) string {
+	switch purpose {
+	case DirectChannelPurposeSync, DirectChannelPurposeWorker:
+		return purpose
+	default:
+		return DirectChannelPurposeProvingKey
+	}
+}
+
+func directChannelPurposeAllowlist(
+	logger *zap.Logger,
+	purposes []string,
+) map[string]struct{} {
+	if len(purposes) == 0 {
+		return nil
+	}
+
+	allowed := make(map[string]struct{}, len(purposes))
+	for _, purpose := range purposes {
+		switch purpose {
+		case DirectChannelPurposeSync,
+			DirectChannelPurposeWorker,
+			DirectChannelPurposeProvingKey,
+			DirectChannelPurposeChannel:
+			allowed[purpose] = struct{}{}
+		default:
+			logger.Warn(
+				"ignoring unknown direct channel purpose",
+				zap.String("purpose", purpose),
+			)
+		}
+	}
+	return allowed
+}
+
 var BITMASK_ALL = []byte{
 	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
 	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
@@ -89,30 +292,30 @@ var BITMASK_ALL = []byte{
 
 var ANNOUNCE_PREFIX = "quilibrium-2.0.2-dusk-"
 
-func getPeerID(p2pConfig *config.P2PConfig) peer.ID {
-	peerPrivKey, err := hex.DecodeString(p2pConfig.PeerPrivKey)
-	if err != nil {
-		panic(errors.Wrap(err, "error unmarshaling peerkey"))
-	}
+// privateUserAgent is sent to peers by identify in place of the default user
+// agent when P2PConfig.AdvertisementPrivacy is set.
+const privateUserAgent = "quilibrium"
 
-	privKey, err := crypto.UnmarshalEd448PrivateKey(peerPrivKey)
+func getPeerID(p2pConfig *config.P2PConfig) (peer.ID, error) {
+	identity, err := DeriveIdentity(p2pConfig, nil, "")
 	if err != nil {
-		panic(errors.Wrap(err, "error unmarshaling peerkey"))
+		return "", err
 	}
 
-	pub := privKey.GetPublic()
-	id, err := peer.IDFromPublicKey(pub)
-	if err != nil {
-		panic(errors.Wrap(err, "error getting peer id"))
-	}
-
-	return id
+	return identity.PeerID, nil
 }
 
+// NewBlossomSubStreamer creates a host that only joins the DHT, without
+// pubsub. A nil clk uses the wall clock. Errors wrap ErrInvalidP2PConfig or
+// ErrP2PUnavailable.
 func NewBlossomSubStreamer(
 	p2pConfig *config.P2PConfig,
 	logger *zap.Logger,
-) *BlossomSub {
+	clk clock.Clock,
+) (*BlossomSub, error) {
+	if clk == nil {
+		clk = clock.NewRealClock()
+	}
 	ctx := context.Background()
 
 	opts := []libp2pconfig.Option{
@@ -123,7 +326,7 @@ func NewBlossomSubStreamer(
 
 	peerinfo, err := peer.AddrInfoFromString("/ip4/185.209.178.191/udp/8336/quic-v1/p2p/QmcKQjpQmLpbDsiif2MuakhHFyxWvqYauPsJDaXnLav7PJ")
 	if err != nil {
-		panic(err)
+		return nil, invalidP2PConfig(err, "new blossomsub streamer")
 	}
 
 	bootstrappers = append(bootstrappers, *peerinfo)
@@ -132,12 +335,12 @@ func NewBlossomSubStreamer(
 	if p2pConfig.PeerPrivKey != "" {
 		peerPrivKey, err := hex.DecodeString(p2pConfig.PeerPrivKey)
 		if err != nil {
-			panic(errors.Wrap(err, "error unmarshaling peerkey"))
+			return nil, invalidP2PConfig(err, "new blossomsub streamer")
 		}
 
 		privKey, err = crypto.UnmarshalEd448PrivateKey(peerPrivKey)
 		if err != nil {
-			panic(errors.Wrap(err, "error unmarshaling peerkey"))
+			return nil, invalidP2PConfig(err, "new blossomsub streamer")
 		}
 
 		opts = append(opts, libp2p.Identity(privKey))
@@ -150,53 +353,74 @@ func NewBlossomSubStreamer(
 		signKey:    privKey,
 		peerScore:  make(map[string]int64),
 		network:    p2pConfig.Network,
+		clock:      clk,
 	}
 
 	h, err := libp2p.New(opts...)
 	if err != nil {
-		panic(errors.Wrap(err, "error constructing p2p"))
+		return nil, p2pUnavailable(err, "new blossomsub streamer")
 	}
 
 	logger.Info("established peer id", zap.String("peer_id", h.ID().String()))
 
-	_ = initDHT(
+	if _, err := initDHT(
 		ctx,
 		logger,
 		h,
 		false,
 		bootstrappers,
 		p2pConfig.Network,
-	)
+	); err != nil {
+		h.Close()
+		return nil, p2pUnavailable(err, "new blossomsub streamer")
+	}
 
 	peerID := h.ID()
 	bs.peerID = peerID
 	bs.h = h
 	bs.signKey = privKey
 
-	return bs
+	return bs, nil
 }
 
+// NewBlossomSub creates the node's pubsub host, joining the DHT and
+// connecting to bootstrap and discovered peers. A nil clk uses the wall
+// clock. Errors wrap ErrInvalidP2PConfig or ErrP2PUnavailable.
 func NewBlossomSub(
 	p2pConfig *config.P2PConfig,
 	logger *zap.Logger,
-) *BlossomSub {
-	ctx := context.Background()
+	clk clock.Clock,
+) (*BlossomSub, error) {
+	startup.Set(logger, startup.PhaseBootstrappingP2P)
+	if clk == nil {
+		clk = clock.NewRealClock()
+	}
+	// Everything started on ctx is stopped if construction fails.
+	ctx, cancel := context.WithCancel(context.Background())
+	built := false
+	defer func() {
+		if !built {
+			cancel()
+		}
+	}()
 	p2pConfig = withDefaults(p2pConfig)
 
 	opts := []libp2pconfig.Option{
 		libp2p.ListenAddrStrings(p2pConfig.ListenMultiaddr),
 		libp2p.EnableNATService(),
-		libp2p.NATPortMap(),
 	}
 
 	isBootstrapPeer := false
-	peerId := getPeerID(p2pConfig)
+	peerId, err := getPeerID(p2pConfig)
+	if err != nil {
+		return nil, invalidP2PConfig(err, "new blossomsub")
+	}
 
 	if p2pConfig.Network == 0 {
 		for _, peerAddr := range config.BootstrapPeers {
 			peerinfo, err := peer.AddrInfoFromString(peerAddr)
 			if err != nil {
-				panic(err)
+				return nil, invalidP2PConfig(err, "new blossomsub")
 			}
 
 			if bytes.Equal([]byte(peerinfo.ID), []byte(peerId)) {
@@ -208,7 +432,7 @@ func NewBlossomSub(
 		for _, peerAddr := range p2pConfig.BootstrapPeers {
 			peerinfo, err := peer.AddrInfoFromString(peerAddr)
 			if err != nil {
-				panic(err)
+				return nil, invalidP2PConfig(err, "new blossomsub")
 			}
 
 			if bytes.Equal([]byte(peerinfo.ID), []byte(peerId)) {
@@ -218,33 +442,43 @@ func NewBlossomSub(
 		}
 	}
 
-	defaultBootstrapPeers := append([]string{}, p2pConfig.BootstrapPeers...)
-
-	if p2pConfig.Network == 0 {
-		defaultBootstrapPeers = config.BootstrapPeers
+	bootstrappers, err := bootstrapPeers(p2pConfig)
+	if err != nil {
+		return nil, invalidP2PConfig(err, "new blossomsub")
 	}
 
-	bootstrappers := []peer.AddrInfo{}
-
-	for _, peerAddr := range defaultBootstrapPeers {
-		peerinfo, err := peer.AddrInfoFromString(peerAddr)
+	if p2pConfig.NetworkPSK != "" {
+		pnetOpts, err := privateNetworkOptions(p2pConfig, bootstrappers)
 		if err != nil {
-			panic(err)
+			return nil, invalidP2PConfig(err, "new blossomsub")
 		}
+		opts = append(opts, pnetOpts...)
+	}
 
-		bootstrappers = append(bootstrappers, *peerinfo)
+	if p2pConfig.ProxyAddr != "" {
+		proxyOpts, err := proxyOptions(logger, p2pConfig, bootstrappers)
+		if err != nil {
+			return nil, invalidP2PConfig(err, "new blossomsub")
+		}
+		opts = append(opts, proxyOpts...)
+	} else {
+		transportOpts, err := transportOptions(p2pConfig)
+		if err != nil {
+			return nil, invalidP2PConfig(err, "new blossomsub")
+		}
+		opts = append(opts, transportOpts...)
 	}
 
 	var privKey crypto.PrivKey
 	if p2pConfig.PeerPrivKey != "" {
 		peerPrivKey, err := hex.DecodeString(p2pConfig.PeerPrivKey)
 		if err != nil {
-			panic(errors.Wrap(err, "error unmarshaling peerkey"))
+			return nil, invalidP2PConfig(err, "new blossomsub")
 		}
 
 		privKey, err = crypto.UnmarshalEd448PrivateKey(peerPrivKey)
 		if err != nil {
-			panic(errors.Wrap(err, "error unmarshaling peerkey"))
+			return nil, invalidP2PConfig(err, "new blossomsub")
 		}
 
 		opts = append(opts, libp2p.Identity(privKey))
@@ -252,20 +486,59 @@ func NewBlossomSub(
 
 	allowedPeers := []peer.AddrInfo{}
 	allowedPeers = append(allowedPeers, bootstrappers...)
+	bootstrapAllowlist := resolvePeerAddrs(bootstrappers)
 
-	directPeers := []peer.AddrInfo{}
-	if len(p2pConfig.DirectPeers) > 0 {
+	directPeers, err := parsePeerAddrs(p2pConfig.DirectPeers)
+	if err != nil {
+		return nil, invalidP2PConfig(err, "new blossomsub")
+	}
+	if len(directPeers) > 0 {
 		logger.Info("found direct peers in config")
-		for _, peerAddr := range p2pConfig.DirectPeers {
-			peerinfo, err := peer.AddrInfoFromString(peerAddr)
-			if err != nil {
-				panic(err)
-			}
+		for _, peerinfo := range directPeers {
 			logger.Info("adding direct peer", zap.String("peer", peerinfo.ID.String()))
-			directPeers = append(directPeers, *peerinfo)
 		}
 	}
 	allowedPeers = append(allowedPeers, directPeers...)
+	directAllowlist := resolvePeerAddrs(directPeers)
+
+	bans := internal.NewBanGater(clk)
+	gaters := internal.ConnectionGaters{bans}
+	// Churn gating is opt-in: a peer or subnet limit enables gating on that
+	// dimension, and the gater is only installed if one of them is set.
+	if p2pConfig.ChurnPeerLimit > 0 || p2pConfig.ChurnSubnetLimit > 0 {
+		exempt := make([]peer.ID, 0, len(allowedPeers))
+		for _, p := range allowedPeers {
+			exempt = append(exempt, p.ID)
+		}
+		gaters = append(gaters, internal.NewChurnGater(
+			logger,
+			clk,
+			p2pConfig.ChurnWindow,
+			p2pConfig.ChurnBackoff,
+			p2pConfig.ChurnPeerLimit,
+			p2pConfig.ChurnSubnetLimit,
+			exempt,
+		))
+	}
+	if len(p2pConfig.AllowCIDRs) > 0 || len(p2pConfig.DenyCIDRs) > 0 {
+		cidrGater, err := internal.NewCIDRGater(
+			p2pConfig.AllowCIDRs,
+			p2pConfig.DenyCIDRs,
+		)
+		if err != nil {
+			return nil, invalidP2PConfig(err, "new blossomsub")
+		}
+		gaters = append(gaters, cidrGater)
+	}
+	opts = append(opts, libp2p.ConnectionGater(gaters))
+
+	addressQuality := internal.NewAddressQuality(
+		clk,
+		p2pConfig.AddressFailureLimit,
+	)
+	swarmOpts := []swarm.Option{
+		swarm.WithDialRanker(addressQuality.DialRanker(swarm.DefaultDialRanker)),
+	}
 
 	if p2pConfig.LowWatermarkConnections != -1 &&
 		p2pConfig.HighWatermarkConnections != -1 {
@@ -275,48 +548,182 @@ func NewBlossomSub(
 			connmgr.WithEmergencyTrim(true),
 		)
 		if err != nil {
-			panic(err)
+			return nil, invalidP2PConfig(err, "new blossomsub")
 		}
 
 		rm, err := resourceManager(
 			p2pConfig.HighWatermarkConnections,
-			allowedPeers,
+			p2pConfig.ResourceLimits,
+			append(slices.Clone(bootstrapAllowlist), directAllowlist...),
 		)
 		if err != nil {
-			panic(err)
+			return nil, invalidP2PConfig(err, "new blossomsub")
 		}
 
-		opts = append(
-			opts,
-			libp2p.SwarmOpts(
-				swarm.WithIPv6BlackHoleConfig(false, 0, 0),
-				swarm.WithUDPBlackHoleConfig(false, 0, 0),
-			),
+		swarmOpts = append(
+			swarmOpts,
+			swarm.WithIPv6BlackHoleConfig(false, 0, 0),
+			swarm.WithUDPBlackHoleConfig(false, 0, 0),
 		)
 		opts = append(opts, libp2p.ConnectionManager(cm))
 		opts = append(opts, libp2p.ResourceManager(rm))
 	}
+	opts = append(opts, libp2p.SwarmOpts(swarmOpts...))
+
+	var addrsFactory basichost.AddrsFactory
+	var announceRotation *internal.AnnounceRotation
+	if len(p2pConfig.AnnounceMultiaddrs) > 0 {
+		announceRotation, err = internal.NewAnnounceRotation(
+			p2pConfig.AnnounceMultiaddrs,
+		)
+		if err != nil {
+			return nil, invalidP2PConfig(err, "new blossomsub")
+		}
+		addrsFactory = announceRotation.AddrsFactory
+	}
+	if len(p2pConfig.NoAnnounceMultiaddrs) > 0 ||
+		p2pConfig.AdvertisementPrivacy {
+		noAnnounce, err := internal.NewNoAnnounce(
+			p2pConfig.NoAnnounceMultiaddrs,
+			p2pConfig.AdvertisementPrivacy,
+		)
+		if err != nil {
+			return nil, invalidP2PConfig(err, "new blossomsub")
+		}
+		addrsFactory = noAnnounce.AddrsFactory(addrsFactory)
+	}
+	if addrsFactory != nil {
+		opts = append(opts, libp2p.AddrsFactory(addrsFactory))
+	}
+	if p2pConfig.AdvertisementPrivacy {
+		// The default user agent names the build of the node.
+		opts = append(opts, libp2p.UserAgent(privateUserAgent))
+	}
+
+	var holePunchTracer *internal.HolePunchTracer
+	if p2pConfig.EnableHolePunching {
+		holePunchTracer = &internal.HolePunchTracer{}
+		opts = append(opts, libp2p.EnableHolePunching(
+			holepunch.WithTracer(holePunchTracer),
+		))
+	}
+
+	messageSizes, err := newMessageSizeLimits(p2pConfig)
+	if err != nil {
+		return nil, invalidP2PConfig(err, "new blossomsub")
+	}
+
+	private, err := newPrivateBitmasks(p2pConfig)
+	if err != nil {
+		return nil, invalidP2PConfig(err, "new blossomsub")
+	}
 
 	bs := &BlossomSub{
-		ctx:        ctx,
-		logger:     logger,
-		bitmaskMap: make(map[string]*blossomsub.Bitmask),
-		signKey:    privKey,
-		peerScore:  make(map[string]int64),
-		network:    p2pConfig.Network,
+		ctx:               ctx,
+		logger:            logger,
+		bitmaskMap:        make(map[string]*blossomsub.Bitmask),
+		signKey:           privKey,
+		peerScore:         make(map[string]int64),
+		peerScoreHalfLife: p2pConfig.PeerScoreHalfLife,
+		network:           p2pConfig.Network,
+		clock:             clk,
+		messageSizes:      messageSizes,
+		private:           private,
+		bans:              bans,
+
+		handlerPanicThreshold:  p2pConfig.HandlerPanicThreshold,
+		handlerBreakerCooldown: p2pConfig.HandlerBreakerCooldown,
+		directChannelPurposes: directChannelPurposeAllowlist(
+			logger,
+			p2pConfig.DirectChannelPurposes,
+		),
+		holePunchTracer:          holePunchTracer,
+		directChannelDialTimeout: p2pConfig.DirectChannelDialTimeout,
+		directChannelDialRetries: max(*p2pConfig.DirectChannelDialRetries, 0),
+		directChannelDialBackoff: p2pConfig.DirectChannelDialBackoff,
+	}
+	if p2pConfig.DeadLetterCapacity > 0 {
+		bs.deadLetters = internal.NewDeadLetters(
+			p2pConfig.DeadLetterCapacity,
+			p2pConfig.DeadLetterMaxSize,
+		)
+	}
+	if len(p2pConfig.DirectChannelAllowlist) > 0 ||
+		p2pConfig.DirectChannelProversOnly {
+		bs.directChannelAllowlist = map[peer.ID]struct{}{}
+		for _, s := range p2pConfig.DirectChannelAllowlist {
+			id, err := peer.Decode(s)
+			if err != nil {
+				return nil, invalidP2PConfig(err, "new blossomsub")
+			}
+			bs.directChannelAllowlist[id] = struct{}{}
+		}
+		bs.directChannelProversOnly = p2pConfig.DirectChannelProversOnly
+	}
+
+	var ps peerstore.Peerstore
+	if p2pConfig.PeerstorePath != "" {
+		pps, err := openPeerstore(ctx, logger, p2pConfig)
+		if err != nil {
+			return nil, p2pUnavailable(err, "new blossomsub")
+		}
+		ps = pps
+		opts = append(opts, libp2p.Peerstore(ps))
 	}
 
+	bs.bandwidth = internal.NewBandwidth(p2pConfig.MetricsBandwidthPeers)
+	opts = append(opts, libp2p.BandwidthReporter(bs.bandwidth))
+	opts = append(opts, libp2p.NATManager(bs.newNATManager))
 	h, err := libp2p.New(opts...)
 	if err != nil {
-		panic(errors.Wrap(err, "error constructing p2p"))
+		// Once created, the host owns and closes the peerstore.
+		if ps != nil {
+			ps.Close()
+		}
+		return nil, p2pUnavailable(err, "new blossomsub")
+	}
+	// Release the host, its listeners and the DHT if construction fails past
+	// here.
+	var kademliaDHT *dht.IpfsDHT
+	fail := func(err error) (*BlossomSub, error) {
+		if kademliaDHT != nil {
+			kademliaDHT.Close()
+		}
+		h.Close()
+		return nil, err
 	}
 	idService := internal.IDServiceFromHost(h)
+	if announceRotation != nil {
+		go announceRotation.Run(
+			ctx,
+			logger.Named("announce-rotation"),
+			h,
+			bs.clock,
+			p2pConfig.AnnounceRotationPeriod,
+		)
+	}
+	addressQuality.Attach(h)
+	bs.addressQuality = addressQuality
+
+	internal.ObserveBandwidth(bs.bandwidth)
+	go bs.bandwidth.Run(
+		ctx,
+		bs.clock,
+		bandwidthTrimPeriod,
+		bandwidthIdleTimeout,
+	)
+
+	go internal.NewDirectPeerPins(
+		logger.Named("direct-peer-pins"),
+		h,
+		directPeers,
+	).Run(ctx, bs.clock, p2pConfig.DirectPeerCheckPeriod)
 
 	logger.Info("established peer id", zap.String("peer_id", h.ID().String()))
 
 	reachabilitySub, err := h.EventBus().Subscribe(&event.EvtLocalReachabilityChanged{}, eventbus.Name("blossomsub"))
 	if err != nil {
-		panic(err)
+		return fail(p2pUnavailable(err, "new blossomsub"))
 	}
 	go func() {
 		defer reachabilitySub.Close()
@@ -329,7 +736,10 @@ func NewBlossomSub(
 				if !ok {
 					return
 				}
-				switch state := evt.(event.EvtLocalReachabilityChanged).Reachability; state {
+				state := evt.(event.EvtLocalReachabilityChanged).Reachability
+				bs.reachability.Store(int32(state))
+				bs.reachabilityChanged.Store(bs.clock.Now().UnixMilli())
+				switch state {
 				case network.ReachabilityPublic:
 					logger.Info("node is externally reachable")
 				case network.ReachabilityPrivate:
@@ -343,7 +753,7 @@ func NewBlossomSub(
 		}
 	}()
 
-	kademliaDHT := initDHT(
+	kademliaDHT, err = initDHT(
 		ctx,
 		logger,
 		h,
@@ -351,30 +761,84 @@ func NewBlossomSub(
 		bootstrappers,
 		p2pConfig.Network,
 	)
+	if err != nil {
+		return fail(p2pUnavailable(err, "new blossomsub"))
+	}
+	bs.idService = idService
 	h = routedhost.Wrap(h, kademliaDHT)
 
 	routingDiscovery := routing.NewRoutingDiscovery(kademliaDHT)
 	util.Advertise(ctx, routingDiscovery, getNetworkNamespace(p2pConfig.Network))
 
+	if err := internal.MonitorPublicAddresses(
+		ctx,
+		logger.Named("address-monitor"),
+		h,
+		func(ctx context.Context) {
+			kademliaDHT.RefreshRoutingTable()
+			if _, err := routingDiscovery.Advertise(
+				ctx,
+				getNetworkNamespace(p2pConfig.Network),
+			); err != nil {
+				logger.Warn("could not re-advertise", zap.Error(err))
+			}
+		},
+	); err != nil {
+		return fail(p2pUnavailable(err, "new blossomsub"))
+	}
+
+	bs.peerSources = internal.NewPeerSourceStats(
+		bs.clock,
+		peerSourceRetentionWindow,
+	)
+	bs.peerSources.Listen(h.Network())
+
+	bootstrapSource := internal.NewResolvingPeerSource(
+		madns.DefaultResolver,
+		bootstrappers,
+		true,
+	)
+	if err := bootstrapSource.Resolve(ctx); err != nil {
+		logger.Warn("could not resolve bootstrap peers", zap.Error(err))
+	}
+	go bootstrapSource.Run(
+		ctx,
+		logger.Named("bootstrap-resolver"),
+		bs.clock,
+		rcmgr.GetAllowlist(h.Network().ResourceManager()),
+		bootstrapAllowlist,
+		p2pConfig.BootstrapResolveInterval,
+	)
+	bs.bootstrapSource = bootstrapSource
+	bs.directAllowlist = internal.NewAllowlistSync(
+		logger.Named("direct-peers"),
+		rcmgr.GetAllowlist(h.Network().ResourceManager()),
+		directAllowlist,
+	)
+
 	minBootstrapPeers := min(len(bootstrappers), p2pConfig.MinBootstrapPeers)
 	bootstrap := internal.NewPeerConnector(
 		ctx,
 		logger.Named("bootstrap"),
 		h,
 		idService,
+		addressQuality,
 		minBootstrapPeers,
 		p2pConfig.BootstrapParallelism,
-		internal.NewStaticPeerSource(bootstrappers, true),
+		bootstrapSource,
+		"bootstrap",
+		bs.peerSources,
 	)
-	if err := bootstrap.Connect(ctx); err != nil {
-		panic(err)
+	if _, err := bootstrap.Connect(ctx); err != nil {
+		return fail(p2pUnavailable(err, "new blossomsub"))
 	}
+	bs.forcedBootstrap = bootstrap
 	bootstrap = internal.NewConditionalPeerConnector(
 		ctx,
 		internal.NewNotEnoughPeersCondition(
 			h,
 			minBootstrapPeers,
-			internal.PeerAddrInfosToPeerIDMap(bootstrappers),
+			bootstrapSource.PeerIDs,
 		),
 		bootstrap,
 	)
@@ -385,6 +849,7 @@ func NewBlossomSub(
 		logger.Named("discovery"),
 		h,
 		idService,
+		addressQuality,
 		p2pConfig.D,
 		p2pConfig.DiscoveryParallelism,
 		internal.NewRoutingDiscoveryPeerSource(
@@ -392,34 +857,40 @@ func NewBlossomSub(
 			getNetworkNamespace(p2pConfig.Network),
 			p2pConfig.DiscoveryPeerLookupLimit,
 		),
+		"dht",
+		bs.peerSources,
 	)
-	if err := discovery.Connect(ctx); err != nil {
-		panic(err)
+	if _, err := discovery.Connect(ctx); err != nil {
+		return fail(p2pUnavailable(err, "new blossomsub"))
 	}
-	discovery = internal.NewChainedPeerConnector(ctx, bootstrap, discovery)
+	bs.forcedDiscovery = discovery
+	discovery = internal.NewChainedPeerConnector(
+		ctx,
+		bs.peerSources,
+		internal.SourcedPeerConnector{Source: "bootstrap", Connector: bootstrap},
+		internal.SourcedPeerConnector{Source: "dht", Connector: discovery},
+	)
 	bs.discovery = discovery
 
+	bs.peerHealth = internal.NewPeerHealth(bs.clock)
 	internal.MonitorPeers(
 		ctx,
 		logger.Named("peer-monitor"),
+		bs.clock,
 		h,
 		p2pConfig.PingTimeout,
 		p2pConfig.PingPeriod,
 		p2pConfig.PingAttempts,
+		bs.peerHealth,
 	)
 
-	// TODO: turn into an option flag for console logging, this is too noisy for
-	// default logging behavior
-	var tracer *blossomsub.JSONTracer
-	if p2pConfig.TraceLogFile == "" {
-		// tracer, err = blossomsub.NewStdoutJSONTracer()
-		// if err != nil {
-		// 	panic(errors.Wrap(err, "error building stdout tracer"))
-		// }
-	} else {
-		tracer, err = blossomsub.NewJSONTracer(p2pConfig.TraceLogFile)
-		if err != nil {
-			panic(errors.Wrap(err, "error building file tracer"))
+	bs.traceLog = newTraceLogState(p2pConfig)
+	if p2pConfig.TraceLogFile != "" {
+		if _, err := bs.startTraceLog(
+			p2pConfig.TraceLogFile,
+			&protobufs.StartTraceLogRequest{},
+		); err != nil {
+			return fail(invalidP2PConfig(err, "new blossomsub"))
 		}
 	}
 
@@ -431,12 +902,20 @@ func NewBlossomSub(
 		blossomOpts = append(blossomOpts, blossomsub.WithDirectPeers(directPeers))
 	}
 
-	if tracer != nil {
-		blossomOpts = append(blossomOpts, blossomsub.WithEventTracer(tracer))
+	// The tracer is always set, so that the trace log can be started without
+	// a restart.
+	blossomOpts = append(
+		blossomOpts,
+		blossomsub.WithEventTracer(bs.traceLog.tracer),
+	)
+	bitmaskScoreParams, err := newBitmaskScoreParams(p2pConfig)
+	if err != nil {
+		return fail(invalidP2PConfig(err, "new blossomsub"))
 	}
 	blossomOpts = append(blossomOpts, blossomsub.WithPeerScore(
 		&blossomsub.PeerScoreParams{
 			SkipAtomicValidation:        false,
+			Bitmasks:                    bitmaskScoreParams,
 			BitmaskScoreCap:             0,
 			IPColocationFactorWeight:    0,
 			IPColocationFactorThreshold: 6,
@@ -446,10 +925,8 @@ func NewBlossomSub(
 			DecayInterval:               10 * time.Second,
 			DecayToZero:                 .1,
 			RetainScore:                 60 * time.Minute,
-			AppSpecificScore: func(p peer.ID) float64 {
-				return float64(bs.GetPeerScore([]byte(p)))
-			},
-			AppSpecificWeight: 10.0,
+			AppSpecificScore:            bs.appSpecificScore,
+			AppSpecificWeight:           10.0,
 		},
 		&blossomsub.PeerScoreThresholds{
 			SkipAtomicValidation:        false,
@@ -462,8 +939,20 @@ func NewBlossomSub(
 	))
 	blossomOpts = append(blossomOpts,
 		blossomsub.WithValidateQueueSize(p2pConfig.ValidateQueueSize),
+		blossomsub.WithAdaptiveValidateQueue(
+			p2pConfig.ValidateQueueMinSize,
+			p2pConfig.ValidateQueueMaxSize,
+		),
 		blossomsub.WithValidateWorkers(p2pConfig.ValidateWorkers),
 	)
+	// Signatures are verified on the validate workers unless a separate stage
+	// is configured.
+	if p2pConfig.SignatureVerifyWorkers > 0 {
+		blossomOpts = append(blossomOpts, blossomsub.WithSignatureVerifyWorkers(
+			p2pConfig.SignatureVerifyWorkers,
+		))
+	}
+	observability.SetBitmaskLabelPrefix(p2pConfig.MetricsBitmaskPrefix)
 	blossomOpts = append(blossomOpts, observability.WithPrometheusRawTracer())
 	blossomOpts = append(blossomOpts, blossomsub.WithPeerFilter(internal.NewStaticPeerFilter(
 		// We filter out the bootstrap peers explicitly from BlossomSub
@@ -478,27 +967,49 @@ func NewBlossomSub(
 	blossomOpts = append(blossomOpts, blossomsub.WithDiscovery(
 		internal.NewPeerConnectorDiscovery(discovery),
 	))
+	bs.gossipLimiter, err = newGossipRateLimiter(bs.clock, p2pConfig)
+	if err != nil {
+		return fail(invalidP2PConfig(err, "new blossomsub"))
+	}
+	bs.gossipLimitPenalty = p2pConfig.GossipLimitPenalty
+	if bs.gossipLimiter != nil {
+		blossomOpts = append(blossomOpts, blossomsub.WithAppSpecificRpcInspector(
+			bs.inspectGossipRate(bs.gossipLimiter),
+		))
+	}
 
 	params := toBlossomSubParams(p2pConfig)
 	rt := blossomsub.NewBlossomSubRouter(h, params, bs.network)
 	blossomOpts = append(blossomOpts, rt.WithDefaultTagTracer())
 	pubsub, err := blossomsub.NewBlossomSubWithRouter(ctx, h, rt, blossomOpts...)
 	if err != nil {
-		panic(err)
+		return fail(p2pUnavailable(err, "new blossomsub"))
 	}
 
+	observability.ObserveValidateQueueLimit(pubsub.ValidateQueueLimit)
+
 	peerID := h.ID()
 	bs.ps = pubsub
+	bs.rt = rt
 	bs.peerID = peerID
 	bs.h = h
 	bs.signKey = privKey
 
-	return bs
+	if err := bs.startPeerMetadataExchange(ctx); err != nil {
+		return fail(p2pUnavailable(err, "new blossomsub"))
+	}
+
+	built = true
+	return bs, nil
 }
 
 // adjusted from Lotus' reference implementation, addressing
 // https://github.com/libp2p/go-libp2p/issues/1640
-func resourceManager(highWatermark int, allowed []peer.AddrInfo) (
+func resourceManager(
+	highWatermark int,
+	limits *config.ResourceLimits,
+	allowed []ma.Multiaddr,
+) (
 	network.ResourceManager,
 	error,
 ) {
@@ -547,7 +1058,26 @@ func resourceManager(highWatermark int, allowed []peer.AddrInfo) (
 		changes.ProtocolDefault.Streams = rcmgr.LimitVal(1 << bits.Len(48*maxconns))
 	}
 
+	if limits != nil {
+		changes.System = overrideResourceLimits(changes.System, limits.System)
+		changes.Transient = overrideResourceLimits(
+			changes.Transient,
+			limits.Transient,
+		)
+		changes.ServiceDefault = overrideResourceLimits(
+			changes.ServiceDefault,
+			limits.Service,
+		)
+		changes.ProtocolDefault = overrideResourceLimits(
+			changes.ProtocolDefault,
+			limits.Protocol,
+		)
+	}
+
 	changedLimitConfig := changes.Build(defaultLimitConfig)
+	if limits != nil && limits.Unlimited {
+		changedLimitConfig = rcmgr.InfiniteLimits
+	}
 
 	limiter := rcmgr.NewFixedLimiter(changedLimitConfig)
 
@@ -564,30 +1094,176 @@ func resourceManager(highWatermark int, allowed []peer.AddrInfo) (
 		rcmgr.WithTraceReporter(str),
 	)
 
-	resolver := madns.DefaultResolver
-	var allowedMaddrs []ma.Multiaddr
-	for _, pi := range allowed {
+	opts = append(
+		opts,
+		rcmgr.WithAllowlistedMultiaddrs(allowed),
+	)
+
+	mgr, err := rcmgr.NewResourceManager(limiter, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "resource manager")
+	}
+
+	return mgr, nil
+}
+
+// overrideResourceLimits sets the limits configured for a scope over the
+// computed ones.
+func overrideResourceLimits(
+	limits rcmgr.ResourceLimits,
+	scope config.ResourceScopeLimits,
+) rcmgr.ResourceLimits {
+	override := func(limit *rcmgr.LimitVal, value int) {
+		switch {
+		case value < 0:
+			*limit = rcmgr.Unlimited
+		case value > 0:
+			*limit = rcmgr.LimitVal(value)
+		}
+	}
+	switch {
+	case scope.Memory < 0:
+		limits.Memory = rcmgr.Unlimited64
+	case scope.Memory > 0:
+		limits.Memory = rcmgr.LimitVal64(scope.Memory)
+	}
+	override(&limits.FD, scope.FD)
+	override(&limits.Conns, scope.Conns)
+	override(&limits.ConnsInbound, scope.ConnsInbound)
+	override(&limits.ConnsOutbound, scope.ConnsOutbound)
+	override(&limits.Streams, scope.Streams)
+	override(&limits.StreamsInbound, scope.StreamsInbound)
+	override(&limits.StreamsOutbound, scope.StreamsOutbound)
+	return limits
+}
+
+// bootstrapPeers returns the bootstrap peers of the network. The main network
+// always uses the built-in list.
+func bootstrapPeers(p2pConfig *config.P2PConfig) ([]peer.AddrInfo, error) {
+	if p2pConfig.Network == 0 {
+		return parsePeerAddrs(config.BootstrapPeers)
+	}
+	return parsePeerAddrs(p2pConfig.BootstrapPeers)
+}
+
+func parsePeerAddrs(addrs []string) ([]peer.AddrInfo, error) {
+	peers := make([]peer.AddrInfo, 0, len(addrs))
+	for _, addr := range addrs {
+		info, err := peer.AddrInfoFromString(addr)
+		if err != nil {
+			return nil, errors.Wrap(err, "parse peer addrs")
+		}
+		peers = append(peers, *info)
+	}
+	return peers, nil
+}
+
+// resolvePeerAddrs resolves the dns and dnsaddr multiaddrs of the peers into
+// their allowlist addresses, dropping those that fail to resolve and those
+// resolved for another peer.
+func resolvePeerAddrs(peers []peer.AddrInfo) []ma.Multiaddr {
+	resolvedPeers := make([]peer.AddrInfo, 0, len(peers))
+	for _, pi := range peers {
+		info := peer.AddrInfo{ID: pi.ID}
 		for _, addr := range pi.Addrs {
-			resolved, err := resolver.Resolve(context.Background(), addr)
+			resolved, err := madns.DefaultResolver.Resolve(
+				context.Background(),
+				addr,
+			)
 			if err != nil {
 				continue
 			}
-			allowedMaddrs = append(allowedMaddrs, resolved...)
+			for _, r := range resolved {
+				transport, id, err := peer.SplitAddr(r)
+				if err != nil || transport == nil || (id != "" && id != pi.ID) {
+					continue
+				}
+				info.Addrs = append(info.Addrs, transport)
+			}
 		}
+		resolvedPeers = append(resolvedPeers, info)
 	}
+	return internal.PeerAllowlistAddrs(resolvedPeers)
+}
 
-	opts = append(opts, rcmgr.WithAllowlistedMultiaddrs(allowedMaddrs))
+func (b *BlossomSub) PublishToBitmask(bitmask []byte, data []byte) error {
+	return b.PublishToBitmaskAs(PublishRoleNode, bitmask, data)
+}
 
-	mgr, err := rcmgr.NewResourceManager(limiter, opts...)
+// PublishToBitmaskAs publishes data to the bitmask under the given role,
+// subject to the bitmask's publish authorizer.
+func (b *BlossomSub) PublishToBitmaskAs(
+	role PublishRole,
+	bitmask []byte,
+	data []byte,
+) error {
+	if err := b.authorizePublish(role, bitmask, data); err != nil {
+		return errors.Wrap(err, "publish to bitmask")
+	}
+
+	wire, err := b.private.seal(bitmask, data)
 	if err != nil {
-		return nil, errors.Wrap(err, "resource manager")
+		return errors.Wrap(err, "publish to bitmask")
 	}
 
-	return mgr, nil
+	if err := b.checkMessageSize(bitmask, wire); err != nil {
+		return errors.Wrap(err, "publish to bitmask")
+	}
+
+	if err := b.runPublishPlugins(bitmask, data); err != nil {
+		return errors.Wrap(err, "publish to bitmask")
+	}
+
+	return b.ps.Publish(b.ctx, bitmask, wire)
 }
 
-func (b *BlossomSub) PublishToBitmask(bitmask []byte, data []byte) error {
-	return b.ps.Publish(b.ctx, bitmask, data)
+// PublishContext publishes data to the bitmask, giving up once ctx is done.
+// If the router's send queue is full it returns ErrBackpressure rather than
+// waiting for room.
+func (b *BlossomSub) PublishContext(
+	ctx context.Context,
+	bitmask []byte,
+	data []byte,
+) error {
+	return b.PublishContextAs(ctx, PublishRoleNode, bitmask, data)
+}
+
+// PublishContextAs is PublishContext under the given role, subject to the
+// bitmask's publish authorizer.
+func (b *BlossomSub) PublishContextAs(
+	ctx context.Context,
+	role PublishRole,
+	bitmask []byte,
+	data []byte,
+) error {
+	if err := b.authorizePublish(role, bitmask, data); err != nil {
+		return errors.Wrap(err, "publish context")
+	}
+
+	wire, err := b.private.seal(bitmask, data)
+	if err != nil {
+		return errors.Wrap(err, "publish context")
+	}
+
+	if err := b.checkMessageSize(bitmask, wire); err != nil {
+		return errors.Wrap(err, "publish context")
+	}
+
+	if err := b.runPublishPlugins(bitmask, data); err != nil {
+		return errors.Wrap(err, "publish context")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stop := context.AfterFunc(b.ctx, cancel)
+	defer stop()
+
+	err = b.ps.Publish(ctx, bitmask, wire, blossomsub.WithBackpressure())
+	if errors.Is(err, blossomsub.ErrBackpressure) {
+		return errors.Wrap(ErrBackpressure, "publish context")
+	}
+
+	return errors.Wrap(err, "publish context")
 }
 
 func (b *BlossomSub) Publish(address []byte, data []byte) error {
@@ -595,53 +1271,85 @@ func (b *BlossomSub) Publish(address []byte, data []byte) error {
 	return b.PublishToBitmask(bitmask, data)
 }
 
+// Subscribe joins the bitmask and passes its messages to handler until the
+// returned subscription is cancelled. If one of the constituent bits cannot be
+// subscribed to, none of them stay subscribed and the returned error wraps a
+// *SubscribeError.
 func (b *BlossomSub) Subscribe(
 	bitmask []byte,
 	handler func(message *pb.Message) error,
-) error {
+) (Subscription, error) {
 	b.logger.Info("joining broadcast")
 	bm, err := b.ps.Join(bitmask)
 	if err != nil {
 		b.logger.Error("join failed", zap.Error(err))
-		return errors.Wrap(err, "subscribe")
+		return nil, errors.Wrap(err, "subscribe")
 	}
 
 	b.logger.Info("subscribe to bitmask", zap.Binary("bitmask", bitmask))
-	subs := []*blossomsub.Subscription{}
-	for _, bit := range bm {
-		sub, err := bit.Subscribe()
-		if err != nil {
-			b.logger.Error("subscription failed", zap.Error(err))
-			return errors.Wrap(err, "subscribe")
-		}
-		_, ok := b.bitmaskMap[string(bit.Bitmask())]
-		if !ok {
-			b.bitmaskMap[string(bit.Bitmask())] = bit
-		}
-		subs = append(subs, sub)
+	subs, err := b.subscribeBits(bitmask, bm)
+	if err != nil {
+		b.logger.Error("subscription failed", zap.Error(err))
+		return nil, errors.Wrap(err, "subscribe")
 	}
 
+	ctx, cancel := context.WithCancel(b.ctx)
+	s := &subscription{
+		b:       b,
+		bitmask: slices.Clone(bitmask),
+		bits:    bm,
+		subs:    subs,
+		ctx:     ctx,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+	b.addSubscription(s)
+
 	b.logger.Info(
 		"begin streaming from bitmask",
 		zap.Binary("bitmask", bitmask),
 	)
 
+	breaker := internal.NewHandlerBreaker(
+		b.logger,
+		b.clock,
+		bitmask,
+		b.handlerPanicThreshold,
+		b.handlerBreakerCooldown,
+	)
 	for _, sub := range subs {
 		copiedBitmask := make([]byte, len(bitmask))
 		copy(copiedBitmask[:], bitmask[:])
 		sub := sub
 
+		s.readers.Add(1)
 		go func() {
+			defer s.readers.Done()
 			for {
-				m, err := sub.Next(b.ctx)
+				m, err := sub.Next(s.ctx)
 				if err != nil {
-					b.logger.Error(
-						"got error when fetching the next message",
-						zap.Error(err),
-					)
+					// Next only fails once the subscription is cancelled or the
+					// context is done, there is nothing more to read.
+					if s.ctx.Err() == nil {
+						b.logger.Error(
+							"got error when fetching the next message",
+							zap.Error(err),
+						)
+					}
+					return
 				}
 				if bytes.Equal(m.Bitmask, copiedBitmask) {
-					if err = handler(m.Message); err != nil {
+					message, err := b.private.openMessage(copiedBitmask, m)
+					if err != nil {
+						b.logger.Debug("dropping private message", zap.Error(err))
+						continue
+					}
+					if err = b.runReceivePlugins(copiedBitmask, message); err != nil {
+						continue
+					}
+					if err = breaker.Call(func() error {
+						return handler(message)
+					}); err != nil {
 						b.logger.Debug("message handler returned error", zap.Error(err))
 					}
 				}
@@ -649,12 +1357,29 @@ func (b *BlossomSub) Subscribe(
 		}()
 	}
 
-	return nil
+	go func() {
+		s.readers.Wait()
+		close(s.done)
+	}()
+
+	return s, nil
 }
 
+// Unsubscribe cancels every subscription to the bitmask and stops their
+// readers.
 func (b *BlossomSub) Unsubscribe(bitmask []byte, raw bool) {
+	subs := b.subscriptionsTo(bitmask)
+	if len(subs) != 0 {
+		for _, s := range subs {
+			s.Cancel()
+		}
+		return
+	}
+
 	networkBitmask := append([]byte{b.network}, bitmask...)
+	b.subscriptionsMx.Lock()
 	bm, ok := b.bitmaskMap[string(networkBitmask)]
+	b.subscriptionsMx.Unlock()
 	if !ok {
 		return
 	}
@@ -663,15 +1388,60 @@ func (b *BlossomSub) Unsubscribe(bitmask []byte, raw bool) {
 }
 
 func (b *BlossomSub) RegisterValidator(
-	bitmask []byte, validator func(peerID peer.ID, message *pb.Message) ValidationResult, sync bool,
+	bitmask []byte, validator Validator, sync bool,
 ) error {
-	validatorEx := func(
+	return b.ps.RegisterBitmaskValidator(
+		bitmask,
+		b.validatorEx(bitmask, validator),
+		blossomsub.WithValidatorInline(sync),
+	)
+}
+
+// validatorEx wraps validator with the checks made on every bitmask, and
+// captures the messages rejected as dead letters.
+func (b *BlossomSub) validatorEx(
+	bitmask []byte,
+	validator Validator,
+) blossomsub.ValidatorEx {
+	maxSize, limited := b.messageSizes.limit(bitmask)
+	private := b.private.isPrivate(bitmask)
+	return func(
 		ctx context.Context, peerID peer.ID, message *blossomsub.Message,
 	) blossomsub.ValidationResult {
-		switch v := validator(peerID, message.Message); v {
+		// Drop oversized messages before the validator spends time decoding
+		// them. Size limits are local configuration that peers may not share,
+		// so the message is ignored rather than rejected, leaving the sender's
+		// score alone.
+		if limited && len(message.Data) > maxSize {
+			b.captureDeadLetter(peerID, bitmask, deadLetterTooLarge, message)
+			return blossomsub.ValidationIgnore
+		}
+		// Payloads on private bitmasks are opaque to the mesh, only their
+		// envelope is verified here. The handler checks the content.
+		if private {
+			data, err := b.private.open(bitmask, message.Data)
+			if err != nil {
+				b.captureDeadLetter(
+					peerID,
+					bitmask,
+					deadLetterPrivateEnvelope,
+					message,
+				)
+				b.penalizeGossipRate(peerID)
+				return blossomsub.ValidationReject
+			}
+			message.ValidatorData = openedPayload(data)
+			return blossomsub.ValidationAccept
+		}
+		switch v, reason := validator(peerID, message.Message); v {
 		case ValidationResultAccept:
 			return blossomsub.ValidationAccept
 		case ValidationResultReject:
+			if reason == "" {
+				reason = deadLetterValidator
+			}
+			b.captureDeadLetter(peerID, bitmask, reason, message)
+			b.penalizeGossipRate(peerID)
 			return blossomsub.ValidationReject
 		case ValidationResultIgnore:
 			return blossomsub.ValidationIgnore
@@ -679,8 +1449,6 @@ func (b *BlossomSub) RegisterValidator(
 			panic("unreachable")
 		}
 	}
-	var _ blossomsub.ValidatorEx = validatorEx
-	return b.ps.RegisterBitmaskValidator(bitmask, validatorEx, blossomsub.WithValidatorInline(sync))
 }
 
 func (b *BlossomSub) UnregisterValidator(bitmask []byte) error {
@@ -709,6 +1477,38 @@ func (b *BlossomSub) GetRandomPeer(bitmask []byte) ([]byte, error) {
 	return []byte(peers[sel.Int64()]), nil
 }
 
+// GetBestPeers returns up to n peers of the bitmask, best first, weighing
+// each by its peer score and the round trip time measured by the peer
+// monitor.
+func (b *BlossomSub) GetBestPeers(bitmask []byte, n int) ([][]byte, error) {
+	networkBitmask := append([]byte{b.network}, bitmask...)
+	peers := b.ps.ListPeers(networkBitmask)
+	if len(peers) == 0 {
+		return nil, errors.Wrap(
+			ErrNoPeersAvailable,
+			"get best peers",
+		)
+	}
+
+	quality := make(map[peer.ID]float64, len(peers))
+	for _, p := range peers {
+		quality[p] = internal.PeerQuality(
+			b.ps.PeerScore(p),
+			b.h.Peerstore().LatencyEWMA(p),
+		)
+	}
+	sort.SliceStable(peers, func(i, j int) bool {
+		return quality[peers[i]] > quality[peers[j]]
+	})
+
+	best := make([][]byte, 0, min(n, len(peers)))
+	for _, p := range peers[:min(n, len(peers))] {
+		best = append(best, []byte(p))
+	}
+
+	return best, nil
+}
+
 func initDHT(
 	ctx context.Context,
 	logger *zap.Logger,
@@ -716,7 +1516,7 @@ func initDHT(
 	isBootstrapPeer bool,
 	bootstrappers []peer.AddrInfo,
 	network uint8,
-) *dht.IpfsDHT {
+) (*dht.IpfsDHT, error) {
 	logger.Info("establishing dht")
 	var mode dht.ModeOpt
 	if isBootstrapPeer || network != 0 {
@@ -737,20 +1537,21 @@ func initDHT(
 		opts...,
 	)
 	if err != nil {
-		panic(err)
+		return nil, errors.Wrap(err, "init dht")
 	}
 	if err := kademliaDHT.Bootstrap(ctx); err != nil {
-		panic(err)
+		return nil, errors.Wrap(err, "init dht")
 	}
-	return kademliaDHT
+	return kademliaDHT, nil
 }
 
 func (b *BlossomSub) Reconnect(peerId []byte) error {
 	peer := peer.ID(peerId)
 	info := b.h.Peerstore().PeerInfo(peer)
 	b.h.ConnManager().Unprotect(info.ID, "bootstrap")
-	time.Sleep(10 * time.Second)
+	b.clock.Sleep(10 * time.Second)
 	if err := b.h.Connect(b.ctx, info); err != nil {
+		b.addressQuality.ObserveDialError(info.ID, err)
 		return errors.Wrap(err, "reconnect")
 	}
 
@@ -758,12 +1559,178 @@ func (b *BlossomSub) Reconnect(peerId []byte) error {
 	return nil
 }
 
+// ReloadPeers replaces the direct and bootstrap peers with those of the
+// config, as read again by the operator. The blossomsub direct peers, their
+// connection manager protections and the resource manager allowlist follow.
+func (b *BlossomSub) ReloadPeers(p2pConfig *config.P2PConfig) error {
+	bootstrappers, err := bootstrapPeers(p2pConfig)
+	if err != nil {
+		return errors.Wrap(err, "reload peers")
+	}
+	directPeers, err := parsePeerAddrs(p2pConfig.DirectPeers)
+	if err != nil {
+		return errors.Wrap(err, "reload peers")
+	}
+
+	b.reloadMx.Lock()
+	defer b.reloadMx.Unlock()
+
+	if err := b.rt.SetDirectPeers(directPeers); err != nil {
+		return errors.Wrap(err, "reload peers")
+	}
+	b.directAllowlist.Sync(resolvePeerAddrs(directPeers))
+
+	kept := internal.PeerAddrInfosToPeerIDMap(bootstrappers)
+	for p := range b.bootstrapSource.PeerIDs() {
+		if _, ok := kept[p]; !ok {
+			b.h.ConnManager().Unprotect(p, "bootstrap")
+		}
+	}
+	b.bootstrapSource.SetPeers(bootstrappers)
+
+	b.logger.Info(
+		"reloaded peers",
+		zap.Int("direct_peers", len(directPeers)),
+		zap.Int("bootstrap_peers", len(bootstrappers)),
+	)
+	return nil
+}
+
+// operatorProtectTag is the connection manager tag for peers protected through
+// ProtectPeer, kept separate from "bootstrap" so the two never undo each other.
+const operatorProtectTag = "operator"
+
+func (b *BlossomSub) ConnectPeer(
+	ctx context.Context,
+	multiaddr string,
+) ([]byte, error) {
+	info, err := peer.AddrInfoFromString(multiaddr)
+	if err != nil {
+		return nil, errors.Wrap(err, "connect peer")
+	}
+
+	if info.ID == b.h.ID() {
+		return nil, errors.Wrap(errors.New("cannot connect to self"), "connect peer")
+	}
+
+	// An explicit dial should not be refused because of an earlier failure.
+	if sw, ok := b.h.Network().(*swarm.Swarm); ok {
+		sw.Backoff().Clear(info.ID)
+	}
+
+	if err := b.h.Connect(ctx, *info); err != nil {
+		return nil, errors.Wrap(err, "connect peer")
+	}
+
+	return []byte(info.ID), nil
+}
+
+func (b *BlossomSub) DisconnectPeer(peerId []byte) error {
+	if err := b.h.Network().ClosePeer(peer.ID(peerId)); err != nil {
+		return errors.Wrap(err, "disconnect peer")
+	}
+
+	return nil
+}
+
+func (b *BlossomSub) ProtectPeer(peerId []byte, protect bool) {
+	if protect {
+		b.h.ConnManager().Protect(peer.ID(peerId), operatorProtectTag)
+	} else {
+		b.h.ConnManager().Unprotect(peer.ID(peerId), operatorProtectTag)
+	}
+}
+
+func (b *BlossomSub) GetPeerConnection(
+	peerId []byte,
+) *protobufs.PeerConnectionResponse {
+	p := peer.ID(peerId)
+	resp := &protobufs.PeerConnectionResponse{
+		PeerId:        peerId,
+		Connectedness: connectednessString(b.h.Network().Connectedness(p)),
+		Protected:     b.h.ConnManager().IsProtected(p, ""),
+		PeerScore:     b.ps.PeerScore(p),
+	}
+
+	for _, c := range b.h.Network().ConnsToPeer(p) {
+		stat := c.Stat()
+		resp.Connections = append(resp.Connections, &protobufs.PeerConnection{
+			LocalMultiaddr:  c.LocalMultiaddr().String(),
+			RemoteMultiaddr: c.RemoteMultiaddr().String(),
+			Direction:       directionString(stat.Direction),
+			OpenedAt:        stat.Opened.UnixMilli(),
+			Limited:         stat.Limited,
+		})
+	}
+
+	for _, a := range b.h.Peerstore().Addrs(p) {
+		resp.KnownMultiaddrs = append(resp.KnownMultiaddrs, a.String())
+	}
+
+	return resp
+}
+
+func directionString(d network.Direction) string {
+	if d == network.DirInbound {
+		return "inbound"
+	}
+	return "outbound"
+}
+
+func connectednessString(c network.Connectedness) string {
+	switch c {
+	case network.Connected:
+		return "connected"
+	case network.CanConnect:
+		return "can_connect"
+	case network.CannotConnect:
+		return "cannot_connect"
+	case network.Limited:
+		return "limited"
+	default:
+		return "not_connected"
+	}
+}
+
 func (b *BlossomSub) Bootstrap(ctx context.Context) error {
-	return b.bootstrap.Connect(ctx)
+	_, err := b.bootstrap.Connect(ctx)
+	return err
 }
 
 func (b *BlossomSub) DiscoverPeers(ctx context.Context) error {
-	return b.discovery.Connect(ctx)
+	_, err := b.discovery.Connect(ctx)
+	return err
+}
+
+// RunPeerDiscovery runs a bootstrap round, a discovery round over the DHT, or
+// both, right away. Unlike Bootstrap, the bootstrap round runs even if enough
+// bootstrap peers are connected.
+func (b *BlossomSub) RunPeerDiscovery(
+	ctx context.Context,
+	bootstrap, discover bool,
+) *protobufs.PeerDiscoveryResponse {
+	resp := &protobufs.PeerDiscoveryResponse{}
+	run := func(source string, connector internal.PeerConnector) {
+		result, err := connector.Connect(ctx)
+		round := &protobufs.PeerDiscoveryRound{
+			Source:           source,
+			Attempted:        result.Attempted,
+			Connected:        result.Connected,
+			AlreadyConnected: result.Duplicate,
+		}
+		if err != nil {
+			round.Error = err.Error()
+		}
+		resp.Rounds = append(resp.Rounds, round)
+	}
+	if bootstrap && b.forcedBootstrap != nil {
+		run("bootstrap", b.forcedBootstrap)
+	}
+	if discover && b.forcedDiscovery != nil {
+		run("dht", b.forcedDiscovery)
+	}
+	resp.PeerCount = uint32(len(b.h.Network().Peers()))
+	return resp
 }
 
 func (b *BlossomSub) GetPeerScore(peerId []byte) int64 {
@@ -818,11 +1785,44 @@ func (b *BlossomSub) GetNetworkInfo() *protobufs.NetworkInfoResponse {
 		for _, a := range addrs {
 			multiaddrs = append(multiaddrs, a.String())
 		}
-		resp.NetworkInfo = append(resp.NetworkInfo, &protobufs.NetworkInfo{
-			PeerId:     []byte(p),
-			Multiaddrs: multiaddrs,
-			PeerScore:  b.ps.PeerScore(p),
-		})
+		info := &protobufs.NetworkInfo{
+			PeerId:        []byte(p),
+			Multiaddrs:    multiaddrs,
+			PeerScore:     b.ps.PeerScore(p),
+			RoundTripTime: b.h.Peerstore().LatencyEWMA(p).Milliseconds(),
+		}
+		if agent, err := b.h.Peerstore().Get(p, "AgentVersion"); err == nil {
+			info.AgentVersion, _ = agent.(string)
+		}
+		info.Metadata = b.GetPeerMetadata([]byte(p))
+		var oldest network.Conn
+		for _, c := range b.h.Network().ConnsToPeer(p) {
+			if oldest == nil || c.Stat().Opened.Before(oldest.Stat().Opened) {
+				oldest = c
+			}
+		}
+		if oldest != nil {
+			stat := oldest.Stat()
+			info.Direction = directionString(stat.Direction)
+			info.Transport = oldest.ConnState().Transport
+			if !stat.Opened.IsZero() {
+				info.ConnectedDuration = b.clock.Since(stat.Opened).Milliseconds()
+			}
+		}
+		resp.NetworkInfo = append(resp.NetworkInfo, info)
+	}
+	resp.HolePunchingEnabled = b.holePunchTracer != nil
+	resp.HolePunchSuccesses, resp.HolePunchFailures = b.holePunchTracer.Counts()
+	if b.peerSources != nil {
+		for _, stat := range b.peerSources.Stats() {
+			resp.PeerSources = append(resp.PeerSources, &protobufs.PeerSourceStats{
+				Source:    stat.Source,
+				Connected: stat.Connected,
+				Retained:  stat.Retained,
+				Dropped:   stat.Dropped,
+				Weight:    stat.Weight,
+			})
+		}
 	}
 	return resp
 }
@@ -831,6 +1831,14 @@ func (b *BlossomSub) GetNetworkPeersCount() int {
 	return len(b.h.Network().Peers())
 }
 
+func (b *BlossomSub) GetMeshPeersCount(bitmask []byte) int {
+	if b.rt == nil {
+		return 0
+	}
+	networkBitmask := append([]byte{b.network}, bitmask...)
+	return b.rt.MeshPeerCount(networkBitmask)
+}
+
 func (b *BlossomSub) GetMultiaddrOfPeerStream(
 	ctx context.Context,
 	peerId []byte,
@@ -856,6 +1864,18 @@ func (b *BlossomSub) StartDirectChannelListener(
 	purpose string,
 	server *grpc.Server,
 ) error {
+	// A disabled purpose never gets a stream handler, so peers requesting it
+	// are refused during protocol negotiation.
+	if b.directChannelPurposes != nil {
+		class := directChannelPurposeClass(purpose)
+		if _, ok := b.directChannelPurposes[class]; !ok {
+			return errors.Wrap(
+				ErrDirectChannelPurposeDisabled,
+				"start direct channel listener: "+class,
+			)
+		}
+	}
+
 	bind, err := gostream.Listen(
 		b.h,
 		protocol.ID(
@@ -866,7 +1886,53 @@ func (b *BlossomSub) StartDirectChannelListener(
 		return errors.Wrap(err, "start direct channel listener")
 	}
 
-	return errors.Wrap(server.Serve(bind), "start direct channel listener")
+	var listener net.Listener = bind
+	if b.directChannelAllowlist != nil {
+		listener = internal.FilterDirectChannelListener(
+			listener,
+			purpose,
+			b.allowDirectChannel,
+		)
+	}
+
+	return errors.Wrap(
+		server.Serve(internal.MeterDirectChannelListener(listener, purpose)),
+		"start direct channel listener",
+	)
+}
+
+// SetDirectChannelAuthorizer sets the function deciding which peers are
+// provers, whose direct channels are accepted when restricted to provers.
+func (b *BlossomSub) SetDirectChannelAuthorizer(
+	authorize func(peerId []byte) bool,
+) {
+	b.directChannelAuthorizer.Store(&authorize)
+}
+
+// allowDirectChannel reports whether direct channels from the peer are
+// accepted. Without an authorizer, only allowlisted peers are.
+func (b *BlossomSub) allowDirectChannel(id peer.ID) bool {
+	if _, ok := b.directChannelAllowlist[id]; ok {
+		return true
+	}
+	if !b.directChannelProversOnly {
+		return false
+	}
+	authorize := b.directChannelAuthorizer.Load()
+	return authorize != nil && (*authorize)([]byte(id))
+}
+
+// DirectChannelCreds returns the credentials option of gRPC servers serving
+// direct channels, which attributes calls to the peer at the other end of the
+// stream, see DirectChannelPeer.
+func DirectChannelCreds() grpc.ServerOption {
+	return grpc.Creds(internal.NewPeerCredentials(""))
+}
+
+// DirectChannelPeer returns the peer that made a gRPC call over a direct
+// channel.
+func DirectChannelPeer(ctx context.Context) (peer.ID, bool) {
+	return internal.PeerFromContext(ctx)
 }
 
 type extraCloseConn struct {
@@ -880,68 +1946,144 @@ func (c *extraCloseConn) Close() error {
 	return err
 }
 
+// GetDirectChannel opens a gRPC client connection to the peer over a direct
+// channel. The first stream is opened before returning, retrying with backoff,
+// so that a peer that cannot be reached fails with ErrPeerOffline and one that
+// does not serve the channel with ErrProtocolNotSupported.
 func (b *BlossomSub) GetDirectChannel(peerID []byte, purpose string) (
-	cc *grpc.ClientConn, err error,
+	*grpc.ClientConn,
+	error,
 ) {
-	// Kind of a weird hack, but gostream can induce panics if the peer drops at
-	// the time of connection, this avoids the problem.
-	defer func() {
-		if r := recover(); r != nil {
-			cc = nil
-			err = errors.New("connection failed")
-		}
-	}()
-
 	id := peer.ID(peerID)
-
 	// Open question: should we prefix this so a node can run both in mainnet and
 	// testnet? Feels like a bad idea and would be preferable to discourage.
-	cc, err = qgrpc.DialContext(
+	protocolID := protocol.ID("/p2p/direct-channel/" + id.String() + purpose)
+
+	first, err := b.dialDirectChannel(id, protocolID, purpose)
+	if err != nil {
+		return nil, errors.Wrap(err, "get direct channel")
+	}
+
+	// The first connection uses the stream already open, reconnections open
+	// new ones.
+	var firstTaken atomic.Bool
+	cc, err := qgrpc.DialContext(
 		b.ctx,
 		"passthrough:///",
 		grpc.WithContextDialer(
 			func(ctx context.Context, _ string) (net.Conn, error) {
-				// If we are not already connected to the peer, we will manually dial it
-				// before opening the direct channel. We will close the peer connection
-				// when the direct channel is closed.
-				alreadyConnected := false
-				switch connectedness := b.h.Network().Connectedness(id); connectedness {
-				case network.Connected, network.Limited:
-					alreadyConnected = true
-				default:
-					if err := b.h.Connect(ctx, peer.AddrInfo{ID: id}); err != nil {
-						return nil, errors.Wrap(err, "connect")
-					}
-				}
-				c, err := gostream.Dial(
-					network.WithNoDial(ctx, "direct-channel"),
-					b.h,
-					id,
-					protocol.ID(
-						"/p2p/direct-channel/"+id.String()+purpose,
-					),
-				)
-				if err != nil {
-					return nil, errors.Wrap(err, "dial direct channel")
+				if firstTaken.CompareAndSwap(false, true) {
+					return first, nil
 				}
-				if alreadyConnected {
-					return c, nil
-				}
-				return &extraCloseConn{
-					Conn:       c,
-					extraClose: func() { _ = b.h.Network().ClosePeer(id) },
-				}, nil
+				return b.dialStream(ctx, id, protocolID, purpose)
 			},
 		),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		// The stream must end at the peer dialed, as verified by libp2p.
+		grpc.WithTransportCredentials(internal.NewPeerCredentials(id)),
 	)
 	if err != nil {
-		return nil, errors.Wrap(err, "dial context")
+		if firstTaken.CompareAndSwap(false, true) {
+			first.Close()
+		}
+		return nil, errors.Wrap(err, "get direct channel")
 	}
 
 	return cc, nil
 }
 
+// dialDirectChannel opens a direct channel stream to the peer, retrying with
+// exponential backoff unless the peer does not serve the channel.
+func (b *BlossomSub) dialDirectChannel(
+	id peer.ID,
+	protocolID protocol.ID,
+	purpose string,
+) (net.Conn, error) {
+	dial := func() (net.Conn, error) {
+		ctx := b.ctx
+		if b.directChannelDialTimeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = clock.WithTimeout(
+				b.ctx,
+				b.clock,
+				b.directChannelDialTimeout,
+			)
+			defer cancel()
+		}
+		return b.dialStream(ctx, id, protocolID, purpose)
+	}
+
+	backoff := b.directChannelDialBackoff
+	for attempt := 0; ; attempt++ {
+		conn, err := dial()
+		if err == nil {
+			return conn, nil
+		}
+		if errors.Is(err, ErrProtocolNotSupported) ||
+			attempt >= b.directChannelDialRetries {
+			return nil, errors.Wrap(err, "dial direct channel")
+		}
+
+		b.logger.Debug(
+			"could not open direct channel, retrying",
+			zap.String("peer_id", id.String()),
+			zap.String("purpose", purpose),
+			zap.Duration("backoff", backoff),
+			zap.Error(err),
+		)
+		select {
+		case <-b.ctx.Done():
+			return nil, errors.Wrap(err, "dial direct channel")
+		case <-b.clock.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// dialStream opens a metered stream to the peer as a net.Conn. If we are not
+// already connected to the peer, we will manually dial it before opening the
+// stream, and close the peer connection when the stream is closed.
+func (b *BlossomSub) dialStream(
+	ctx context.Context,
+	id peer.ID,
+	protocolID protocol.ID,
+	purpose string,
+) (net.Conn, error) {
+	alreadyConnected := false
+	switch connectedness := b.h.Network().Connectedness(id); connectedness {
+	case network.Connected, network.Limited:
+		alreadyConnected = true
+	default:
+		if err := b.h.Connect(ctx, peer.AddrInfo{ID: id}); err != nil {
+			internal.ObserveDirectChannelDial(purpose, err)
+			return nil, errors.Wrap(classify(ErrPeerOffline, err), "dial stream")
+		}
+	}
+	c, err := gostream.Dial(
+		network.WithNoDial(ctx, "direct-channel"),
+		b.h,
+		id,
+		protocolID,
+	)
+	internal.ObserveDirectChannelDial(purpose, err)
+	if err != nil {
+		switch {
+		case errors.Is(err, msmux.ErrNotSupported[protocol.ID]{}):
+			err = classify(ErrProtocolNotSupported, err)
+		case b.h.Network().Connectedness(id) != network.Connected:
+			err = classify(ErrPeerOffline, err)
+		}
+		return nil, errors.Wrap(err, "dial stream")
+	}
+	c = internal.MeterDirectChannelConn(c, purpose, "outbound")
+	if alreadyConnected {
+		return c, nil
+	}
+	return &extraCloseConn{
+		Conn:       c,
+		extraClose: func() { _ = b.h.Network().ClosePeer(id) },
+	}, nil
+}
+
 func (b *BlossomSub) GetPublicKey() []byte {
 	pub, _ := b.signKey.GetPublic().Raw()
 	return pub
@@ -1066,9 +2208,79 @@ func withDefaults(p2pConfig *config.P2PConfig) *config.P2PConfig {
 	if p2pConfig.ValidateQueueSize == 0 {
 		p2pConfig.ValidateQueueSize = blossomsub.DefaultValidateQueueSize
 	}
+	if p2pConfig.ValidateQueueMinSize == 0 {
+		p2pConfig.ValidateQueueMinSize = min(
+			defaultValidateQueueMinSize,
+			p2pConfig.ValidateQueueSize,
+		)
+	}
+	if p2pConfig.ValidateQueueMaxSize == 0 {
+		p2pConfig.ValidateQueueMaxSize = 4 * p2pConfig.ValidateQueueSize
+	}
 	if p2pConfig.ValidateWorkers == 0 {
 		p2pConfig.ValidateWorkers = qruntime.WorkerCount(0, false)
 	}
+	if p2pConfig.ChurnWindow == 0 {
+		p2pConfig.ChurnWindow = defaultChurnWindow
+	}
+	if p2pConfig.ChurnBackoff == 0 {
+		p2pConfig.ChurnBackoff = defaultChurnBackoff
+	}
+	if p2pConfig.HandlerPanicThreshold == 0 {
+		p2pConfig.HandlerPanicThreshold = defaultHandlerPanicThreshold
+	}
+	if p2pConfig.HandlerBreakerCooldown == 0 {
+		p2pConfig.HandlerBreakerCooldown = defaultHandlerBreakerCooldown
+	}
+	if p2pConfig.AddressFailureLimit == 0 {
+		p2pConfig.AddressFailureLimit = defaultAddressFailureLimit
+	}
+	if p2pConfig.AnnounceRotationPeriod == 0 {
+		p2pConfig.AnnounceRotationPeriod = defaultAnnounceRotationPeriod
+	}
+	if p2pConfig.PeerstoreGCInterval == 0 {
+		p2pConfig.PeerstoreGCInterval = defaultPeerstoreGCInterval
+	}
+	if p2pConfig.PeerScoreHalfLife == 0 {
+		p2pConfig.PeerScoreHalfLife = defaultPeerScoreHalfLife
+	}
+	if p2pConfig.DirectPeerCheckPeriod == 0 {
+		p2pConfig.DirectPeerCheckPeriod = defaultDirectPeerCheckPeriod
+	}
+	if p2pConfig.GossipLimitPenalty == 0 {
+		p2pConfig.GossipLimitPenalty = defaultGossipLimitPenalty
+	}
+	if p2pConfig.BootstrapResolveInterval == 0 {
+		p2pConfig.BootstrapResolveInterval = defaultBootstrapResolveInterval
+	}
+	if p2pConfig.MetricsBandwidthPeers == 0 {
+		p2pConfig.MetricsBandwidthPeers = defaultMetricsBandwidthPeers
+	}
+	if p2pConfig.TraceLogMaxSize == 0 {
+		p2pConfig.TraceLogMaxSize = defaultTraceLogMaxSize
+	}
+	if p2pConfig.TraceLogMaxAge == 0 {
+		p2pConfig.TraceLogMaxAge = defaultTraceLogMaxAge
+	}
+	if p2pConfig.TraceLogMaxBackups == 0 {
+		p2pConfig.TraceLogMaxBackups = defaultTraceLogMaxBackups
+	}
+	if p2pConfig.TraceLogSampleRate == 0 {
+		p2pConfig.TraceLogSampleRate = defaultTraceLogSampleRate
+	}
+	if p2pConfig.DeadLetterMaxSize == 0 {
+		p2pConfig.DeadLetterMaxSize = defaultDeadLetterMaxSize
+	}
+	if p2pConfig.DirectChannelDialTimeout == 0 {
+		p2pConfig.DirectChannelDialTimeout = defaultDirectChannelDialTimeout
+	}
+	if p2pConfig.DirectChannelDialRetries == nil {
+		retries := defaultDirectChannelDialRetries
+		p2pConfig.DirectChannelDialRetries = &retries
+	}
+	if p2pConfig.DirectChannelDialBackoff == 0 {
+		p2pConfig.DirectChannelDialBackoff = defaultDirectChannelDialBackoff
+	}
 	return p2pConfig
 }
 
@@ -0,0 +1,150 @@
+package p2p
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	blossomsub "source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+var errRouterUnavailable = errors.New("blossomsub router unavailable")
+
+// GetBlossomSubParams returns the effective router parameters, peer score
+// parameters and peer score thresholds.
+func (b *BlossomSub) GetBlossomSubParams() (
+	*protobufs.BlossomSubParamsResponse,
+	error,
+) {
+	if b.rt == nil {
+		return nil, errors.Wrap(errRouterUnavailable, "get blossomsub params")
+	}
+
+	params, err := b.rt.Params()
+	if err != nil {
+		return nil, errors.Wrap(err, "get blossomsub params")
+	}
+
+	scoreParams, err := b.rt.PeerScoreParams()
+	if err != nil {
+		return nil, errors.Wrap(err, "get blossomsub params")
+	}
+
+	thresholds, err := b.rt.PeerScoreThresholds()
+	if err != nil {
+		return nil, errors.Wrap(err, "get blossomsub params")
+	}
+
+	return &protobufs.BlossomSubParamsResponse{
+		Params:              blossomSubParamsToProto(params),
+		PeerScoreParams:     peerScoreParamsToProto(scoreParams),
+		PeerScoreThresholds: peerScoreThresholdsToProto(thresholds),
+	}, nil
+}
+
+// UpdateBlossomSubParams adjusts the router parameters that are safe to change
+// at runtime. Intended for experimentation, it is refused on mainnet.
+func (b *BlossomSub) UpdateBlossomSubParams(
+	req *protobufs.UpdateBlossomSubParamsRequest,
+) (*protobufs.BlossomSubParamsResponse, error) {
+	if b.network == 0 {
+		return nil, errors.Wrap(
+			errors.New("runtime parameter changes are disabled on mainnet"),
+			"update blossomsub params",
+		)
+	}
+
+	if b.rt == nil {
+		return nil, errors.Wrap(errRouterUnavailable, "update blossomsub params")
+	}
+
+	if _, err := b.rt.UpdateParams(blossomsub.BlossomSubParamsUpdate{
+		Dlazy:                int(req.Dlazy),
+		GossipRetransmission: int(req.GossipRetransmission),
+		IWantFollowupTime: time.Duration(req.IwantFollowupTime) *
+			time.Millisecond,
+	}); err != nil {
+		return nil, errors.Wrap(err, "update blossomsub params")
+	}
+
+	b.logger.Info(
+		"updated blossomsub params",
+		zap.Int64("dlazy", req.Dlazy),
+		zap.Int64("gossip_retransmission", req.GossipRetransmission),
+		zap.Int64("iwant_followup_time_ms", req.IwantFollowupTime),
+	)
+
+	resp, err := b.GetBlossomSubParams()
+	return resp, errors.Wrap(err, "update blossomsub params")
+}
+
+func blossomSubParamsToProto(
+	p blossomsub.BlossomSubParams,
+) *protobufs.BlossomSubParams {
+	return &protobufs.BlossomSubParams{
+		D:                         int64(p.D),
+		Dlo:                       int64(p.Dlo),
+		Dhi:                       int64(p.Dhi),
+		Dscore:                    int64(p.Dscore),
+		Dout:                      int64(p.Dout),
+		Dlazy:                     int64(p.Dlazy),
+		BitmaskWidth:              int64(p.BitmaskWidth),
+		HistoryLength:             int64(p.HistoryLength),
+		HistoryGossip:             int64(p.HistoryGossip),
+		GossipRetransmission:      int64(p.GossipRetransmission),
+		HeartbeatInitialDelay:     p.HeartbeatInitialDelay.Milliseconds(),
+		HeartbeatInterval:         p.HeartbeatInterval.Milliseconds(),
+		SlowHeartbeatWarning:      p.SlowHeartbeatWarning,
+		FanoutTtl:                 p.FanoutTTL.Milliseconds(),
+		PrunePeers:                int64(p.PrunePeers),
+		PruneBackoff:              p.PruneBackoff.Milliseconds(),
+		UnsubscribeBackoff:        p.UnsubscribeBackoff.Milliseconds(),
+		Connectors:                int64(p.Connectors),
+		MaxPendingConnections:     int64(p.MaxPendingConnections),
+		ConnectionTimeout:         p.ConnectionTimeout.Milliseconds(),
+		DirectConnectTicks:        p.DirectConnectTicks,
+		DirectConnectInitialDelay: p.DirectConnectInitialDelay.Milliseconds(),
+		OpportunisticGraftTicks:   p.OpportunisticGraftTicks,
+		OpportunisticGraftPeers:   int64(p.OpportunisticGraftPeers),
+		GraftFloodThreshold:       p.GraftFloodThreshold.Milliseconds(),
+		MaxIhaveLength:            int64(p.MaxIHaveLength),
+		MaxIhaveMessages:          int64(p.MaxIHaveMessages),
+		IwantFollowupTime:         p.IWantFollowupTime.Milliseconds(),
+	}
+}
+
+func peerScoreParamsToProto(
+	p *blossomsub.PeerScoreParams,
+) *protobufs.PeerScoreParams {
+	if p == nil {
+		return nil
+	}
+
+	return &protobufs.PeerScoreParams{
+		BitmaskScoreCap:             p.BitmaskScoreCap,
+		AppSpecificWeight:           p.AppSpecificWeight,
+		IpColocationFactorWeight:    p.IPColocationFactorWeight,
+		IpColocationFactorThreshold: int64(p.IPColocationFactorThreshold),
+		BehaviourPenaltyWeight:      p.BehaviourPenaltyWeight,
+		BehaviourPenaltyThreshold:   p.BehaviourPenaltyThreshold,
+		BehaviourPenaltyDecay:       p.BehaviourPenaltyDecay,
+		DecayInterval:               p.DecayInterval.Milliseconds(),
+		DecayToZero:                 p.DecayToZero,
+		RetainScore:                 p.RetainScore.Milliseconds(),
+		SeenMsgTtl:                  p.SeenMsgTTL.Milliseconds(),
+		BitmaskParamsCount:          int64(len(p.Bitmasks)),
+	}
+}
+
+func peerScoreThresholdsToProto(
+	t blossomsub.PeerScoreThresholds,
+) *protobufs.PeerScoreThresholds {
+	return &protobufs.PeerScoreThresholds{
+		GossipThreshold:             t.GossipThreshold,
+		PublishThreshold:            t.PublishThreshold,
+		GraylistThreshold:           t.GraylistThreshold,
+		AcceptPxThreshold:           t.AcceptPXThreshold,
+		OpportunisticGraftThreshold: t.OpportunisticGraftThreshold,
+	}
+}
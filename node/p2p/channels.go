@@ -0,0 +1,172 @@
+package p2p
+
+import (
+	"context"
+	"net"
+	"regexp"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/libp2p/go-libp2p/p2p/net/gostream"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p/internal"
+)
+
+const channelProtocolPrefix = "/p2p/channel/"
+
+// ErrInvalidChannelProtocol is returned for protocol suffixes that are not
+// one or more slash separated segments of letters, digits, '.', '_' or '-',
+// e.g. "operator-sync/1.0.0".
+var ErrInvalidChannelProtocol = errors.New("invalid channel protocol")
+
+// ErrChannelHandlerRegistered is returned when registering a second handler
+// for the same protocol suffix.
+var ErrChannelHandlerRegistered = errors.New("channel handler already registered")
+
+var channelProtocolPattern = regexp.MustCompile(
+	`^[A-Za-z0-9._-]+(/[A-Za-z0-9._-]+)*$`,
+)
+
+// ChannelHandler serves an inbound channel opened by the peer. The handler
+// owns the connection and must close it.
+type ChannelHandler func(peerID []byte, conn net.Conn)
+
+func channelProtocol(protocolSuffix string) (protocol.ID, error) {
+	if !channelProtocolPattern.MatchString(protocolSuffix) {
+		return "", errors.Wrap(ErrInvalidChannelProtocol, protocolSuffix)
+	}
+	return protocol.ID(channelProtocolPrefix + protocolSuffix), nil
+}
+
+// OpenChannel opens a raw stream to the peer for the protocol suffix, which
+// the peer must have registered a ChannelHandler for. Both ends of the channel
+// are authenticated by their peer identities.
+func (b *BlossomSub) OpenChannel(peerID []byte, protocolSuffix string) (
+	conn net.Conn,
+	err error,
+) {
+	protocolID, err := channelProtocol(protocolSuffix)
+	if err != nil {
+		return nil, errors.Wrap(err, "open channel")
+	}
+
+	// gostream can panic if the peer drops while the stream is being opened.
+	defer func() {
+		if r := recover(); r != nil {
+			conn = nil
+			err = errors.New("open channel: connection failed")
+		}
+	}()
+
+	conn, err = b.dialStream(
+		b.ctx,
+		peer.ID(peerID),
+		protocolID,
+		DirectChannelPurposeChannel,
+	)
+	return conn, errors.Wrap(err, "open channel")
+}
+
+// RegisterChannelHandler serves inbound channels for the protocol suffix with
+// the handler, each in its own goroutine.
+func (b *BlossomSub) RegisterChannelHandler(
+	protocolSuffix string,
+	handler ChannelHandler,
+) error {
+	protocolID, err := channelProtocol(protocolSuffix)
+	if err != nil {
+		return errors.Wrap(err, "register channel handler")
+	}
+
+	if b.directChannelPurposes != nil {
+		if _, ok := b.directChannelPurposes[DirectChannelPurposeChannel]; !ok {
+			return errors.Wrap(
+				ErrDirectChannelPurposeDisabled,
+				"register channel handler",
+			)
+		}
+	}
+
+	b.channelListenersMx.Lock()
+	defer b.channelListenersMx.Unlock()
+
+	if _, ok := b.channelListeners[protocolSuffix]; ok {
+		return errors.Wrap(
+			ErrChannelHandlerRegistered,
+			"register channel handler: "+protocolSuffix,
+		)
+	}
+
+	listener, err := gostream.Listen(b.h, protocolID)
+	if err != nil {
+		return errors.Wrap(err, "register channel handler")
+	}
+	listener = internal.MeterDirectChannelListener(
+		listener,
+		DirectChannelPurposeChannel,
+	)
+
+	if b.channelListeners == nil {
+		b.channelListeners = map[string]net.Listener{}
+	}
+	b.channelListeners[protocolSuffix] = listener
+
+	go b.serveChannel(protocolSuffix, listener, handler)
+
+	return nil
+}
+
+// UnregisterChannelHandler stops serving inbound channels for the protocol
+// suffix. Channels already handed to the handler stay open.
+func (b *BlossomSub) UnregisterChannelHandler(protocolSuffix string) {
+	b.channelListenersMx.Lock()
+	listener, ok := b.channelListeners[protocolSuffix]
+	delete(b.channelListeners, protocolSuffix)
+	b.channelListenersMx.Unlock()
+
+	if ok {
+		listener.Close()
+	}
+}
+
+func (b *BlossomSub) serveChannel(
+	protocolSuffix string,
+	listener net.Listener,
+	handler ChannelHandler,
+) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			// Unregistering closes the listener, which cancels Accept.
+			if !errors.Is(err, context.Canceled) {
+				b.logger.Debug(
+					"channel listener stopped",
+					zap.String("protocol", protocolSuffix),
+					zap.Error(err),
+				)
+			}
+			return
+		}
+
+		peerID, ok := gostream.PeerIDFromAddr(conn.RemoteAddr())
+		if !ok {
+			conn.Close()
+			continue
+		}
+
+		go func() {
+			defer func() {
+				if r := recover(); r != nil {
+					conn.Close()
+					b.logger.Error(
+						"channel handler panicked",
+						zap.String("protocol", protocolSuffix),
+						zap.Any("panic", r),
+					)
+				}
+			}()
+			handler([]byte(peerID), conn)
+		}()
+	}
+}
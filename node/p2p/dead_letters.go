@@ -0,0 +1,79 @@
+package p2p
+
+import (
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	blossomsub "source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p/internal"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+// Reasons a message is dropped by validation, as reported for dead letters.
+const (
+	deadLetterTooLarge        = "too_large"
+	deadLetterPrivateEnvelope = "private_envelope"
+	deadLetterValidator       = "validator"
+)
+
+// ErrDeadLettersDisabled is returned when listing dead letters while they are
+// not captured.
+var ErrDeadLettersDisabled = errors.New("dead letter capture disabled")
+
+// captureDeadLetter keeps a message dropped by validation, if enabled.
+func (b *BlossomSub) captureDeadLetter(
+	peerID peer.ID,
+	bitmask []byte,
+	reason string,
+	message *blossomsub.Message,
+) {
+	if b.deadLetters == nil {
+		return
+	}
+	data, err := message.Marshal()
+	if err != nil {
+		return
+	}
+	b.deadLetters.Add(internal.DeadLetter{
+		RejectedAt: b.clock.Now(),
+		PeerID:     peerID,
+		Bitmask:    bitmask,
+		Reason:     reason,
+		Message:    data,
+	})
+}
+
+// SetDeadLetterMaxSize keeps up to maxSize bytes of the messages rejected on
+// bitmask, or whole messages if zero or less, in place of
+// P2PConfig.DeadLetterMaxSize. Messages that are useless once cut, such as
+// frames, can be kept whole this way.
+func (b *BlossomSub) SetDeadLetterMaxSize(bitmask []byte, maxSize int) {
+	if b.deadLetters == nil {
+		return
+	}
+	b.deadLetters.SetMaxSize(bitmask, maxSize)
+}
+
+// GetDeadLetters returns up to limit of the latest messages rejected by
+// validation on bitmask, or on any bitmask if empty. A limit of zero returns
+// every captured message.
+func (b *BlossomSub) GetDeadLetters(
+	bitmask []byte,
+	limit int,
+) (*protobufs.DeadLettersResponse, error) {
+	if b.deadLetters == nil {
+		return nil, errors.Wrap(ErrDeadLettersDisabled, "get dead letters")
+	}
+	letters, captured := b.deadLetters.List(bitmask, limit)
+	resp := &protobufs.DeadLettersResponse{Captured: captured}
+	for _, letter := range letters {
+		resp.Letters = append(resp.Letters, &protobufs.DeadLetter{
+			RejectedAt: letter.RejectedAt.UnixMilli(),
+			PeerId:     []byte(letter.PeerID),
+			Bitmask:    letter.Bitmask,
+			Reason:     letter.Reason,
+			Message:    letter.Message,
+			Size:       uint64(letter.Size),
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,78 @@
+package p2p
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	blossomsub "source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub"
+	"source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub/pb"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p/internal"
+)
+
+func TestValidatorDeadLetters(t *testing.T) {
+	b := &BlossomSub{
+		logger:      zap.NewNop(),
+		clock:       clock.NewFakeClock(time.UnixMilli(1700000000000)),
+		deadLetters: internal.NewDeadLetters(10, 64),
+	}
+	validator := func(_ peer.ID, message *pb.Message) (ValidationResult, string) {
+		switch string(message.Data) {
+		case "accept":
+			return ValidationResultAccept, ""
+		case "ignore":
+			return ValidationResultIgnore, ""
+		case "unexplained":
+			return ValidationResultReject, ""
+		default:
+			return ValidationResultReject, "malformed_frame"
+		}
+	}
+	validate := func(bitmask []byte, data []byte) blossomsub.ValidationResult {
+		return b.validatorEx(bitmask, validator)(
+			context.Background(),
+			"peer",
+			&blossomsub.Message{Message: &pb.Message{Data: data}},
+		)
+	}
+
+	// Only rejections are captured, with the reason given by the validator.
+	frames := []byte{0x00, 0x00, 0x00, 0x01}
+	require.Equal(t, blossomsub.ValidationAccept, validate(frames, []byte("accept")))
+	require.Equal(t, blossomsub.ValidationIgnore, validate(frames, []byte("ignore")))
+	require.Equal(
+		t,
+		blossomsub.ValidationReject,
+		validate(frames, []byte("unexplained")),
+	)
+	require.Equal(
+		t,
+		blossomsub.ValidationReject,
+		validate(frames, []byte("frame")),
+	)
+	resp, err := b.GetDeadLetters(frames, 0)
+	require.NoError(t, err)
+	require.Len(t, resp.Letters, 2)
+	require.Equal(t, "malformed_frame", resp.Letters[0].Reason)
+	require.Equal(t, deadLetterValidator, resp.Letters[1].Reason)
+	require.Equal(t, uint64(2), resp.Captured)
+
+	// Messages are cut to the size limit, unless their bitmask has its own.
+	large := make([]byte, 256)
+	validate([]byte{0x01}, large)
+	b.SetDeadLetterMaxSize(frames, 0)
+	validate(frames, large)
+	resp, err = b.GetDeadLetters(nil, 2)
+	require.NoError(t, err)
+	require.Len(t, resp.Letters[0].Message, int(resp.Letters[0].Size))
+	require.Len(t, resp.Letters[1].Message, 64)
+	require.Equal(
+		t,
+		uint64((&pb.Message{Data: large}).Size()),
+		resp.Letters[1].Size,
+	)
+}
@@ -0,0 +1,143 @@
+package p2p
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/peerstore"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/libp2p/go-libp2p/core/test"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+)
+
+const testDirectChannelProtocol = protocol.ID("/p2p/direct-channel/test")
+
+func newTestDirectChannelBlossomSub(
+	t *testing.T,
+	clk clock.Clock,
+	retries int,
+) *BlossomSub {
+	h, err := libp2p.New(libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"))
+	require.NoError(t, err)
+	t.Cleanup(func() { h.Close() })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	return &BlossomSub{
+		ctx:                      ctx,
+		logger:                   zap.NewNop(),
+		clock:                    clk,
+		h:                        h,
+		directChannelDialRetries: retries,
+		directChannelDialBackoff: time.Second,
+	}
+}
+
+type dialResult struct {
+	conn net.Conn
+	err  error
+}
+
+func dialDirectChannel(b *BlossomSub, id peer.ID) <-chan dialResult {
+	done := make(chan dialResult, 1)
+	go func() {
+		conn, err := b.dialDirectChannel(id, testDirectChannelProtocol, "test")
+		done <- dialResult{conn: conn, err: err}
+	}()
+	return done
+}
+
+func awaitDial(t *testing.T, done <-chan dialResult) dialResult {
+	select {
+	case r := <-done:
+		return r
+	case <-time.After(10 * time.Second):
+		t.Fatal("direct channel dial did not return")
+		return dialResult{}
+	}
+}
+
+func TestDialDirectChannelRetries(t *testing.T) {
+	clk := clock.NewFakeClock(time.Unix(1700000000, 0))
+	a := newTestDirectChannelBlossomSub(t, clk, 2)
+	b := newTestDirectChannelBlossomSub(t, clock.NewRealClock(), 0)
+	b.h.SetStreamHandler(testDirectChannelProtocol, func(network.Stream) {})
+
+	// Without an address the peer is offline, and is dialed again after the
+	// backoff.
+	done := dialDirectChannel(a, b.h.ID())
+	clk.BlockUntil(1)
+	a.h.Peerstore().AddAddrs(b.h.ID(), b.h.Addrs(), peerstore.PermanentAddrTTL)
+	clk.Advance(time.Second)
+	r := awaitDial(t, done)
+	require.NoError(t, r.err)
+	r.conn.Close()
+
+	// The backoff doubles with each retry, and the last failure is returned.
+	done = dialDirectChannel(a, test.RandPeerIDFatal(t))
+	clk.BlockUntil(1)
+	clk.Advance(time.Second)
+	clk.BlockUntil(1)
+	clk.Advance(time.Second)
+	select {
+	case <-done:
+		t.Fatal("retried before the backoff")
+	case <-time.After(100 * time.Millisecond):
+	}
+	clk.Advance(time.Second)
+	require.ErrorIs(t, awaitDial(t, done).err, ErrPeerOffline)
+}
+
+func TestDialDirectChannelWithoutRetries(t *testing.T) {
+	a := newTestDirectChannelBlossomSub(
+		t,
+		clock.NewFakeClock(time.Unix(1700000000, 0)),
+		0,
+	)
+
+	// The first failure is returned without waiting.
+	r := awaitDial(t, dialDirectChannel(a, test.RandPeerIDFatal(t)))
+	require.ErrorIs(t, r.err, ErrPeerOffline)
+	require.NotErrorIs(t, r.err, ErrProtocolNotSupported)
+}
+
+func TestDialDirectChannelProtocolNotSupported(t *testing.T) {
+	a := newTestDirectChannelBlossomSub(
+		t,
+		clock.NewFakeClock(time.Unix(1700000000, 0)),
+		2,
+	)
+	b := newTestDirectChannelBlossomSub(t, clock.NewRealClock(), 0)
+	require.NoError(t, a.h.Connect(context.Background(), peer.AddrInfo{
+		ID:    b.h.ID(),
+		Addrs: b.h.Addrs(),
+	}))
+
+	// A connected peer not serving the channel fails at once, since retrying
+	// would not change the answer.
+	r := awaitDial(t, dialDirectChannel(a, b.h.ID()))
+	require.ErrorIs(t, r.err, ErrProtocolNotSupported)
+	require.NotErrorIs(t, r.err, ErrPeerOffline)
+}
+
+func TestDirectChannelDialRetriesDefault(t *testing.T) {
+	require.Equal(
+		t,
+		defaultDirectChannelDialRetries,
+		*withDefaults(&config.P2PConfig{}).DirectChannelDialRetries,
+	)
+
+	// Zero is kept, disabling retries, rather than replaced by the default.
+	retries := 0
+	require.Zero(t, *withDefaults(&config.P2PConfig{
+		DirectChannelDialRetries: &retries,
+	}).DirectChannelDialRetries)
+}
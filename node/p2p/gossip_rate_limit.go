@@ -0,0 +1,82 @@
+package p2p
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	blossomsub "source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub"
+	"source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub/pb"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p/internal"
+)
+
+// newGossipRateLimiter builds the limiter of P2PConfig.GossipLimits, or nil if
+// none are configured.
+func newGossipRateLimiter(
+	clk clock.Clock,
+	p2pConfig *config.P2PConfig,
+) (*internal.GossipRateLimiter, error) {
+	if len(p2pConfig.GossipLimits) == 0 {
+		return nil, nil
+	}
+
+	limits := map[string]internal.GossipRateLimit{}
+	fallback := internal.GossipRateLimit{}
+	for _, l := range p2pConfig.GossipLimits {
+		limit := internal.GossipRateLimit{
+			MessagesPerSecond: l.MessagesPerSecond,
+			BytesPerSecond:    l.BytesPerSecond,
+		}
+		if l.Bitmask == "" {
+			fallback = limit
+			continue
+		}
+
+		bitmask, err := hex.DecodeString(strings.TrimPrefix(l.Bitmask, "0x"))
+		if err != nil {
+			return nil, errors.Wrap(err, "new gossip rate limiter")
+		}
+		limits[string(bitmask)] = limit
+	}
+
+	return internal.NewGossipRateLimiter(clk, limits, fallback), nil
+}
+
+// inspectGossipRate drops the messages of the RPC over the sender's rate. The
+// sender is not penalized for them until one of its messages fails validation,
+// see penalizeGossipRate, as honest peers relaying a burst exceed it too.
+func (b *BlossomSub) inspectGossipRate(
+	limiter *internal.GossipRateLimiter,
+) func(peer.ID, *blossomsub.RPC) error {
+	return func(from peer.ID, rpc *blossomsub.RPC) error {
+		if len(rpc.Publish) == 0 {
+			return nil
+		}
+
+		allowed := make([]*pb.Message, 0, len(rpc.Publish))
+		for _, msg := range rpc.Publish {
+			if limiter.Allow(from, msg.Bitmask, msg.Size()) {
+				allowed = append(allowed, msg)
+			}
+		}
+
+		rpc.Publish = allowed
+		return nil
+	}
+}
+
+// penalizeGossipRate lowers the app specific score of a peer whose message
+// failed validation by the gossip limit penalty for each of its messages
+// recently dropped by the rate limiter, so that a peer flooding the network
+// with invalid messages is eventually graylisted.
+func (b *BlossomSub) penalizeGossipRate(from peer.ID) {
+	if b.gossipLimiter == nil || b.gossipLimitPenalty <= 0 {
+		return
+	}
+	if dropped := b.gossipLimiter.TakeDropped(from); dropped != 0 {
+		b.AddPeerScore([]byte(from), -b.gossipLimitPenalty*int64(dropped))
+	}
+}
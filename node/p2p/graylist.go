@@ -0,0 +1,172 @@
+package p2p
+
+import (
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+const (
+	// App specific score of a peer exempt from graylisting, enough to outweigh
+	// any penalties it accrued.
+	graylistOverrideScore = 1_000_000
+	// Longest an operator can exempt a peer from graylisting at once.
+	maxGraylistOverride = 24 * time.Hour
+)
+
+func (b *BlossomSub) graylistOverridden(p peer.ID) bool {
+	b.graylistMx.Lock()
+	defer b.graylistMx.Unlock()
+
+	until, ok := b.graylistOverrides[p]
+	if !ok {
+		return false
+	}
+	if b.clock.Now().After(until) {
+		delete(b.graylistOverrides, p)
+		return false
+	}
+	return true
+}
+
+func (b *BlossomSub) graylistOverrideUntil(p peer.ID) time.Time {
+	b.graylistMx.Lock()
+	defer b.graylistMx.Unlock()
+	return b.graylistOverrides[p]
+}
+
+// appSpecificScore is the application part of a peer's BlossomSub score,
+// the score set by the application adjusted by the health of the peer.
+func (b *BlossomSub) appSpecificScore(p peer.ID) float64 {
+	if b.graylistOverridden(p) {
+		return graylistOverrideScore
+	}
+	return float64(b.GetPeerScore([]byte(p))) + b.peerHealth.Score(p)
+}
+
+// OverrideGraylist exempts the peer from graylisting for duration, capped at
+// maxGraylistOverride, and dials it if it is not connected. A duration of
+// zero or less lifts a previous override.
+func (b *BlossomSub) OverrideGraylist(
+	peerId []byte,
+	duration time.Duration,
+) *protobufs.ScoredPeer {
+	p := peer.ID(peerId)
+
+	b.graylistMx.Lock()
+	if duration <= 0 {
+		delete(b.graylistOverrides, p)
+	} else {
+		if b.graylistOverrides == nil {
+			b.graylistOverrides = make(map[peer.ID]time.Time)
+		}
+		b.graylistOverrides[p] = b.clock.Now().Add(
+			min(duration, maxGraylistOverride),
+		)
+	}
+	b.graylistMx.Unlock()
+
+	if duration > 0 && len(b.h.Network().ConnsToPeer(p)) == 0 {
+		go func() {
+			info := b.h.Peerstore().PeerInfo(p)
+			if err := b.h.Connect(b.ctx, info); err != nil {
+				b.logger.Debug(
+					"could not connect to overridden peer",
+					zap.String("peer_id", p.String()),
+					zap.Error(err),
+				)
+			}
+		}()
+	}
+
+	threshold, _ := b.graylistThreshold()
+	return b.scoredPeer(p, threshold)
+}
+
+// GetScoredPeers lists the connected peers with a negative score, which
+// includes the graylisted ones, and the peers exempt from graylisting.
+func (b *BlossomSub) GetScoredPeers() (*protobufs.ScoredPeersResponse, error) {
+	threshold, err := b.graylistThreshold()
+	if err != nil {
+		return nil, errors.Wrap(err, "get scored peers")
+	}
+
+	resp := &protobufs.ScoredPeersResponse{GraylistThreshold: threshold}
+	seen := map[peer.ID]struct{}{}
+	for _, p := range b.h.Network().Peers() {
+		seen[p] = struct{}{}
+		if b.ps.PeerScore(p) < 0 || b.graylistOverridden(p) {
+			resp.Peers = append(resp.Peers, b.scoredPeer(p, threshold))
+		}
+	}
+
+	b.graylistMx.Lock()
+	overridden := make([]peer.ID, 0, len(b.graylistOverrides))
+	for p := range b.graylistOverrides {
+		if _, ok := seen[p]; !ok {
+			overridden = append(overridden, p)
+		}
+	}
+	b.graylistMx.Unlock()
+
+	for _, p := range overridden {
+		if b.graylistOverridden(p) {
+			resp.Peers = append(resp.Peers, b.scoredPeer(p, threshold))
+		}
+	}
+
+	return resp, nil
+}
+
+func (b *BlossomSub) graylistThreshold() (float64, error) {
+	thresholds, err := b.rt.PeerScoreThresholds()
+	if err != nil {
+		return 0, err
+	}
+	return thresholds.GraylistThreshold, nil
+}
+
+func (b *BlossomSub) scoredPeer(
+	p peer.ID,
+	threshold float64,
+) *protobufs.ScoredPeer {
+	score := b.ps.PeerScore(p)
+	resp := &protobufs.ScoredPeer{
+		PeerId:     []byte(p),
+		PeerScore:  score,
+		Graylisted: score < threshold,
+	}
+	if until := b.graylistOverrideUntil(p); !until.IsZero() {
+		resp.OverrideUntil = until.UnixMilli()
+	}
+	return resp
+}
+
+// GetPeerHealth returns the results of the peer monitor pings of the connected
+// peers, worst first.
+func (b *BlossomSub) GetPeerHealth() *protobufs.PeerHealthResponse {
+	resp := &protobufs.PeerHealthResponse{}
+	if b.peerHealth == nil {
+		return resp
+	}
+	for _, status := range b.peerHealth.Statuses() {
+		health := &protobufs.PeerHealth{
+			PeerId:              []byte(status.PeerID),
+			ConsecutiveFailures: uint32(status.ConsecutiveFailures),
+			Pings:               uint64(status.Pings),
+			FailedPings:         uint64(status.FailedPings),
+			Reconnects:          uint32(status.Reconnects),
+			LastRtt:             status.LastRTT.Milliseconds(),
+			AverageRtt:          status.AverageRTT.Milliseconds(),
+			Score:               status.Score,
+		}
+		if !status.LastPing.IsZero() {
+			health.LastPing = status.LastPing.UnixMilli()
+		}
+		resp.Peers = append(resp.Peers, health)
+	}
+	return resp
+}
@@ -0,0 +1,66 @@
+package p2p
+
+import (
+	"encoding/hex"
+
+	"github.com/iden3/go-iden3-crypto/poseidon"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	"source.quilibrium.com/quilibrium/monorepo/node/keys"
+)
+
+// Identity is the network identity of a node, as derived from its config.
+type Identity struct {
+	PeerID peer.ID
+	// The address under which the node appears in the prover tries, the
+	// Poseidon hash of the proving public key, or nil if the proving key is
+	// not known.
+	ProverAddress []byte
+}
+
+// DeriveIdentity derives the peer ID of the node the config belongs to
+// without starting a host, and its prover address if keyManager holds the
+// proving key. keyManager may be nil.
+func DeriveIdentity(
+	p2pConfig *config.P2PConfig,
+	keyManager keys.KeyManager,
+	provingKeyId string,
+) (*Identity, error) {
+	peerPrivKey, err := hex.DecodeString(p2pConfig.PeerPrivKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "derive identity: decode peer key")
+	}
+
+	privKey, err := crypto.UnmarshalEd448PrivateKey(peerPrivKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "derive identity: unmarshal peer key")
+	}
+
+	id, err := peer.IDFromPublicKey(privKey.GetPublic())
+	if err != nil {
+		return nil, errors.Wrap(err, "derive identity: peer id")
+	}
+
+	identity := &Identity{PeerID: id}
+	if keyManager == nil {
+		return identity, nil
+	}
+
+	rawKey, err := keyManager.GetRawKey(provingKeyId)
+	if errors.Is(err, keys.KeyNotFoundErr) {
+		return identity, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "derive identity: proving key")
+	}
+
+	h, err := poseidon.HashBytes(rawKey.PublicKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "derive identity: prover address")
+	}
+	identity.ProverAddress = h.FillBytes(make([]byte, 32))
+
+	return identity, nil
+}
@@ -0,0 +1,51 @@
+package p2p_test
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"testing"
+
+	"github.com/iden3/go-iden3-crypto/poseidon"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	"source.quilibrium.com/quilibrium/monorepo/node/keys"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p"
+)
+
+func TestDeriveIdentity(t *testing.T) {
+	privKey, pubKey, err := crypto.GenerateEd448Key(rand.Reader)
+	require.NoError(t, err)
+	raw, err := privKey.Raw()
+	require.NoError(t, err)
+	expected, err := peer.IDFromPublicKey(pubKey)
+	require.NoError(t, err)
+
+	cfg := &config.P2PConfig{PeerPrivKey: hex.EncodeToString(raw)}
+	identity, err := p2p.DeriveIdentity(cfg, nil, "")
+	require.NoError(t, err)
+	require.Equal(t, expected, identity.PeerID)
+	require.Nil(t, identity.ProverAddress)
+
+	// The prover address is that of the proving key, once there is one.
+	keyManager := keys.NewInMemoryKeyManager()
+	identity, err = p2p.DeriveIdentity(cfg, keyManager, "default-proving-key")
+	require.NoError(t, err)
+	require.Nil(t, identity.ProverAddress)
+
+	_, err = keyManager.CreateSigningKey("default-proving-key", keys.KeyTypeEd448)
+	require.NoError(t, err)
+	provingKey, err := keyManager.GetRawKey("default-proving-key")
+	require.NoError(t, err)
+	address, err := poseidon.HashBytes(provingKey.PublicKey)
+	require.NoError(t, err)
+
+	identity, err = p2p.DeriveIdentity(cfg, keyManager, "default-proving-key")
+	require.NoError(t, err)
+	require.Equal(t, expected, identity.PeerID)
+	require.Equal(t, address.FillBytes(make([]byte, 32)), identity.ProverAddress)
+
+	_, err = p2p.DeriveIdentity(&config.P2PConfig{PeerPrivKey: "zz"}, nil, "")
+	require.Error(t, err)
+}
@@ -0,0 +1,102 @@
+package internal
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/libp2p/go-libp2p/core/event"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/p2p/host/eventbus"
+	ma "github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+)
+
+var publicAddressChangesTotal = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Namespace: "p2p",
+		Name:      "public_address_changes_total",
+		Help:      "Times the set of public IP addresses of the host changed.",
+	},
+)
+
+func init() {
+	prometheus.MustRegister(publicAddressChangesTotal)
+}
+
+// publicIPs returns the sorted, deduplicated public IP addresses the host is
+// listening on or has been observed at.
+func publicIPs(addrs []ma.Multiaddr) []string {
+	seen := map[string]struct{}{}
+	for _, addr := range addrs {
+		if public, err := manet.IsPublicAddr(addr); err != nil || !public {
+			continue
+		}
+		ip, err := manet.ToIP(addr)
+		if err != nil {
+			continue
+		}
+		seen[ip.String()] = struct{}{}
+	}
+
+	ips := make([]string, 0, len(seen))
+	for ip := range seen {
+		ips = append(ips, ip)
+	}
+	sort.Strings(ips)
+	return ips
+}
+
+// MonitorPublicAddresses watches the addresses of the host, including those
+// observed by peers through identify, and calls onChange whenever the set of
+// public IP addresses changes from a previously known, non-empty set. This
+// lets the host refresh its records right away rather than staying
+// unreachable until they expire.
+func MonitorPublicAddresses(
+	ctx context.Context,
+	logger *zap.Logger,
+	h host.Host,
+	onChange func(ctx context.Context),
+) error {
+	sub, err := h.EventBus().Subscribe(
+		&event.EvtLocalAddressesUpdated{},
+		eventbus.Name("address-monitor"),
+	)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		defer sub.Close()
+		previous := publicIPs(h.Addrs())
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case _, ok := <-sub.Out():
+				if !ok {
+					return
+				}
+				current := publicIPs(h.Addrs())
+				if len(current) == 0 ||
+					strings.Join(current, ",") == strings.Join(previous, ",") {
+					continue
+				}
+				if len(previous) != 0 {
+					publicAddressChangesTotal.Inc()
+					logger.Info(
+						"public address changed, re-announcing",
+						zap.Strings("previous", previous),
+						zap.Strings("current", current),
+					)
+					onChange(ctx)
+				}
+				previous = current
+			}
+		}
+	}()
+
+	return nil
+}
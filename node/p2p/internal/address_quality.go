@@ -0,0 +1,209 @@
+package internal
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/p2p/net/swarm"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prometheus/client_golang/prometheus"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+)
+
+const (
+	// Maximum number of addresses scored, beyond which the worst address is
+	// forgotten to make room.
+	addressQualityMaxAddrs = 16384
+	// How long a collected address is kept out of the peerstore.
+	addressQualityBackoff = time.Hour
+	// How much later than proven addresses other addresses are dialed.
+	addressQualityUnprovenDelay = 250 * time.Millisecond
+)
+
+var addressesCollectedTotal = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Namespace: "p2p",
+		Name:      "addresses_collected_total",
+		Help:      "Peer addresses removed from the peerstore after repeatedly failing.",
+	},
+)
+
+func init() {
+	prometheus.MustRegister(addressesCollectedTotal)
+}
+
+type addrScore struct {
+	peer         peer.ID
+	successes    int
+	failures     int
+	backoffUntil time.Time
+}
+
+// worseThan reports whether s is less worth remembering than other: it
+// succeeded fewer times or, as often, failed more times.
+func (s *addrScore) worseThan(other *addrScore) bool {
+	if s.successes != other.successes {
+		return s.successes < other.successes
+	}
+	return s.failures > other.failures
+}
+
+// AddressQuality tracks which peer addresses succeed when dialed. Proven
+// addresses are dialed ahead of the others, and addresses that fail
+// failureLimit times in a row without ever succeeding are removed from the
+// peerstore and kept out of it for a while.
+type AddressQuality struct {
+	mx           sync.Mutex
+	addrs        map[string]*addrScore
+	failureLimit int
+	host         host.Host
+	clock        clock.Clock
+}
+
+// NewAddressQuality creates an address quality tracker. A failureLimit of
+// zero or less never collects addresses.
+func NewAddressQuality(clk clock.Clock, failureLimit int) *AddressQuality {
+	return &AddressQuality{
+		addrs:        make(map[string]*addrScore),
+		failureLimit: failureLimit,
+		clock:        clk,
+	}
+}
+
+// Attach starts recording successful connections of the host.
+func (q *AddressQuality) Attach(h host.Host) {
+	q.mx.Lock()
+	q.host = h
+	q.mx.Unlock()
+
+	h.Network().Notify(&network.NotifyBundle{
+		ConnectedF: func(_ network.Network, conn network.Conn) {
+			if conn.Stat().Direction != network.DirOutbound {
+				return
+			}
+			q.observeSuccess(conn.RemotePeer(), conn.RemoteMultiaddr())
+		},
+	})
+}
+
+func (q *AddressQuality) scoreLocked(p peer.ID, addr ma.Multiaddr) *addrScore {
+	key := addr.String()
+	score, ok := q.addrs[key]
+	if !ok || score.peer != p {
+		if !ok && len(q.addrs) >= addressQualityMaxAddrs {
+			q.evictLocked()
+		}
+		score = &addrScore{peer: p}
+		q.addrs[key] = score
+	}
+	return score
+}
+
+// evictLocked forgets the worst scored address.
+func (q *AddressQuality) evictLocked() {
+	var worstKey string
+	var worst *addrScore
+	for k, score := range q.addrs {
+		if worst == nil || score.worseThan(worst) {
+			worstKey, worst = k, score
+		}
+	}
+	delete(q.addrs, worstKey)
+}
+
+func (q *AddressQuality) observeSuccess(p peer.ID, addr ma.Multiaddr) {
+	q.mx.Lock()
+	defer q.mx.Unlock()
+
+	score := q.scoreLocked(p, addr)
+	score.successes++
+	score.failures = 0
+	score.backoffUntil = time.Time{}
+}
+
+// ObserveDialError records the addresses that failed in a dial of p.
+func (q *AddressQuality) ObserveDialError(p peer.ID, err error) {
+	if q == nil {
+		return
+	}
+
+	var dialErr *swarm.DialError
+	if !errors.As(err, &dialErr) {
+		return
+	}
+
+	q.mx.Lock()
+	defer q.mx.Unlock()
+
+	for _, te := range dialErr.DialErrors {
+		score := q.scoreLocked(p, te.Address)
+		score.failures++
+		if q.failureLimit <= 0 || score.successes != 0 ||
+			score.failures < q.failureLimit || q.host == nil {
+			continue
+		}
+
+		score.backoffUntil = q.clock.Now().Add(addressQualityBackoff)
+		q.host.Peerstore().SetAddr(p, te.Address, 0)
+		addressesCollectedTotal.Inc()
+	}
+}
+
+// Usable filters out the addresses of p that were collected and are still
+// being kept out of the peerstore.
+func (q *AddressQuality) Usable(p peer.ID, addrs []ma.Multiaddr) []ma.Multiaddr {
+	if q == nil {
+		return addrs
+	}
+
+	q.mx.Lock()
+	defer q.mx.Unlock()
+
+	now := q.clock.Now()
+	usable := make([]ma.Multiaddr, 0, len(addrs))
+	for _, addr := range addrs {
+		score, ok := q.addrs[addr.String()]
+		if ok && score.peer == p && now.Before(score.backoffUntil) {
+			continue
+		}
+		usable = append(usable, addr)
+	}
+	return usable
+}
+
+// DialRanker wraps ranker so that proven addresses are dialed first, staggered
+// as ranked by ranker, and the remaining addresses, ranked the same way,
+// shortly after the last proven one.
+func (q *AddressQuality) DialRanker(ranker network.DialRanker) network.DialRanker {
+	return func(addrs []ma.Multiaddr) []network.AddrDelay {
+		q.mx.Lock()
+		var proven, rest []ma.Multiaddr
+		for _, addr := range addrs {
+			if score, ok := q.addrs[addr.String()]; ok && score.successes != 0 {
+				proven = append(proven, addr)
+			} else {
+				rest = append(rest, addr)
+			}
+		}
+		q.mx.Unlock()
+
+		if len(proven) == 0 {
+			return ranker(addrs)
+		}
+
+		ranked := ranker(proven)
+		delay := time.Duration(0)
+		for _, ad := range ranked {
+			delay = max(delay, ad.Delay)
+		}
+		for _, ad := range ranker(rest) {
+			ad.Delay += delay + addressQualityUnprovenDelay
+			ranked = append(ranked, ad)
+		}
+		return ranked
+	}
+}
@@ -0,0 +1,167 @@
+package internal_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/peerstore"
+	"github.com/libp2p/go-libp2p/p2p/net/swarm"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/require"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p/internal"
+)
+
+func dialError(p peer.ID, addrs ...ma.Multiaddr) error {
+	dialErr := &swarm.DialError{Peer: p}
+	for _, addr := range addrs {
+		dialErr.DialErrors = append(dialErr.DialErrors, swarm.TransportError{
+			Address: addr,
+			Cause:   errors.New("connection refused"),
+		})
+	}
+	return dialErr
+}
+
+// newAddressQualityHosts returns a client host attached to q, connected to
+// the returned server over its proven address.
+func newAddressQualityHosts(
+	t *testing.T,
+	q *internal.AddressQuality,
+) (client host.Host, server host.Host) {
+	server, err := libp2p.New(libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"))
+	require.NoError(t, err)
+	t.Cleanup(func() { server.Close() })
+
+	client, err = libp2p.New(libp2p.NoListenAddrs)
+	require.NoError(t, err)
+	t.Cleanup(func() { client.Close() })
+	q.Attach(client)
+
+	require.NoError(t, client.Connect(context.Background(), peer.AddrInfo{
+		ID:    server.ID(),
+		Addrs: server.Addrs(),
+	}))
+	return client, server
+}
+
+// flatRanker dials every address at once.
+func flatRanker(addrs []ma.Multiaddr) []network.AddrDelay {
+	ranked := make([]network.AddrDelay, 0, len(addrs))
+	for _, addr := range addrs {
+		ranked = append(ranked, network.AddrDelay{Addr: addr})
+	}
+	return ranked
+}
+
+func TestAddressQualityDialRanker(t *testing.T) {
+	q := internal.NewAddressQuality(clock.NewFakeClock(time.Unix(0, 0)), 3)
+	_, server := newAddressQualityHosts(t, q)
+	proven := server.Addrs()[0]
+	unproven := mustMultiaddr(t, "/ip4/127.0.0.1/tcp/1")
+
+	// Without a proven address the ranking is left to the wrapped ranker.
+	ranker := q.DialRanker(swarm.DefaultDialRanker)
+	require.Equal(
+		t,
+		swarm.DefaultDialRanker([]ma.Multiaddr{unproven}),
+		ranker([]ma.Multiaddr{unproven}),
+	)
+
+	// Proven addresses go first, staggered by the wrapped ranker, and the
+	// others after the last of them.
+	staggered := func(addrs []ma.Multiaddr) []network.AddrDelay {
+		ranked := flatRanker(addrs)
+		for i := range ranked {
+			ranked[i].Delay = time.Duration(i) * time.Second
+		}
+		return ranked
+	}
+	ranked := q.DialRanker(staggered)([]ma.Multiaddr{unproven, proven})
+	require.Len(t, ranked, 2)
+	require.Equal(t, proven, ranked[0].Addr)
+	require.Zero(t, ranked[0].Delay)
+	require.Equal(t, unproven, ranked[1].Addr)
+	require.Equal(t, 250*time.Millisecond, ranked[1].Delay)
+}
+
+func TestAddressQualityCollectsFailingAddresses(t *testing.T) {
+	clk := clock.NewFakeClock(time.Unix(0, 0))
+	q := internal.NewAddressQuality(clk, 3)
+	client, server := newAddressQualityHosts(t, q)
+	proven := server.Addrs()[0]
+	failing := mustMultiaddr(t, "/ip4/127.0.0.1/tcp/1")
+	client.Peerstore().AddAddr(server.ID(), failing, peerstore.PermanentAddrTTL)
+
+	// Addresses that never succeeded are collected once they reach the
+	// failure limit, proven ones never are.
+	for i := 0; i < 3; i++ {
+		require.ElementsMatch(
+			t,
+			[]ma.Multiaddr{proven, failing},
+			q.Usable(server.ID(), []ma.Multiaddr{proven, failing}),
+		)
+		q.ObserveDialError(server.ID(), dialError(server.ID(), proven, failing))
+	}
+	require.Equal(
+		t,
+		[]ma.Multiaddr{proven},
+		q.Usable(server.ID(), []ma.Multiaddr{proven, failing}),
+	)
+	require.NotContains(t, client.Peerstore().Addrs(server.ID()), failing)
+
+	// The same address of another peer is unaffected.
+	other := peer.ID("other")
+	require.Equal(
+		t,
+		[]ma.Multiaddr{failing},
+		q.Usable(other, []ma.Multiaddr{failing}),
+	)
+
+	// Collected addresses are usable again after the backoff.
+	clk.Advance(time.Hour)
+	require.Equal(
+		t,
+		[]ma.Multiaddr{proven, failing},
+		q.Usable(server.ID(), []ma.Multiaddr{proven, failing}),
+	)
+
+	// Errors other than dial errors are ignored.
+	q.ObserveDialError(server.ID(), errors.New("no addresses"))
+}
+
+func TestAddressQualityEvictsWorstAddress(t *testing.T) {
+	q := internal.NewAddressQuality(clock.NewFakeClock(time.Unix(0, 0)), 3)
+	_, server := newAddressQualityHosts(t, q)
+	proven := server.Addrs()[0]
+	p := server.ID()
+
+	// An address one failure short of the limit is the worst scored once the
+	// tracker is full of addresses that failed once.
+	worst := mustMultiaddr(t, "/ip4/127.0.0.2/tcp/1")
+	q.ObserveDialError(p, dialError(p, worst))
+	q.ObserveDialError(p, dialError(p, worst))
+	for i := 0; i < 16382; i++ {
+		q.ObserveDialError(p, dialError(p, mustMultiaddr(t, fmt.Sprintf(
+			"/ip4/127.1.%d.%d/tcp/1",
+			i/256,
+			i%256,
+		))))
+	}
+	q.ObserveDialError(p, dialError(p, mustMultiaddr(t, "/ip4/127.0.0.3/tcp/1")))
+
+	// Its failures were forgotten, so that a third one does not collect it,
+	// while the proven address is still dialed first.
+	q.ObserveDialError(p, dialError(p, worst))
+	require.Equal(t, []ma.Multiaddr{worst}, q.Usable(p, []ma.Multiaddr{worst}))
+	ranked := q.DialRanker(flatRanker)([]ma.Multiaddr{worst, proven})
+	require.Equal(t, proven, ranked[0].Addr)
+	require.Zero(t, ranked[0].Delay)
+}
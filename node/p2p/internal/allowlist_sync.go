@@ -0,0 +1,74 @@
+package internal
+
+import (
+	rcmgr "github.com/libp2p/go-libp2p/p2p/host/resource-manager"
+	ma "github.com/multiformats/go-multiaddr"
+	"go.uber.org/zap"
+)
+
+// AllowlistSync keeps a set of addresses in the allowlist of the resource
+// manager, adding the addresses that join the set and removing those that
+// leave it.
+type AllowlistSync struct {
+	logger    *zap.Logger
+	allowlist *rcmgr.Allowlist
+	allowed   map[string]ma.Multiaddr
+}
+
+// NewAllowlistSync creates a sync of the allowlist, which may be nil if the
+// host has no resource manager. The initial addresses are taken to be in the
+// allowlist already.
+func NewAllowlistSync(
+	logger *zap.Logger,
+	allowlist *rcmgr.Allowlist,
+	initial []ma.Multiaddr,
+) *AllowlistSync {
+	s := &AllowlistSync{
+		logger:    logger,
+		allowlist: allowlist,
+		allowed:   map[string]ma.Multiaddr{},
+	}
+	for _, addr := range initial {
+		s.allowed[string(addr.Bytes())] = addr
+	}
+	return s
+}
+
+// Sync moves the allowlist from the previous set of addresses to the given
+// one. It is not safe for concurrent use.
+func (s *AllowlistSync) Sync(addrs []ma.Multiaddr) {
+	current := make(map[string]ma.Multiaddr, len(addrs))
+	for _, addr := range addrs {
+		current[string(addr.Bytes())] = addr
+	}
+
+	for key, addr := range current {
+		if _, ok := s.allowed[key]; ok {
+			continue
+		}
+		s.logger.Info("allowlisting address", zap.String("multiaddr", addr.String()))
+		if s.allowlist != nil {
+			if err := s.allowlist.Add(addr); err != nil {
+				s.logger.Debug("could not allowlist address", zap.Error(err))
+			}
+		}
+	}
+	for key, addr := range s.allowed {
+		if _, ok := current[key]; ok {
+			continue
+		}
+		s.logger.Info(
+			"removing address from allowlist",
+			zap.String("multiaddr", addr.String()),
+		)
+		if s.allowlist != nil {
+			if err := s.allowlist.Remove(addr); err != nil {
+				s.logger.Debug(
+					"could not remove address from allowlist",
+					zap.Error(err),
+				)
+			}
+		}
+	}
+	s.allowed = current
+}
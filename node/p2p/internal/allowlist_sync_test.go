@@ -0,0 +1,44 @@
+package internal_test
+
+import (
+	"testing"
+
+	rcmgr "github.com/libp2p/go-libp2p/p2p/host/resource-manager"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p/internal"
+)
+
+func TestAllowlistSyncMovesAddresses(t *testing.T) {
+	addr := func(s string) ma.Multiaddr {
+		m, err := ma.NewMultiaddr(s)
+		require.NoError(t, err)
+		return m
+	}
+	old := addr("/ip4/1.2.3.4/udp/8336/quic-v1")
+	kept := addr("/ip4/5.6.7.8/udp/8336/quic-v1")
+	added := addr("/ip4/9.9.9.9/udp/8336/quic-v1")
+
+	mgr, err := rcmgr.NewResourceManager(
+		rcmgr.NewFixedLimiter(rcmgr.DefaultLimits.AutoScale()),
+		rcmgr.WithAllowlistedMultiaddrs([]ma.Multiaddr{old, kept}),
+	)
+	require.NoError(t, err)
+	defer mgr.Close()
+	allowlist := rcmgr.GetAllowlist(mgr)
+
+	allowed := internal.NewAllowlistSync(
+		zap.NewNop(),
+		allowlist,
+		[]ma.Multiaddr{old, kept},
+	)
+	allowed.Sync([]ma.Multiaddr{kept, added})
+
+	require.False(t, allowlist.Allowed(old))
+	require.True(t, allowlist.Allowed(kept))
+	require.True(t, allowlist.Allowed(added))
+
+	// without a resource manager there is nothing to sync
+	internal.NewAllowlistSync(zap.NewNop(), nil, nil).Sync([]ma.Multiaddr{added})
+}
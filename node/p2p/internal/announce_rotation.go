@@ -0,0 +1,101 @@
+package internal
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/host"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+)
+
+var announceRotationsTotal = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Namespace: "p2p",
+		Name:      "announce_rotations_total",
+		Help:      "Times the announced address moved to the next one in the pool.",
+	},
+)
+
+func init() {
+	prometheus.MustRegister(announceRotationsTotal)
+}
+
+// AnnounceRotation announces one address of a pool at a time in place of the
+// addresses the host listens on, moving to the next one on a schedule. The
+// announced address is swapped in a single step, so identify pushes, signed
+// peer records and DHT records never mix addresses of two fronts.
+type AnnounceRotation struct {
+	pool    []ma.Multiaddr
+	current atomic.Int64
+}
+
+// NewAnnounceRotation parses the pool of announce addresses.
+func NewAnnounceRotation(addrs []string) (*AnnounceRotation, error) {
+	if len(addrs) == 0 {
+		return nil, errors.Wrap(
+			errors.New("empty announce address pool"),
+			"new announce rotation",
+		)
+	}
+
+	r := &AnnounceRotation{}
+	for _, addr := range addrs {
+		m, err := ma.NewMultiaddr(addr)
+		if err != nil {
+			return nil, errors.Wrap(err, "new announce rotation")
+		}
+		r.pool = append(r.pool, m)
+	}
+	return r, nil
+}
+
+// AddrsFactory is a libp2p address factory announcing the current address.
+func (r *AnnounceRotation) AddrsFactory([]ma.Multiaddr) []ma.Multiaddr {
+	return []ma.Multiaddr{r.pool[r.current.Load()]}
+}
+
+// Run moves to the next address every period until ctx is done, signalling
+// the host so that it pushes and republishes its addresses. A pool of one
+// address or a period of zero or less never rotates.
+func (r *AnnounceRotation) Run(
+	ctx context.Context,
+	logger *zap.Logger,
+	h host.Host,
+	clk clock.Clock,
+	period time.Duration,
+) {
+	if len(r.pool) < 2 || period <= 0 {
+		return
+	}
+
+	signaler, ok := h.(interface{ SignalAddressChange() })
+	if !ok {
+		logger.Warn("host does not support address change signals")
+		return
+	}
+
+	ticker := clk.NewTicker(period)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.Chan():
+		}
+
+		next := (r.current.Load() + 1) % int64(len(r.pool))
+		r.current.Store(next)
+		signaler.SignalAddressChange()
+		announceRotationsTotal.Inc()
+		logger.Info(
+			"rotated announce address",
+			zap.String("multiaddr", r.pool[next].String()),
+		)
+	}
+}
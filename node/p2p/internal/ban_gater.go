@@ -0,0 +1,154 @@
+package internal
+
+import (
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/connmgr"
+	"github.com/libp2p/go-libp2p/core/control"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/prometheus/client_golang/prometheus"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+)
+
+var bannedConnectionsTotal = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Namespace: "p2p",
+		Name:      "banned_connections_total",
+		Help:      "Connections to or from banned peers that were refused.",
+	},
+)
+
+func init() {
+	prometheus.MustRegister(bannedConnectionsTotal)
+}
+
+// BanGater refuses connections to and from the peers banned by the operator,
+// until each ban expires or is lifted. Bans are kept in memory only.
+type BanGater struct {
+	clock clock.Clock
+
+	mx sync.Mutex
+	// Expiry of each ban, zero for bans that do not expire.
+	bans map[peer.ID]time.Time
+}
+
+var _ connmgr.ConnectionGater = (*BanGater)(nil)
+
+// NewBanGater creates a ban gater with no bans.
+func NewBanGater(clk clock.Clock) *BanGater {
+	return &BanGater{
+		clock: clk,
+		bans:  map[peer.ID]time.Time{},
+	}
+}
+
+// Ban bans the peer for ttl, or until lifted if ttl is zero or less, and
+// returns when the ban expires, zero if it does not.
+func (g *BanGater) Ban(p peer.ID, ttl time.Duration) time.Time {
+	g.mx.Lock()
+	defer g.mx.Unlock()
+
+	until := time.Time{}
+	if ttl > 0 {
+		until = g.clock.Now().Add(ttl)
+	}
+	g.bans[p] = until
+
+	return until
+}
+
+// Unban lifts the ban of the peer, reporting whether it was banned.
+func (g *BanGater) Unban(p peer.ID) bool {
+	g.mx.Lock()
+	defer g.mx.Unlock()
+
+	_, ok := g.bans[p]
+	delete(g.bans, p)
+	g.pruneLocked()
+
+	return ok
+}
+
+// Bans returns the banned peers and when each ban expires, zero for bans that
+// do not expire.
+func (g *BanGater) Bans() map[peer.ID]time.Time {
+	g.mx.Lock()
+	defer g.mx.Unlock()
+
+	g.pruneLocked()
+	bans := make(map[peer.ID]time.Time, len(g.bans))
+	for p, until := range g.bans {
+		bans[p] = until
+	}
+
+	return bans
+}
+
+// Banned reports whether the peer is banned.
+func (g *BanGater) Banned(p peer.ID) bool {
+	g.mx.Lock()
+	defer g.mx.Unlock()
+
+	until, ok := g.bans[p]
+	if !ok {
+		return false
+	}
+	if !until.IsZero() && !g.clock.Now().Before(until) {
+		delete(g.bans, p)
+		return false
+	}
+
+	return true
+}
+
+func (g *BanGater) pruneLocked() {
+	now := g.clock.Now()
+	for p, until := range g.bans {
+		if !until.IsZero() && !now.Before(until) {
+			delete(g.bans, p)
+		}
+	}
+}
+
+func (g *BanGater) allow(p peer.ID) bool {
+	if g.Banned(p) {
+		bannedConnectionsTotal.Inc()
+		return false
+	}
+
+	return true
+}
+
+// InterceptPeerDial implements connmgr.ConnectionGater.
+func (g *BanGater) InterceptPeerDial(p peer.ID) bool {
+	return g.allow(p)
+}
+
+// InterceptAddrDial implements connmgr.ConnectionGater.
+func (g *BanGater) InterceptAddrDial(p peer.ID, _ multiaddr.Multiaddr) bool {
+	return g.allow(p)
+}
+
+// InterceptAccept implements connmgr.ConnectionGater.
+func (g *BanGater) InterceptAccept(network.ConnMultiaddrs) bool {
+	return true
+}
+
+// InterceptSecured implements connmgr.ConnectionGater.
+func (g *BanGater) InterceptSecured(
+	_ network.Direction,
+	p peer.ID,
+	_ network.ConnMultiaddrs,
+) bool {
+	return g.allow(p)
+}
+
+// InterceptUpgraded implements connmgr.ConnectionGater.
+func (g *BanGater) InterceptUpgraded(
+	network.Conn,
+) (bool, control.DisconnectReason) {
+	return true, 0
+}
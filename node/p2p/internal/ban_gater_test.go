@@ -0,0 +1,56 @@
+package internal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p/internal"
+)
+
+func TestBanGater(t *testing.T) {
+	clk := clock.NewFakeClock(time.Unix(1700000000, 0))
+	g := internal.NewBanGater(clk)
+	addrs := remoteAddrs(t, "10.0.0.1")
+
+	// Banned peers are refused in both directions, others are not, and
+	// nothing is refused before the peer is known.
+	until := g.Ban("temporary", time.Hour)
+	require.Equal(t, clk.Now().Add(time.Hour), until)
+	require.True(t, g.Ban("permanent", 0).IsZero())
+	for _, p := range []peer.ID{"temporary", "permanent"} {
+		require.True(t, g.Banned(p))
+		require.False(t, g.InterceptPeerDial(p))
+		require.False(t, g.InterceptAddrDial(p, addrs.remote))
+		require.False(t, g.InterceptSecured(network.DirInbound, p, addrs))
+		require.False(t, g.InterceptSecured(network.DirOutbound, p, addrs))
+	}
+	require.True(t, g.InterceptPeerDial("other"))
+	require.True(t, g.InterceptSecured(network.DirInbound, "other", addrs))
+	require.True(t, g.InterceptAccept(addrs))
+	allow, _ := g.InterceptUpgraded(nil)
+	require.True(t, allow)
+	require.Equal(t, map[peer.ID]time.Time{
+		"temporary": until,
+		"permanent": {},
+	}, g.Bans())
+
+	// Bans expire after their ttl, others last until lifted.
+	clk.Advance(time.Hour)
+	require.False(t, g.Banned("temporary"))
+	require.True(t, g.InterceptPeerDial("temporary"))
+	require.Equal(t, map[peer.ID]time.Time{"permanent": {}}, g.Bans())
+	require.True(t, g.Unban("permanent"))
+	require.False(t, g.Unban("permanent"))
+	require.True(t, g.InterceptPeerDial("permanent"))
+	require.Empty(t, g.Bans())
+
+	// Banning again replaces the previous ban.
+	g.Ban("peer", 0)
+	g.Ban("peer", time.Minute)
+	clk.Advance(time.Minute)
+	require.False(t, g.Banned("peer"))
+}
@@ -0,0 +1,228 @@
+package internal
+
+import (
+	"context"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/metrics"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/prometheus/client_golang/prometheus"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+)
+
+var (
+	bandwidthBytesDesc = prometheus.NewDesc(
+		"p2p_bandwidth_bytes_total",
+		"Bytes transferred over all connections.",
+		[]string{"direction"},
+		nil,
+	)
+	bandwidthRateDesc = prometheus.NewDesc(
+		"p2p_bandwidth_bytes_per_second",
+		"Smoothed transfer rate over all connections.",
+		[]string{"direction"},
+		nil,
+	)
+	protocolBandwidthBytesDesc = prometheus.NewDesc(
+		"p2p_protocol_bandwidth_bytes_total",
+		"Bytes transferred over streams, by protocol.",
+		[]string{"protocol", "direction"},
+		nil,
+	)
+	protocolBandwidthRateDesc = prometheus.NewDesc(
+		"p2p_protocol_bandwidth_bytes_per_second",
+		"Smoothed transfer rate over streams, by protocol.",
+		[]string{"protocol", "direction"},
+		nil,
+	)
+	peerBandwidthBytesDesc = prometheus.NewDesc(
+		"p2p_peer_bandwidth_bytes_total",
+		"Bytes transferred over streams, by peer, for the busiest peers.",
+		[]string{"peer_id", "direction"},
+		nil,
+	)
+	peerBandwidthRateDesc = prometheus.NewDesc(
+		"p2p_peer_bandwidth_bytes_per_second",
+		"Smoothed transfer rate over streams, by peer, for the busiest peers.",
+		[]string{"peer_id", "direction"},
+		nil,
+	)
+)
+
+var observedBandwidth atomic.Pointer[Bandwidth]
+
+type bandwidthCollector struct{}
+
+func init() {
+	prometheus.MustRegister(bandwidthCollector{})
+}
+
+// ObserveBandwidth sets the bandwidth accounting reported by the bandwidth
+// metrics.
+func ObserveBandwidth(b *Bandwidth) {
+	observedBandwidth.Store(b)
+}
+
+// Describe implements prometheus.Collector.
+func (bandwidthCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- bandwidthBytesDesc
+	ch <- bandwidthRateDesc
+	ch <- protocolBandwidthBytesDesc
+	ch <- protocolBandwidthRateDesc
+	ch <- peerBandwidthBytesDesc
+	ch <- peerBandwidthRateDesc
+}
+
+// Collect implements prometheus.Collector.
+func (bandwidthCollector) Collect(ch chan<- prometheus.Metric) {
+	b := observedBandwidth.Load()
+	if b == nil {
+		return
+	}
+
+	collect := func(
+		bytesDesc, rateDesc *prometheus.Desc,
+		stats metrics.Stats,
+		labels ...string,
+	) {
+		ch <- prometheus.MustNewConstMetric(
+			bytesDesc,
+			prometheus.CounterValue,
+			float64(stats.TotalIn),
+			append(labels, "in")...,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			bytesDesc,
+			prometheus.CounterValue,
+			float64(stats.TotalOut),
+			append(labels, "out")...,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			rateDesc,
+			prometheus.GaugeValue,
+			stats.RateIn,
+			append(labels, "in")...,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			rateDesc,
+			prometheus.GaugeValue,
+			stats.RateOut,
+			append(labels, "out")...,
+		)
+	}
+
+	collect(bandwidthBytesDesc, bandwidthRateDesc, b.GetBandwidthTotals())
+	for _, p := range b.Protocols() {
+		collect(
+			protocolBandwidthBytesDesc,
+			protocolBandwidthRateDesc,
+			p.Stats,
+			string(p.Protocol),
+		)
+	}
+	if b.metricsPeerLimit >= 0 {
+		for _, p := range b.Peers(b.metricsPeerLimit) {
+			collect(
+				peerBandwidthBytesDesc,
+				peerBandwidthRateDesc,
+				p.Stats,
+				p.Peer.String(),
+			)
+		}
+	}
+}
+
+// PeerBandwidth is the traffic exchanged with a peer.
+type PeerBandwidth struct {
+	Peer  peer.ID
+	Stats metrics.Stats
+}
+
+// ProtocolBandwidth is the traffic of a protocol.
+type ProtocolBandwidth struct {
+	Protocol protocol.ID
+	Stats    metrics.Stats
+}
+
+// Bandwidth is the bandwidth reporter of the host, accounting traffic in
+// total and by peer and protocol.
+type Bandwidth struct {
+	*metrics.BandwidthCounter
+	// Maximum number of peers, the busiest first, reported as metrics. Zero
+	// reports every peer and a negative limit none.
+	metricsPeerLimit int
+}
+
+// NewBandwidth creates a bandwidth reporter reporting up to metricsPeerLimit
+// peers as metrics.
+func NewBandwidth(metricsPeerLimit int) *Bandwidth {
+	return &Bandwidth{
+		BandwidthCounter: metrics.NewBandwidthCounter(),
+		metricsPeerLimit: metricsPeerLimit,
+	}
+}
+
+// Peers returns the traffic by peer, the busiest first, up to limit peers. A
+// limit of zero returns every peer.
+func (b *Bandwidth) Peers(limit int) []PeerBandwidth {
+	byPeer := b.GetBandwidthByPeer()
+	peers := make([]PeerBandwidth, 0, len(byPeer))
+	for p, stats := range byPeer {
+		peers = append(peers, PeerBandwidth{Peer: p, Stats: stats})
+	}
+	sort.Slice(peers, func(i, j int) bool {
+		ti := peers[i].Stats.TotalIn + peers[i].Stats.TotalOut
+		tj := peers[j].Stats.TotalIn + peers[j].Stats.TotalOut
+		if ti != tj {
+			return ti > tj
+		}
+		return peers[i].Peer < peers[j].Peer
+	})
+	if limit > 0 && len(peers) > limit {
+		peers = peers[:limit]
+	}
+	return peers
+}
+
+// Protocols returns the traffic by protocol, the busiest first.
+func (b *Bandwidth) Protocols() []ProtocolBandwidth {
+	byProtocol := b.GetBandwidthByProtocol()
+	protocols := make([]ProtocolBandwidth, 0, len(byProtocol))
+	for p, stats := range byProtocol {
+		protocols = append(protocols, ProtocolBandwidth{Protocol: p, Stats: stats})
+	}
+	sort.Slice(protocols, func(i, j int) bool {
+		ti := protocols[i].Stats.TotalIn + protocols[i].Stats.TotalOut
+		tj := protocols[j].Stats.TotalIn + protocols[j].Stats.TotalOut
+		if ti != tj {
+			return ti > tj
+		}
+		return protocols[i].Protocol < protocols[j].Protocol
+	})
+	return protocols
+}
+
+// Run forgets, every period until ctx is done, the peers and protocols that
+// have been idle for longer than idle, so that departed peers do not
+// accumulate.
+func (b *Bandwidth) Run(
+	ctx context.Context,
+	clk clock.Clock,
+	period time.Duration,
+	idle time.Duration,
+) {
+	ticker := clk.NewTicker(period)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.Chan():
+			b.TrimIdle(clk.Now().Add(-idle))
+		}
+	}
+}
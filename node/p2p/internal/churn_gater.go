@@ -0,0 +1,262 @@
+package internal
+
+import (
+	"net"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/connmgr"
+	"github.com/libp2p/go-libp2p/core/control"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+)
+
+var churnGatedTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "p2p",
+		Name:      "churn_gated_connections_total",
+		Help:      "Connections refused because the peer or subnet was churning.",
+	},
+	[]string{"reason"},
+)
+
+func init() {
+	prometheus.MustRegister(churnGatedTotal)
+}
+
+type churnRecord struct {
+	// Ring of the times of the last connects, up to the limit, the oldest at
+	// next once full.
+	connects    []time.Time
+	next        int
+	gatedUntil  time.Time
+	lastTouched time.Time
+}
+
+// ChurnGater refuses connections from peers, or subnets, that connect more
+// than a limit number of times within a window. Offenders are gated for the
+// backoff duration, sparing identify, DHT and scoring work that each new
+// connection triggers. Inbound connections are gated at accept time by
+// subnet, and by peer once the peer is authenticated.
+type ChurnGater struct {
+	logger      *zap.Logger
+	window      time.Duration
+	backoff     time.Duration
+	peerLimit   int
+	subnetLimit int
+	exempt      map[peer.ID]struct{}
+
+	clock   clock.Clock
+	mx      sync.Mutex
+	peers   map[peer.ID]*churnRecord
+	subnets map[string]*churnRecord
+	pruned  time.Time
+}
+
+var _ connmgr.ConnectionGater = (*ChurnGater)(nil)
+
+// NewChurnGater creates a churn gater. A limit of zero or less disables
+// gating on that dimension. Exempt peers are never gated.
+func NewChurnGater(
+	logger *zap.Logger,
+	clk clock.Clock,
+	window time.Duration,
+	backoff time.Duration,
+	peerLimit int,
+	subnetLimit int,
+	exempt []peer.ID,
+) *ChurnGater {
+	exemptPeers := make(map[peer.ID]struct{}, len(exempt))
+	for _, p := range exempt {
+		exemptPeers[p] = struct{}{}
+	}
+
+	return &ChurnGater{
+		logger:      logger,
+		window:      window,
+		backoff:     backoff,
+		peerLimit:   peerLimit,
+		subnetLimit: subnetLimit,
+		exempt:      exemptPeers,
+		peers:       make(map[peer.ID]*churnRecord),
+		subnets:     make(map[string]*churnRecord),
+		clock:       clk,
+		pruned:      clk.Now(),
+	}
+}
+
+// InterceptPeerDial implements connmgr.ConnectionGater.
+func (g *ChurnGater) InterceptPeerDial(p peer.ID) bool {
+	return true
+}
+
+// InterceptAddrDial implements connmgr.ConnectionGater.
+func (g *ChurnGater) InterceptAddrDial(peer.ID, multiaddr.Multiaddr) bool {
+	return true
+}
+
+// InterceptAccept implements connmgr.ConnectionGater.
+func (g *ChurnGater) InterceptAccept(addrs network.ConnMultiaddrs) bool {
+	subnet, ok := subnetOf(addrs.RemoteMultiaddr())
+	if !ok || g.subnetLimit <= 0 {
+		return true
+	}
+
+	g.mx.Lock()
+	defer g.mx.Unlock()
+
+	if r, ok := g.subnets[subnet]; ok && g.clock.Now().Before(r.gatedUntil) {
+		churnGatedTotal.WithLabelValues("subnet").Inc()
+		return false
+	}
+
+	return true
+}
+
+// InterceptSecured implements connmgr.ConnectionGater.
+func (g *ChurnGater) InterceptSecured(
+	dir network.Direction,
+	p peer.ID,
+	addrs network.ConnMultiaddrs,
+) bool {
+	if _, ok := g.exempt[p]; ok {
+		return true
+	}
+
+	g.mx.Lock()
+	defer g.mx.Unlock()
+
+	now := g.clock.Now()
+	g.prune(now)
+
+	if g.peerLimit > 0 {
+		r := recordFor(g.peers, p, now)
+		if now.Before(r.gatedUntil) {
+			churnGatedTotal.WithLabelValues("peer").Inc()
+			return false
+		}
+		if g.observe(r, g.peerLimit, now) {
+			g.logger.Debug(
+				"gating churning peer",
+				zap.String("peer_id", p.String()),
+				zap.Duration("backoff", g.backoff),
+			)
+			churnGatedTotal.WithLabelValues("peer").Inc()
+			return false
+		}
+	}
+
+	// Outbound connections are our own doing, so they count against the peer
+	// but not against its subnet.
+	subnet, ok := subnetOf(addrs.RemoteMultiaddr())
+	if !ok || g.subnetLimit <= 0 || dir != network.DirInbound {
+		return true
+	}
+
+	r := recordFor(g.subnets, subnet, now)
+	if now.Before(r.gatedUntil) {
+		churnGatedTotal.WithLabelValues("subnet").Inc()
+		return false
+	}
+	if g.observe(r, g.subnetLimit, now) {
+		g.logger.Debug(
+			"gating churning subnet",
+			zap.String("subnet", subnet),
+			zap.Duration("backoff", g.backoff),
+		)
+		churnGatedTotal.WithLabelValues("subnet").Inc()
+		return false
+	}
+
+	return true
+}
+
+// InterceptUpgraded implements connmgr.ConnectionGater.
+func (g *ChurnGater) InterceptUpgraded(
+	network.Conn,
+) (bool, control.DisconnectReason) {
+	return true, 0
+}
+
+func recordFor[K comparable](
+	records map[K]*churnRecord,
+	key K,
+	now time.Time,
+) *churnRecord {
+	r, ok := records[key]
+	if !ok {
+		r = &churnRecord{}
+		records[key] = r
+	}
+	r.lastTouched = now
+	return r
+}
+
+// observe records a connection and reports whether it pushed the record over
+// its limit, in which case the record is gated. The connection is over the
+// limit when the one it replaces in the ring, limit connections ago, is still
+// within the window.
+func (g *ChurnGater) observe(r *churnRecord, limit int, now time.Time) bool {
+	if len(r.connects) < limit {
+		r.connects = append(r.connects, now)
+		return false
+	}
+
+	oldest := r.connects[r.next]
+	r.connects[r.next] = now
+	r.next = (r.next + 1) % limit
+	if oldest.After(now.Add(-g.window)) {
+		r.gatedUntil = now.Add(g.backoff)
+		r.connects = r.connects[:0]
+		r.next = 0
+		return true
+	}
+
+	return false
+}
+
+// prune drops records that are neither gated nor seen within the window, so
+// the maps do not grow with every peer ever encountered. It runs at most once
+// per window.
+func (g *ChurnGater) prune(now time.Time) {
+	cutoff := now.Add(-g.window)
+	if g.pruned.After(cutoff) {
+		return
+	}
+	g.pruned = now
+
+	for k, r := range g.peers {
+		if r.lastTouched.Before(cutoff) && now.After(r.gatedUntil) {
+			delete(g.peers, k)
+		}
+	}
+	for k, r := range g.subnets {
+		if r.lastTouched.Before(cutoff) && now.After(r.gatedUntil) {
+			delete(g.subnets, k)
+		}
+	}
+}
+
+// subnetOf groups IPv4 addresses by /24 and IPv6 addresses by /48.
+func subnetOf(addr multiaddr.Multiaddr) (string, bool) {
+	if addr == nil {
+		return "", false
+	}
+
+	ip, err := manet.ToIP(addr)
+	if err != nil {
+		return "", false
+	}
+
+	mask := net.CIDRMask(48, 128)
+	if ip4 := ip.To4(); ip4 != nil {
+		ip, mask = ip4, net.CIDRMask(24, 32)
+	}
+
+	return (&net.IPNet{IP: ip.Mask(mask), Mask: mask}).String(), true
+}
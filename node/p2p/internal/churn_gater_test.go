@@ -0,0 +1,123 @@
+package internal_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p/internal"
+)
+
+type connAddrs struct {
+	remote ma.Multiaddr
+}
+
+func (c connAddrs) LocalMultiaddr() ma.Multiaddr  { return nil }
+func (c connAddrs) RemoteMultiaddr() ma.Multiaddr { return c.remote }
+
+func remoteAddrs(t *testing.T, ip string) connAddrs {
+	return connAddrs{remote: mustMultiaddr(t, "/ip4/"+ip+"/tcp/8336")}
+}
+
+func TestChurnGaterPeerLimit(t *testing.T) {
+	clk := clock.NewFakeClock(time.Unix(1700000000, 0))
+	g := internal.NewChurnGater(
+		zap.NewNop(),
+		clk,
+		time.Minute,
+		10*time.Minute,
+		2,
+		0,
+		[]peer.ID{"exempt"},
+	)
+	addrs := remoteAddrs(t, "10.0.0.1")
+
+	// Connects further apart than the window are never gated.
+	for i := 0; i < 5; i++ {
+		require.True(t, g.InterceptSecured(network.DirInbound, "peer", addrs))
+		clk.Advance(61 * time.Second)
+	}
+
+	// A connect more than the limit within the window gates the peer, in both
+	// directions, for the backoff.
+	require.True(t, g.InterceptSecured(network.DirInbound, "peer", addrs))
+	require.True(t, g.InterceptSecured(network.DirOutbound, "peer", addrs))
+	require.False(t, g.InterceptSecured(network.DirInbound, "peer", addrs))
+	clk.Advance(9 * time.Minute)
+	require.False(t, g.InterceptSecured(network.DirOutbound, "peer", addrs))
+	require.True(t, g.InterceptSecured(network.DirInbound, "other", addrs))
+	clk.Advance(time.Minute)
+	require.True(t, g.InterceptSecured(network.DirInbound, "peer", addrs))
+
+	// Exempt peers are never gated.
+	for i := 0; i < 10; i++ {
+		require.True(t, g.InterceptSecured(network.DirInbound, "exempt", addrs))
+	}
+}
+
+func TestChurnGaterSubnetLimit(t *testing.T) {
+	clk := clock.NewFakeClock(time.Unix(1700000000, 0))
+	g := internal.NewChurnGater(
+		zap.NewNop(),
+		clk,
+		time.Minute,
+		10*time.Minute,
+		0,
+		3,
+		nil,
+	)
+
+	// Outbound connections do not count against the subnet.
+	for i := 0; i < 5; i++ {
+		require.True(t, g.InterceptSecured(
+			network.DirOutbound,
+			peer.ID(fmt.Sprintf("outbound-%d", i)),
+			remoteAddrs(t, fmt.Sprintf("10.0.0.%d", i+1)),
+		))
+	}
+
+	// Distinct peers from one /24 count together.
+	for i := 0; i < 3; i++ {
+		require.True(t, g.InterceptSecured(
+			network.DirInbound,
+			peer.ID(fmt.Sprintf("inbound-%d", i)),
+			remoteAddrs(t, fmt.Sprintf("10.0.0.%d", i+1)),
+		))
+	}
+	require.False(t, g.InterceptSecured(
+		network.DirInbound,
+		"inbound-3",
+		remoteAddrs(t, "10.0.0.200"),
+	))
+
+	// Once gated the subnet is refused at accept time, other subnets are not.
+	require.False(t, g.InterceptAccept(remoteAddrs(t, "10.0.0.201")))
+	require.True(t, g.InterceptAccept(remoteAddrs(t, "10.0.1.1")))
+	clk.Advance(10 * time.Minute)
+	require.True(t, g.InterceptAccept(remoteAddrs(t, "10.0.0.201")))
+}
+
+func TestChurnGaterDisabled(t *testing.T) {
+	g := internal.NewChurnGater(
+		zap.NewNop(),
+		clock.NewFakeClock(time.Unix(1700000000, 0)),
+		time.Minute,
+		10*time.Minute,
+		0,
+		0,
+		nil,
+	)
+
+	// Without limits nothing is gated.
+	addrs := remoteAddrs(t, "10.0.0.1")
+	for i := 0; i < 100; i++ {
+		require.True(t, g.InterceptAccept(addrs))
+		require.True(t, g.InterceptSecured(network.DirInbound, "peer", addrs))
+	}
+}
@@ -0,0 +1,126 @@
+package internal
+
+import (
+	"net"
+
+	"github.com/libp2p/go-libp2p/core/connmgr"
+	"github.com/libp2p/go-libp2p/core/control"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var cidrGatedTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "p2p",
+		Name:      "cidr_gated_connections_total",
+		Help:      "Connections refused because the remote address is outside the allowed or inside the denied CIDR ranges.",
+	},
+	[]string{"direction"},
+)
+
+func init() {
+	prometheus.MustRegister(cidrGatedTotal)
+}
+
+// CIDRGater refuses inbound accepts and outbound dials by the remote IP
+// address. An address in a denied range is refused; otherwise, if any ranges
+// are allowed, an address outside all of them is refused. Addresses that are
+// not IP addresses are refused only when ranges are allowed.
+type CIDRGater struct {
+	allow []*net.IPNet
+	deny  []*net.IPNet
+}
+
+var _ connmgr.ConnectionGater = (*CIDRGater)(nil)
+
+// NewCIDRGater creates a gater of the allowed and denied CIDR ranges.
+func NewCIDRGater(allow []string, deny []string) (*CIDRGater, error) {
+	allowNets, err := parseCIDRs(allow)
+	if err != nil {
+		return nil, errors.Wrap(err, "new cidr gater")
+	}
+	denyNets, err := parseCIDRs(deny)
+	if err != nil {
+		return nil, errors.Wrap(err, "new cidr gater")
+	}
+
+	return &CIDRGater{allow: allowNets, deny: denyNets}, nil
+}
+
+func parseCIDRs(cidrs []string) ([]*net.IPNet, error) {
+	nets := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		nets = append(nets, ipNet)
+	}
+	return nets, nil
+}
+
+// Allowed reports whether connections to or from the address are allowed.
+func (g *CIDRGater) Allowed(addr multiaddr.Multiaddr) bool {
+	ip, err := manet.ToIP(addr)
+	if err != nil {
+		return len(g.allow) == 0
+	}
+
+	for _, ipNet := range g.deny {
+		if ipNet.Contains(ip) {
+			return false
+		}
+	}
+	if len(g.allow) == 0 {
+		return true
+	}
+	for _, ipNet := range g.allow {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// InterceptPeerDial implements connmgr.ConnectionGater.
+func (g *CIDRGater) InterceptPeerDial(peer.ID) bool {
+	return true
+}
+
+// InterceptAddrDial implements connmgr.ConnectionGater.
+func (g *CIDRGater) InterceptAddrDial(_ peer.ID, addr multiaddr.Multiaddr) bool {
+	if !g.Allowed(addr) {
+		cidrGatedTotal.WithLabelValues("outbound").Inc()
+		return false
+	}
+	return true
+}
+
+// InterceptAccept implements connmgr.ConnectionGater.
+func (g *CIDRGater) InterceptAccept(addrs network.ConnMultiaddrs) bool {
+	if !g.Allowed(addrs.RemoteMultiaddr()) {
+		cidrGatedTotal.WithLabelValues("inbound").Inc()
+		return false
+	}
+	return true
+}
+
+// InterceptSecured implements connmgr.ConnectionGater.
+func (g *CIDRGater) InterceptSecured(
+	network.Direction,
+	peer.ID,
+	network.ConnMultiaddrs,
+) bool {
+	return true
+}
+
+// InterceptUpgraded implements connmgr.ConnectionGater.
+func (g *CIDRGater) InterceptUpgraded(
+	network.Conn,
+) (bool, control.DisconnectReason) {
+	return true, 0
+}
@@ -0,0 +1,113 @@
+package internal_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/p2p/net/swarm"
+	"github.com/stretchr/testify/require"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p/internal"
+)
+
+func TestCIDRGater(t *testing.T) {
+	g, err := internal.NewCIDRGater(
+		[]string{"10.0.0.0/8", "2001:db8::/32"},
+		[]string{"10.1.0.0/16"},
+	)
+	require.NoError(t, err)
+
+	// Accepts and dials are gated alike: addresses in an allowed range pass
+	// unless also in a denied one, and anything else is refused.
+	for addr, allowed := range map[string]bool{
+		"/ip4/10.0.0.1/tcp/8336":          true,
+		"/ip4/10.1.0.1/tcp/8336":          false,
+		"/ip4/192.0.2.1/tcp/8336":         false,
+		"/ip6/2001:db8::1/udp/8336/quic":  true,
+		"/ip6/2001:db9::1/udp/8336/quic":  false,
+		"/dns4/example.com/tcp/8336":      false,
+		"/ip4/10.0.0.1/udp/8336/quic-v1":  true,
+		"/ip4/10.1.255.255/udp/8336/quic": false,
+	} {
+		m := mustMultiaddr(t, addr)
+		require.Equal(t, allowed, g.Allowed(m), addr)
+		require.Equal(t, allowed, g.InterceptAddrDial("peer", m), addr)
+		require.Equal(
+			t,
+			allowed,
+			g.InterceptAccept(connAddrs{remote: m}),
+			addr,
+		)
+	}
+
+	// Once connected nothing more is gated.
+	require.True(t, g.InterceptPeerDial("peer"))
+	require.True(t, g.InterceptSecured(
+		network.DirInbound,
+		"peer",
+		remoteAddrs(t, "192.0.2.1"),
+	))
+	allow, _ := g.InterceptUpgraded(nil)
+	require.True(t, allow)
+}
+
+func TestCIDRGaterDenyOnly(t *testing.T) {
+	g, err := internal.NewCIDRGater(nil, []string{"192.0.2.0/24"})
+	require.NoError(t, err)
+
+	// Without allowed ranges only the denied ones are refused, and addresses
+	// without an IP pass.
+	for addr, allowed := range map[string]bool{
+		"/ip4/192.0.2.1/tcp/8336":    false,
+		"/ip4/198.51.100.1/tcp/8336": true,
+		"/dns4/example.com/tcp/8336": true,
+	} {
+		m := mustMultiaddr(t, addr)
+		require.Equal(t, allowed, g.InterceptAddrDial("peer", m), addr)
+		require.Equal(
+			t,
+			allowed,
+			g.InterceptAccept(connAddrs{remote: m}),
+			addr,
+		)
+	}
+}
+
+func TestNewCIDRGaterInvalidRange(t *testing.T) {
+	_, err := internal.NewCIDRGater([]string{"10.0.0.0"}, nil)
+	require.Error(t, err)
+	_, err = internal.NewCIDRGater(nil, []string{"10.0.0.0/33"})
+	require.Error(t, err)
+}
+
+func TestCIDRGaterHosts(t *testing.T) {
+	deny, err := internal.NewCIDRGater(nil, []string{"127.0.0.0/8"})
+	require.NoError(t, err)
+	newHost := func(opts ...libp2p.Option) host.Host {
+		h, err := libp2p.New(append(
+			opts,
+			libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"),
+		)...)
+		require.NoError(t, err)
+		t.Cleanup(func() { h.Close() })
+		return h
+	}
+	connect := func(client, server host.Host) error {
+		return client.Connect(context.Background(), peer.AddrInfo{
+			ID:    server.ID(),
+			Addrs: server.Addrs(),
+		})
+	}
+
+	// A gated server refuses the connection once accepted.
+	server := newHost(libp2p.ConnectionGater(deny))
+	require.Error(t, connect(newHost(), server))
+
+	// A gated client does not dial.
+	client := newHost(libp2p.ConnectionGater(deny))
+	err = connect(client, newHost())
+	require.ErrorIs(t, err, swarm.ErrGaterDisallowedConnection)
+}
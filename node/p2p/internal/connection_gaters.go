@@ -0,0 +1,74 @@
+package internal
+
+import (
+	"github.com/libp2p/go-libp2p/core/connmgr"
+	"github.com/libp2p/go-libp2p/core/control"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+)
+
+// ConnectionGaters combines connection gaters, as libp2p takes only one. A
+// connection is allowed if every gater allows it.
+type ConnectionGaters []connmgr.ConnectionGater
+
+var _ connmgr.ConnectionGater = ConnectionGaters(nil)
+
+// InterceptPeerDial implements connmgr.ConnectionGater.
+func (g ConnectionGaters) InterceptPeerDial(p peer.ID) bool {
+	for _, gater := range g {
+		if !gater.InterceptPeerDial(p) {
+			return false
+		}
+	}
+	return true
+}
+
+// InterceptAddrDial implements connmgr.ConnectionGater.
+func (g ConnectionGaters) InterceptAddrDial(
+	p peer.ID,
+	addr multiaddr.Multiaddr,
+) bool {
+	for _, gater := range g {
+		if !gater.InterceptAddrDial(p, addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// InterceptAccept implements connmgr.ConnectionGater.
+func (g ConnectionGaters) InterceptAccept(addrs network.ConnMultiaddrs) bool {
+	for _, gater := range g {
+		if !gater.InterceptAccept(addrs) {
+			return false
+		}
+	}
+	return true
+}
+
+// InterceptSecured implements connmgr.ConnectionGater.
+func (g ConnectionGaters) InterceptSecured(
+	dir network.Direction,
+	p peer.ID,
+	addrs network.ConnMultiaddrs,
+) bool {
+	for _, gater := range g {
+		if !gater.InterceptSecured(dir, p, addrs) {
+			return false
+		}
+	}
+	return true
+}
+
+// InterceptUpgraded implements connmgr.ConnectionGater.
+func (g ConnectionGaters) InterceptUpgraded(
+	conn network.Conn,
+) (bool, control.DisconnectReason) {
+	for _, gater := range g {
+		if allow, reason := gater.InterceptUpgraded(conn); !allow {
+			return false, reason
+		}
+	}
+	return true, 0
+}
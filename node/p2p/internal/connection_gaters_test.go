@@ -0,0 +1,89 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/control"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/require"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p/internal"
+)
+
+// stubGater allows or refuses everything, counting the calls it sees.
+type stubGater struct {
+	allow  bool
+	reason control.DisconnectReason
+	calls  int
+}
+
+func (g *stubGater) InterceptPeerDial(peer.ID) bool {
+	g.calls++
+	return g.allow
+}
+
+func (g *stubGater) InterceptAddrDial(peer.ID, ma.Multiaddr) bool {
+	g.calls++
+	return g.allow
+}
+
+func (g *stubGater) InterceptAccept(network.ConnMultiaddrs) bool {
+	g.calls++
+	return g.allow
+}
+
+func (g *stubGater) InterceptSecured(
+	network.Direction,
+	peer.ID,
+	network.ConnMultiaddrs,
+) bool {
+	g.calls++
+	return g.allow
+}
+
+func (g *stubGater) InterceptUpgraded(
+	network.Conn,
+) (bool, control.DisconnectReason) {
+	g.calls++
+	return g.allow, g.reason
+}
+
+func TestConnectionGaters(t *testing.T) {
+	addrs := remoteAddrs(t, "10.0.0.1")
+	intercept := func(g internal.ConnectionGaters) []bool {
+		upgraded, _ := g.InterceptUpgraded(nil)
+		return []bool{
+			g.InterceptPeerDial("peer"),
+			g.InterceptAddrDial("peer", addrs.remote),
+			g.InterceptAccept(addrs),
+			g.InterceptSecured(network.DirInbound, "peer", addrs),
+			upgraded,
+		}
+	}
+
+	// Without gaters everything is allowed.
+	require.Equal(t, []bool{true, true, true, true, true}, intercept(nil))
+
+	// Everything is allowed when every gater allows it.
+	first := &stubGater{allow: true}
+	second := &stubGater{allow: true}
+	require.Equal(
+		t,
+		[]bool{true, true, true, true, true},
+		intercept(internal.ConnectionGaters{first, second}),
+	)
+	require.Equal(t, 5, first.calls)
+	require.Equal(t, 5, second.calls)
+
+	// The first gater refusing decides, without asking the others, and its
+	// disconnect reason is returned.
+	refusing := &stubGater{reason: 7}
+	last := &stubGater{allow: true}
+	g := internal.ConnectionGaters{first, refusing, last}
+	require.Equal(t, []bool{false, false, false, false, false}, intercept(g))
+	require.Equal(t, 5, refusing.calls)
+	require.Zero(t, last.calls)
+	_, reason := g.InterceptUpgraded(nil)
+	require.Equal(t, control.DisconnectReason(7), reason)
+}
@@ -0,0 +1,121 @@
+package internal
+
+import (
+	"bytes"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var deadLettersCapturedTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "p2p",
+		Name:      "dead_letters_captured_total",
+		Help:      "Rejected messages captured for inspection, by reason.",
+	},
+	[]string{"reason"},
+)
+
+func init() {
+	prometheus.MustRegister(deadLettersCapturedTotal)
+}
+
+// DeadLetter is a message rejected by validation.
+type DeadLetter struct {
+	RejectedAt time.Time
+	// The peer the message was received from.
+	PeerID  peer.ID
+	Bitmask []byte
+	Reason  string
+	// The encoded message, cut to the size limit of the dead letters.
+	Message []byte
+	// The size of the encoded message before it was cut.
+	Size int
+}
+
+// DeadLetters keeps the latest messages rejected by validation in a ring
+// buffer, so that publishers can find out why their messages are rejected.
+type DeadLetters struct {
+	// Size past which messages are cut, zero or less for no limit.
+	maxSize int
+
+	mx sync.Mutex
+	// Sizes replacing maxSize on some bitmasks, guarded by mx.
+	maxSizes map[string]int
+	letters  []DeadLetter
+	next     int
+	captured uint64
+}
+
+// NewDeadLetters creates a ring buffer of capacity rejected messages, keeping
+// up to maxSize bytes of each.
+func NewDeadLetters(capacity int, maxSize int) *DeadLetters {
+	return &DeadLetters{
+		maxSize:  maxSize,
+		maxSizes: map[string]int{},
+		letters:  make([]DeadLetter, 0, capacity),
+	}
+}
+
+// SetMaxSize keeps up to maxSize bytes of the messages rejected on bitmask,
+// or whole messages if zero or less, in place of the size given at creation.
+func (d *DeadLetters) SetMaxSize(bitmask []byte, maxSize int) {
+	d.mx.Lock()
+	defer d.mx.Unlock()
+
+	d.maxSizes[string(bitmask)] = maxSize
+}
+
+// Add captures a rejected message, evicting the oldest one if full.
+func (d *DeadLetters) Add(letter DeadLetter) {
+	deadLettersCapturedTotal.WithLabelValues(letter.Reason).Inc()
+
+	d.mx.Lock()
+	defer d.mx.Unlock()
+
+	maxSize, ok := d.maxSizes[string(letter.Bitmask)]
+	if !ok {
+		maxSize = d.maxSize
+	}
+	letter.Size = len(letter.Message)
+	if maxSize > 0 && len(letter.Message) > maxSize {
+		letter.Message = letter.Message[:maxSize]
+	}
+	// The buffer may be kept for long, do not pin a larger backing array.
+	letter.Message = bytes.Clone(letter.Message)
+	letter.Bitmask = bytes.Clone(letter.Bitmask)
+
+	d.captured++
+	if cap(d.letters) == 0 {
+		return
+	}
+	if len(d.letters) < cap(d.letters) {
+		d.letters = append(d.letters, letter)
+		return
+	}
+	d.letters[d.next] = letter
+	d.next = (d.next + 1) % len(d.letters)
+}
+
+// List returns up to limit of the captured messages rejected on bitmask, the
+// latest first, and the number of messages captured overall. An empty bitmask
+// matches every message and a limit of zero returns them all.
+func (d *DeadLetters) List(bitmask []byte, limit int) ([]DeadLetter, uint64) {
+	d.mx.Lock()
+	defer d.mx.Unlock()
+
+	letters := []DeadLetter{}
+	for i := 0; i < len(d.letters); i++ {
+		if limit > 0 && len(letters) >= limit {
+			break
+		}
+		letter := d.letters[(d.next-1-i+2*len(d.letters))%len(d.letters)]
+		if len(bitmask) != 0 && !bytes.Equal(letter.Bitmask, bitmask) {
+			continue
+		}
+		letters = append(letters, letter)
+	}
+	return letters, d.captured
+}
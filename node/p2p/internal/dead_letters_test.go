@@ -0,0 +1,60 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p/internal"
+)
+
+func TestDeadLetters(t *testing.T) {
+	letters := internal.NewDeadLetters(3, 4)
+	add := func(bitmask byte, message string) {
+		letters.Add(internal.DeadLetter{
+			Bitmask: []byte{bitmask},
+			Reason:  "validator",
+			Message: []byte(message),
+		})
+	}
+	messages := func(list []internal.DeadLetter) []string {
+		out := []string{}
+		for _, letter := range list {
+			out = append(out, string(letter.Message))
+		}
+		return out
+	}
+
+	list, captured := letters.List(nil, 0)
+	require.Empty(t, list)
+	require.Zero(t, captured)
+
+	add(1, "a")
+	add(2, "b")
+	list, captured = letters.List(nil, 0)
+	require.Equal(t, []string{"b", "a"}, messages(list))
+	require.Equal(t, uint64(2), captured)
+
+	// Evicts the oldest messages and cuts long ones.
+	add(1, "c")
+	add(1, "dddddd")
+	list, captured = letters.List(nil, 0)
+	require.Equal(t, []string{"dddd", "c", "b"}, messages(list))
+	require.Equal(t, 6, list[0].Size)
+	require.Equal(t, uint64(4), captured)
+
+	list, _ = letters.List([]byte{1}, 0)
+	require.Equal(t, []string{"dddd", "c"}, messages(list))
+	list, _ = letters.List([]byte{1}, 1)
+	require.Equal(t, []string{"dddd"}, messages(list))
+	list, _ = letters.List([]byte{3}, 0)
+	require.Empty(t, list)
+
+	// Bitmasks with their own size are cut to it instead.
+	letters.SetMaxSize([]byte{2}, 0)
+	letters.SetMaxSize([]byte{3}, 2)
+	add(2, "eeeeee")
+	add(3, "ffffff")
+	list, _ = letters.List(nil, 2)
+	require.Equal(t, []string{"ff", "eeeeee"}, messages(list))
+	require.Equal(t, 6, list[1].Size)
+}
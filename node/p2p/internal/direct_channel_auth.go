@@ -0,0 +1,160 @@
+package internal
+
+import (
+	"context"
+	"net"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/p2p/net/gostream"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/credentials"
+	grpcpeer "google.golang.org/grpc/peer"
+)
+
+var directChannelRejectedTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "p2p",
+		Name:      "direct_channel_rejected_total",
+		Help:      "Direct channel streams refused as the peer is not allowed.",
+	},
+	[]string{"purpose"},
+)
+
+func init() {
+	prometheus.MustRegister(directChannelRejectedTotal)
+}
+
+// PeerAuthInfo is the gRPC auth info of a direct channel. The peer is the one
+// at the other end of the libp2p stream, which the security handshake of the
+// libp2p connection authenticated.
+type PeerAuthInfo struct {
+	credentials.CommonAuthInfo
+	PeerID peer.ID
+}
+
+var _ credentials.AuthInfo = PeerAuthInfo{}
+
+// AuthType implements credentials.AuthInfo.
+func (PeerAuthInfo) AuthType() string {
+	return "libp2p"
+}
+
+// PeerCredentials are the gRPC transport credentials of direct channels,
+// which run over libp2p streams. The streams are already encrypted and
+// authenticated, so the handshake only attributes the stream to its peer and,
+// when dialing, checks that it is the expected peer.
+type PeerCredentials struct {
+	expected peer.ID
+}
+
+var _ credentials.TransportCredentials = (*PeerCredentials)(nil)
+
+// NewPeerCredentials creates credentials requiring the remote peer to be
+// expected, or accepting any peer if expected is empty, as servers do.
+func NewPeerCredentials(expected peer.ID) *PeerCredentials {
+	return &PeerCredentials{expected: expected}
+}
+
+func (c *PeerCredentials) handshake(conn net.Conn) (
+	net.Conn,
+	credentials.AuthInfo,
+	error,
+) {
+	id, ok := gostream.PeerIDFromAddr(conn.RemoteAddr())
+	if !ok {
+		return nil, nil, errors.New("connection is not a libp2p stream")
+	}
+	if c.expected != "" && id != c.expected {
+		return nil, nil, errors.Errorf(
+			"expected peer %s, connected to %s",
+			c.expected,
+			id,
+		)
+	}
+	return conn, PeerAuthInfo{
+		CommonAuthInfo: credentials.CommonAuthInfo{
+			SecurityLevel: credentials.PrivacyAndIntegrity,
+		},
+		PeerID: id,
+	}, nil
+}
+
+// ClientHandshake implements credentials.TransportCredentials.
+func (c *PeerCredentials) ClientHandshake(
+	_ context.Context,
+	_ string,
+	conn net.Conn,
+) (net.Conn, credentials.AuthInfo, error) {
+	return c.handshake(conn)
+}
+
+// ServerHandshake implements credentials.TransportCredentials.
+func (c *PeerCredentials) ServerHandshake(conn net.Conn) (
+	net.Conn,
+	credentials.AuthInfo,
+	error,
+) {
+	return c.handshake(conn)
+}
+
+// Info implements credentials.TransportCredentials.
+func (c *PeerCredentials) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{SecurityProtocol: "libp2p"}
+}
+
+// Clone implements credentials.TransportCredentials.
+func (c *PeerCredentials) Clone() credentials.TransportCredentials {
+	return &PeerCredentials{expected: c.expected}
+}
+
+// OverrideServerName implements credentials.TransportCredentials.
+func (c *PeerCredentials) OverrideServerName(string) error {
+	return nil
+}
+
+// PeerFromContext returns the peer at the other end of the direct channel of
+// a gRPC call.
+func PeerFromContext(ctx context.Context) (peer.ID, bool) {
+	p, ok := grpcpeer.FromContext(ctx)
+	if !ok {
+		return "", false
+	}
+	if info, ok := p.AuthInfo.(PeerAuthInfo); ok {
+		return info.PeerID, true
+	}
+	return gostream.PeerIDFromAddr(p.Addr)
+}
+
+type filteredListener struct {
+	net.Listener
+	purpose string
+	allow   func(peer.ID) bool
+}
+
+// FilterDirectChannelListener wraps a direct channel listener so that streams
+// from peers that are not allowed are closed as soon as they are accepted.
+func FilterDirectChannelListener(
+	l net.Listener,
+	purpose string,
+	allow func(peer.ID) bool,
+) net.Listener {
+	return &filteredListener{Listener: l, purpose: purpose, allow: allow}
+}
+
+func (l *filteredListener) Accept() (net.Conn, error) {
+	for {
+		conn, err := l.Listener.Accept()
+		if err != nil {
+			return nil, err
+		}
+		id, ok := gostream.PeerIDFromAddr(conn.RemoteAddr())
+		if ok && l.allow(id) {
+			return conn, nil
+		}
+		directChannelRejectedTotal.WithLabelValues(
+			directChannelPurposeLabel(l.purpose),
+		).Inc()
+		conn.Close()
+	}
+}
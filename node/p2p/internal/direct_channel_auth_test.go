@@ -0,0 +1,121 @@
+package internal_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/p2p/net/gostream"
+	"github.com/stretchr/testify/require"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p/internal"
+)
+
+const testDirectChannelProtocol = "/p2p/direct-channel/test"
+
+func newDirectChannelHosts(t *testing.T) (server, client host.Host) {
+	server, err := libp2p.New(libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"))
+	require.NoError(t, err)
+	t.Cleanup(func() { server.Close() })
+
+	client, err = libp2p.New(libp2p.NoListenAddrs)
+	require.NoError(t, err)
+	t.Cleanup(func() { client.Close() })
+
+	require.NoError(t, client.Connect(context.Background(), peer.AddrInfo{
+		ID:    server.ID(),
+		Addrs: server.Addrs(),
+	}))
+	return server, client
+}
+
+func TestPeerCredentials(t *testing.T) {
+	server, client := newDirectChannelHosts(t)
+	listener, err := gostream.Listen(server, testDirectChannelProtocol)
+	require.NoError(t, err)
+	defer listener.Close()
+
+	accepted := make(chan net.Conn, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err == nil {
+			accepted <- conn
+		}
+	}()
+
+	conn, err := gostream.Dial(
+		context.Background(),
+		client,
+		server.ID(),
+		testDirectChannelProtocol,
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, _, err = internal.NewPeerCredentials(client.ID()).ClientHandshake(
+		context.Background(),
+		"",
+		conn,
+	)
+	require.Error(t, err)
+
+	_, info, err := internal.NewPeerCredentials(server.ID()).ClientHandshake(
+		context.Background(),
+		"",
+		conn,
+	)
+	require.NoError(t, err)
+	require.Equal(t, server.ID(), info.(internal.PeerAuthInfo).PeerID)
+
+	// Streams are only accepted once data arrives.
+	_, err = conn.Write([]byte{0})
+	require.NoError(t, err)
+	serverConn := <-accepted
+	defer serverConn.Close()
+	_, info, err = internal.NewPeerCredentials("").ServerHandshake(serverConn)
+	require.NoError(t, err)
+	require.Equal(t, client.ID(), info.(internal.PeerAuthInfo).PeerID)
+}
+
+func TestFilterDirectChannelListener(t *testing.T) {
+	server, client := newDirectChannelHosts(t)
+	bind, err := gostream.Listen(server, testDirectChannelProtocol)
+	require.NoError(t, err)
+	listener := internal.FilterDirectChannelListener(
+		bind,
+		"test",
+		func(id peer.ID) bool { return id != client.ID() },
+	)
+
+	accepted := make(chan error, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err == nil {
+			conn.Close()
+		}
+		accepted <- err
+	}()
+
+	conn, err := gostream.Dial(
+		context.Background(),
+		client,
+		server.ID(),
+		testDirectChannelProtocol,
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = conn.Write([]byte{0})
+	require.NoError(t, err)
+
+	// The stream is closed by the server without being handed out.
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	_, err = conn.Read(make([]byte, 1))
+	require.ErrorIs(t, err, io.EOF)
+
+	require.NoError(t, listener.Close())
+	require.Error(t, <-accepted)
+}
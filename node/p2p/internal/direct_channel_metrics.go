@@ -0,0 +1,147 @@
+package internal
+
+import (
+	"net"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	directChannelsOpen = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "p2p",
+			Name:      "direct_channels_open",
+			Help:      "Open direct channel streams.",
+		},
+		[]string{"purpose", "side"},
+	)
+	directChannelDialsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "p2p",
+			Name:      "direct_channel_dials_total",
+			Help:      "Direct channel dials by result.",
+		},
+		[]string{"purpose", "result"},
+	)
+	directChannelBytesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "p2p",
+			Name:      "direct_channel_bytes_total",
+			Help:      "Bytes transferred over direct channel streams.",
+		},
+		[]string{"purpose", "direction"},
+	)
+	directChannelDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "p2p",
+			Name:      "direct_channel_duration_seconds",
+			Help:      "Lifetime of direct channel streams.",
+			Buckets:   prometheus.ExponentialBuckets(0.01, 4, 10),
+		},
+		[]string{"purpose", "side"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(
+		directChannelsOpen,
+		directChannelDialsTotal,
+		directChannelBytesTotal,
+		directChannelDuration,
+	)
+}
+
+// directChannelPurposes are the purposes reported as metric labels as is. Any
+// other purpose, such as the per proving key public channels, is reported as
+// "other" to keep label cardinality bounded.
+var directChannelPurposes = map[string]struct{}{
+	"sync":    {},
+	"worker":  {},
+	"channel": {},
+}
+
+func directChannelPurposeLabel(purpose string) string {
+	if _, ok := directChannelPurposes[purpose]; ok {
+		return purpose
+	}
+	return "other"
+}
+
+// ObserveDirectChannelDial records the outcome of dialing a direct channel.
+func ObserveDirectChannelDial(purpose string, err error) {
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	directChannelDialsTotal.WithLabelValues(
+		directChannelPurposeLabel(purpose),
+		result,
+	).Inc()
+}
+
+// meteredConn counts bytes transferred over a direct channel stream, and its
+// lifetime once closed.
+type meteredConn struct {
+	net.Conn
+	purpose   string
+	side      string
+	opened    time.Time
+	closeOnce sync.Once
+}
+
+// MeterDirectChannelConn wraps a direct channel stream so that it is
+// reported in the direct channel metrics. Side is "outbound" for dialed
+// streams and "inbound" for accepted ones.
+func MeterDirectChannelConn(conn net.Conn, purpose, side string) net.Conn {
+	label := directChannelPurposeLabel(purpose)
+	directChannelsOpen.WithLabelValues(label, side).Inc()
+	return &meteredConn{
+		Conn:    conn,
+		purpose: label,
+		side:    side,
+		opened:  time.Now(),
+	}
+}
+
+func (c *meteredConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	directChannelBytesTotal.WithLabelValues(c.purpose, "received").Add(float64(n))
+	return n, err
+}
+
+func (c *meteredConn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	directChannelBytesTotal.WithLabelValues(c.purpose, "sent").Add(float64(n))
+	return n, err
+}
+
+func (c *meteredConn) Close() error {
+	c.closeOnce.Do(func() {
+		directChannelsOpen.WithLabelValues(c.purpose, c.side).Dec()
+		directChannelDuration.WithLabelValues(c.purpose, c.side).Observe(
+			time.Since(c.opened).Seconds(),
+		)
+	})
+	return c.Conn.Close()
+}
+
+type meteredListener struct {
+	net.Listener
+	purpose string
+}
+
+// MeterDirectChannelListener wraps a direct channel listener so that accepted
+// streams are reported in the direct channel metrics.
+func MeterDirectChannelListener(l net.Listener, purpose string) net.Listener {
+	return &meteredListener{Listener: l, purpose: purpose}
+}
+
+func (l *meteredListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return MeterDirectChannelConn(conn, l.purpose, "inbound"), nil
+}
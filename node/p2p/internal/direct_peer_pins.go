@@ -0,0 +1,160 @@
+package internal
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/sec"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+)
+
+var directPeerIdentityMismatchesTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "p2p",
+		Name:      "direct_peer_identity_mismatches_total",
+		Help:      "Dials to a direct peer's address that reached a different peer identity.",
+	},
+	[]string{"peer_id"},
+)
+
+func init() {
+	prometheus.MustRegister(directPeerIdentityMismatchesTotal)
+}
+
+// The time allowed for each dial of a direct peer.
+const directPeerDialTimeout = 30 * time.Second
+
+// DirectPeerPins pins each configured direct peer to the peer ID given with
+// its address. The address may resolve elsewhere over time, but a dial that
+// reaches a different identity, as after a DNS hijack of the peering endpoint,
+// is refused by the security handshake. DirectPeerPins dials the direct peers
+// itself so that such a refusal is raised, rather than left to the debug logs
+// of the router's own redials.
+type DirectPeerPins struct {
+	logger *zap.Logger
+	h      host.Host
+	peers  []peer.AddrInfo
+
+	mx sync.Mutex
+	// The identity last reached in place of each mismatched direct peer.
+	mismatched map[peer.ID]peer.ID
+}
+
+// NewDirectPeerPins pins the direct peers to their configured peer IDs.
+func NewDirectPeerPins(
+	logger *zap.Logger,
+	h host.Host,
+	peers []peer.AddrInfo,
+) *DirectPeerPins {
+	return &DirectPeerPins{
+		logger:     logger,
+		h:          h,
+		peers:      peers,
+		mismatched: map[peer.ID]peer.ID{},
+	}
+}
+
+// Check dials the direct peers that are not connected, returning the identity
+// reached in place of each one whose address now belongs to another peer.
+func (p *DirectPeerPins) Check(
+	ctx context.Context,
+	clk clock.Clock,
+) map[peer.ID]peer.ID {
+	found := map[peer.ID]peer.ID{}
+	for _, info := range p.peers {
+		if p.h.Network().Connectedness(info.ID) == network.Connected {
+			p.clear(info.ID)
+			continue
+		}
+
+		dialCtx, cancel := clock.WithTimeout(ctx, clk, directPeerDialTimeout)
+		err := p.h.Connect(dialCtx, info)
+		cancel()
+		if err == nil {
+			p.clear(info.ID)
+			continue
+		}
+
+		var mismatch sec.ErrPeerIDMismatch
+		if !errors.As(err, &mismatch) {
+			p.logger.Debug(
+				"could not dial direct peer",
+				zap.String("peer_id", info.ID.String()),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		found[info.ID] = mismatch.Actual
+		directPeerIdentityMismatchesTotal.WithLabelValues(info.ID.String()).Inc()
+		p.report(info, mismatch.Actual)
+	}
+
+	return found
+}
+
+// Run checks the direct peers every period until ctx is done. A period of
+// zero or less never checks.
+func (p *DirectPeerPins) Run(
+	ctx context.Context,
+	clk clock.Clock,
+	period time.Duration,
+) {
+	if len(p.peers) == 0 || period <= 0 {
+		return
+	}
+
+	ticker := clk.NewTicker(period)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.Chan():
+		}
+
+		p.Check(ctx, clk)
+	}
+}
+
+// report logs a mismatch at error level the first time the direct peer is
+// found to resolve to the identity, and at debug level while it persists.
+func (p *DirectPeerPins) report(info peer.AddrInfo, actual peer.ID) {
+	p.mx.Lock()
+	previous, ok := p.mismatched[info.ID]
+	p.mismatched[info.ID] = actual
+	p.mx.Unlock()
+
+	log := p.logger.Error
+	if ok && previous == actual {
+		log = p.logger.Debug
+	}
+	log(
+		"direct peer address reached a different peer identity, refusing it",
+		zap.String("peer_id", info.ID.String()),
+		zap.String("actual_peer_id", actual.String()),
+		zap.Any("multiaddrs", info.Addrs),
+	)
+}
+
+func (p *DirectPeerPins) clear(id peer.ID) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if _, ok := p.mismatched[id]; !ok {
+		return
+	}
+	delete(p.mismatched, id)
+	p.logger.Info(
+		"direct peer reached at its pinned identity again",
+		zap.String("peer_id", id.String()),
+	)
+}
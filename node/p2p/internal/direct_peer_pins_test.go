@@ -0,0 +1,44 @@
+package internal_test
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p/internal"
+)
+
+func TestDirectPeerPinsRefuseDifferentIdentity(t *testing.T) {
+	dialer, err := libp2p.New(libp2p.NoListenAddrs)
+	require.NoError(t, err)
+	defer dialer.Close()
+
+	endpoint, err := libp2p.New(
+		libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"),
+	)
+	require.NoError(t, err)
+	defer endpoint.Close()
+
+	_, pinnedPub, err := crypto.GenerateEd448Key(rand.Reader)
+	require.NoError(t, err)
+	pinned, err := peer.IDFromPublicKey(pinnedPub)
+	require.NoError(t, err)
+
+	pins := internal.NewDirectPeerPins(zap.NewNop(), dialer, []peer.AddrInfo{
+		{ID: pinned, Addrs: endpoint.Addrs()},
+	})
+	found := pins.Check(context.Background(), clock.NewRealClock())
+	require.Equal(t, map[peer.ID]peer.ID{pinned: endpoint.ID()}, found)
+	require.Empty(t, dialer.Network().ConnsToPeer(endpoint.ID()))
+
+	pins = internal.NewDirectPeerPins(zap.NewNop(), dialer, []peer.AddrInfo{
+		{ID: endpoint.ID(), Addrs: endpoint.Addrs()},
+	})
+	require.Empty(t, pins.Check(context.Background(), clock.NewRealClock()))
+}
@@ -21,7 +21,7 @@ func (d *peerConnectorDiscovery) Advertise(ctx context.Context, ns string, opts
 
 // FindPeers implements discovery.Discoverer.
 func (d *peerConnectorDiscovery) FindPeers(ctx context.Context, ns string, opts ...discovery.Option) (<-chan peer.AddrInfo, error) {
-	if err := d.connector.Connect(ctx); err != nil {
+	if _, err := d.connector.Connect(ctx); err != nil {
 		return nil, err
 	}
 	ch := make(chan peer.AddrInfo)
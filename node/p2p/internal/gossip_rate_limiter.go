@@ -0,0 +1,169 @@
+package internal
+
+import (
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prometheus/client_golang/prometheus"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+)
+
+var gossipRateLimitedTotal = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Namespace: "p2p",
+		Name:      "gossip_rate_limited_total",
+		Help:      "Inbound gossip messages dropped by the per-peer rate limiter.",
+	},
+)
+
+func init() {
+	prometheus.MustRegister(gossipRateLimitedTotal)
+}
+
+// Buckets idle for longer than this are refilled completely, so they are
+// forgotten rather than kept for peers that have gone away.
+const gossipBucketIdle = time.Minute
+
+// GossipRateLimit is the sustained rate of inbound gossip allowed from a peer
+// on a bitmask, with a burst of one second's worth. A message larger than the
+// burst passes once the bucket is full, leaving it in deficit. A rate of zero
+// or less is not limited.
+type GossipRateLimit struct {
+	MessagesPerSecond float64
+	BytesPerSecond    float64
+}
+
+type gossipBucketKey struct {
+	peer    peer.ID
+	bitmask string
+}
+
+type gossipBucket struct {
+	messages float64
+	bytes    float64
+	updated  time.Time
+}
+
+type gossipStrikes struct {
+	dropped int
+	updated time.Time
+}
+
+// GossipRateLimiter is a token bucket rate limiter of inbound gossip, keyed by
+// peer and bitmask. The bitmasks without a limit of their own share a bucket
+// per peer, so that the buckets a peer holds are bounded by the configured
+// limits rather than by the bitmasks it sends on.
+type GossipRateLimiter struct {
+	clock clock.Clock
+	// Limits by bitmask, and the limit of the bitmasks without their own.
+	limits   map[string]GossipRateLimit
+	fallback GossipRateLimit
+
+	mx      sync.Mutex
+	buckets map[gossipBucketKey]*gossipBucket
+	// Messages dropped by peer since they were last taken.
+	strikes map[peer.ID]*gossipStrikes
+	pruned  time.Time
+}
+
+// NewGossipRateLimiter limits each bitmask keyed in limits by its limit, and
+// every other bitmask by fallback.
+func NewGossipRateLimiter(
+	clk clock.Clock,
+	limits map[string]GossipRateLimit,
+	fallback GossipRateLimit,
+) *GossipRateLimiter {
+	return &GossipRateLimiter{
+		clock:    clk,
+		limits:   limits,
+		fallback: fallback,
+		buckets:  map[gossipBucketKey]*gossipBucket{},
+		strikes:  map[peer.ID]*gossipStrikes{},
+		pruned:   clk.Now(),
+	}
+}
+
+// Allow reports whether a message of size bytes from the peer on the bitmask
+// is within its rate, taking it from the peer's bucket if so.
+func (l *GossipRateLimiter) Allow(p peer.ID, bitmask []byte, size int) bool {
+	key := gossipBucketKey{peer: p, bitmask: string(bitmask)}
+	limit, ok := l.limits[string(bitmask)]
+	if !ok {
+		limit = l.fallback
+		key.bitmask = ""
+	}
+	if limit.MessagesPerSecond <= 0 && limit.BytesPerSecond <= 0 {
+		return true
+	}
+
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	now := l.clock.Now()
+	if now.Sub(l.pruned) > gossipBucketIdle {
+		for key, b := range l.buckets {
+			if now.Sub(b.updated) > gossipBucketIdle {
+				delete(l.buckets, key)
+			}
+		}
+		for p, s := range l.strikes {
+			if now.Sub(s.updated) > gossipBucketIdle {
+				delete(l.strikes, p)
+			}
+		}
+		l.pruned = now
+	}
+
+	// At least one message fits in the bucket, however slow the rate.
+	messageBurst := max(limit.MessagesPerSecond, 1)
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &gossipBucket{
+			messages: messageBurst,
+			bytes:    limit.BytesPerSecond,
+			updated:  now,
+		}
+		l.buckets[key] = b
+	}
+
+	elapsed := now.Sub(b.updated).Seconds()
+	b.messages = min(
+		b.messages+elapsed*limit.MessagesPerSecond,
+		messageBurst,
+	)
+	b.bytes = min(b.bytes+elapsed*limit.BytesPerSecond, limit.BytesPerSecond)
+	b.updated = now
+
+	if (limit.MessagesPerSecond > 0 && b.messages < 1) ||
+		(limit.BytesPerSecond > 0 &&
+			b.bytes < min(float64(size), limit.BytesPerSecond)) {
+		gossipRateLimitedTotal.Inc()
+		s, ok := l.strikes[p]
+		if !ok {
+			s = &gossipStrikes{}
+			l.strikes[p] = s
+		}
+		s.dropped++
+		s.updated = now
+		return false
+	}
+
+	b.messages--
+	b.bytes -= float64(size)
+	return true
+}
+
+// TakeDropped returns the number of messages from the peer dropped within the
+// last minute or so, and forgets them.
+func (l *GossipRateLimiter) TakeDropped(p peer.ID) int {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	s, ok := l.strikes[p]
+	if !ok {
+		return 0
+	}
+	delete(l.strikes, p)
+	return s.dropped
+}
@@ -0,0 +1,86 @@
+package internal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p/internal"
+)
+
+func TestGossipRateLimiter(t *testing.T) {
+	clk := clock.NewFakeClock(time.Unix(0, 0))
+	limited := []byte{0x01}
+	limiter := internal.NewGossipRateLimiter(
+		clk,
+		map[string]internal.GossipRateLimit{
+			string(limited): {MessagesPerSecond: 2, BytesPerSecond: 100},
+		},
+		internal.GossipRateLimit{},
+	)
+	a, b := peer.ID("a"), peer.ID("b")
+
+	require.True(t, limiter.Allow(a, limited, 10))
+	require.True(t, limiter.Allow(a, limited, 10))
+	require.False(t, limiter.Allow(a, limited, 10))
+
+	// Peers have buckets of their own, and unlimited bitmasks are not limited.
+	require.True(t, limiter.Allow(b, limited, 10))
+	for i := 0; i < 10; i++ {
+		require.True(t, limiter.Allow(a, []byte{0x02}, 1000))
+	}
+
+	clk.Advance(500 * time.Millisecond)
+	require.True(t, limiter.Allow(a, limited, 10))
+	require.False(t, limiter.Allow(a, limited, 10))
+
+	// A message larger than the burst passes a full bucket, leaving a deficit.
+	clk.Advance(time.Second)
+	require.True(t, limiter.Allow(b, limited, 150))
+	clk.Advance(time.Second)
+	require.False(t, limiter.Allow(b, limited, 60))
+	clk.Advance(200 * time.Millisecond)
+	require.True(t, limiter.Allow(b, limited, 60))
+}
+
+func TestGossipRateLimiterFallback(t *testing.T) {
+	clk := clock.NewFakeClock(time.Unix(0, 0))
+	limiter := internal.NewGossipRateLimiter(
+		clk,
+		map[string]internal.GossipRateLimit{},
+		internal.GossipRateLimit{MessagesPerSecond: 2},
+	)
+	a, b := peer.ID("a"), peer.ID("b")
+
+	// Bitmasks without a limit of their own share the peer's bucket, so sending
+	// on fresh bitmasks does not escape the limit.
+	require.True(t, limiter.Allow(a, []byte{0x01}, 10))
+	require.True(t, limiter.Allow(a, []byte{0x02}, 10))
+	require.False(t, limiter.Allow(a, []byte{0x03}, 10))
+	require.True(t, limiter.Allow(b, []byte{0x03}, 10))
+}
+
+func TestGossipRateLimiterDropped(t *testing.T) {
+	clk := clock.NewFakeClock(time.Unix(0, 0))
+	limiter := internal.NewGossipRateLimiter(
+		clk,
+		map[string]internal.GossipRateLimit{},
+		internal.GossipRateLimit{MessagesPerSecond: 1},
+	)
+	a, b := peer.ID("a"), peer.ID("b")
+
+	require.True(t, limiter.Allow(a, []byte{0x01}, 10))
+	require.False(t, limiter.Allow(a, []byte{0x01}, 10))
+	require.False(t, limiter.Allow(a, []byte{0x01}, 10))
+	require.Equal(t, 2, limiter.TakeDropped(a))
+	require.Equal(t, 0, limiter.TakeDropped(a))
+	require.Equal(t, 0, limiter.TakeDropped(b))
+
+	// Drops are forgotten once the peer has been quiet for a while.
+	require.False(t, limiter.Allow(a, []byte{0x01}, 10))
+	clk.Advance(2 * time.Minute)
+	require.True(t, limiter.Allow(b, []byte{0x01}, 10))
+	require.Equal(t, 0, limiter.TakeDropped(a))
+}
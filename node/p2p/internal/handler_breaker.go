@@ -0,0 +1,140 @@
+package internal
+
+import (
+	"encoding/hex"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+)
+
+var (
+	handlerPanicsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "p2p",
+			Name:      "handler_panics_total",
+			Help:      "Panics recovered from subscription message handlers.",
+		},
+		[]string{"bitmask"},
+	)
+	handlerBreakerTripsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "p2p",
+			Name:      "handler_breaker_trips_total",
+			Help:      "Times a subscription handler circuit breaker opened.",
+		},
+		[]string{"bitmask"},
+	)
+	handlerBreakerDroppedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "p2p",
+			Name:      "handler_breaker_dropped_total",
+			Help:      "Messages not delivered because the handler circuit breaker was open.",
+		},
+		[]string{"bitmask"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(
+		handlerPanicsTotal,
+		handlerBreakerTripsTotal,
+		handlerBreakerDroppedTotal,
+	)
+}
+
+// HandlerBreaker recovers panics from a subscription message handler and,
+// once the handler has panicked threshold times in a row, stops delivering
+// messages to it for the cooldown period. The subscription keeps reading so
+// that it stays alive and its queue does not back up. After the cooldown a
+// single further panic reopens the breaker.
+type HandlerBreaker struct {
+	logger    *zap.Logger
+	clock     clock.Clock
+	bitmask   string
+	threshold int
+	cooldown  time.Duration
+
+	mx          sync.Mutex
+	consecutive int
+	openUntil   time.Time
+}
+
+// NewHandlerBreaker creates a breaker for the handler subscribed to bitmask.
+// A threshold of zero or less disables the breaker, panics are still
+// recovered. The p2p config turns a zero handlerPanicThreshold into the
+// default, so it is disabled there with a negative one.
+func NewHandlerBreaker(
+	logger *zap.Logger,
+	clk clock.Clock,
+	bitmask []byte,
+	threshold int,
+	cooldown time.Duration,
+) *HandlerBreaker {
+	return &HandlerBreaker{
+		logger:    logger,
+		clock:     clk,
+		bitmask:   hex.EncodeToString(bitmask),
+		threshold: threshold,
+		cooldown:  cooldown,
+	}
+}
+
+// Call delivers a message to the handler unless the breaker is open,
+// converting a panic into an error.
+func (b *HandlerBreaker) Call(handler func() error) (err error) {
+	b.mx.Lock()
+	open := b.clock.Now().Before(b.openUntil)
+	b.mx.Unlock()
+	if open {
+		handlerBreakerDroppedTotal.WithLabelValues(b.bitmask).Inc()
+		return nil
+	}
+
+	panicked := true
+	defer func() {
+		if r := recover(); r != nil {
+			handlerPanicsTotal.WithLabelValues(b.bitmask).Inc()
+			b.logger.Error(
+				"message handler panicked",
+				zap.String("bitmask", b.bitmask),
+				zap.Any("panic", r),
+				zap.ByteString("stack", debug.Stack()),
+			)
+			err = errors.Errorf("message handler panicked: %v", r)
+		}
+		b.record(panicked)
+	}()
+
+	err = handler()
+	panicked = false
+	return err
+}
+
+func (b *HandlerBreaker) record(panicked bool) {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	if !panicked {
+		b.consecutive = 0
+		return
+	}
+
+	b.consecutive++
+	if b.threshold <= 0 || b.consecutive < b.threshold {
+		return
+	}
+
+	b.openUntil = b.clock.Now().Add(b.cooldown)
+	b.consecutive = b.threshold - 1
+	handlerBreakerTripsTotal.WithLabelValues(b.bitmask).Inc()
+	b.logger.Warn(
+		"pausing delivery to persistently panicking message handler",
+		zap.String("bitmask", b.bitmask),
+		zap.Duration("cooldown", b.cooldown),
+	)
+}
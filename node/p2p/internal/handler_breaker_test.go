@@ -0,0 +1,82 @@
+package internal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p/internal"
+)
+
+func TestHandlerBreaker(t *testing.T) {
+	clk := clock.NewFakeClock(time.Unix(1700000000, 0))
+	breaker := internal.NewHandlerBreaker(
+		zap.NewNop(),
+		clk,
+		[]byte{0x01},
+		3,
+		30*time.Second,
+	)
+
+	calls := 0
+	panicking := func() error {
+		calls++
+		panic("boom")
+	}
+	failing := errors.New("failed")
+	erroring := func() error {
+		calls++
+		return failing
+	}
+
+	// Panics become errors, and a returned error is not a panic.
+	require.ErrorContains(t, breaker.Call(panicking), "boom")
+	require.ErrorContains(t, breaker.Call(panicking), "boom")
+	require.ErrorIs(t, breaker.Call(erroring), failing)
+	require.ErrorContains(t, breaker.Call(panicking), "boom")
+	require.ErrorContains(t, breaker.Call(panicking), "boom")
+	require.Equal(t, 5, calls)
+
+	// The third panic in a row opens the breaker for the cooldown.
+	require.ErrorContains(t, breaker.Call(panicking), "boom")
+	require.NoError(t, breaker.Call(panicking))
+	clk.Advance(29 * time.Second)
+	require.NoError(t, breaker.Call(panicking))
+	require.Equal(t, 6, calls)
+
+	// After the cooldown a single further panic reopens it.
+	clk.Advance(time.Second)
+	require.ErrorContains(t, breaker.Call(panicking), "boom")
+	require.NoError(t, breaker.Call(panicking))
+	require.Equal(t, 7, calls)
+
+	// A delivery that succeeds closes it again.
+	clk.Advance(30 * time.Second)
+	require.NoError(t, breaker.Call(func() error { calls++; return nil }))
+	require.ErrorContains(t, breaker.Call(panicking), "boom")
+	require.ErrorContains(t, breaker.Call(panicking), "boom")
+	require.Equal(t, 10, calls)
+}
+
+func TestHandlerBreakerDisabled(t *testing.T) {
+	breaker := internal.NewHandlerBreaker(
+		zap.NewNop(),
+		clock.NewFakeClock(time.Unix(1700000000, 0)),
+		[]byte{0x01},
+		-1,
+		30*time.Second,
+	)
+
+	// Without a threshold panics are recovered but delivery never pauses.
+	calls := 0
+	for i := 0; i < 10; i++ {
+		require.Error(t, breaker.Call(func() error {
+			calls++
+			panic("boom")
+		}))
+	}
+	require.Equal(t, 10, calls)
+}
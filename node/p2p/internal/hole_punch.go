@@ -0,0 +1,62 @@
+package internal
+
+import (
+	"sync/atomic"
+
+	"github.com/libp2p/go-libp2p/p2p/protocol/holepunch"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var holePunchesTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "p2p",
+		Name:      "hole_punches_total",
+		Help:      "Finished DCUtR hole punches and direct dials, by kind and result.",
+	},
+	[]string{"kind", "result"},
+)
+
+func init() {
+	prometheus.MustRegister(holePunchesTotal)
+}
+
+// HolePunchTracer counts the outcomes of the hole punching service, both the
+// hole punches and the direct dials tried before them.
+type HolePunchTracer struct {
+	successes atomic.Uint64
+	failures  atomic.Uint64
+}
+
+var _ holepunch.EventTracer = (*HolePunchTracer)(nil)
+
+func (t *HolePunchTracer) Trace(evt *holepunch.Event) {
+	var kind string
+	var success bool
+	switch e := evt.Evt.(type) {
+	case *holepunch.EndHolePunchEvt:
+		kind, success = "hole_punch", e.Success
+	case *holepunch.DirectDialEvt:
+		kind, success = "direct_dial", e.Success
+	default:
+		return
+	}
+
+	result := "failure"
+	if success {
+		result = "success"
+		if kind == "hole_punch" {
+			t.successes.Add(1)
+		}
+	} else if kind == "hole_punch" {
+		t.failures.Add(1)
+	}
+	holePunchesTotal.WithLabelValues(kind, result).Inc()
+}
+
+// Counts returns the number of successful and failed hole punches.
+func (t *HolePunchTracer) Counts() (successes uint64, failures uint64) {
+	if t == nil {
+		return 0, 0
+	}
+	return t.successes.Load(), t.failures.Load()
+}
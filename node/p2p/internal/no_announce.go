@@ -0,0 +1,95 @@
+package internal
+
+import (
+	"net"
+
+	basichost "github.com/libp2p/go-libp2p/p2p/host/basic"
+	ma "github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"github.com/pkg/errors"
+)
+
+// NoAnnounce keeps addresses from being announced. An entry either names an
+// address exactly or, ending in an ipcidr component such as
+// /ip4/10.0.0.0/ipcidr/8, covers every address of an IP in the range.
+type NoAnnounce struct {
+	addrs  []ma.Multiaddr
+	ranges []*net.IPNet
+	// Whether private, loopback and link-local addresses are kept as well.
+	private bool
+}
+
+// NewNoAnnounce parses the addresses and ranges not to announce, and keeps
+// private addresses from being announced as well if private is set.
+func NewNoAnnounce(addrs []string, private bool) (*NoAnnounce, error) {
+	n := &NoAnnounce{private: private}
+	for _, addr := range addrs {
+		m, err := ma.NewMultiaddr(addr)
+		if err != nil {
+			return nil, errors.Wrap(err, "new no announce")
+		}
+
+		bits, err := m.ValueForProtocol(ma.P_IPCIDR)
+		if err != nil {
+			n.addrs = append(n.addrs, m)
+			continue
+		}
+
+		ip, err := manet.ToIP(m)
+		if err != nil {
+			return nil, errors.Wrap(err, "new no announce")
+		}
+		_, ipNet, err := net.ParseCIDR(ip.String() + "/" + bits)
+		if err != nil {
+			return nil, errors.Wrap(err, "new no announce")
+		}
+		n.ranges = append(n.ranges, ipNet)
+	}
+	return n, nil
+}
+
+// Announced reports whether the address may be announced.
+func (n *NoAnnounce) Announced(addr ma.Multiaddr) bool {
+	for _, m := range n.addrs {
+		if m.Equal(addr) {
+			return false
+		}
+	}
+
+	if len(n.ranges) == 0 && !n.private {
+		return true
+	}
+	ip, err := manet.ToIP(addr)
+	if err != nil {
+		return true
+	}
+	if n.private &&
+		(ip.IsPrivate() || ip.IsLoopback() || ip.IsLinkLocalUnicast()) {
+		return false
+	}
+	for _, ipNet := range n.ranges {
+		if ipNet.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// AddrsFactory wraps an address factory, or the host's default one if nil,
+// dropping the addresses not to announce from those it returns.
+func (n *NoAnnounce) AddrsFactory(
+	next basichost.AddrsFactory,
+) basichost.AddrsFactory {
+	if next == nil {
+		next = basichost.DefaultAddrsFactory
+	}
+	return func(addrs []ma.Multiaddr) []ma.Multiaddr {
+		announced := make([]ma.Multiaddr, 0, len(addrs))
+		for _, addr := range next(addrs) {
+			if n.Announced(addr) {
+				announced = append(announced, addr)
+			}
+		}
+		return announced
+	}
+}
@@ -0,0 +1,74 @@
+package internal_test
+
+import (
+	"testing"
+
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/require"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p/internal"
+)
+
+func mustMultiaddr(t *testing.T, s string) ma.Multiaddr {
+	m, err := ma.NewMultiaddr(s)
+	require.NoError(t, err)
+	return m
+}
+
+func TestNoAnnounce(t *testing.T) {
+	noAnnounce, err := internal.NewNoAnnounce([]string{
+		"/ip4/192.0.2.1/udp/8336/quic-v1",
+		"/ip4/10.0.0.0/ipcidr/8",
+		"/ip6/fd00::/ipcidr/8",
+	}, false)
+	require.NoError(t, err)
+
+	addrs := []ma.Multiaddr{
+		mustMultiaddr(t, "/ip4/192.0.2.1/udp/8336/quic-v1"),
+		mustMultiaddr(t, "/ip4/192.0.2.1/tcp/8336"),
+		mustMultiaddr(t, "/ip4/10.1.2.3/udp/8336/quic-v1"),
+		mustMultiaddr(t, "/ip6/fd12::1/udp/8336/quic-v1"),
+		mustMultiaddr(t, "/ip6/2001:db8::1/udp/8336/quic-v1"),
+		mustMultiaddr(t, "/dns4/example.com/udp/8336/quic-v1"),
+	}
+	require.Equal(
+		t,
+		[]ma.Multiaddr{addrs[1], addrs[4], addrs[5]},
+		noAnnounce.AddrsFactory(nil)(addrs),
+	)
+
+	// Filtering applies to the addresses of the wrapped factory.
+	forced := mustMultiaddr(t, "/ip4/10.0.0.1/udp/8336/quic-v1")
+	require.Empty(t, noAnnounce.AddrsFactory(
+		func([]ma.Multiaddr) []ma.Multiaddr { return []ma.Multiaddr{forced} },
+	)(addrs))
+
+	_, err = internal.NewNoAnnounce([]string{"not a multiaddr"}, false)
+	require.Error(t, err)
+	_, err = internal.NewNoAnnounce([]string{"/ip4/10.0.0.0/ipcidr/33"}, false)
+	require.Error(t, err)
+}
+
+func TestNoAnnouncePrivate(t *testing.T) {
+	noAnnounce, err := internal.NewNoAnnounce(nil, true)
+	require.NoError(t, err)
+
+	for addr, announced := range map[string]bool{
+		"/ip4/203.0.113.1/udp/8336/quic-v1":  true,
+		"/ip6/2001:db8::1/udp/8336/quic-v1":  true,
+		"/dns4/example.com/udp/8336/quic-v1": true,
+		"/ip4/192.168.1.2/udp/8336/quic-v1":  false,
+		"/ip4/10.1.2.3/tcp/8336":             false,
+		"/ip4/127.0.0.1/udp/8336/quic-v1":    false,
+		"/ip4/169.254.1.1/udp/8336/quic-v1":  false,
+		"/ip6/fd12::1/udp/8336/quic-v1":      false,
+		"/ip6/::1/udp/8336/quic-v1":          false,
+		"/ip6/fe80::1/udp/8336/quic-v1":      false,
+	} {
+		require.Equal(
+			t,
+			announced,
+			noAnnounce.Announced(mustMultiaddr(t, addr)),
+			addr,
+		)
+	}
+}
@@ -2,6 +2,7 @@ package internal
 
 import (
 	"context"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -16,8 +17,44 @@ import (
 
 // PeerConnector is a connector to peers.
 type PeerConnector interface {
-	// Connect connects to peers.
-	Connect(context.Context) error
+	// Connect connects to peers, returning what the round did.
+	Connect(context.Context) (PeerConnectResult, error)
+}
+
+// QuotaPeerConnector is a PeerConnector whose rounds connect up to a quota of
+// peers.
+type QuotaPeerConnector interface {
+	PeerConnector
+	// Quota returns the number of peers a round connects to by default.
+	Quota() int
+	// ConnectQuota connects to up to quota peers, returning what the round did.
+	ConnectQuota(ctx context.Context, quota int) (PeerConnectResult, error)
+}
+
+// connectRequest asks a connector's run loop for a round of connections, up to
+// quota peers or the connector's default quota if negative.
+type connectRequest struct {
+	quota int
+	done  chan<- PeerConnectResult
+}
+
+// PeerConnectResult counts the peers of a round of connections.
+type PeerConnectResult struct {
+	// Peers dialed.
+	Attempted uint32
+	// Dialed peers that connected and were identified.
+	Connected uint32
+	// Peers skipped as they were connected already.
+	Duplicate uint32
+}
+
+// Add sums the counts of two rounds.
+func (r PeerConnectResult) Add(o PeerConnectResult) PeerConnectResult {
+	return PeerConnectResult{
+		Attempted: r.Attempted + o.Attempted,
+		Connected: r.Connected + o.Connected,
+		Duplicate: r.Duplicate + o.Duplicate,
+	}
 }
 
 type peerConnector struct {
@@ -25,29 +62,56 @@ type peerConnector struct {
 	logger      *zap.Logger
 	host        host.Host
 	idService   identify.IDService
-	connectCh   chan (chan<- struct{})
+	addrQuality *AddressQuality
+	connectCh   chan connectRequest
 	minPeers    int
 	parallelism int
 	source      PeerSource
+	sourceName  string
+	sourceStats *PeerSourceStats
 }
 
 // Connect implements PeerConnector.
-func (pc *peerConnector) Connect(ctx context.Context) error {
-	done := make(chan struct{})
+func (pc *peerConnector) Connect(ctx context.Context) (PeerConnectResult, error) {
+	return requestConnect(ctx, pc.ctx, pc.connectCh, -1)
+}
+
+// Quota implements QuotaPeerConnector.
+func (pc *peerConnector) Quota() int {
+	return pc.minPeers
+}
+
+// ConnectQuota implements QuotaPeerConnector.
+func (pc *peerConnector) ConnectQuota(
+	ctx context.Context,
+	quota int,
+) (PeerConnectResult, error) {
+	return requestConnect(ctx, pc.ctx, pc.connectCh, max(quota, 0))
+}
+
+// requestConnect hands a round of up to quota peers to the run loop of a
+// connector living on runCtx and waits for its result.
+func requestConnect(
+	ctx context.Context,
+	runCtx context.Context,
+	connectCh chan<- connectRequest,
+	quota int,
+) (PeerConnectResult, error) {
+	done := make(chan PeerConnectResult, 1)
 	select {
 	case <-ctx.Done():
-		return ctx.Err()
-	case <-pc.ctx.Done():
-		return pc.ctx.Err()
-	case pc.connectCh <- done:
+		return PeerConnectResult{}, ctx.Err()
+	case <-runCtx.Done():
+		return PeerConnectResult{}, runCtx.Err()
+	case connectCh <- connectRequest{quota: quota, done: done}:
 	}
 	select {
 	case <-ctx.Done():
-		return ctx.Err()
-	case <-pc.ctx.Done():
-		return pc.ctx.Err()
-	case <-done:
-		return nil
+		return PeerConnectResult{}, ctx.Err()
+	case <-runCtx.Done():
+		return PeerConnectResult{}, runCtx.Err()
+	case result := <-done:
+		return result, nil
 	}
 }
 
@@ -75,11 +139,16 @@ func (pc *peerConnector) connectToPeer(
 		return
 	}
 
-	pc.host.Peerstore().AddAddrs(p.ID, p.Addrs, peerstore.AddressTTL)
+	pc.host.Peerstore().AddAddrs(
+		p.ID,
+		pc.addrQuality.Usable(p.ID, p.Addrs),
+		peerstore.AddressTTL,
+	)
 
 	conn, err := pc.host.Network().DialPeer(ctx, p.ID)
 	if err != nil {
 		logger.Debug("error while connecting to dht peer", zap.Error(err))
+		pc.addrQuality.ObserveDialError(p.ID, err)
 		atomic.AddUint32(failure, 1)
 		return
 	}
@@ -94,12 +163,16 @@ func (pc *peerConnector) connectToPeer(
 	case <-pc.idService.IdentifyWait(conn):
 		logger.Debug("connected to peer")
 		atomic.AddUint32(success, 1)
+		if pc.sourceStats != nil {
+			pc.sourceStats.Connected(pc.sourceName, p.ID)
+		}
 	}
 }
 
 func (pc *peerConnector) connectToPeers(
 	ctx context.Context,
 	ch <-chan peer.AddrInfo,
+	quota int,
 	duplicate, success, failure *uint32,
 ) {
 	var inflight chan struct{} = make(chan struct{}, pc.parallelism)
@@ -109,7 +182,7 @@ func (pc *peerConnector) connectToPeers(
 		logger := pc.logger.With(zap.String("peer_id", p.ID.String()))
 		logger.Debug("received peer")
 
-		if atomic.LoadUint32(success) >= uint32(pc.minPeers) {
+		if atomic.LoadUint32(success) >= uint32(quota) {
 			logger.Debug("reached max findings")
 			return
 		}
@@ -133,8 +206,14 @@ func (pc *peerConnector) connectToPeers(
 	}
 }
 
-func (pc *peerConnector) connect() {
+func (pc *peerConnector) connect(quota int) PeerConnectResult {
 	logger := pc.logger
+	if quota < 0 {
+		quota = pc.minPeers
+	}
+	if quota == 0 {
+		return PeerConnectResult{}
+	}
 
 	logger.Info("initiating peer connections")
 	var success, failure, duplicate uint32
@@ -152,16 +231,22 @@ func (pc *peerConnector) connect() {
 	peerChan, err := pc.source.Peers(ctx)
 	if err != nil {
 		logger.Error("could not find peers", zap.Error(err))
-		return
+		return PeerConnectResult{}
 	}
 
 	pc.connectToPeers(
 		ctx,
 		peerChan,
+		quota,
 		&duplicate,
 		&success,
 		&failure,
 	)
+	return PeerConnectResult{
+		Attempted: atomic.LoadUint32(&success) + atomic.LoadUint32(&failure),
+		Connected: atomic.LoadUint32(&success),
+		Duplicate: atomic.LoadUint32(&duplicate),
+	}
 }
 
 func (pc *peerConnector) run() {
@@ -169,60 +254,142 @@ func (pc *peerConnector) run() {
 		select {
 		case <-pc.ctx.Done():
 			return
-		case done := <-pc.connectCh:
-			pc.connect()
-			close(done)
+		case req := <-pc.connectCh:
+			req.done <- pc.connect(req.quota)
 		}
 	}
 }
 
-// NewPeerConnector creates a new peer connector.
+// NewPeerConnector creates a new peer connector. The peers it connects to are
+// credited to sourceName in sourceStats, if given.
 func NewPeerConnector(
 	ctx context.Context,
 	logger *zap.Logger,
 	host host.Host,
 	idService identify.IDService,
+	addrQuality *AddressQuality,
 	minPeers, parallelism int,
 	source PeerSource,
+	sourceName string,
+	sourceStats *PeerSourceStats,
 ) PeerConnector {
 	pc := &peerConnector{
 		ctx:         ctx,
 		logger:      logger,
 		host:        host,
 		idService:   idService,
-		connectCh:   make(chan (chan<- struct{})),
+		addrQuality: addrQuality,
+		connectCh:   make(chan connectRequest),
 		minPeers:    minPeers,
 		parallelism: parallelism,
 		source:      source,
+		sourceName:  sourceName,
+		sourceStats: sourceStats,
 	}
 	go pc.run()
 	return pc
 }
 
+// SourcedPeerConnector is a peer connector along with the name its peers are
+// credited to in the peer source stats.
+type SourcedPeerConnector struct {
+	Source    string
+	Connector PeerConnector
+}
+
 type chainedPeerConnector struct {
 	ctx        context.Context
-	connectors []PeerConnector
-	connectCh  chan (chan<- struct{})
+	stats      *PeerSourceStats
+	connectors []SourcedPeerConnector
+	connectCh  chan connectRequest
 }
 
 // Connect implements PeerConnector.
-func (cpc *chainedPeerConnector) Connect(ctx context.Context) error {
-	done := make(chan struct{})
-	select {
-	case <-ctx.Done():
-		return ctx.Err()
-	case <-cpc.ctx.Done():
-		return cpc.ctx.Err()
-	case cpc.connectCh <- done:
+func (cpc *chainedPeerConnector) Connect(ctx context.Context) (PeerConnectResult, error) {
+	return requestConnect(ctx, cpc.ctx, cpc.connectCh, -1)
+}
+
+// Quota implements QuotaPeerConnector. It is the sum of the default quotas of
+// the connectors.
+func (cpc *chainedPeerConnector) Quota() int {
+	quota := 0
+	for _, c := range cpc.connectors {
+		if q, ok := c.Connector.(QuotaPeerConnector); ok {
+			quota += q.Quota()
+		}
 	}
-	select {
-	case <-ctx.Done():
-		return ctx.Err()
-	case <-cpc.ctx.Done():
-		return cpc.ctx.Err()
-	case <-done:
-		return nil
+	return quota
+}
+
+// ConnectQuota implements QuotaPeerConnector.
+func (cpc *chainedPeerConnector) ConnectQuota(
+	ctx context.Context,
+	quota int,
+) (PeerConnectResult, error) {
+	return requestConnect(ctx, cpc.ctx, cpc.connectCh, max(quota, 0))
+}
+
+// weights returns the weight of each connector's source, one for all of them
+// without stats.
+func (cpc *chainedPeerConnector) weights() []float64 {
+	weights := make([]float64, len(cpc.connectors))
+	for i, c := range cpc.connectors {
+		weights[i] = 1
+		if cpc.stats != nil {
+			weights[i] = cpc.stats.Weight(c.Source)
+		}
+	}
+	return weights
+}
+
+// allocate splits the quota of a round between the connectors that take one,
+// in proportion to the weights of their sources. Each gets at least one peer,
+// so that a source keeps being judged however poorly it did. Returns -1 for
+// connectors without a quota.
+func (cpc *chainedPeerConnector) allocate(
+	quota int,
+	weights []float64,
+) []int {
+	quotas := make([]int, len(cpc.connectors))
+	total := 0.0
+	takers := 0
+	for i, c := range cpc.connectors {
+		if _, ok := c.Connector.(QuotaPeerConnector); !ok {
+			quotas[i] = -1
+			continue
+		}
+		quotas[i] = 1
+		total += weights[i]
+		takers++
+	}
+	remaining := quota - takers
+	if remaining <= 0 || total == 0 {
+		return quotas
+	}
+
+	// Largest remainder, ties going to the earlier connector.
+	remainders := make([]float64, len(quotas))
+	allocated := 0
+	for i := range quotas {
+		if quotas[i] < 0 {
+			continue
+		}
+		share := float64(remaining) * weights[i] / total
+		quotas[i] += int(share)
+		allocated += int(share)
+		remainders[i] = share - float64(int(share))
+	}
+	for ; allocated < remaining; allocated++ {
+		best := -1
+		for i := range quotas {
+			if quotas[i] >= 0 && (best < 0 || remainders[i] > remainders[best]) {
+				best = i
+			}
+		}
+		quotas[best]++
+		remainders[best] = -1
 	}
+	return quotas
 }
 
 func (cpc *chainedPeerConnector) run() {
@@ -230,21 +397,61 @@ func (cpc *chainedPeerConnector) run() {
 		select {
 		case <-cpc.ctx.Done():
 			return
-		case done := <-cpc.connectCh:
-			for _, pc := range cpc.connectors {
-				_ = pc.Connect(cpc.ctx)
+		case req := <-cpc.connectCh:
+			weights := cpc.weights()
+			quotas := make([]int, len(cpc.connectors))
+			switch {
+			case req.quota >= 0:
+				quotas = cpc.allocate(req.quota, weights)
+			case cpc.stats != nil:
+				quotas = cpc.allocate(cpc.Quota(), weights)
+			default:
+				for i := range quotas {
+					quotas[i] = -1
+				}
 			}
-			close(done)
+
+			// Sources whose peers most often stay connected go first, ties
+			// keeping the configured order.
+			order := make([]int, len(cpc.connectors))
+			for i := range order {
+				order[i] = i
+			}
+			sort.SliceStable(order, func(i, j int) bool {
+				return weights[order[i]] > weights[order[j]]
+			})
+
+			var result PeerConnectResult
+			for _, i := range order {
+				var r PeerConnectResult
+				q, ok := cpc.connectors[i].Connector.(QuotaPeerConnector)
+				if ok && quotas[i] >= 0 {
+					r, _ = q.ConnectQuota(cpc.ctx, quotas[i])
+				} else {
+					r, _ = cpc.connectors[i].Connector.Connect(cpc.ctx)
+				}
+				result = result.Add(r)
+			}
+			req.done <- result
 		}
 	}
 }
 
-// NewChainedPeerConnector creates a new chained peer connector.
-func NewChainedPeerConnector(ctx context.Context, connectors ...PeerConnector) PeerConnector {
+// NewChainedPeerConnector creates a new chained peer connector, which runs
+// the connectors in turn, those whose peers most often stay connected first.
+// The default quotas of the connectors are pooled and split between them by
+// the same measure. Without stats the connectors run in the given order, each
+// with its own quota.
+func NewChainedPeerConnector(
+	ctx context.Context,
+	stats *PeerSourceStats,
+	connectors ...SourcedPeerConnector,
+) PeerConnector {
 	cpc := &chainedPeerConnector{
 		ctx:        ctx,
+		stats:      stats,
 		connectors: connectors,
-		connectCh:  make(chan (chan<- struct{})),
+		connectCh:  make(chan connectRequest),
 	}
 	go cpc.run()
 	return cpc
@@ -254,7 +461,7 @@ type conditionalPeerConnector struct {
 	ctx       context.Context
 	condition PeerConnectorCondition
 	connector PeerConnector
-	connectCh chan (chan<- struct{})
+	connectCh chan connectRequest
 }
 
 func (cpc *conditionalPeerConnector) run() {
@@ -262,33 +469,41 @@ func (cpc *conditionalPeerConnector) run() {
 		select {
 		case <-cpc.ctx.Done():
 			return
-		case done := <-cpc.connectCh:
+		case req := <-cpc.connectCh:
+			var result PeerConnectResult
 			if cpc.condition.Should() {
-				_ = cpc.connector.Connect(cpc.ctx)
+				q, ok := cpc.connector.(QuotaPeerConnector)
+				if ok && req.quota >= 0 {
+					result, _ = q.ConnectQuota(cpc.ctx, req.quota)
+				} else {
+					result, _ = cpc.connector.Connect(cpc.ctx)
+				}
 			}
-			close(done)
+			req.done <- result
 		}
 	}
 }
 
 // Connect implements PeerConnector.
-func (cpc *conditionalPeerConnector) Connect(ctx context.Context) error {
-	done := make(chan struct{})
-	select {
-	case <-ctx.Done():
-		return ctx.Err()
-	case <-cpc.ctx.Done():
-		return cpc.ctx.Err()
-	case cpc.connectCh <- done:
-	}
-	select {
-	case <-ctx.Done():
-		return ctx.Err()
-	case <-cpc.ctx.Done():
-		return cpc.ctx.Err()
-	case <-done:
-		return nil
+func (cpc *conditionalPeerConnector) Connect(ctx context.Context) (PeerConnectResult, error) {
+	return requestConnect(ctx, cpc.ctx, cpc.connectCh, -1)
+}
+
+// Quota implements QuotaPeerConnector. It is the quota of the wrapped
+// connector, zero if it has none.
+func (cpc *conditionalPeerConnector) Quota() int {
+	if q, ok := cpc.connector.(QuotaPeerConnector); ok {
+		return q.Quota()
 	}
+	return 0
+}
+
+// ConnectQuota implements QuotaPeerConnector.
+func (cpc *conditionalPeerConnector) ConnectQuota(
+	ctx context.Context,
+	quota int,
+) (PeerConnectResult, error) {
+	return requestConnect(ctx, cpc.ctx, cpc.connectCh, max(quota, 0))
 }
 
 // NewConditionalPeerConnector creates a new conditional peer connector.
@@ -301,7 +516,7 @@ func NewConditionalPeerConnector(
 		ctx:       ctx,
 		condition: condition,
 		connector: connector,
-		connectCh: make(chan (chan<- struct{})),
+		connectCh: make(chan connectRequest),
 	}
 	go cpc.run()
 	return cpc
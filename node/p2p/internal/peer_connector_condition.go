@@ -14,22 +14,24 @@ type PeerConnectorCondition interface {
 type notEnoughPeersCondition struct {
 	host     host.Host
 	minPeers int
-	peers    map[peer.ID]struct{}
+	peers    func() map[peer.ID]struct{}
 }
 
 // Should implements PeerConnectorCondition.
 func (c *notEnoughPeersCondition) Should() bool {
 	count := 0
+	peers := c.peers()
 	for _, p := range c.host.Network().Peers() {
-		if _, ok := c.peers[p]; ok {
+		if _, ok := peers[p]; ok {
 			count++
 		}
 	}
 	return count < c.minPeers
 }
 
-// NewNotEnoughPeersCondition creates a new not enough peers condition.
-func NewNotEnoughPeersCondition(host host.Host, minPeers int, peers map[peer.ID]struct{}) PeerConnectorCondition {
+// NewNotEnoughPeersCondition creates a new not enough peers condition, over
+// the set of peers returned by peers at the time of each check.
+func NewNotEnoughPeersCondition(host host.Host, minPeers int, peers func() map[peer.ID]struct{}) PeerConnectorCondition {
 	return &notEnoughPeersCondition{
 		host:     host,
 		minPeers: minPeers,
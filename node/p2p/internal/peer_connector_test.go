@@ -0,0 +1,124 @@
+package internal_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p/internal"
+)
+
+// recordingConnector records the quota of each round, -1 for rounds connected
+// with Connect.
+type recordingConnector struct {
+	name   string
+	quota  int
+	mx     *sync.Mutex
+	rounds *[]string
+	quotas []int
+}
+
+func (c *recordingConnector) record(quota int) internal.PeerConnectResult {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	*c.rounds = append(*c.rounds, c.name)
+	c.quotas = append(c.quotas, quota)
+	return internal.PeerConnectResult{Connected: uint32(max(quota, 0))}
+}
+
+func (c *recordingConnector) Connect(
+	ctx context.Context,
+) (internal.PeerConnectResult, error) {
+	return c.record(-1), nil
+}
+
+func (c *recordingConnector) Quota() int {
+	return c.quota
+}
+
+func (c *recordingConnector) ConnectQuota(
+	ctx context.Context,
+	quota int,
+) (internal.PeerConnectResult, error) {
+	return c.record(quota), nil
+}
+
+func TestChainedPeerConnectorAllocatesQuota(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	clk := clock.NewFakeClock(time.Unix(0, 0))
+	stats := internal.NewPeerSourceStats(clk, time.Minute)
+	mx := &sync.Mutex{}
+	rounds := []string{}
+	dht := &recordingConnector{name: "dht", quota: 8, mx: mx, rounds: &rounds}
+	bootstrap := &recordingConnector{
+		name:   "bootstrap",
+		quota:  2,
+		mx:     mx,
+		rounds: &rounds,
+	}
+	chain := internal.NewChainedPeerConnector(
+		ctx,
+		stats,
+		internal.SourcedPeerConnector{Source: "dht", Connector: dht},
+		internal.SourcedPeerConnector{Source: "bootstrap", Connector: bootstrap},
+	)
+
+	// Unjudged sources share the pooled quotas evenly, in the given order.
+	result, err := chain.Connect(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint32(10), result.Connected)
+	require.Equal(t, []string{"dht", "bootstrap"}, rounds)
+	require.Equal(t, []int{5}, dht.quotas)
+	require.Equal(t, []int{5}, bootstrap.quotas)
+
+	// dht weighs 1/5 after three dropped peers, bootstrap 1/2.
+	for _, p := range []peer.ID{"a", "b", "c"} {
+		stats.Connected("dht", p)
+		stats.Disconnected(p)
+	}
+	rounds = rounds[:0]
+	_, err = chain.Connect(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"bootstrap", "dht"}, rounds)
+	require.Equal(t, []int{5, 7}, bootstrap.quotas)
+	require.Equal(t, []int{5, 3}, dht.quotas)
+
+	// A source always gets at least one peer.
+	_, err = chain.(internal.QuotaPeerConnector).ConnectQuota(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, bootstrap.quotas[2])
+	require.Equal(t, 1, dht.quotas[2])
+}
+
+func TestChainedPeerConnectorWithoutStats(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	mx := &sync.Mutex{}
+	rounds := []string{}
+	dht := &recordingConnector{name: "dht", quota: 8, mx: mx, rounds: &rounds}
+	bootstrap := &recordingConnector{
+		name:   "bootstrap",
+		quota:  2,
+		mx:     mx,
+		rounds: &rounds,
+	}
+	chain := internal.NewChainedPeerConnector(
+		ctx,
+		nil,
+		internal.SourcedPeerConnector{Source: "dht", Connector: dht},
+		internal.SourcedPeerConnector{Source: "bootstrap", Connector: bootstrap},
+	)
+
+	// Each connector runs in the given order with its own quota.
+	_, err := chain.Connect(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"dht", "bootstrap"}, rounds)
+	require.Equal(t, []int{-1}, dht.quotas)
+	require.Equal(t, []int{-1}, bootstrap.quotas)
+	require.Equal(t, 10, chain.(internal.QuotaPeerConnector).Quota())
+}
@@ -0,0 +1,211 @@
+package internal
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+)
+
+const (
+	// Score lost for each consecutive failed ping, up to the given number of
+	// failures.
+	pingFailurePenalty       = 10
+	maxPenalizedPingFailures = 10
+	// Round trip time above which a peer loses score, in proportion to how
+	// far above it is, up to the given penalty.
+	highLatencyThreshold  = 500 * time.Millisecond
+	highLatencyPenalty    = 5
+	maxHighLatencyPenalty = 20
+	// Score gained for each period a peer stays connected and answering
+	// pings, up to the given bonus.
+	healthyBonusPeriod = 30 * time.Minute
+	maxHealthyBonus    = 5
+	// Weight of the latest round trip time in the average.
+	averageRTTWeight = 8
+	// How long the record of a disconnected peer is kept, so that a peer
+	// cannot shed its failures by reconnecting.
+	peerHealthRetention = time.Hour
+)
+
+type peerHealthEntry struct {
+	failures     int
+	rtt          time.Duration
+	healthySince time.Time
+	lastPing     time.Time
+	averageRTT   time.Duration
+	pings        int
+	failedPings  int
+	// Set while the peer is disconnected.
+	disconnectedAt time.Time
+	reconnects     int
+}
+
+// PeerHealthStatus is the ping record of a peer since it first connected, kept
+// across reconnections.
+type PeerHealthStatus struct {
+	PeerID              peer.ID
+	LastPing            time.Time
+	ConsecutiveFailures int
+	Pings               int
+	FailedPings         int
+	Reconnects          int
+	// Round trip times of the answered pings, the average weighing recent
+	// pings the most.
+	LastRTT    time.Duration
+	AverageRTT time.Duration
+	Score      float64
+}
+
+// PeerHealth turns the pings of the peer monitor into a score. Failing pings
+// and high latency lower the score of a peer, while staying connected and
+// answering pings slowly raises it.
+type PeerHealth struct {
+	clock clock.Clock
+	mx    sync.Mutex
+	peers map[peer.ID]*peerHealthEntry
+}
+
+// NewPeerHealth creates an empty peer health record.
+func NewPeerHealth(clk clock.Clock) *PeerHealth {
+	return &PeerHealth{clock: clk, peers: map[peer.ID]*peerHealthEntry{}}
+}
+
+func (h *PeerHealth) entry(p peer.ID) *peerHealthEntry {
+	e, ok := h.peers[p]
+	if !ok {
+		e = &peerHealthEntry{}
+		h.peers[p] = e
+	}
+	return e
+}
+
+// Success records a ping of the peer answered after rtt.
+func (h *PeerHealth) Success(p peer.ID, rtt time.Duration) {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	e := h.entry(p)
+	e.failures = 0
+	e.rtt = rtt
+	if e.averageRTT == 0 {
+		e.averageRTT = rtt
+	} else {
+		e.averageRTT += (rtt - e.averageRTT) / averageRTTWeight
+	}
+	e.lastPing = h.clock.Now()
+	e.pings++
+	if e.healthySince.IsZero() {
+		e.healthySince = h.clock.Now()
+	}
+}
+
+// Failure records a ping of the peer that failed or timed out.
+func (h *PeerHealth) Failure(p peer.ID) {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	e := h.entry(p)
+	e.failures++
+	e.healthySince = time.Time{}
+	e.lastPing = h.clock.Now()
+	e.pings++
+	e.failedPings++
+}
+
+// Retain marks the peers not in connected as disconnected, forgetting those
+// disconnected for longer than an hour, and counts the reconnections of the
+// others.
+func (h *PeerHealth) Retain(connected []peer.ID) {
+	keep := make(map[peer.ID]struct{}, len(connected))
+	for _, p := range connected {
+		keep[p] = struct{}{}
+	}
+
+	h.mx.Lock()
+	defer h.mx.Unlock()
+	now := h.clock.Now()
+	for p, e := range h.peers {
+		_, ok := keep[p]
+		switch {
+		case ok && !e.disconnectedAt.IsZero():
+			e.disconnectedAt = time.Time{}
+			e.reconnects++
+		case ok:
+		case e.disconnectedAt.IsZero():
+			// The peer no longer stays connected.
+			e.disconnectedAt = now
+			e.healthySince = time.Time{}
+		case now.Sub(e.disconnectedAt) > peerHealthRetention:
+			delete(h.peers, p)
+		}
+	}
+}
+
+// Score returns the score of the peer, zero if it was never pinged or has been
+// disconnected for long enough to be forgotten.
+func (h *PeerHealth) Score(p peer.ID) float64 {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	e, ok := h.peers[p]
+	if !ok {
+		return 0
+	}
+	return h.score(e)
+}
+
+// Statuses returns the ping records of the connected peers, lowest score
+// first.
+func (h *PeerHealth) Statuses() []PeerHealthStatus {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	statuses := make([]PeerHealthStatus, 0, len(h.peers))
+	for p, e := range h.peers {
+		if !e.disconnectedAt.IsZero() {
+			continue
+		}
+		statuses = append(statuses, PeerHealthStatus{
+			PeerID:              p,
+			LastPing:            e.lastPing,
+			ConsecutiveFailures: e.failures,
+			Pings:               e.pings,
+			FailedPings:         e.failedPings,
+			Reconnects:          e.reconnects,
+			LastRTT:             e.rtt,
+			AverageRTT:          e.averageRTT,
+			Score:               h.score(e),
+		})
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		if statuses[i].Score != statuses[j].Score {
+			return statuses[i].Score < statuses[j].Score
+		}
+		return statuses[i].PeerID < statuses[j].PeerID
+	})
+	return statuses
+}
+
+// score must be called with mx held.
+func (h *PeerHealth) score(e *peerHealthEntry) float64 {
+	score := -float64(pingFailurePenalty * min(
+		e.failures,
+		maxPenalizedPingFailures,
+	))
+	if e.rtt > highLatencyThreshold {
+		score -= min(
+			highLatencyPenalty*float64(e.rtt)/float64(highLatencyThreshold),
+			maxHighLatencyPenalty,
+		)
+	}
+	if !e.healthySince.IsZero() {
+		score += float64(min(
+			int64(h.clock.Since(e.healthySince)/healthyBonusPeriod),
+			maxHealthyBonus,
+		))
+	}
+	return score
+}
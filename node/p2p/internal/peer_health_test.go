@@ -0,0 +1,133 @@
+package internal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p/internal"
+)
+
+func TestPeerHealth(t *testing.T) {
+	clk := clock.NewFakeClock(time.Unix(0, 0))
+	health := internal.NewPeerHealth(clk)
+	healthy, slow, failing := peer.ID("healthy"), peer.ID("slow"), peer.ID("failing")
+
+	require.Zero(t, health.Score(healthy))
+
+	// Staying connected and answering pings slowly raises the score, up to a
+	// cap.
+	health.Success(healthy, 50*time.Millisecond)
+	require.Zero(t, health.Score(healthy))
+	clk.Advance(time.Hour)
+	health.Success(healthy, 50*time.Millisecond)
+	require.Equal(t, 2.0, health.Score(healthy))
+	clk.Advance(24 * time.Hour)
+	require.Equal(t, 5.0, health.Score(healthy))
+
+	// High latency lowers the score in proportion, up to a cap.
+	health.Success(slow, time.Second)
+	require.Equal(t, -10.0, health.Score(slow))
+	health.Success(slow, time.Minute)
+	require.Equal(t, -20.0, health.Score(slow))
+
+	// Consecutive failures lower the score, up to a cap, and reset the time
+	// the peer has been healthy for.
+	health.Success(failing, 50*time.Millisecond)
+	clk.Advance(time.Hour)
+	health.Failure(failing)
+	health.Failure(failing)
+	require.Equal(t, -20.0, health.Score(failing))
+	for i := 0; i < 20; i++ {
+		health.Failure(failing)
+	}
+	require.Equal(t, -100.0, health.Score(failing))
+	health.Success(failing, 50*time.Millisecond)
+	require.Zero(t, health.Score(failing))
+
+	health.Retain([]peer.ID{healthy})
+	require.Equal(t, 5.0, health.Score(healthy))
+	require.Equal(t, -20.0, health.Score(slow))
+}
+
+func TestPeerHealthRetain(t *testing.T) {
+	clk := clock.NewFakeClock(time.Unix(0, 0))
+	health := internal.NewPeerHealth(clk)
+	flapping, gone := peer.ID("flapping"), peer.ID("gone")
+
+	health.Success(flapping, 50*time.Millisecond)
+	health.Failure(flapping)
+	health.Failure(flapping)
+	health.Success(gone, 50*time.Millisecond)
+	clk.Advance(time.Hour)
+	require.Equal(t, 2.0, health.Score(gone))
+
+	// Disconnected peers keep their record, but no longer earn the bonus of
+	// staying connected, and are not listed.
+	health.Retain(nil)
+	require.Equal(t, -20.0, health.Score(flapping))
+	require.Zero(t, health.Score(gone))
+	require.Empty(t, health.Statuses())
+
+	// A peer reconnecting within the retention keeps its failures.
+	clk.Advance(30 * time.Minute)
+	health.Retain([]peer.ID{flapping})
+	require.Equal(t, -20.0, health.Score(flapping))
+	statuses := health.Statuses()
+	require.Len(t, statuses, 1)
+	require.Equal(t, flapping, statuses[0].PeerID)
+	require.Equal(t, 3, statuses[0].Pings)
+	require.Equal(t, 1, statuses[0].Reconnects)
+
+	// Peers disconnected for longer are forgotten.
+	clk.Advance(time.Hour)
+	health.Retain([]peer.ID{flapping})
+	require.Zero(t, health.Score(gone))
+	health.Success(gone, 50*time.Millisecond)
+	clk.Advance(time.Hour)
+	health.Retain([]peer.ID{flapping, gone})
+	statuses = health.Statuses()
+	require.Len(t, statuses, 2)
+	for _, status := range statuses {
+		if status.PeerID == gone {
+			require.Equal(t, 1, status.Pings)
+			require.Zero(t, status.Reconnects)
+		}
+	}
+}
+
+func TestPeerHealthStatuses(t *testing.T) {
+	clk := clock.NewFakeClock(time.Unix(0, 0))
+	health := internal.NewPeerHealth(clk)
+	healthy, flapping := peer.ID("healthy"), peer.ID("flapping")
+
+	health.Success(healthy, 100*time.Millisecond)
+	health.Success(healthy, 900*time.Millisecond)
+	clk.Advance(time.Minute)
+	health.Success(flapping, 100*time.Millisecond)
+	health.Failure(flapping)
+	health.Failure(flapping)
+
+	require.Equal(t, []internal.PeerHealthStatus{
+		{
+			PeerID:              flapping,
+			LastPing:            clk.Now(),
+			ConsecutiveFailures: 2,
+			Pings:               3,
+			FailedPings:         2,
+			LastRTT:             100 * time.Millisecond,
+			AverageRTT:          100 * time.Millisecond,
+			Score:               -20,
+		},
+		{
+			PeerID:     healthy,
+			LastPing:   time.Unix(0, 0),
+			Pings:      2,
+			LastRTT:    900 * time.Millisecond,
+			AverageRTT: 200 * time.Millisecond,
+			Score:      -9,
+		},
+	}, health.Statuses())
+}
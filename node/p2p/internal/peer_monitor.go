@@ -9,29 +9,35 @@ import (
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/p2p/protocol/ping"
 	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
 )
 
 type peerMonitor struct {
+	clock    clock.Clock
 	h        host.Host
 	timeout  time.Duration
 	period   time.Duration
 	attempts int
+	health   *PeerHealth
 }
 
 func (pm *peerMonitor) pingOnce(ctx context.Context, logger *zap.Logger, conn network.Conn) bool {
-	pingCtx, cancel := context.WithTimeout(ctx, pm.timeout)
+	pingCtx, cancel := clock.WithTimeout(ctx, pm.clock, pm.timeout)
 	defer cancel()
 	select {
 	case <-ctx.Done():
 	case <-pingCtx.Done():
 		logger.Debug("ping timeout")
+		pm.health.Failure(conn.RemotePeer())
 		return false
 	case res := <-ping.PingConn(pingCtx, pm.h.Peerstore(), conn):
 		if res.Error != nil {
 			logger.Debug("ping error", zap.Error(res.Error))
+			pm.health.Failure(conn.RemotePeer())
 			return false
 		}
 		logger.Debug("ping success", zap.Duration("rtt", res.RTT))
+		pm.health.Success(conn.RemotePeer(), res.RTT)
 	}
 	return true
 }
@@ -54,8 +60,9 @@ func (pm *peerMonitor) run(ctx context.Context, logger *zap.Logger) {
 		select {
 		case <-ctx.Done():
 			return
-		case <-time.After(pm.period):
+		case <-pm.clock.After(pm.period):
 			peers := pm.h.Network().Peers()
+			pm.health.Retain(peers)
 			logger.Debug("pinging connected peers", zap.Int("peer_count", len(peers)))
 			wg := &sync.WaitGroup{}
 			for _, id := range peers {
@@ -74,15 +81,18 @@ func (pm *peerMonitor) run(ctx context.Context, logger *zap.Logger) {
 
 // MonitorPeers periodically looks up the peers connected to the host and pings them
 // repeatedly to ensure they are still reachable. If the peer is not reachable after
-// the attempts, the connections to the peer are closed.
+// the attempts, the connections to the peer are closed. The results of the pings
+// are recorded in health.
 func MonitorPeers(
-	ctx context.Context, logger *zap.Logger, h host.Host, timeout, period time.Duration, attempts int,
+	ctx context.Context, logger *zap.Logger, clk clock.Clock, h host.Host, timeout, period time.Duration, attempts int, health *PeerHealth,
 ) {
 	pm := &peerMonitor{
+		clock:    clk,
 		h:        h,
 		timeout:  timeout,
 		period:   period,
 		attempts: attempts,
+		health:   health,
 	}
 	go pm.run(ctx, logger)
 }
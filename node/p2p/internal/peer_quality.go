@@ -0,0 +1,32 @@
+package internal
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	// Round trip time assumed for peers that have not been pinged yet.
+	peerQualityUnmeasuredRTT = 500 * time.Millisecond
+	// Floor on the round trip time, so that peers on the same host or LAN do
+	// not outweigh every other consideration.
+	peerQualityMinRTT = 10 * time.Millisecond
+	// Peer score at which the score factor drops to 1/(1+e) of its neutral
+	// value, or rises to e/(1+e) above it.
+	peerQualityScoreScale = 100
+)
+
+// PeerQuality weighs a peer by its peer score and measured round trip time,
+// for choosing whom to ask for data. The score factor is a logistic curve,
+// equal to one half at a score of zero, so that negatively scored peers fall
+// quickly towards zero while positive scores saturate. It is divided by the
+// round trip time in seconds.
+func PeerQuality(score float64, rtt time.Duration) float64 {
+	if rtt <= 0 {
+		rtt = peerQualityUnmeasuredRTT
+	}
+	rtt = max(rtt, peerQualityMinRTT)
+
+	scoreFactor := 1 / (1 + math.Exp(-score/peerQualityScoreScale))
+	return scoreFactor / rtt.Seconds()
+}
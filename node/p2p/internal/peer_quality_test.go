@@ -0,0 +1,27 @@
+package internal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p/internal"
+)
+
+func TestPeerQuality(t *testing.T) {
+	fast := internal.PeerQuality(0, 20*time.Millisecond)
+	slow := internal.PeerQuality(0, 400*time.Millisecond)
+	require.Greater(t, fast, slow)
+
+	require.Greater(
+		t,
+		internal.PeerQuality(200, 50*time.Millisecond),
+		internal.PeerQuality(-200, 50*time.Millisecond),
+	)
+
+	// a punished peer loses to a slower but neutral one
+	require.Less(t, internal.PeerQuality(-1000, 20*time.Millisecond), slow)
+
+	// unmeasured peers rank behind measured fast ones
+	require.Less(t, internal.PeerQuality(0, 0), fast)
+}
@@ -0,0 +1,150 @@
+package internal
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+)
+
+// PeerSourceStat is the tally of a peer source.
+type PeerSourceStat struct {
+	Source string
+	// Peers connected to from the source.
+	Connected uint64
+	// Peers that stayed connected for the retention window.
+	Retained uint64
+	// Peers that disconnected within the retention window.
+	Dropped uint64
+	// Smoothed share of the judged peers that were retained.
+	Weight float64
+}
+
+type pendingSourcePeer struct {
+	source string
+	at     time.Time
+}
+
+// PeerSourceStats tracks which peer sources yield peers that stay connected.
+// A peer connected to from a source is retained if it is still connected once
+// the retention window has passed, and dropped if it disconnects before then.
+type PeerSourceStats struct {
+	mx      sync.Mutex
+	clock   clock.Clock
+	window  time.Duration
+	sources map[string]*PeerSourceStat
+	pending map[peer.ID]pendingSourcePeer
+}
+
+// NewPeerSourceStats creates a tracker judging peers after the retention
+// window.
+func NewPeerSourceStats(
+	clk clock.Clock,
+	window time.Duration,
+) *PeerSourceStats {
+	return &PeerSourceStats{
+		clock:   clk,
+		window:  window,
+		sources: map[string]*PeerSourceStat{},
+		pending: map[peer.ID]pendingSourcePeer{},
+	}
+}
+
+// Listen counts the disconnections of the network against the sources of the
+// disconnected peers.
+func (s *PeerSourceStats) Listen(n network.Network) {
+	n.Notify(&network.NotifyBundle{
+		DisconnectedF: func(n network.Network, c network.Conn) {
+			if n.Connectedness(c.RemotePeer()) != network.Connected {
+				s.Disconnected(c.RemotePeer())
+			}
+		},
+	})
+}
+
+func (s *PeerSourceStats) stat(source string) *PeerSourceStat {
+	stat, ok := s.sources[source]
+	if !ok {
+		stat = &PeerSourceStat{Source: source}
+		s.sources[source] = stat
+	}
+	return stat
+}
+
+// Connected records that the peer was connected to from the source.
+func (s *PeerSourceStats) Connected(source string, p peer.ID) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	s.stat(source).Connected++
+	s.pending[p] = pendingSourcePeer{source: source, at: s.clock.Now()}
+}
+
+// Disconnected records that the peer is no longer connected.
+func (s *PeerSourceStats) Disconnected(p peer.ID) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	pending, ok := s.pending[p]
+	if !ok {
+		return
+	}
+	delete(s.pending, p)
+	if s.clock.Since(pending.at) < s.window {
+		s.stat(pending.source).Dropped++
+	} else {
+		s.stat(pending.source).Retained++
+	}
+}
+
+// sweep counts the pending peers that outlasted the retention window as
+// retained. It must be called with mx held.
+func (s *PeerSourceStats) sweep() {
+	for p, pending := range s.pending {
+		if s.clock.Since(pending.at) >= s.window {
+			delete(s.pending, p)
+			s.stat(pending.source).Retained++
+		}
+	}
+}
+
+// weight must be called with mx held. Sources without judged peers weigh one
+// half.
+func (s *PeerSourceStats) weight(source string) float64 {
+	stat, ok := s.sources[source]
+	if !ok {
+		return 0.5
+	}
+	return float64(stat.Retained+1) / float64(stat.Retained+stat.Dropped+2)
+}
+
+// Weight returns the smoothed share of the source's judged peers that were
+// retained.
+func (s *PeerSourceStats) Weight(source string) float64 {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	s.sweep()
+	return s.weight(source)
+}
+
+// Stats returns the tally of every source, by source name.
+func (s *PeerSourceStats) Stats() []PeerSourceStat {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	s.sweep()
+	stats := make([]PeerSourceStat, 0, len(s.sources))
+	for source, stat := range s.sources {
+		stat := *stat
+		stat.Weight = s.weight(source)
+		stats = append(stats, stat)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].Source < stats[j].Source
+	})
+	return stats
+}
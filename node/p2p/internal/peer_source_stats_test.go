@@ -0,0 +1,37 @@
+package internal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p/internal"
+)
+
+func TestPeerSourceStats(t *testing.T) {
+	clk := clock.NewFakeClock(time.Unix(0, 0))
+	stats := internal.NewPeerSourceStats(clk, time.Minute)
+
+	require.Equal(t, 0.5, stats.Weight("dht"))
+
+	stats.Connected("bootstrap", peer.ID("a"))
+	stats.Connected("dht", peer.ID("b"))
+	stats.Connected("dht", peer.ID("c"))
+
+	// b drops within the window, a and c outlast it
+	clk.Advance(30 * time.Second)
+	stats.Disconnected(peer.ID("b"))
+	clk.Advance(time.Minute)
+	stats.Disconnected(peer.ID("c"))
+
+	// disconnections of untracked peers are ignored
+	stats.Disconnected(peer.ID("d"))
+
+	require.Equal(t, []internal.PeerSourceStat{
+		{Source: "bootstrap", Connected: 1, Retained: 1, Weight: 2.0 / 3},
+		{Source: "dht", Connected: 2, Retained: 1, Dropped: 1, Weight: 0.5},
+	}, stats.Stats())
+	require.Greater(t, stats.Weight("bootstrap"), stats.Weight("dht"))
+}
@@ -0,0 +1,205 @@
+package internal
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/transport"
+	"github.com/libp2p/go-libp2p/p2p/transport/tcp"
+	ma "github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"github.com/pkg/errors"
+	"golang.org/x/net/proxy"
+)
+
+const proxyConnectTimeout = 30 * time.Second
+
+// NewProxyDialer parses a SOCKS5 proxy address, either "host:port" or a
+// "socks5://[user:password@]host:port" URL such as a local Tor client.
+func NewProxyDialer(addr string) (proxy.ContextDialer, error) {
+	if !strings.Contains(addr, "://") {
+		addr = "socks5://" + addr
+	}
+
+	u, err := url.Parse(addr)
+	if err != nil {
+		return nil, errors.Wrap(err, "new proxy dialer")
+	}
+	if u.Scheme != "socks5" && u.Scheme != "socks5h" {
+		return nil, errors.Errorf(
+			"new proxy dialer: unsupported proxy scheme %q",
+			u.Scheme,
+		)
+	}
+
+	d, err := proxy.FromURL(u, proxy.Direct)
+	if err != nil {
+		return nil, errors.Wrap(err, "new proxy dialer")
+	}
+
+	return d.(proxy.ContextDialer), nil
+}
+
+// ProxyTransport is a TCP transport whose outbound connections are made
+// through a SOCKS5 proxy. Listening is unaffected.
+type ProxyTransport struct {
+	*tcp.TcpTransport
+	upgrader transport.Upgrader
+	rcmgr    network.ResourceManager
+	dialer   proxy.ContextDialer
+}
+
+var _ transport.Transport = (*ProxyTransport)(nil)
+var _ transport.DialUpdater = (*ProxyTransport)(nil)
+var _ transport.SkipResolver = (*ProxyTransport)(nil)
+
+// NewProxyTransport returns a transport constructor for libp2p.Transport
+// that dials through the given proxy.
+func NewProxyTransport(
+	dialer proxy.ContextDialer,
+) func(
+	upgrader transport.Upgrader,
+	rcmgr network.ResourceManager,
+) (*ProxyTransport, error) {
+	return func(
+		upgrader transport.Upgrader,
+		rcmgr network.ResourceManager,
+	) (*ProxyTransport, error) {
+		if rcmgr == nil {
+			rcmgr = &network.NullResourceManager{}
+		}
+
+		t, err := tcp.NewTCPTransport(upgrader, rcmgr)
+		if err != nil {
+			return nil, errors.Wrap(err, "new proxy transport")
+		}
+
+		return &ProxyTransport{
+			TcpTransport: t,
+			upgrader:     upgrader,
+			rcmgr:        rcmgr,
+			dialer:       dialer,
+		}, nil
+	}
+}
+
+// CanDial accepts plain TCP addresses over /ip4, /ip6 or a /dns name. Names
+// are resolved by the proxy.
+func (t *ProxyTransport) CanDial(addr ma.Multiaddr) bool {
+	protocols := addr.Protocols()
+	if len(protocols) != 2 || protocols[1].Code != ma.P_TCP {
+		return false
+	}
+
+	switch protocols[0].Code {
+	case ma.P_IP4, ma.P_IP6, ma.P_DNS, ma.P_DNS4, ma.P_DNS6:
+		return true
+	default:
+		return false
+	}
+}
+
+// SkipResolve keeps the swarm from resolving /dns names locally, which would
+// leak the peers dialed to the local resolver. The proxy resolves them
+// instead.
+func (t *ProxyTransport) SkipResolve(context.Context, ma.Multiaddr) bool {
+	return true
+}
+
+func (t *ProxyTransport) Dial(
+	ctx context.Context,
+	raddr ma.Multiaddr,
+	p peer.ID,
+) (transport.CapableConn, error) {
+	connScope, err := t.rcmgr.OpenConnection(network.DirOutbound, true, raddr)
+	if err != nil {
+		return nil, errors.Wrap(err, "proxy dial")
+	}
+
+	c, err := t.dialWithScope(ctx, raddr, p, connScope)
+	if err != nil {
+		connScope.Done()
+		return nil, errors.Wrap(err, "proxy dial")
+	}
+
+	return c, nil
+}
+
+// DialWithUpdates overrides the TCP transport's, which would dial directly.
+func (t *ProxyTransport) DialWithUpdates(
+	ctx context.Context,
+	raddr ma.Multiaddr,
+	p peer.ID,
+	_ chan<- transport.DialUpdate,
+) (transport.CapableConn, error) {
+	return t.Dial(ctx, raddr, p)
+}
+
+func (t *ProxyTransport) dialWithScope(
+	ctx context.Context,
+	raddr ma.Multiaddr,
+	p peer.ID,
+	connScope network.ConnManagementScope,
+) (transport.CapableConn, error) {
+	if err := connScope.SetPeer(p); err != nil {
+		return nil, err
+	}
+
+	_, hostport, err := manet.DialArgs(raddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, proxyConnectTimeout)
+	defer cancel()
+
+	conn, err := t.dialer.DialContext(ctx, "tcp", hostport)
+	if err != nil {
+		return nil, err
+	}
+
+	laddr, err := manet.FromNetAddr(conn.LocalAddr())
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	direction := network.DirOutbound
+	if ok, isClient, _ := network.GetSimultaneousConnect(ctx); ok && !isClient {
+		direction = network.DirInbound
+	}
+
+	return t.upgrader.Upgrade(
+		ctx,
+		t,
+		&proxiedConn{Conn: conn, laddr: laddr, raddr: raddr},
+		direction,
+		p,
+		connScope,
+	)
+}
+
+func (t *ProxyTransport) String() string {
+	return "TCP over SOCKS5"
+}
+
+// proxiedConn reports the dialed peer address as its remote address, rather
+// than the address of the proxy.
+type proxiedConn struct {
+	net.Conn
+	laddr ma.Multiaddr
+	raddr ma.Multiaddr
+}
+
+func (c *proxiedConn) LocalMultiaddr() ma.Multiaddr {
+	return c.laddr
+}
+
+func (c *proxiedConn) RemoteMultiaddr() ma.Multiaddr {
+	return c.raddr
+}
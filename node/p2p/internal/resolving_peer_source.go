@@ -0,0 +1,213 @@
+package internal
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	rcmgr "github.com/libp2p/go-libp2p/p2p/host/resource-manager"
+	ma "github.com/multiformats/go-multiaddr"
+	madns "github.com/multiformats/go-multiaddr-dns"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+)
+
+// ResolvingPeerSource is a static peer source whose dns and dnsaddr
+// multiaddrs are resolved again on every Resolve, so that bootstrap operators
+// can rotate the addresses behind their names without nodes dialing dead ones
+// until restarted.
+type ResolvingPeerSource struct {
+	resolver *madns.Resolver
+	permute  bool
+	changed  chan struct{}
+
+	mx    sync.RWMutex
+	peers []peer.AddrInfo
+	// The last successful resolution of each configured address, by peer and
+	// address.
+	resolved map[peer.ID]map[string][]ma.Multiaddr
+}
+
+var _ PeerSource = (*ResolvingPeerSource)(nil)
+
+// NewResolvingPeerSource creates a peer source of the peers, which hands out
+// the configured addresses until they are first resolved.
+func NewResolvingPeerSource(
+	resolver *madns.Resolver,
+	peers []peer.AddrInfo,
+	permute bool,
+) *ResolvingPeerSource {
+	return &ResolvingPeerSource{
+		resolver: resolver,
+		peers:    peers,
+		permute:  permute,
+		changed:  make(chan struct{}, 1),
+		resolved: map[peer.ID]map[string][]ma.Multiaddr{},
+	}
+}
+
+// SetPeers replaces the peers, which Run resolves right away.
+func (s *ResolvingPeerSource) SetPeers(peers []peer.AddrInfo) {
+	s.mx.Lock()
+	s.peers = peers
+	s.mx.Unlock()
+
+	select {
+	case s.changed <- struct{}{}:
+	default:
+	}
+}
+
+// PeerIDs returns the IDs of the peers.
+func (s *ResolvingPeerSource) PeerIDs() map[peer.ID]struct{} {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	return PeerAddrInfosToPeerIDMap(s.peers)
+}
+
+// Peers implements PeerSource.
+func (s *ResolvingPeerSource) Peers(context.Context) (<-chan peer.AddrInfo, error) {
+	peers := s.PeerAddrInfos()
+	if s.permute {
+		peers = Permuted(peers)
+	}
+	ch := make(chan peer.AddrInfo, len(peers))
+	for _, p := range peers {
+		ch <- p
+	}
+	close(ch)
+	return ch, nil
+}
+
+// PeerAddrInfos returns the peers with their addresses as last resolved.
+func (s *ResolvingPeerSource) PeerAddrInfos() []peer.AddrInfo {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	peers := make([]peer.AddrInfo, 0, len(s.peers))
+	for _, p := range s.peers {
+		info := peer.AddrInfo{ID: p.ID}
+		for _, addr := range p.Addrs {
+			if resolved, ok := s.resolved[p.ID][string(addr.Bytes())]; ok {
+				info.Addrs = append(info.Addrs, resolved...)
+			} else {
+				info.Addrs = append(info.Addrs, addr)
+			}
+		}
+		peers = append(peers, info)
+	}
+	return peers
+}
+
+// Resolve resolves the configured addresses of the peers. Addresses that fail
+// to resolve, or resolve to nothing, keep their previous resolution. Resolved
+// addresses naming another peer are dropped, as dnsaddr records of a name are
+// shared by every peer behind it.
+func (s *ResolvingPeerSource) Resolve(ctx context.Context) error {
+	s.mx.RLock()
+	peers := s.peers
+	s.mx.RUnlock()
+
+	var lastErr error
+	resolved := map[peer.ID]map[string][]ma.Multiaddr{}
+	for _, p := range peers {
+		resolved[p.ID] = map[string][]ma.Multiaddr{}
+		for _, addr := range p.Addrs {
+			if !madns.Matches(addr) {
+				continue
+			}
+			addrs, err := s.resolver.Resolve(ctx, addr)
+			if err != nil {
+				lastErr = err
+				continue
+			}
+			var own []ma.Multiaddr
+			for _, a := range addrs {
+				transport, id, err := peer.SplitAddr(a)
+				if err != nil || transport == nil || (id != "" && id != p.ID) {
+					continue
+				}
+				own = append(own, transport)
+			}
+			if len(own) > 0 {
+				resolved[p.ID][string(addr.Bytes())] = own
+			}
+		}
+	}
+
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	for id, addrs := range resolved {
+		if s.resolved[id] == nil {
+			s.resolved[id] = map[string][]ma.Multiaddr{}
+		}
+		for addr, own := range addrs {
+			s.resolved[id][addr] = own
+		}
+	}
+	return lastErr
+}
+
+// Run resolves the peers every period, or as soon as they are replaced, until
+// ctx is done, moving the allowlist of the resource manager, if any, from the
+// addresses that are no longer resolved to the new ones. The initial addresses
+// are those the allowlist was created with. A period of zero or less only
+// follows replacements.
+func (s *ResolvingPeerSource) Run(
+	ctx context.Context,
+	logger *zap.Logger,
+	clk clock.Clock,
+	allowlist *rcmgr.Allowlist,
+	initial []ma.Multiaddr,
+	period time.Duration,
+) {
+	allowed := NewAllowlistSync(logger, allowlist, initial)
+
+	var tick <-chan time.Time
+	if period > 0 {
+		ticker := clk.NewTicker(period)
+		defer ticker.Stop()
+		tick = ticker.Chan()
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-tick:
+		case <-s.changed:
+		}
+
+		if err := s.Resolve(ctx); err != nil {
+			logger.Debug("could not resolve peer addresses", zap.Error(err))
+		}
+		allowed.Sync(PeerAllowlistAddrs(s.PeerAddrInfos()))
+	}
+}
+
+// PeerAllowlistAddrs returns the addresses of the peers that no longer need
+// resolving, each ending in the /p2p component of its peer so that the
+// allowlist exempts the peer alone rather than anyone dialing from the
+// address.
+func PeerAllowlistAddrs(peers []peer.AddrInfo) []ma.Multiaddr {
+	var addrs []ma.Multiaddr
+	for _, p := range peers {
+		info := peer.AddrInfo{ID: p.ID}
+		for _, addr := range p.Addrs {
+			if !madns.Matches(addr) {
+				info.Addrs = append(info.Addrs, addr)
+			}
+		}
+		if len(info.Addrs) == 0 {
+			continue
+		}
+		bound, err := peer.AddrInfoToP2pAddrs(&info)
+		if err != nil {
+			continue
+		}
+		addrs = append(addrs, bound...)
+	}
+	return addrs
+}
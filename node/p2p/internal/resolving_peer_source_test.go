@@ -0,0 +1,204 @@
+package internal_test
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	rcmgr "github.com/libp2p/go-libp2p/p2p/host/resource-manager"
+	ma "github.com/multiformats/go-multiaddr"
+	madns "github.com/multiformats/go-multiaddr-dns"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p/internal"
+)
+
+func TestResolvingPeerSourceFollowsRotatedAddresses(t *testing.T) {
+	newID := func() peer.ID {
+		_, pub, err := crypto.GenerateEd448Key(rand.Reader)
+		require.NoError(t, err)
+		id, err := peer.IDFromPublicKey(pub)
+		require.NoError(t, err)
+		return id
+	}
+	bootstrapper, other := newID(), newID()
+	addr := func(s string) ma.Multiaddr {
+		m, err := ma.NewMultiaddr(s)
+		require.NoError(t, err)
+		return m
+	}
+
+	backend := &madns.MockResolver{TXT: map[string][]string{
+		"_dnsaddr.bootstrap.example": {
+			"dnsaddr=/ip4/1.2.3.4/tcp/8336/p2p/" + bootstrapper.String(),
+			"dnsaddr=/ip4/5.6.7.8/tcp/8336/p2p/" + other.String(),
+		},
+	}}
+	resolver, err := madns.NewResolver(madns.WithDefaultResolver(backend))
+	require.NoError(t, err)
+
+	info, err := peer.AddrInfoFromString(
+		"/dnsaddr/bootstrap.example/p2p/" + bootstrapper.String(),
+	)
+	require.NoError(t, err)
+	source := internal.NewResolvingPeerSource(
+		resolver,
+		[]peer.AddrInfo{*info},
+		false,
+	)
+
+	// configured addresses are handed out until resolved
+	require.Equal(t, []peer.AddrInfo{*info}, source.PeerAddrInfos())
+
+	require.NoError(t, source.Resolve(context.Background()))
+	require.Equal(t, []peer.AddrInfo{{
+		ID:    bootstrapper,
+		Addrs: []ma.Multiaddr{addr("/ip4/1.2.3.4/tcp/8336")},
+	}}, source.PeerAddrInfos())
+
+	backend.TXT["_dnsaddr.bootstrap.example"] = []string{
+		"dnsaddr=/ip4/9.9.9.9/tcp/8336/p2p/" + bootstrapper.String(),
+	}
+	require.NoError(t, source.Resolve(context.Background()))
+	require.Equal(t, []peer.AddrInfo{{
+		ID:    bootstrapper,
+		Addrs: []ma.Multiaddr{addr("/ip4/9.9.9.9/tcp/8336")},
+	}}, source.PeerAddrInfos())
+
+	// an empty answer keeps the last resolution
+	backend.TXT["_dnsaddr.bootstrap.example"] = nil
+	require.NoError(t, source.Resolve(context.Background()))
+	require.Equal(t, []peer.AddrInfo{{
+		ID:    bootstrapper,
+		Addrs: []ma.Multiaddr{addr("/ip4/9.9.9.9/tcp/8336")},
+	}}, source.PeerAddrInfos())
+}
+
+func TestResolvingPeerSourceSetPeers(t *testing.T) {
+	_, pub, err := crypto.GenerateEd448Key(rand.Reader)
+	require.NoError(t, err)
+	id, err := peer.IDFromPublicKey(pub)
+	require.NoError(t, err)
+	addr, err := ma.NewMultiaddr("/ip4/1.2.3.4/udp/8336/quic-v1")
+	require.NoError(t, err)
+
+	source := internal.NewResolvingPeerSource(madns.DefaultResolver, nil, false)
+	require.Empty(t, source.PeerIDs())
+
+	info := peer.AddrInfo{ID: id, Addrs: []ma.Multiaddr{addr}}
+	source.SetPeers([]peer.AddrInfo{info})
+	require.Equal(t, map[peer.ID]struct{}{id: {}}, source.PeerIDs())
+	require.Equal(t, []peer.AddrInfo{info}, source.PeerAddrInfos())
+}
+
+func TestResolvingPeerSourceRunMovesAllowlist(t *testing.T) {
+	newID := func() peer.ID {
+		_, pub, err := crypto.GenerateEd448Key(rand.Reader)
+		require.NoError(t, err)
+		id, err := peer.IDFromPublicKey(pub)
+		require.NoError(t, err)
+		return id
+	}
+	bootstrapper, other := newID(), newID()
+	addr := func(s string) ma.Multiaddr {
+		m, err := ma.NewMultiaddr(s)
+		require.NoError(t, err)
+		return m
+	}
+
+	backend := &madns.MockResolver{TXT: map[string][]string{
+		"_dnsaddr.bootstrap.example": {
+			"dnsaddr=/ip4/1.2.3.4/tcp/8336/p2p/" + bootstrapper.String(),
+		},
+	}}
+	resolver, err := madns.NewResolver(madns.WithDefaultResolver(backend))
+	require.NoError(t, err)
+	info, err := peer.AddrInfoFromString(
+		"/dnsaddr/bootstrap.example/p2p/" + bootstrapper.String(),
+	)
+	require.NoError(t, err)
+	source := internal.NewResolvingPeerSource(
+		resolver,
+		[]peer.AddrInfo{*info},
+		false,
+	)
+
+	// The allowlist starts with the addresses resolved at startup.
+	initial := internal.PeerAllowlistAddrs([]peer.AddrInfo{{
+		ID:    bootstrapper,
+		Addrs: []ma.Multiaddr{addr("/ip4/1.2.3.4/tcp/8336")},
+	}})
+	mgr, err := rcmgr.NewResourceManager(
+		rcmgr.NewFixedLimiter(rcmgr.DefaultLimits.AutoScale()),
+		rcmgr.WithAllowlistedMultiaddrs(initial),
+	)
+	require.NoError(t, err)
+	defer mgr.Close()
+	allowlist := rcmgr.GetAllowlist(mgr)
+	require.True(t, allowlist.AllowedPeerAndMultiaddr(
+		bootstrapper,
+		addr("/ip4/1.2.3.4/tcp/8336"),
+	))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go source.Run(
+		ctx,
+		zap.NewNop(),
+		clock.NewFakeClock(time.Unix(0, 0)),
+		allowlist,
+		initial,
+		0,
+	)
+
+	backend.TXT["_dnsaddr.bootstrap.example"] = []string{
+		"dnsaddr=/ip4/9.9.9.9/tcp/8336/p2p/" + bootstrapper.String(),
+	}
+	source.SetPeers([]peer.AddrInfo{*info})
+	require.Eventually(t, func() bool {
+		return allowlist.AllowedPeerAndMultiaddr(
+			bootstrapper,
+			addr("/ip4/9.9.9.9/tcp/8336"),
+		)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The initial entry is dropped, and the new one exempts the peer alone.
+	require.False(t, allowlist.AllowedPeerAndMultiaddr(
+		bootstrapper,
+		addr("/ip4/1.2.3.4/tcp/8336"),
+	))
+	require.False(t, allowlist.AllowedPeerAndMultiaddr(
+		other,
+		addr("/ip4/9.9.9.9/tcp/8336"),
+	))
+}
+
+func TestPeerAllowlistAddrs(t *testing.T) {
+	_, pub, err := crypto.GenerateEd448Key(rand.Reader)
+	require.NoError(t, err)
+	id, err := peer.IDFromPublicKey(pub)
+	require.NoError(t, err)
+	addr := func(s string) ma.Multiaddr {
+		m, err := ma.NewMultiaddr(s)
+		require.NoError(t, err)
+		return m
+	}
+
+	require.Equal(t, []ma.Multiaddr{
+		addr("/ip4/1.2.3.4/udp/8336/quic-v1/p2p/" + id.String()),
+	}, internal.PeerAllowlistAddrs([]peer.AddrInfo{
+		{
+			ID: id,
+			Addrs: []ma.Multiaddr{
+				addr("/ip4/1.2.3.4/udp/8336/quic-v1"),
+				addr("/dns4/bootstrap.example/udp/8336/quic-v1"),
+			},
+		},
+		// Peers left without addresses are not allowlisted at all.
+		{ID: id, Addrs: []ma.Multiaddr{addr("/dnsaddr/bootstrap.example")}},
+	}))
+}
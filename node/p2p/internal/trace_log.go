@@ -0,0 +1,315 @@
+package internal
+
+import (
+	"compress/gzip"
+	"hash/fnv"
+	"io"
+	"math"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	blossomsub "source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub"
+	"source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub/pb"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+)
+
+// rotatedSuffixFormat is the time format of the suffix of rotated files, fine
+// enough for files rotated in quick succession not to collide.
+const rotatedSuffixFormat = "20060102T150405.000000000"
+
+// RotatingFile is a file that is rotated once it grows past a size or gets
+// older than an age. Rotated files are renamed with the time of the rotation
+// as a suffix, compressed with gzip in the background, and removed once more
+// than a number of them are kept.
+type RotatingFile struct {
+	logger *zap.Logger
+	clock  clock.Clock
+	path   string
+	// Rotation thresholds and number of rotated files kept, zero or less for no
+	// limit.
+	maxSize    int64
+	maxAge     time.Duration
+	maxBackups int
+
+	mx     sync.Mutex
+	f      *os.File
+	size   int64
+	opened time.Time
+	// Compression of the rotated files, which Close waits for.
+	compressing sync.WaitGroup
+	compressMx  sync.Mutex
+}
+
+var _ io.WriteCloser = (*RotatingFile)(nil)
+
+// OpenRotatingFile opens the file at path, truncating it.
+func OpenRotatingFile(
+	logger *zap.Logger,
+	clk clock.Clock,
+	path string,
+	maxSize int64,
+	maxAge time.Duration,
+	maxBackups int,
+) (*RotatingFile, error) {
+	r := &RotatingFile{
+		logger:     logger,
+		clock:      clk,
+		path:       path,
+		maxSize:    maxSize,
+		maxAge:     maxAge,
+		maxBackups: maxBackups,
+	}
+	if err := r.open(); err != nil {
+		return nil, errors.Wrap(err, "open rotating file")
+	}
+	return r, nil
+}
+
+func (r *RotatingFile) open() error {
+	f, err := os.OpenFile(r.path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	r.f = f
+	r.size = 0
+	r.opened = r.clock.Now()
+	return nil
+}
+
+// Write implements io.Writer, rotating the file first if the write would take
+// it past the size limit or the file is past the age limit.
+func (r *RotatingFile) Write(p []byte) (int, error) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	if r.f == nil {
+		return 0, os.ErrClosed
+	}
+
+	full := r.maxSize > 0 && r.size > 0 && r.size+int64(len(p)) > r.maxSize
+	old := r.maxAge > 0 && r.clock.Since(r.opened) >= r.maxAge
+	if full || old {
+		if err := r.rotate(); err != nil {
+			return 0, errors.Wrap(err, "write")
+		}
+	}
+
+	n, err := r.f.Write(p)
+	r.size += int64(n)
+	return n, err
+}
+
+// rotate must be called with mx held.
+func (r *RotatingFile) rotate() error {
+	if err := r.f.Close(); err != nil {
+		return errors.Wrap(err, "rotate")
+	}
+	r.f = nil
+
+	rotated := r.path + "." + r.clock.Now().UTC().Format(rotatedSuffixFormat)
+	if err := os.Rename(r.path, rotated); err != nil {
+		return errors.Wrap(err, "rotate")
+	}
+	if err := r.open(); err != nil {
+		return errors.Wrap(err, "rotate")
+	}
+
+	r.compressing.Add(1)
+	go func() {
+		defer r.compressing.Done()
+
+		// Compress one file at a time, in rotation order, so that pruning only
+		// ever sees complete archives.
+		r.compressMx.Lock()
+		defer r.compressMx.Unlock()
+
+		if err := compressFile(rotated); err != nil {
+			r.logger.Warn(
+				"could not compress rotated file",
+				zap.String("path", rotated),
+				zap.Error(err),
+			)
+		}
+		r.prune()
+	}()
+	return nil
+}
+
+// prune removes the oldest rotated files past the number kept.
+func (r *RotatingFile) prune() {
+	if r.maxBackups <= 0 {
+		return
+	}
+
+	backups, err := filepath.Glob(r.path + ".*.gz")
+	if err != nil {
+		return
+	}
+	// The suffix sorts in rotation order.
+	sort.Strings(backups)
+	for len(backups) > r.maxBackups {
+		if err := os.Remove(backups[0]); err != nil {
+			r.logger.Warn(
+				"could not remove rotated file",
+				zap.String("path", backups[0]),
+				zap.Error(err),
+			)
+		}
+		backups = backups[1:]
+	}
+}
+
+func compressFile(path string) error {
+	in, err := os.Open(path)
+	if err != nil {
+		return errors.Wrap(err, "compress file")
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(
+		path+".gz",
+		os.O_CREATE|os.O_WRONLY|os.O_TRUNC,
+		0644,
+	)
+	if err != nil {
+		return errors.Wrap(err, "compress file")
+	}
+	zw := gzip.NewWriter(out)
+	if _, err := io.Copy(zw, in); err != nil {
+		out.Close()
+		os.Remove(path + ".gz")
+		return errors.Wrap(err, "compress file")
+	}
+	if err := zw.Close(); err != nil {
+		out.Close()
+		os.Remove(path + ".gz")
+		return errors.Wrap(err, "compress file")
+	}
+	if err := out.Close(); err != nil {
+		os.Remove(path + ".gz")
+		return errors.Wrap(err, "compress file")
+	}
+	return errors.Wrap(os.Remove(path), "compress file")
+}
+
+// Close implements io.Closer, waiting for the rotated files to be compressed.
+func (r *RotatingFile) Close() error {
+	r.mx.Lock()
+	var err error
+	if r.f != nil {
+		err = r.f.Close()
+		r.f = nil
+	}
+	r.mx.Unlock()
+
+	r.compressing.Wait()
+	return err
+}
+
+// SwitchTracer passes trace events on to a tracer that can be replaced while
+// the router runs, and drops them while none is set.
+type SwitchTracer struct {
+	tracer atomic.Pointer[blossomsub.EventTracer]
+}
+
+var _ blossomsub.EventTracer = (*SwitchTracer)(nil)
+
+// Set passes the events on to tracer from now on, or drops them if it is nil,
+// and returns the tracer they were passed on to until now.
+func (t *SwitchTracer) Set(
+	tracer blossomsub.EventTracer,
+) blossomsub.EventTracer {
+	var previous *blossomsub.EventTracer
+	if tracer == nil {
+		previous = t.tracer.Swap(nil)
+	} else {
+		previous = t.tracer.Swap(&tracer)
+	}
+	if previous == nil {
+		return nil
+	}
+	return *previous
+}
+
+// Trace implements blossomsub.EventTracer.
+func (t *SwitchTracer) Trace(evt *pb.TraceEvent) {
+	if tracer := t.tracer.Load(); tracer != nil {
+		(*tracer).Trace(evt)
+	}
+}
+
+// SampledTracer passes a share of the trace events on to a tracer. Events of
+// a message are sampled by its ID, so that the events of a sampled message
+// are all kept and its propagation can be followed, while other events are
+// sampled independently.
+type SampledTracer struct {
+	tracer blossomsub.EventTracer
+	rate   float64
+	// Message IDs hashing below the threshold are sampled.
+	threshold uint64
+}
+
+var _ blossomsub.EventTracer = (*SampledTracer)(nil)
+
+// NewSampledTracer creates a tracer passing the share rate, between zero and
+// one, of the events on to tracer.
+func NewSampledTracer(
+	tracer blossomsub.EventTracer,
+	rate float64,
+) *SampledTracer {
+	rate = max(min(rate, 1), 0)
+	threshold := uint64(math.MaxUint64)
+	if rate < 1 {
+		threshold = uint64(rate * math.MaxUint64)
+	}
+	return &SampledTracer{
+		tracer:    tracer,
+		rate:      rate,
+		threshold: threshold,
+	}
+}
+
+// Trace implements blossomsub.EventTracer.
+func (t *SampledTracer) Trace(evt *pb.TraceEvent) {
+	if t.sampled(evt) {
+		t.tracer.Trace(evt)
+	}
+}
+
+func (t *SampledTracer) sampled(evt *pb.TraceEvent) bool {
+	if t.rate >= 1 {
+		return true
+	}
+
+	id := traceMessageID(evt)
+	if id == nil {
+		return rand.Float64() < t.rate
+	}
+	h := fnv.New64a()
+	h.Write(id)
+	return h.Sum64() < t.threshold
+}
+
+func traceMessageID(evt *pb.TraceEvent) []byte {
+	switch evt.GetType() {
+	case pb.TraceEvent_PUBLISH_MESSAGE:
+		return evt.GetPublishMessage().GetMessageID()
+	case pb.TraceEvent_REJECT_MESSAGE:
+		return evt.GetRejectMessage().GetMessageID()
+	case pb.TraceEvent_DUPLICATE_MESSAGE:
+		return evt.GetDuplicateMessage().GetMessageID()
+	case pb.TraceEvent_DELIVER_MESSAGE:
+		return evt.GetDeliverMessage().GetMessageID()
+	case pb.TraceEvent_UNDELIVERABLE_MESSAGE:
+		return evt.GetUndeliverableMessage().GetMessageID()
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,125 @@
+package internal_test
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub/pb"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p/internal"
+)
+
+func TestRotatingFile(t *testing.T) {
+	clk := clock.NewFakeClock(time.Unix(0, 0))
+	path := filepath.Join(t.TempDir(), "trace.json")
+	f, err := internal.OpenRotatingFile(zap.NewNop(), clk, path, 10, time.Hour, 2)
+	require.NoError(t, err)
+
+	write := func(s string) {
+		_, err := f.Write([]byte(s))
+		require.NoError(t, err)
+		clk.Advance(time.Millisecond)
+	}
+	write("aaaaaaaa")
+	write("bb")
+	// Rotates by size.
+	write("cccccc")
+	// Rotates by age.
+	clk.Advance(time.Hour)
+	write("dd")
+	write("eeeeeeee")
+	// Rotates by size, pruning the first file.
+	write("ff")
+	require.NoError(t, f.Close())
+
+	current, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "ff", string(current))
+
+	backups, err := filepath.Glob(path + ".*")
+	require.NoError(t, err)
+	require.Len(t, backups, 2)
+
+	var contents []string
+	for _, backup := range backups {
+		require.Equal(t, ".gz", filepath.Ext(backup))
+		in, err := os.Open(backup)
+		require.NoError(t, err)
+		zr, err := gzip.NewReader(in)
+		require.NoError(t, err)
+		content, err := io.ReadAll(zr)
+		require.NoError(t, err)
+		in.Close()
+		contents = append(contents, string(content))
+	}
+	require.Equal(t, []string{"cccccc", "ddeeeeeeee"}, contents)
+}
+
+type countingTracer struct {
+	events int
+}
+
+func (c *countingTracer) Trace(*pb.TraceEvent) {
+	c.events++
+}
+
+func TestSampledTracer(t *testing.T) {
+	deliver := func(id string) *pb.TraceEvent {
+		return &pb.TraceEvent{
+			Type: pb.TraceEvent_DELIVER_MESSAGE.Enum(),
+			DeliverMessage: &pb.TraceEvent_DeliverMessage{
+				MessageID: []byte(id),
+			},
+		}
+	}
+
+	all := &countingTracer{}
+	internal.NewSampledTracer(all, 1).Trace(deliver("a"))
+	require.Equal(t, 1, all.events)
+
+	none := &countingTracer{}
+	internal.NewSampledTracer(none, 0).Trace(deliver("a"))
+	require.Equal(t, 0, none.events)
+
+	// Events of a message are all sampled or all dropped.
+	some := &countingTracer{}
+	tracer := internal.NewSampledTracer(some, 0.5)
+	for i := 0; i < 100; i++ {
+		before := some.events
+		tracer.Trace(deliver(string(rune('a' + i))))
+		sampled := some.events - before
+		tracer.Trace(deliver(string(rune('a' + i))))
+		require.Equal(t, before+2*sampled, some.events)
+	}
+	require.Greater(t, some.events, 0)
+	require.Less(t, some.events, 200)
+}
+
+func TestSwitchTracer(t *testing.T) {
+	evt := &pb.TraceEvent{Type: pb.TraceEvent_JOIN.Enum()}
+	tracer := &internal.SwitchTracer{}
+
+	// Events are dropped while no tracer is set.
+	tracer.Trace(evt)
+
+	first := &countingTracer{}
+	require.Nil(t, tracer.Set(first))
+	tracer.Trace(evt)
+	require.Equal(t, 1, first.events)
+
+	second := &countingTracer{}
+	require.Same(t, first, tracer.Set(second))
+	tracer.Trace(evt)
+	require.Equal(t, 1, first.events)
+	require.Equal(t, 1, second.events)
+
+	require.Same(t, second, tracer.Set(nil))
+	tracer.Trace(evt)
+	require.Equal(t, 1, second.events)
+}
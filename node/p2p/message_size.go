@@ -0,0 +1,78 @@
+package p2p
+
+import (
+	"bytes"
+	"encoding/hex"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+)
+
+// ErrMessageTooLarge is returned when publishing a message larger than the
+// maximum size configured for its bitmask.
+var ErrMessageTooLarge = errors.New("message too large")
+
+type messageSizeLimit struct {
+	prefix []byte
+	max    int
+}
+
+// messageSizeLimits are the maximum message sizes by bitmask prefix, longest
+// prefix first.
+type messageSizeLimits []messageSizeLimit
+
+// newMessageSizeLimits parses P2PConfig.MaxMessageSizes, which maps hex
+// encoded bitmask prefixes to maximum message sizes in bytes.
+func newMessageSizeLimits(
+	p2pConfig *config.P2PConfig,
+) (messageSizeLimits, error) {
+	limits := make(messageSizeLimits, 0, len(p2pConfig.MaxMessageSizes))
+	for encoded, size := range p2pConfig.MaxMessageSizes {
+		prefix, err := hex.DecodeString(strings.TrimPrefix(encoded, "0x"))
+		if err != nil {
+			return nil, errors.Wrap(err, "new message size limits")
+		}
+		if size <= 0 {
+			return nil, errors.Wrap(
+				errors.Errorf("max message size of %s is %d", encoded, size),
+				"new message size limits",
+			)
+		}
+		limits = append(limits, messageSizeLimit{prefix: prefix, max: size})
+	}
+
+	sort.Slice(limits, func(i, j int) bool {
+		return len(limits[i].prefix) > len(limits[j].prefix)
+	})
+
+	return limits, nil
+}
+
+// limit returns the maximum message size of the bitmask, given by its longest
+// configured prefix.
+func (l messageSizeLimits) limit(bitmask []byte) (int, bool) {
+	for _, limit := range l {
+		if bytes.HasPrefix(bitmask, limit.prefix) {
+			return limit.max, true
+		}
+	}
+
+	return 0, false
+}
+
+// checkMessageSize refuses data larger than the bitmask's maximum size.
+func (b *BlossomSub) checkMessageSize(bitmask []byte, data []byte) error {
+	limit, ok := b.messageSizes.limit(bitmask)
+	if !ok || len(data) <= limit {
+		return nil
+	}
+
+	return errors.Wrapf(
+		ErrMessageTooLarge,
+		"%d bytes over the limit of %d",
+		len(data),
+		limit,
+	)
+}
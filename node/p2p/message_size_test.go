@@ -0,0 +1,141 @@
+package p2p
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	blossomsub "source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub"
+	"source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub/pb"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p/internal"
+)
+
+func TestNewMessageSizeLimits(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		sizes map[string]int
+		valid bool
+	}{
+		{name: "none", sizes: nil, valid: true},
+		{name: "hex", sizes: map[string]int{"0001": 10}, valid: true},
+		{name: "prefixed hex", sizes: map[string]int{"0x0001": 10}, valid: true},
+		{name: "empty prefix", sizes: map[string]int{"": 10}, valid: true},
+		{name: "not hex", sizes: map[string]int{"0xzz": 10}},
+		{name: "odd length", sizes: map[string]int{"001": 10}},
+		{name: "zero size", sizes: map[string]int{"00": 0}},
+		{name: "negative size", sizes: map[string]int{"00": -1}},
+	} {
+		_, err := newMessageSizeLimits(&config.P2PConfig{
+			MaxMessageSizes: test.sizes,
+		})
+		if test.valid {
+			require.NoError(t, err, test.name)
+		} else {
+			require.Error(t, err, test.name)
+		}
+	}
+}
+
+func TestMessageSizeLimit(t *testing.T) {
+	limits, err := newMessageSizeLimits(&config.P2PConfig{
+		MaxMessageSizes: map[string]int{
+			"0x00":   100,
+			"0000":   50,
+			"000001": 10,
+		},
+	})
+	require.NoError(t, err)
+
+	// The longest matching prefix wins, whatever the order of the config.
+	for _, test := range []struct {
+		bitmask []byte
+		max     int
+		limited bool
+	}{
+		{bitmask: []byte{0x00, 0x00, 0x01, 0xff}, max: 10, limited: true},
+		{bitmask: []byte{0x00, 0x00, 0x01}, max: 10, limited: true},
+		{bitmask: []byte{0x00, 0x00, 0x02}, max: 50, limited: true},
+		{bitmask: []byte{0x00, 0x01}, max: 100, limited: true},
+		{bitmask: []byte{0x00}, max: 100, limited: true},
+		{bitmask: []byte{0x01, 0x00}},
+		{bitmask: []byte{}},
+	} {
+		maxSize, limited := limits.limit(test.bitmask)
+		require.Equal(t, test.max, maxSize, test.bitmask)
+		require.Equal(t, test.limited, limited, test.bitmask)
+	}
+}
+
+func TestCheckMessageSize(t *testing.T) {
+	limits, err := newMessageSizeLimits(&config.P2PConfig{
+		MaxMessageSizes: map[string]int{"01": 4},
+	})
+	require.NoError(t, err)
+	b := &BlossomSub{messageSizes: limits}
+
+	for _, test := range []struct {
+		bitmask  []byte
+		size     int
+		tooLarge bool
+	}{
+		{bitmask: []byte{0x01}, size: 4},
+		{bitmask: []byte{0x01}, size: 5, tooLarge: true},
+		{bitmask: []byte{0x02}, size: 1024},
+	} {
+		err := b.checkMessageSize(test.bitmask, make([]byte, test.size))
+		if test.tooLarge {
+			require.ErrorIs(t, err, ErrMessageTooLarge, test)
+		} else {
+			require.NoError(t, err, test)
+		}
+	}
+}
+
+func TestValidatorIgnoresOversizedMessages(t *testing.T) {
+	limits, err := newMessageSizeLimits(&config.P2PConfig{
+		MaxMessageSizes: map[string]int{"01": 4},
+	})
+	require.NoError(t, err)
+	b := &BlossomSub{
+		logger:       zap.NewNop(),
+		clock:        clock.NewFakeClock(time.UnixMilli(1700000000000)),
+		messageSizes: limits,
+		deadLetters:  internal.NewDeadLetters(10, 64),
+	}
+	validated := 0
+	validate := b.validatorEx(
+		[]byte{0x01},
+		func(peer.ID, *pb.Message) (ValidationResult, string) {
+			validated++
+			return ValidationResultAccept, ""
+		},
+	)
+	message := func(size int) *blossomsub.Message {
+		return &blossomsub.Message{Message: &pb.Message{Data: make([]byte, size)}}
+	}
+
+	require.Equal(
+		t,
+		blossomsub.ValidationAccept,
+		validate(context.Background(), "peer", message(4)),
+	)
+	require.Equal(t, 1, validated)
+
+	// Oversized messages are ignored, not rejected, since peers may configure
+	// other limits, and never reach the validator.
+	require.Equal(
+		t,
+		blossomsub.ValidationIgnore,
+		validate(context.Background(), "peer", message(5)),
+	)
+	require.Equal(t, 1, validated)
+	resp, err := b.GetDeadLetters(nil, 0)
+	require.NoError(t, err)
+	require.Len(t, resp.Letters, 1)
+	require.Equal(t, deadLetterTooLarge, resp.Letters[0].Reason)
+}
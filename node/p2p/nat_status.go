@@ -0,0 +1,115 @@
+package p2p
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	basichost "github.com/libp2p/go-libp2p/p2p/host/basic"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+// natStatusReporter is implemented by the libp2p NAT manager.
+type natStatusReporter interface {
+	Status() basichost.NATStatus
+}
+
+// newNATManager creates the NAT manager of the host, keeping it so that its
+// mappings can be reported.
+func (b *BlossomSub) newNATManager(n network.Network) basichost.NATManager {
+	b.natManager = basichost.NewNATManager(n)
+	return b.natManager
+}
+
+// GetNATStatus reports the reachability of the node, the NAT device found and
+// the port mappings on it.
+func (b *BlossomSub) GetNATStatus() *protobufs.NATStatusResponse {
+	resp := &protobufs.NATStatusResponse{
+		Reachability: strings.ToLower(
+			network.Reachability(b.reachability.Load()).String(),
+		),
+		NatState: "disabled",
+	}
+	for _, addr := range b.h.Addrs() {
+		if public, err := manet.IsPublicAddr(addr); err == nil && public {
+			resp.ExternalMultiaddrs = append(resp.ExternalMultiaddrs, addr.String())
+		}
+	}
+
+	reporter, ok := b.natManager.(natStatusReporter)
+	if !ok {
+		return resp
+	}
+
+	status := reporter.Status()
+	switch {
+	case status.Discovering:
+		resp.NatState = "discovering"
+	case status.DiscoveryErr != nil:
+		resp.NatState = "not_found"
+		resp.NatError = status.DiscoveryErr.Error()
+	default:
+		resp.NatState = "found"
+	}
+	resp.NatType = status.Type
+	if status.ExternalAddr.IsValid() {
+		resp.NatExternalIp = status.ExternalAddr.String()
+	}
+
+	for _, m := range status.Mappings {
+		mapping := &protobufs.NATMapping{
+			Protocol:     m.Protocol,
+			InternalPort: uint32(m.InternalPort),
+			ExternalPort: uint32(m.ExternalPort),
+			Renewed:      m.Renewed.UnixMilli(),
+		}
+		if m.Lease > 0 {
+			mapping.Expires = m.Renewed.Add(m.Lease).UnixMilli()
+		}
+		if m.Err != nil {
+			mapping.Error = m.Err.Error()
+		}
+		resp.Mappings = append(resp.Mappings, mapping)
+	}
+	sort.Slice(resp.Mappings, func(i, j int) bool {
+		if resp.Mappings[i].Protocol != resp.Mappings[j].Protocol {
+			return resp.Mappings[i].Protocol < resp.Mappings[j].Protocol
+		}
+		return resp.Mappings[i].InternalPort < resp.Mappings[j].InternalPort
+	})
+
+	return resp
+}
+
+// GetReachability reports the reachability of the node as determined by
+// AutoNAT, the addresses it listens on, advertises and is observed at by its
+// peers, and its NAT status.
+func (b *BlossomSub) GetReachability() *protobufs.ReachabilityResponse {
+	resp := &protobufs.ReachabilityResponse{
+		Reachability: strings.ToLower(
+			network.Reachability(b.reachability.Load()).String(),
+		),
+		ReachabilityChangedAt: b.reachabilityChanged.Load(),
+		Nat:                   b.GetNATStatus(),
+	}
+	for _, addr := range b.h.Network().ListenAddresses() {
+		resp.ListenMultiaddrs = append(resp.ListenMultiaddrs, addr.String())
+	}
+	for _, addr := range b.h.Addrs() {
+		resp.AdvertisedMultiaddrs = append(
+			resp.AdvertisedMultiaddrs,
+			addr.String(),
+		)
+	}
+	if b.idService != nil {
+		for _, addr := range b.idService.OwnObservedAddrs() {
+			resp.ObservedMultiaddrs = append(
+				resp.ObservedMultiaddrs,
+				addr.String(),
+			)
+		}
+	}
+	sort.Strings(resp.ObservedMultiaddrs)
+	return resp
+}
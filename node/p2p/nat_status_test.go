@@ -0,0 +1,121 @@
+package p2p
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/network"
+	basichost "github.com/libp2p/go-libp2p/p2p/host/basic"
+	inat "github.com/libp2p/go-libp2p/p2p/net/nat"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+// statusNATManager reports a fixed NAT status.
+type statusNATManager struct {
+	basichost.NATManager
+	status basichost.NATStatus
+}
+
+func (m *statusNATManager) Status() basichost.NATStatus {
+	return m.status
+}
+
+func newTestNATStatusBlossomSub(t *testing.T) *BlossomSub {
+	public, err := ma.NewMultiaddr("/ip4/1.2.3.4/tcp/8336")
+	require.NoError(t, err)
+	h, err := libp2p.New(
+		libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"),
+		libp2p.AddrsFactory(func(addrs []ma.Multiaddr) []ma.Multiaddr {
+			return append(addrs, public)
+		}),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { h.Close() })
+
+	b := &BlossomSub{h: h}
+	b.reachability.Store(int32(network.ReachabilityPublic))
+	return b
+}
+
+func TestGetNATStatus(t *testing.T) {
+	b := newTestNATStatusBlossomSub(t)
+
+	// Without a NAT manager only the reachability and the public addresses
+	// are reported.
+	require.True(t, proto.Equal(&protobufs.NATStatusResponse{
+		Reachability:       "public",
+		NatState:           "disabled",
+		ExternalMultiaddrs: []string{"/ip4/1.2.3.4/tcp/8336"},
+	}, b.GetNATStatus()))
+
+	nat := &statusNATManager{status: basichost.NATStatus{Discovering: true}}
+	b.natManager = nat
+	require.Equal(t, "discovering", b.GetNATStatus().NatState)
+
+	nat.status = basichost.NATStatus{DiscoveryErr: errors.New("no NAT found")}
+	resp := b.GetNATStatus()
+	require.Equal(t, "not_found", resp.NatState)
+	require.Equal(t, "no NAT found", resp.NatError)
+
+	// Mappings are sorted, and expire only when leased.
+	renewed := time.UnixMilli(1700000000000)
+	nat.status = basichost.NATStatus{
+		Type:         "NAT-PMP",
+		ExternalAddr: netip.AddrFrom4([4]byte{203, 0, 113, 1}),
+		Mappings: []inat.Mapping{
+			{
+				Protocol:     "udp",
+				InternalPort: 8336,
+				Renewed:      renewed,
+				Err:          errors.New("refused"),
+			},
+			{
+				Protocol:     "tcp",
+				InternalPort: 8337,
+				ExternalPort: 8337,
+				Renewed:      renewed,
+			},
+			{
+				Protocol:     "tcp",
+				InternalPort: 8336,
+				ExternalPort: 18336,
+				Lease:        time.Minute,
+				Renewed:      renewed,
+			},
+		},
+	}
+	require.True(t, proto.Equal(&protobufs.NATStatusResponse{
+		Reachability:       "public",
+		NatState:           "found",
+		NatType:            "NAT-PMP",
+		NatExternalIp:      "203.0.113.1",
+		ExternalMultiaddrs: []string{"/ip4/1.2.3.4/tcp/8336"},
+		Mappings: []*protobufs.NATMapping{
+			{
+				Protocol:     "tcp",
+				InternalPort: 8336,
+				ExternalPort: 18336,
+				Renewed:      renewed.UnixMilli(),
+				Expires:      renewed.Add(time.Minute).UnixMilli(),
+			},
+			{
+				Protocol:     "tcp",
+				InternalPort: 8337,
+				ExternalPort: 8337,
+				Renewed:      renewed.UnixMilli(),
+			},
+			{
+				Protocol:     "udp",
+				InternalPort: 8336,
+				Renewed:      renewed.UnixMilli(),
+				Error:        "refused",
+			},
+		},
+	}, b.GetNATStatus()))
+}
@@ -0,0 +1,42 @@
+package p2p
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	blossomsub "source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+)
+
+func TestGetNetworkInfoConnectedDuration(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	h, err := libp2p.New(libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"))
+	require.NoError(t, err)
+	t.Cleanup(func() { h.Close() })
+	other, err := libp2p.New(libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"))
+	require.NoError(t, err)
+	t.Cleanup(func() { other.Close() })
+	ps, err := blossomsub.NewBlossomSub(ctx, h)
+	require.NoError(t, err)
+
+	require.NoError(t, h.Connect(ctx, peer.AddrInfo{
+		ID:    other.ID(),
+		Addrs: other.Addrs(),
+	}))
+	opened := h.Network().ConnsToPeer(other.ID())[0].Stat().Opened
+	clk := clock.NewFakeClock(opened)
+	b := &BlossomSub{ps: ps, h: h, logger: zap.NewNop(), clock: clk}
+
+	// The connection has been open for as long as the clock has advanced.
+	clk.Advance(90 * time.Second)
+	info := b.GetNetworkInfo().NetworkInfo
+	require.Len(t, info, 1)
+	require.Equal(t, []byte(other.ID()), info[0].PeerId)
+	require.Equal(t, int64(90000), info[0].ConnectedDuration)
+}
@@ -0,0 +1,61 @@
+package p2ptest
+
+import (
+	"strings"
+	"time"
+
+	"github.com/iden3/go-iden3-crypto/poseidon"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+// LyingPeerAnnounce builds the pubsub payload of a data peer announcement
+// claiming maxFrame, ready to be published to an engine's info filter. Pair
+// it with EmptyFrameServer or StallingServer to model a peer that lies about
+// how far ahead it is.
+func LyingPeerAnnounce(
+	peerID []byte,
+	multiaddr string,
+	maxFrame uint64,
+) ([]byte, error) {
+	announce := &protobufs.DataPeerListAnnounce{
+		Peer: &protobufs.DataPeer{
+			PeerId:    peerID,
+			Multiaddr: multiaddr,
+			MaxFrame:  maxFrame,
+			Timestamp: time.Now().UnixMilli(),
+			Version:   config.GetVersion(),
+		},
+	}
+
+	any := &anypb.Any{}
+	if err := any.MarshalFrom(announce); err != nil {
+		return nil, errors.Wrap(err, "lying peer announce")
+	}
+
+	any.TypeUrl = strings.Replace(
+		any.TypeUrl,
+		"type.googleapis.com",
+		"types.quilibrium.com",
+		1,
+	)
+
+	payload, err := proto.Marshal(any)
+	if err != nil {
+		return nil, errors.Wrap(err, "lying peer announce")
+	}
+
+	h, err := poseidon.HashBytes(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "lying peer announce")
+	}
+
+	data, err := proto.Marshal(&protobufs.Message{
+		Hash:    h.Bytes(),
+		Payload: payload,
+	})
+	return data, errors.Wrap(err, "lying peer announce")
+}
@@ -0,0 +1,109 @@
+package p2ptest
+
+import (
+	"context"
+	"net"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+// FrameSource supplies the honest frame for a frame number, which the
+// adversarial servers then withhold or tamper with.
+type FrameSource func(frameNumber uint64) (*protobufs.ClockFrame, error)
+
+// EmptyFrameServer backs up a lie about max frame: it advertises nothing
+// and answers every frame request with an empty response.
+type EmptyFrameServer struct {
+	protobufs.UnimplementedDataServiceServer
+}
+
+func (s *EmptyFrameServer) GetDataFrame(
+	ctx context.Context,
+	req *protobufs.GetDataFrameRequest,
+) (*protobufs.DataFrameResponse, error) {
+	return &protobufs.DataFrameResponse{}, nil
+}
+
+// MalformedFrameServer serves frames from Source with a corrupted proof, so
+// they carry the right frame number and parent but fail verification.
+type MalformedFrameServer struct {
+	protobufs.UnimplementedDataServiceServer
+	Source FrameSource
+}
+
+func (s *MalformedFrameServer) GetDataFrame(
+	ctx context.Context,
+	req *protobufs.GetDataFrameRequest,
+) (*protobufs.DataFrameResponse, error) {
+	frame, err := s.Source(req.FrameNumber)
+	if err != nil {
+		return nil, errors.Wrap(err, "get data frame")
+	}
+
+	frame = proto.Clone(frame).(*protobufs.ClockFrame)
+	for i := range frame.Output {
+		frame.Output[i] ^= 0xff
+	}
+
+	return &protobufs.DataFrameResponse{ClockFrame: frame}, nil
+}
+
+// StallingServer serves honest frames from Source until StallAt is
+// requested, then holds the request open until the caller gives up.
+type StallingServer struct {
+	protobufs.UnimplementedDataServiceServer
+	Source  FrameSource
+	StallAt uint64
+}
+
+func (s *StallingServer) GetDataFrame(
+	ctx context.Context,
+	req *protobufs.GetDataFrameRequest,
+) (*protobufs.DataFrameResponse, error) {
+	if req.FrameNumber >= s.StallAt {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+
+	frame, err := s.Source(req.FrameNumber)
+	if err != nil {
+		return nil, errors.Wrap(err, "get data frame")
+	}
+
+	return &protobufs.DataFrameResponse{ClockFrame: frame}, nil
+}
+
+// ServeDataService runs server on an in-memory listener and returns a client
+// connection to it, suitable for returning from a fake GetDirectChannel.
+// The returned function stops the server.
+func ServeDataService(
+	server protobufs.DataServiceServer,
+) (*grpc.ClientConn, func(), error) {
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	protobufs.RegisterDataServiceServer(s, server)
+	go func() {
+		_ = s.Serve(lis)
+	}()
+
+	cc, err := grpc.Dial(
+		"bufconn",
+		grpc.WithContextDialer(
+			func(ctx context.Context, _ string) (net.Conn, error) {
+				return lis.DialContext(ctx)
+			},
+		),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		s.Stop()
+		return nil, nil, errors.Wrap(err, "serve data service")
+	}
+
+	return cc, s.Stop, nil
+}
@@ -0,0 +1,80 @@
+package p2ptest
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub/pb"
+)
+
+// FloodIHave opens a raw BlossomSub stream from h to target and sends rounds
+// control messages, each advertising idsPerRound random message IDs on the
+// bitmask that the adversary never intends to deliver.
+func FloodIHave(
+	ctx context.Context,
+	h host.Host,
+	target peer.ID,
+	protocolID protocol.ID,
+	bitmask []byte,
+	rounds int,
+	idsPerRound int,
+) error {
+	s, err := h.NewStream(ctx, target, protocolID)
+	if err != nil {
+		return errors.Wrap(err, "flood ihave")
+	}
+	defer s.Close()
+
+	// announce the subscription first, otherwise the target discards gossip
+	// for a bitmask it does not believe the adversary is in
+	if err := writeRPC(s, &pb.RPC{
+		Subscriptions: []*pb.RPC_SubOpts{
+			{Subscribe: true, Bitmask: bitmask},
+		},
+	}); err != nil {
+		return errors.Wrap(err, "flood ihave")
+	}
+
+	for i := 0; i < rounds; i++ {
+		if ctx.Err() != nil {
+			return errors.Wrap(ctx.Err(), "flood ihave")
+		}
+
+		ids := make([][]byte, idsPerRound)
+		for j := range ids {
+			ids[j] = make([]byte, 32)
+			if _, err := rand.Read(ids[j]); err != nil {
+				return errors.Wrap(err, "flood ihave")
+			}
+		}
+
+		if err := writeRPC(s, &pb.RPC{
+			Control: &pb.ControlMessage{
+				Ihave: []*pb.ControlIHave{
+					{Bitmask: bitmask, MessageIDs: ids},
+				},
+			},
+		}); err != nil {
+			return errors.Wrap(err, "flood ihave")
+		}
+	}
+
+	return nil
+}
+
+func writeRPC(w interface{ Write([]byte) (int, error) }, rpc *pb.RPC) error {
+	data, err := proto.Marshal(rpc)
+	if err != nil {
+		return err
+	}
+
+	buf := binary.AppendUvarint([]byte{}, uint64(len(data)))
+	_, err = w.Write(append(buf, data...))
+	return err
+}
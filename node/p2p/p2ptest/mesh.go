@@ -0,0 +1,92 @@
+// Package p2ptest provides an in-memory libp2p mesh and adversarial peer
+// behaviours for exercising uncooperative-peer handling in tests.
+package p2ptest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
+	"github.com/pkg/errors"
+	blossomsub "source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub"
+)
+
+// Mesh is a set of in-memory hosts linked through a mock network.
+type Mesh struct {
+	net     mocknet.Mocknet
+	network uint8
+}
+
+// NewMesh creates an empty mesh whose BlossomSub instances join the given
+// network.
+func NewMesh(network uint8) *Mesh {
+	return &Mesh{
+		net:     mocknet.New(),
+		network: network,
+	}
+}
+
+// AddHost adds a bare host to the mesh. Adversaries use bare hosts so that
+// they can speak the wire protocol directly.
+func (m *Mesh) AddHost() (host.Host, error) {
+	h, err := m.net.GenPeer()
+	if err != nil {
+		return nil, errors.Wrap(err, "add host")
+	}
+
+	return h, nil
+}
+
+// AddBlossomSub adds a host running an honest BlossomSub router.
+func (m *Mesh) AddBlossomSub(
+	ctx context.Context,
+	opts ...blossomsub.Option,
+) (host.Host, *blossomsub.PubSub, error) {
+	h, err := m.AddHost()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "add blossomsub")
+	}
+
+	rt := blossomsub.NewBlossomSubRouter(
+		h,
+		blossomsub.DefaultBlossomSubParams(),
+		m.network,
+	)
+	ps, err := blossomsub.NewBlossomSubWithRouter(ctx, h, rt, opts...)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "add blossomsub")
+	}
+
+	return h, ps, nil
+}
+
+// ProtocolID is the BlossomSub protocol spoken on the mesh's network.
+func (m *Mesh) ProtocolID() protocol.ID {
+	if m.network == 0 {
+		return blossomsub.BlossomSubID_v2
+	}
+
+	return protocol.ID(
+		fmt.Sprintf("%s-network-%d", blossomsub.BlossomSubID_v2, m.network),
+	)
+}
+
+// Connect links and connects every host in the mesh to every other host.
+func (m *Mesh) Connect() error {
+	if err := m.net.LinkAll(); err != nil {
+		return errors.Wrap(err, "connect")
+	}
+
+	if err := m.net.ConnectAllButSelf(); err != nil {
+		return errors.Wrap(err, "connect")
+	}
+
+	return nil
+}
+
+// Close shuts down every host in the mesh.
+func (m *Mesh) Close() error {
+	return m.net.Close()
+}
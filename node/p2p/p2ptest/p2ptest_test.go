@@ -0,0 +1,107 @@
+package p2ptest_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p/p2ptest"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+func TestFloodIHave(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	mesh := p2ptest.NewMesh(0)
+	defer mesh.Close()
+
+	honest, ps, err := mesh.AddBlossomSub(ctx)
+	require.NoError(t, err)
+	publisher, publisherPS, err := mesh.AddBlossomSub(ctx)
+	require.NoError(t, err)
+	adversary, err := mesh.AddHost()
+	require.NoError(t, err)
+	require.NoError(t, mesh.Connect())
+
+	bitmask := []byte{0x00, 0x01}
+	subs, err := ps.Subscribe(bitmask)
+	require.NoError(t, err)
+	require.Len(t, subs, 1)
+	_, err = publisherPS.Subscribe(bitmask)
+	require.NoError(t, err)
+
+	assert.NoError(t, p2ptest.FloodIHave(
+		ctx,
+		adversary,
+		honest.ID(),
+		mesh.ProtocolID(),
+		bitmask,
+		100,
+		50,
+	))
+
+	// The flood does not keep the honest peer from receiving what another
+	// honest peer publishes once heartbeats have built the mesh.
+	time.Sleep(2 * time.Second)
+	for i := 0; i < 10; i++ {
+		require.NoError(t, publisherPS.Publish(
+			ctx,
+			bitmask,
+			[]byte(fmt.Sprintf("message %d", i)),
+		))
+	}
+	for i := 0; i < 10; i++ {
+		msg, err := subs[0].Next(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, publisher.ID(), msg.ReceivedFrom)
+		assert.Equal(t, fmt.Sprintf("message %d", i), string(msg.Data))
+	}
+}
+
+func TestAdversarialDataServices(t *testing.T) {
+	source := func(frameNumber uint64) (*protobufs.ClockFrame, error) {
+		return &protobufs.ClockFrame{
+			FrameNumber: frameNumber,
+			Output:      []byte{0x01, 0x02},
+		}, nil
+	}
+
+	cc, stop, err := p2ptest.ServeDataService(
+		&p2ptest.MalformedFrameServer{Source: source},
+	)
+	assert.NoError(t, err)
+	resp, err := protobufs.NewDataServiceClient(cc).GetDataFrame(
+		context.Background(),
+		&protobufs.GetDataFrameRequest{FrameNumber: 4},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(4), resp.ClockFrame.FrameNumber)
+	assert.Equal(t, []byte{0xfe, 0xfd}, resp.ClockFrame.Output)
+	cc.Close()
+	stop()
+
+	cc, stop, err = p2ptest.ServeDataService(
+		&p2ptest.StallingServer{Source: source, StallAt: 2},
+	)
+	assert.NoError(t, err)
+	defer stop()
+	defer cc.Close()
+	client := protobufs.NewDataServiceClient(cc)
+	_, err = client.GetDataFrame(
+		context.Background(),
+		&protobufs.GetDataFrameRequest{FrameNumber: 1},
+	)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err = client.GetDataFrame(
+		ctx,
+		&protobufs.GetDataFrameRequest{FrameNumber: 2},
+	)
+	assert.Error(t, err)
+}
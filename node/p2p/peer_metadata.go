@@ -0,0 +1,251 @@
+package p2p
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/event"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/libp2p/go-libp2p/p2p/host/eventbus"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/proto"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+// Peers serve their metadata on peerMetadataProtocol to the peers that
+// identified them, and push it on peerMetadataPushProtocol to their connected
+// peers when it changes.
+const (
+	peerMetadataProtocol     = protocol.ID("/quilibrium/peer-metadata/1.0.0")
+	peerMetadataPushProtocol = protocol.ID("/quilibrium/peer-metadata/push/1.0.0")
+	// Peerstore key of the marshaled metadata of a peer.
+	peerMetadataKey     = "QuilibriumPeerMetadata"
+	peerMetadataTimeout = 10 * time.Second
+	maxPeerMetadataSize = 64 * 1024
+)
+
+var peerMetadataExchangesTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "p2p",
+		Name:      "peer_metadata_exchanges_total",
+		Help:      "Metadata received from peers, by direction and result.",
+	},
+	[]string{"direction", "result"},
+)
+
+func init() {
+	prometheus.MustRegister(peerMetadataExchangesTotal)
+}
+
+// peerMetadataState is the part of the local metadata set by the consensus
+// engine.
+type peerMetadataState struct {
+	mx      sync.Mutex
+	prover  bool
+	filters [][]byte
+}
+
+// SetPeerMetadata sets the prover status and the shard filters sent to peers,
+// pushing them to the connected peers if they changed.
+func (b *BlossomSub) SetPeerMetadata(prover bool, filters [][]byte) {
+	b.peerMetadata.mx.Lock()
+	changed := b.peerMetadata.prover != prover ||
+		!slices.EqualFunc(b.peerMetadata.filters, filters, bytes.Equal)
+	b.peerMetadata.prover = prover
+	b.peerMetadata.filters = filters
+	b.peerMetadata.mx.Unlock()
+
+	if !changed || b.h == nil {
+		return
+	}
+	for _, p := range b.h.Network().Peers() {
+		go b.pushPeerMetadata(p)
+	}
+}
+
+// GetPeerMetadata returns the metadata the peer sent, or nil if it did not
+// send any.
+func (b *BlossomSub) GetPeerMetadata(peerId []byte) *protobufs.PeerMetadata {
+	v, err := b.h.Peerstore().Get(peer.ID(peerId), peerMetadataKey)
+	if err != nil {
+		return nil
+	}
+	raw, ok := v.([]byte)
+	if !ok {
+		return nil
+	}
+	metadata := &protobufs.PeerMetadata{}
+	if err := proto.Unmarshal(raw, metadata); err != nil {
+		return nil
+	}
+	return metadata
+}
+
+func (b *BlossomSub) localPeerMetadata() *protobufs.PeerMetadata {
+	metadata := &protobufs.PeerMetadata{
+		Version: config.GetVersion(),
+	}
+
+	for _, purpose := range []string{
+		DirectChannelPurposeSync,
+		DirectChannelPurposeWorker,
+		DirectChannelPurposeProvingKey,
+		DirectChannelPurposeChannel,
+	} {
+		if b.directChannelPurposes != nil {
+			if _, ok := b.directChannelPurposes[purpose]; !ok {
+				continue
+			}
+		}
+		metadata.Protocols = append(metadata.Protocols, purpose)
+	}
+	b.channelListenersMx.Lock()
+	channels := make([]string, 0, len(b.channelListeners))
+	for protocolSuffix := range b.channelListeners {
+		channels = append(channels, protocolSuffix)
+	}
+	b.channelListenersMx.Unlock()
+	sort.Strings(channels)
+	metadata.Protocols = append(metadata.Protocols, channels...)
+
+	b.peerMetadata.mx.Lock()
+	metadata.Prover = b.peerMetadata.prover
+	metadata.Filters = b.peerMetadata.filters
+	b.peerMetadata.mx.Unlock()
+
+	return metadata
+}
+
+// startPeerMetadataExchange serves the local metadata and requests the
+// metadata of every peer once identify reports it supports the exchange.
+func (b *BlossomSub) startPeerMetadataExchange(ctx context.Context) error {
+	b.h.SetStreamHandler(peerMetadataProtocol, func(s network.Stream) {
+		defer s.Close()
+		_ = s.SetDeadline(b.clock.Now().Add(peerMetadataTimeout))
+		if _, err := protodelim.MarshalTo(s, b.localPeerMetadata()); err != nil {
+			s.Reset()
+		}
+	})
+	b.h.SetStreamHandler(peerMetadataPushProtocol, func(s network.Stream) {
+		defer s.Close()
+		b.readPeerMetadata(s, "push")
+	})
+
+	sub, err := b.h.EventBus().Subscribe(
+		&event.EvtPeerIdentificationCompleted{},
+		eventbus.Name("peer-metadata"),
+	)
+	if err != nil {
+		return errors.Wrap(err, "start peer metadata exchange")
+	}
+
+	go func() {
+		defer sub.Close()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case evt, ok := <-sub.Out():
+				if !ok {
+					return
+				}
+				identified := evt.(event.EvtPeerIdentificationCompleted)
+				if slices.Contains(identified.Protocols, peerMetadataProtocol) {
+					go b.requestPeerMetadata(ctx, identified.Peer)
+				}
+			}
+		}
+	}()
+
+	// Peers identified before the subscription are requested now.
+	for _, p := range b.h.Network().Peers() {
+		if ok, err := b.h.Peerstore().SupportsProtocols(
+			p,
+			peerMetadataProtocol,
+		); err == nil && len(ok) != 0 {
+			go b.requestPeerMetadata(ctx, p)
+		}
+	}
+
+	return nil
+}
+
+func (b *BlossomSub) requestPeerMetadata(ctx context.Context, p peer.ID) {
+	ctx, cancel := context.WithTimeout(ctx, peerMetadataTimeout)
+	defer cancel()
+
+	s, err := b.h.NewStream(ctx, p, peerMetadataProtocol)
+	if err != nil {
+		peerMetadataExchangesTotal.WithLabelValues("request", "error").Inc()
+		b.logger.Debug(
+			"could not request peer metadata",
+			zap.String("peer_id", p.String()),
+			zap.Error(err),
+		)
+		return
+	}
+	defer s.Close()
+	b.readPeerMetadata(s, "request")
+}
+
+func (b *BlossomSub) pushPeerMetadata(p peer.ID) {
+	if ok, err := b.h.Peerstore().SupportsProtocols(
+		p,
+		peerMetadataPushProtocol,
+	); err != nil || len(ok) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(b.ctx, peerMetadataTimeout)
+	defer cancel()
+
+	s, err := b.h.NewStream(ctx, p, peerMetadataPushProtocol)
+	if err != nil {
+		return
+	}
+	defer s.Close()
+	_ = s.SetDeadline(b.clock.Now().Add(peerMetadataTimeout))
+	if _, err := protodelim.MarshalTo(s, b.localPeerMetadata()); err != nil {
+		s.Reset()
+	}
+}
+
+// readPeerMetadata reads the metadata of the peer at the other end of the
+// stream into the peerstore.
+func (b *BlossomSub) readPeerMetadata(s network.Stream, direction string) {
+	_ = s.SetDeadline(b.clock.Now().Add(peerMetadataTimeout))
+	metadata := &protobufs.PeerMetadata{}
+	if err := (protodelim.UnmarshalOptions{
+		MaxSize: maxPeerMetadataSize,
+	}).UnmarshalFrom(bufio.NewReader(s), metadata); err != nil {
+		s.Reset()
+		peerMetadataExchangesTotal.WithLabelValues(direction, "error").Inc()
+		return
+	}
+
+	raw, err := proto.Marshal(metadata)
+	if err != nil {
+		peerMetadataExchangesTotal.WithLabelValues(direction, "error").Inc()
+		return
+	}
+	if err := b.h.Peerstore().Put(
+		s.Conn().RemotePeer(),
+		peerMetadataKey,
+		raw,
+	); err != nil {
+		peerMetadataExchangesTotal.WithLabelValues(direction, "error").Inc()
+		return
+	}
+	peerMetadataExchangesTotal.WithLabelValues(direction, "ok").Inc()
+}
@@ -0,0 +1,79 @@
+package p2p
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+func newTestPeerMetadataBlossomSub(t *testing.T) *BlossomSub {
+	h, err := libp2p.New(libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"))
+	require.NoError(t, err)
+	t.Cleanup(func() { h.Close() })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	b := &BlossomSub{
+		ctx:    ctx,
+		logger: zap.NewNop(),
+		clock:  clock.NewRealClock(),
+		h:      h,
+		directChannelPurposes: map[string]struct{}{
+			DirectChannelPurposeSync: {},
+		},
+	}
+	require.NoError(t, b.startPeerMetadataExchange(ctx))
+	return b
+}
+
+func TestPeerMetadataExchange(t *testing.T) {
+	a := newTestPeerMetadataBlossomSub(t)
+	b := newTestPeerMetadataBlossomSub(t)
+	b.SetPeerMetadata(true, [][]byte{{0x01}})
+
+	// Nothing is known of a peer before it connects.
+	require.Nil(t, a.GetPeerMetadata([]byte(b.h.ID())))
+
+	// Each peer requests the metadata of the other once identified.
+	require.NoError(t, a.h.Connect(context.Background(), peer.AddrInfo{
+		ID:    b.h.ID(),
+		Addrs: b.h.Addrs(),
+	}))
+	var metadata *protobufs.PeerMetadata
+	require.Eventually(t, func() bool {
+		metadata = a.GetPeerMetadata([]byte(b.h.ID()))
+		return metadata != nil
+	}, 10*time.Second, 10*time.Millisecond)
+	require.Equal(t, config.GetVersion(), metadata.Version)
+	require.Equal(t, []string{DirectChannelPurposeSync}, metadata.Protocols)
+	require.True(t, metadata.Prover)
+	require.Equal(t, [][]byte{{0x01}}, metadata.Filters)
+	require.Eventually(t, func() bool {
+		return b.GetPeerMetadata([]byte(a.h.ID())) != nil
+	}, 10*time.Second, 10*time.Millisecond)
+	require.False(t, b.GetPeerMetadata([]byte(a.h.ID())).Prover)
+
+	// A change is pushed to the connected peers.
+	b.SetPeerMetadata(false, [][]byte{{0x02}, {0x03}})
+	require.Eventually(t, func() bool {
+		metadata = a.GetPeerMetadata([]byte(b.h.ID()))
+		return !metadata.Prover
+	}, 10*time.Second, 10*time.Millisecond)
+	require.Equal(t, [][]byte{{0x02}, {0x03}}, metadata.Filters)
+
+	// Metadata that does not decode is not returned.
+	require.NoError(t, a.h.Peerstore().Put(
+		b.h.ID(),
+		peerMetadataKey,
+		[]byte{0xff},
+	))
+	require.Nil(t, a.GetPeerMetadata([]byte(b.h.ID())))
+}
@@ -0,0 +1,120 @@
+package p2p
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+// peerScoreSaveInterval is how often the application specific peer scores
+// are written to the store.
+const peerScoreSaveInterval = time.Minute
+
+// decayPeerScore halves the score for every half-life elapsed since it was
+// saved. A non-positive half-life keeps the score as is.
+func decayPeerScore(score int64, age time.Duration, halfLife time.Duration) int64 {
+	if halfLife <= 0 || age <= 0 {
+		return score
+	}
+
+	return int64(float64(score) * math.Exp2(-float64(age)/float64(halfLife)))
+}
+
+// PersistPeerScores implements PubSub. It restores the scores saved to the
+// store by a previous run, decayed by the time they spent on disk, so that
+// punished peers do not come back clean after a restart, and saves them back
+// every peerScoreSaveInterval until SavePeerScores is called. The retained
+// scores of the blossomsub router are its own and start over.
+func (b *BlossomSub) PersistPeerScores(scores store.PeerScoreStore) {
+	saved, err := scores.ListPeerScores()
+	if err != nil {
+		b.logger.Warn("could not restore peer scores", zap.Error(err))
+	}
+
+	b.stopPersistingPeerScores()
+	ctx, cancel := context.WithCancel(b.ctx)
+	done := make(chan struct{})
+	now := b.clock.Now()
+	b.peerScoreMx.Lock()
+	b.peerScoreStore = scores
+	b.peerScoreStop = func() {
+		cancel()
+		<-done
+	}
+	for peerId, s := range saved {
+		score := decayPeerScore(s.Score, now.Sub(s.SavedAt), b.peerScoreHalfLife)
+		if _, ok := b.peerScore[peerId]; !ok && score != 0 {
+			b.peerScore[peerId] = score
+		}
+	}
+	restored := len(b.peerScore)
+	b.peerScoreMx.Unlock()
+
+	b.logger.Info("restored peer scores", zap.Int("peers", restored))
+	go func() {
+		defer close(done)
+		b.persistPeerScores(ctx, scores)
+	}()
+}
+
+// SavePeerScores implements PubSub. It saves the scores a last time and stops
+// their periodic saving, so that the store can be closed on shutdown.
+func (b *BlossomSub) SavePeerScores() error {
+	scores := b.stopPersistingPeerScores()
+	if scores == nil {
+		return nil
+	}
+
+	return errors.Wrap(b.savePeerScores(scores), "save peer scores")
+}
+
+// stopPersistingPeerScores stops the periodic saving, waiting for a save in
+// progress, and returns the store the scores were saved to, if any.
+func (b *BlossomSub) stopPersistingPeerScores() store.PeerScoreStore {
+	b.peerScoreMx.Lock()
+	scores, stop := b.peerScoreStore, b.peerScoreStop
+	b.peerScoreStore, b.peerScoreStop = nil, nil
+	b.peerScoreMx.Unlock()
+
+	if stop != nil {
+		stop()
+	}
+	return scores
+}
+
+// persistPeerScores saves a snapshot of the scores every
+// peerScoreSaveInterval until ctx is done.
+func (b *BlossomSub) persistPeerScores(
+	ctx context.Context,
+	scores store.PeerScoreStore,
+) {
+	ticker := b.clock.NewTicker(peerScoreSaveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.Chan():
+		}
+
+		if err := b.savePeerScores(scores); err != nil {
+			b.logger.Warn("could not save peer scores", zap.Error(err))
+		}
+	}
+}
+
+func (b *BlossomSub) savePeerScores(scores store.PeerScoreStore) error {
+	b.peerScoreMx.Lock()
+	snapshot := make(map[string]int64, len(b.peerScore))
+	for peerId, score := range b.peerScore {
+		snapshot[peerId] = score
+	}
+	b.peerScoreMx.Unlock()
+
+	return scores.PutPeerScores(snapshot, b.clock.Now())
+}
@@ -0,0 +1,74 @@
+package p2p
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+func newTestPeerScoreBlossomSub(
+	t *testing.T,
+	clk clock.Clock,
+) *BlossomSub {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	return &BlossomSub{
+		ctx:               ctx,
+		logger:            zap.NewNop(),
+		clock:             clk,
+		peerScore:         map[string]int64{},
+		peerScoreHalfLife: time.Hour,
+	}
+}
+
+func TestDecayPeerScore(t *testing.T) {
+	require.Equal(t, int64(-100), decayPeerScore(-100, 0, time.Hour))
+	require.Equal(t, int64(-50), decayPeerScore(-100, time.Hour, time.Hour))
+	require.Equal(t, int64(-25), decayPeerScore(-100, 2*time.Hour, time.Hour))
+	require.Equal(t, int64(-100), decayPeerScore(-100, time.Hour, 0))
+}
+
+func TestPersistPeerScores(t *testing.T) {
+	clk := clock.NewFakeClock(time.UnixMilli(1700000000000))
+	scores := store.NewPebblePeerScoreStore(store.NewInMemKVDB(), zap.NewNop())
+	require.NoError(t, scores.PutPeerScores(
+		map[string]int64{"a": -100, "b": 1},
+		clk.Now().Add(-time.Hour),
+	))
+
+	// Saved scores are restored decayed by the time they spent in the store.
+	b := newTestPeerScoreBlossomSub(t, clk)
+	b.PersistPeerScores(scores)
+	require.Equal(t, int64(-50), b.GetPeerScore([]byte("a")))
+	require.Equal(t, int64(0), b.GetPeerScore([]byte("b")))
+
+	// They are saved back periodically.
+	b.SetPeerScore([]byte("c"), -10)
+	clk.BlockUntil(1)
+	clk.Advance(peerScoreSaveInterval)
+	require.Eventually(t, func() bool {
+		saved, err := scores.ListPeerScores()
+		require.NoError(t, err)
+		return saved["c"].Score == -10
+	}, time.Second, 10*time.Millisecond)
+
+	// And once more on shutdown, after which the saving stops.
+	b.SetPeerScore([]byte("c"), -20)
+	require.NoError(t, b.SavePeerScores())
+	saved, err := scores.ListPeerScores()
+	require.NoError(t, err)
+	require.Equal(t, int64(-50), saved["a"].Score)
+	require.Equal(t, int64(-20), saved["c"].Score)
+	require.NoError(t, b.SavePeerScores())
+
+	b.SetPeerScore([]byte("c"), -30)
+	clk.Advance(peerScoreSaveInterval)
+	saved, err = scores.ListPeerScores()
+	require.NoError(t, err)
+	require.Equal(t, int64(-20), saved["c"].Score)
+}
@@ -0,0 +1,68 @@
+package p2p
+
+import (
+	"context"
+	"sync"
+
+	"github.com/libp2p/go-libp2p/core/peerstore"
+	"github.com/libp2p/go-libp2p/p2p/host/peerstore/pstoreds"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+// persistentPeerstore is a peerstore backed by its own pebble database, which
+// is closed along with the peerstore.
+type persistentPeerstore struct {
+	peerstore.Peerstore
+	db        *store.PebbleDB
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// openPeerstore opens the on-disk peerstore at the configured path, so that
+// learned addresses and protocols survive restarts. A non-positive GC interval
+// disables the periodic purge of expired addresses.
+func openPeerstore(
+	ctx context.Context,
+	logger *zap.Logger,
+	p2pConfig *config.P2PConfig,
+) (*persistentPeerstore, error) {
+	db, err := store.OpenPebbleDB(p2pConfig.PeerstorePath)
+	if err != nil {
+		return nil, errors.Wrap(err, "open peerstore")
+	}
+
+	datastore, err := store.NewPeerstoreDatastore(db)
+	if err != nil {
+		db.Close()
+		return nil, errors.Wrap(err, "open peerstore")
+	}
+
+	opts := pstoreds.DefaultOpts()
+	opts.GCPurgeInterval = max(p2pConfig.PeerstoreGCInterval, 0)
+	ps, err := pstoreds.NewPeerstore(ctx, datastore, opts)
+	if err != nil {
+		db.Close()
+		return nil, errors.Wrap(err, "open peerstore")
+	}
+
+	logger.Info(
+		"opened persistent peerstore",
+		zap.String("path", p2pConfig.PeerstorePath),
+		zap.Int("known_peers", len(ps.PeersWithAddrs())),
+	)
+
+	return &persistentPeerstore{Peerstore: ps, db: db}, nil
+}
+
+func (p *persistentPeerstore) Close() error {
+	p.closeOnce.Do(func() {
+		p.closeErr = p.Peerstore.Close()
+		if err := p.db.Close(); err != nil && p.closeErr == nil {
+			p.closeErr = err
+		}
+	})
+	return errors.Wrap(p.closeErr, "close peerstore")
+}
@@ -0,0 +1,114 @@
+package p2p
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub/pb"
+)
+
+var ErrVetoed = errors.New("vetoed by plugin")
+
+// MessagePlugin is a hook into the publish and receive paths of the pubsub.
+// Plugins receive the message as it crosses the boundary and must treat it
+// as read-only. Returning an error from either method vetoes the message: a
+// vetoed publish is never sent, and a vetoed receive is not delivered to the
+// subscription handler.
+type MessagePlugin interface {
+	// Name identifies the plugin in logs and when unregistering.
+	Name() string
+	// OnPublish is called before data is published to the bitmask.
+	OnPublish(bitmask []byte, data []byte) error
+	// OnReceive is called before a message is handed to subscribers.
+	OnReceive(bitmask []byte, message *pb.Message) error
+}
+
+type registeredPlugin struct {
+	bitmask []byte
+	plugin  MessagePlugin
+}
+
+// RegisterMessagePlugin installs the plugin for the given bitmask. A nil
+// bitmask registers it for all bitmasks. Plugins run in registration order.
+func (b *BlossomSub) RegisterMessagePlugin(
+	bitmask []byte,
+	plugin MessagePlugin,
+) {
+	b.pluginsMx.Lock()
+	defer b.pluginsMx.Unlock()
+
+	var copied []byte
+	if bitmask != nil {
+		copied = make([]byte, len(bitmask))
+		copy(copied, bitmask)
+	}
+
+	b.plugins = append(b.plugins, registeredPlugin{
+		bitmask: copied,
+		plugin:  plugin,
+	})
+}
+
+// UnregisterMessagePlugin removes every registration of the named plugin.
+func (b *BlossomSub) UnregisterMessagePlugin(name string) {
+	b.pluginsMx.Lock()
+	defer b.pluginsMx.Unlock()
+
+	plugins := []registeredPlugin{}
+	for _, p := range b.plugins {
+		if p.plugin.Name() != name {
+			plugins = append(plugins, p)
+		}
+	}
+	b.plugins = plugins
+}
+
+func (b *BlossomSub) pluginsFor(bitmask []byte) []MessagePlugin {
+	b.pluginsMx.RLock()
+	defer b.pluginsMx.RUnlock()
+
+	plugins := []MessagePlugin{}
+	for _, p := range b.plugins {
+		if p.bitmask == nil || bytes.Equal(p.bitmask, bitmask) {
+			plugins = append(plugins, p.plugin)
+		}
+	}
+
+	return plugins
+}
+
+func (b *BlossomSub) runPublishPlugins(bitmask []byte, data []byte) error {
+	for _, p := range b.pluginsFor(bitmask) {
+		if err := p.OnPublish(bitmask, data); err != nil {
+			b.logger.Debug(
+				"plugin vetoed publish",
+				zap.String("plugin", p.Name()),
+				zap.Binary("bitmask", bitmask),
+				zap.Error(err),
+			)
+			return errors.Wrap(ErrVetoed, p.Name())
+		}
+	}
+
+	return nil
+}
+
+func (b *BlossomSub) runReceivePlugins(
+	bitmask []byte,
+	message *pb.Message,
+) error {
+	for _, p := range b.pluginsFor(bitmask) {
+		if err := p.OnReceive(bitmask, message); err != nil {
+			b.logger.Debug(
+				"plugin vetoed message",
+				zap.String("plugin", p.Name()),
+				zap.Binary("bitmask", bitmask),
+				zap.Error(err),
+			)
+			return errors.Wrap(ErrVetoed, p.Name())
+		}
+	}
+
+	return nil
+}
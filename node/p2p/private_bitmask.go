@@ -0,0 +1,141 @@
+package p2p
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+
+	"github.com/pkg/errors"
+	blossomsub "source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub"
+	"source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub/pb"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+)
+
+// privateEnvelopeVersion prefixes every encrypted payload, so the envelope
+// format can change without ambiguity.
+const privateEnvelopeVersion = 0x01
+
+// ErrPrivateEnvelope is returned when a payload on a private bitmask is not a
+// well formed envelope or does not authenticate under the bitmask's key.
+var ErrPrivateEnvelope = errors.New("invalid private envelope")
+
+// privateBitmasks are the AES-GCM ciphers of the bitmasks whose payloads are
+// encrypted, by bitmask.
+type privateBitmasks map[string]cipher.AEAD
+
+// newPrivateBitmasks parses P2PConfig.PrivateBitmasks, which maps hex encoded
+// bitmasks to hex encoded 32 byte keys distributed out of band.
+func newPrivateBitmasks(
+	p2pConfig *config.P2PConfig,
+) (privateBitmasks, error) {
+	ciphers := make(privateBitmasks, len(p2pConfig.PrivateBitmasks))
+	for encodedBitmask, encodedKey := range p2pConfig.PrivateBitmasks {
+		bitmask, err := hex.DecodeString(strings.TrimPrefix(encodedBitmask, "0x"))
+		if err != nil {
+			return nil, errors.Wrap(err, "new private bitmasks")
+		}
+		key, err := hex.DecodeString(strings.TrimPrefix(encodedKey, "0x"))
+		if err != nil {
+			return nil, errors.Wrap(err, "new private bitmasks")
+		}
+		if len(key) != 32 {
+			return nil, errors.Wrap(
+				errors.Errorf("key of %s is %d bytes, not 32", encodedBitmask, len(key)),
+				"new private bitmasks",
+			)
+		}
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, errors.Wrap(err, "new private bitmasks")
+		}
+		gcm, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, errors.Wrap(err, "new private bitmasks")
+		}
+		ciphers[string(bitmask)] = gcm
+	}
+
+	return ciphers, nil
+}
+
+// seal encrypts data for the bitmask, binding the envelope to it. Data for
+// bitmasks that are not private is returned as is.
+func (p privateBitmasks) seal(bitmask []byte, data []byte) ([]byte, error) {
+	gcm, ok := p[string(bitmask)]
+	if !ok {
+		return data, nil
+	}
+
+	envelope := make([]byte, 1+gcm.NonceSize(), 1+gcm.NonceSize()+len(data)+gcm.Overhead())
+	envelope[0] = privateEnvelopeVersion
+	if _, err := rand.Read(envelope[1:]); err != nil {
+		return nil, errors.Wrap(err, "seal")
+	}
+
+	return gcm.Seal(envelope, envelope[1:], data, bitmask), nil
+}
+
+// open decrypts an envelope sealed for the bitmask. Data for bitmasks that are
+// not private is returned as is.
+func (p privateBitmasks) open(bitmask []byte, envelope []byte) ([]byte, error) {
+	gcm, ok := p[string(bitmask)]
+	if !ok {
+		return envelope, nil
+	}
+
+	if len(envelope) < 1+gcm.NonceSize()+gcm.Overhead() ||
+		envelope[0] != privateEnvelopeVersion {
+		return nil, errors.Wrap(ErrPrivateEnvelope, "open")
+	}
+
+	nonce := envelope[1 : 1+gcm.NonceSize()]
+	data, err := gcm.Open(nil, nonce, envelope[1+gcm.NonceSize():], bitmask)
+	if err != nil {
+		return nil, errors.Wrap(ErrPrivateEnvelope, "open")
+	}
+
+	return data, nil
+}
+
+// openedPayload is the payload of a private message decrypted by its validator,
+// kept as the message's validator data so the handler need not decrypt it
+// again.
+type openedPayload []byte
+
+// isPrivate reports whether payloads on the bitmask are encrypted.
+func (p privateBitmasks) isPrivate(bitmask []byte) bool {
+	_, ok := p[string(bitmask)]
+	return ok
+}
+
+// openMessage returns the message with its payload decrypted, leaving the
+// original untouched. The payload opened by the validator is reused if there
+// is one.
+func (p privateBitmasks) openMessage(
+	bitmask []byte,
+	m *blossomsub.Message,
+) (*pb.Message, error) {
+	message := m.Message
+	if !p.isPrivate(bitmask) {
+		return message, nil
+	}
+
+	data, ok := m.ValidatorData.(openedPayload)
+	if !ok {
+		var err error
+		if data, err = p.open(bitmask, message.Data); err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.Message{
+		From:      message.From,
+		Data:      data,
+		Seqno:     message.Seqno,
+		Bitmask:   message.Bitmask,
+		Signature: message.Signature,
+		Key:       message.Key,
+	}, nil
+}
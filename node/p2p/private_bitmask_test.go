@@ -0,0 +1,113 @@
+package p2p
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	blossomsub "source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub"
+	"source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub/pb"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+)
+
+func newTestPrivateBitmasks(
+	t *testing.T,
+	keys map[string]byte,
+) privateBitmasks {
+	encoded := map[string]string{}
+	for bitmask, key := range keys {
+		encoded[hex.EncodeToString([]byte(bitmask))] = hex.EncodeToString(
+			bytes.Repeat([]byte{key}, 32),
+		)
+	}
+	p, err := newPrivateBitmasks(&config.P2PConfig{PrivateBitmasks: encoded})
+	require.NoError(t, err)
+	return p
+}
+
+func TestPrivateBitmasksRoundTrip(t *testing.T) {
+	private, public := []byte{0x01}, []byte{0x02}
+	p := newTestPrivateBitmasks(t, map[string]byte{string(private): 0x01})
+	data := []byte("payload")
+
+	envelope, err := p.seal(private, data)
+	require.NoError(t, err)
+	require.NotContains(t, string(envelope), string(data))
+	opened, err := p.open(private, envelope)
+	require.NoError(t, err)
+	require.Equal(t, data, opened)
+
+	// Payloads of other bitmasks pass through untouched.
+	envelope, err = p.seal(public, data)
+	require.NoError(t, err)
+	require.Equal(t, data, envelope)
+	opened, err = p.open(public, envelope)
+	require.NoError(t, err)
+	require.Equal(t, data, opened)
+}
+
+func TestPrivateBitmasksRejectForgedEnvelopes(t *testing.T) {
+	a, b := []byte{0x01}, []byte{0x02}
+	p := newTestPrivateBitmasks(t, map[string]byte{
+		string(a): 0x01,
+		string(b): 0x01,
+	})
+	envelope, err := p.seal(a, []byte("payload"))
+	require.NoError(t, err)
+
+	// Sealed under another key.
+	other := newTestPrivateBitmasks(t, map[string]byte{string(a): 0x02})
+	_, err = other.open(a, envelope)
+	require.True(t, errors.Is(err, ErrPrivateEnvelope))
+
+	// Replayed on another bitmask sharing the key, which is bound as AAD.
+	_, err = p.open(b, envelope)
+	require.True(t, errors.Is(err, ErrPrivateEnvelope))
+
+	// Tampered ciphertext.
+	tampered := bytes.Clone(envelope)
+	tampered[len(tampered)-1] ^= 0xff
+	_, err = p.open(a, tampered)
+	require.True(t, errors.Is(err, ErrPrivateEnvelope))
+
+	// Unknown version.
+	tampered = bytes.Clone(envelope)
+	tampered[0] = privateEnvelopeVersion + 1
+	_, err = p.open(a, tampered)
+	require.True(t, errors.Is(err, ErrPrivateEnvelope))
+
+	// Truncated anywhere, down to nothing.
+	for i := 0; i < len(envelope); i++ {
+		_, err = p.open(a, envelope[:i])
+		require.True(t, errors.Is(err, ErrPrivateEnvelope), i)
+	}
+}
+
+func TestPrivateBitmasksOpenMessage(t *testing.T) {
+	bitmask := []byte{0x01}
+	p := newTestPrivateBitmasks(t, map[string]byte{string(bitmask): 0x01})
+	envelope, err := p.seal(bitmask, []byte("payload"))
+	require.NoError(t, err)
+
+	m := &blossomsub.Message{
+		Message: &pb.Message{Bitmask: bitmask, Data: envelope, Seqno: []byte{1}},
+	}
+	message, err := p.openMessage(bitmask, m)
+	require.NoError(t, err)
+	require.Equal(t, []byte("payload"), message.Data)
+	require.Equal(t, []byte{1}, message.Seqno)
+	require.Equal(t, envelope, m.Data)
+
+	// The payload opened by the validator is not decrypted again.
+	m.ValidatorData = openedPayload("opened")
+	message, err = p.openMessage(bitmask, m)
+	require.NoError(t, err)
+	require.Equal(t, []byte("opened"), message.Data)
+
+	m.ValidatorData = nil
+	m.Data = envelope[:len(envelope)-1]
+	_, err = p.openMessage(bitmask, m)
+	require.True(t, errors.Is(err, ErrPrivateEnvelope))
+}
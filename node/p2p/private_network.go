@@ -0,0 +1,86 @@
+package p2p
+
+import (
+	"encoding/hex"
+
+	"github.com/libp2p/go-libp2p"
+	libp2pconfig "github.com/libp2p/go-libp2p/config"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+)
+
+// Whether the address runs over a transport that supports pre-shared keys,
+// which excludes QUIC and everything else over UDP.
+func supportsPrivateNetwork(addr ma.Multiaddr) bool {
+	_, err := addr.ValueForProtocol(ma.P_TCP)
+	if err != nil {
+		return false
+	}
+	_, err = addr.ValueForProtocol(ma.P_UDP)
+	return err != nil
+}
+
+// privateNetworkOptions restricts the host to the private network keyed by
+// the hex encoded 32 byte P2PConfig.NetworkPSK. transportOptions limits such a
+// host to TCP and websocket.
+// It refuses configs whose listen address or bootstrap peers cannot be part
+// of such a network.
+func privateNetworkOptions(
+	p2pConfig *config.P2PConfig,
+	bootstrappers []peer.AddrInfo,
+) ([]libp2pconfig.Option, error) {
+	psk, err := hex.DecodeString(p2pConfig.NetworkPSK)
+	if err != nil {
+		return nil, errors.Wrap(err, "private network options")
+	}
+	if len(psk) != 32 {
+		return nil, errors.Wrap(
+			errors.Errorf("network psk is %d bytes, expected 32", len(psk)),
+			"private network options",
+		)
+	}
+
+	if p2pConfig.Network == 0 {
+		return nil, errors.Wrap(
+			errors.New("network psk cannot be used on mainnet"),
+			"private network options",
+		)
+	}
+
+	listen, err := ma.NewMultiaddr(p2pConfig.ListenMultiaddr)
+	if err != nil {
+		return nil, errors.Wrap(err, "private network options")
+	}
+	if !supportsPrivateNetwork(listen) {
+		return nil, errors.Wrap(
+			errors.Errorf(
+				"listen multiaddr %s does not support private networks, use tcp",
+				listen,
+			),
+			"private network options",
+		)
+	}
+
+	for _, info := range bootstrappers {
+		usable := false
+		for _, addr := range info.Addrs {
+			if supportsPrivateNetwork(addr) {
+				usable = true
+				break
+			}
+		}
+		if !usable {
+			return nil, errors.Wrap(
+				errors.Errorf(
+					"bootstrap peer %s has no tcp address on the private network",
+					info.ID,
+				),
+				"private network options",
+			)
+		}
+	}
+
+	return []libp2pconfig.Option{libp2p.PrivateNetwork(psk)}, nil
+}
@@ -0,0 +1,61 @@
+package p2p_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p"
+)
+
+func TestNewBlossomSubRejectsMismatchedPrivateNetwork(t *testing.T) {
+	privKey, _, err := crypto.GenerateEd448Key(rand.Reader)
+	require.NoError(t, err)
+	raw, err := privKey.Raw()
+	require.NoError(t, err)
+
+	_, bootstrapPub, err := crypto.GenerateEd448Key(rand.Reader)
+	require.NoError(t, err)
+	bootstrapID, err := peer.IDFromPublicKey(bootstrapPub)
+	require.NoError(t, err)
+
+	psk := hex.EncodeToString(bytes.Repeat([]byte{0x01}, 32))
+	for name, cfg := range map[string]*config.P2PConfig{
+		"mainnet": {
+			NetworkPSK:      psk,
+			ListenMultiaddr: "/ip4/127.0.0.1/tcp/0",
+		},
+		"short key": {
+			Network:         1,
+			NetworkPSK:      "0102",
+			ListenMultiaddr: "/ip4/127.0.0.1/tcp/0",
+		},
+		"quic listener": {
+			Network:         1,
+			NetworkPSK:      psk,
+			ListenMultiaddr: "/ip4/127.0.0.1/udp/0/quic-v1",
+		},
+		"quic bootstrap peer": {
+			Network:         1,
+			NetworkPSK:      psk,
+			ListenMultiaddr: "/ip4/127.0.0.1/tcp/0",
+			BootstrapPeers: []string{
+				"/ip4/127.0.0.1/udp/8336/quic-v1/p2p/" + bootstrapID.String(),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cfg.PeerPrivKey = hex.EncodeToString(raw)
+			_, err := p2p.NewBlossomSub(cfg, zap.NewNop(), clock.NewRealClock())
+			require.True(t, errors.Is(err, p2p.ErrInvalidP2PConfig), err)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package p2p
+
+import (
+	"slices"
+
+	"github.com/libp2p/go-libp2p"
+	libp2pconfig "github.com/libp2p/go-libp2p/config"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p/internal"
+)
+
+// proxyOptions routes every outbound connection through the SOCKS5 proxy at
+// P2PConfig.ProxyAddr. The proxied TCP transport replaces the default ones, so
+// QUIC and the other UDP transports are disabled. A listen address other than
+// TCP cannot be served in this mode, and the node only dials out instead.
+// P2PConfig.Transports, if set, must enable TCP.
+func proxyOptions(
+	logger *zap.Logger,
+	p2pConfig *config.P2PConfig,
+	bootstrappers []peer.AddrInfo,
+) ([]libp2pconfig.Option, error) {
+	names, err := enabledTransports(p2pConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "proxy options")
+	}
+	if names != nil && !slices.Contains(names, TransportTCP) {
+		return nil, errors.Wrap(
+			errors.New("a proxy requires the tcp transport"),
+			"proxy options",
+		)
+	}
+
+	dialer, err := internal.NewProxyDialer(p2pConfig.ProxyAddr)
+	if err != nil {
+		return nil, errors.Wrap(err, "proxy options")
+	}
+
+	opts := []libp2pconfig.Option{
+		libp2p.Transport(internal.NewProxyTransport(dialer)),
+	}
+
+	listen, err := ma.NewMultiaddr(p2pConfig.ListenMultiaddr)
+	if err != nil {
+		return nil, errors.Wrap(err, "proxy options")
+	}
+	if !supportsPrivateNetwork(listen) {
+		logger.Warn(
+			"listen multiaddr is not tcp, not listening while using a proxy",
+			zap.String("listen_multiaddr", p2pConfig.ListenMultiaddr),
+		)
+		opts = append(opts, libp2p.NoListenAddrs)
+	}
+
+	for _, info := range bootstrappers {
+		reachable := false
+		for _, addr := range info.Addrs {
+			if supportsPrivateNetwork(addr) {
+				reachable = true
+				break
+			}
+		}
+		if !reachable {
+			logger.Warn(
+				"bootstrap peer has no tcp address, unreachable through the proxy",
+				zap.String("peer_id", info.ID.String()),
+			)
+		}
+	}
+
+	return opts, nil
+}
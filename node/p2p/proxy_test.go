@@ -0,0 +1,30 @@
+package p2p_test
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p"
+)
+
+func TestNewBlossomSubRejectsUnsupportedProxy(t *testing.T) {
+	privKey, _, err := crypto.GenerateEd448Key(rand.Reader)
+	require.NoError(t, err)
+	raw, err := privKey.Raw()
+	require.NoError(t, err)
+
+	_, err = p2p.NewBlossomSub(&config.P2PConfig{
+		Network:         1,
+		PeerPrivKey:     hex.EncodeToString(raw),
+		ListenMultiaddr: "/ip4/127.0.0.1/tcp/0",
+		ProxyAddr:       "http://127.0.0.1:8080",
+	}, zap.NewNop(), clock.NewRealClock())
+	require.True(t, errors.Is(err, p2p.ErrInvalidP2PConfig), err)
+}
@@ -0,0 +1,70 @@
+package p2p
+
+import (
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+var ErrPublishUnauthorized = errors.New("publish unauthorized")
+
+// PublishRole identifies which part of the process is publishing a message.
+type PublishRole string
+
+const (
+	// PublishRoleNode publishes on behalf of the node itself. Plain
+	// PublishToBitmask and Publish calls use this role.
+	PublishRoleNode PublishRole = "node"
+	// PublishRoleRPC publishes messages submitted over RPC.
+	PublishRoleRPC PublishRole = "rpc"
+)
+
+// PublishAuthorizer decides whether a message may be published to a bitmask
+// by the given role. Returning an error refuses the publish. The role only
+// tells where the publish came from; privileges such as being a prover are for
+// the authorizer to establish.
+type PublishAuthorizer func(role PublishRole, bitmask []byte, data []byte) error
+
+// SetPublishAuthorizer guards publishing to the bitmask with authorizer,
+// replacing any previous one. A nil authorizer lifts the guard.
+func (b *BlossomSub) SetPublishAuthorizer(
+	bitmask []byte,
+	authorizer PublishAuthorizer,
+) {
+	b.publishAuthMx.Lock()
+	defer b.publishAuthMx.Unlock()
+
+	if authorizer == nil {
+		delete(b.publishAuth, string(bitmask))
+		return
+	}
+
+	if b.publishAuth == nil {
+		b.publishAuth = make(map[string]PublishAuthorizer)
+	}
+	b.publishAuth[string(bitmask)] = authorizer
+}
+
+func (b *BlossomSub) authorizePublish(
+	role PublishRole,
+	bitmask []byte,
+	data []byte,
+) error {
+	b.publishAuthMx.RLock()
+	authorizer, ok := b.publishAuth[string(bitmask)]
+	b.publishAuthMx.RUnlock()
+	if !ok {
+		return nil
+	}
+
+	if err := authorizer(role, bitmask, data); err != nil {
+		b.logger.Warn(
+			"refused unauthorized publish",
+			zap.String("role", string(role)),
+			zap.Binary("bitmask", bitmask),
+			zap.Error(err),
+		)
+		return errors.Wrap(ErrPublishUnauthorized, err.Error())
+	}
+
+	return nil
+}
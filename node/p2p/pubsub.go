@@ -2,12 +2,16 @@ package p2p
 
 import (
 	"context"
+	"net"
+	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/multiformats/go-multiaddr"
 	"google.golang.org/grpc"
 	"source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub/pb"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
 	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
 )
 
 type ValidationResult int
@@ -18,22 +22,57 @@ const (
 	ValidationResultIgnore
 )
 
+// Validator decides what becomes of a message received on a bitmask. A
+// rejection may give a short snake_case reason, such as "malformed_frame",
+// reported with the dead letter, or none to be reported as "validator".
+// Reasons label metrics and must come from a small fixed set.
+type Validator func(
+	peerID peer.ID,
+	message *pb.Message,
+) (result ValidationResult, rejectReason string)
+
+// Subscription is a handle to the subscription made by PubSub.Subscribe.
+type Subscription interface {
+	Bitmask() []byte
+	// Cancel stops delivering messages to the handler, and leaves the bitmask
+	// unless it is subscribed to elsewhere.
+	Cancel()
+	// Done is closed once the handler is no longer called.
+	Done() <-chan struct{}
+}
+
 type PubSub interface {
 	PublishToBitmask(bitmask []byte, data []byte) error
+	PublishToBitmaskAs(role PublishRole, bitmask []byte, data []byte) error
+	PublishContext(ctx context.Context, bitmask []byte, data []byte) error
+	PublishContextAs(
+		ctx context.Context,
+		role PublishRole,
+		bitmask []byte,
+		data []byte,
+	) error
+	SetPublishAuthorizer(bitmask []byte, authorizer PublishAuthorizer)
 	Publish(address []byte, data []byte) error
-	Subscribe(bitmask []byte, handler func(message *pb.Message) error) error
+	Subscribe(
+		bitmask []byte,
+		handler func(message *pb.Message) error,
+	) (Subscription, error)
 	Unsubscribe(bitmask []byte, raw bool)
 	RegisterValidator(
 		bitmask []byte,
-		validator func(peerID peer.ID, message *pb.Message) ValidationResult,
+		validator Validator,
 		sync bool,
 	) error
 	UnregisterValidator(bitmask []byte) error
+	RegisterMessagePlugin(bitmask []byte, plugin MessagePlugin)
+	UnregisterMessagePlugin(name string)
 	GetPeerID() []byte
 	GetBitmaskPeers() map[string][]string
 	GetPeerstoreCount() int
 	GetNetworkPeersCount() int
+	GetMeshPeersCount(bitmask []byte) int
 	GetRandomPeer(bitmask []byte) ([]byte, error)
+	GetBestPeers(bitmask []byte, n int) ([][]byte, error)
 	GetMultiaddrOfPeerStream(
 		ctx context.Context,
 		peerId []byte,
@@ -45,14 +84,56 @@ type PubSub interface {
 		server *grpc.Server,
 	) error
 	GetDirectChannel(peerId []byte, purpose string) (*grpc.ClientConn, error)
+	SetDirectChannelAuthorizer(authorize func(peerId []byte) bool)
+	OpenChannel(peerId []byte, protocolSuffix string) (net.Conn, error)
+	RegisterChannelHandler(protocolSuffix string, handler ChannelHandler) error
+	UnregisterChannelHandler(protocolSuffix string)
 	GetNetworkInfo() *protobufs.NetworkInfoResponse
+	SetPeerMetadata(prover bool, filters [][]byte)
+	GetPeerMetadata(peerId []byte) *protobufs.PeerMetadata
 	SignMessage(msg []byte) ([]byte, error)
 	GetPublicKey() []byte
 	GetPeerScore(peerId []byte) int64
 	SetPeerScore(peerId []byte, score int64)
 	AddPeerScore(peerId []byte, scoreDelta int64)
+	PersistPeerScores(scores store.PeerScoreStore)
+	SavePeerScores() error
 	Reconnect(peerId []byte) error
+	ConnectPeer(ctx context.Context, multiaddr string) ([]byte, error)
+	DisconnectPeer(peerId []byte) error
+	ProtectPeer(peerId []byte, protect bool)
+	GetPeerConnection(peerId []byte) *protobufs.PeerConnectionResponse
+	GetScoredPeers() (*protobufs.ScoredPeersResponse, error)
+	OverrideGraylist(
+		peerId []byte,
+		duration time.Duration,
+	) *protobufs.ScoredPeer
+	GetPeerHealth() *protobufs.PeerHealthResponse
+	BanPeer(peerId []byte, ttl time.Duration) (*protobufs.PeerBan, error)
+	UnbanPeer(peerId []byte) (*protobufs.PeerBansResponse, error)
+	ListBans() (*protobufs.PeerBansResponse, error)
+	GetNATStatus() *protobufs.NATStatusResponse
+	GetReachability() *protobufs.ReachabilityResponse
+	GetBandwidthStats(peerLimit int) *protobufs.BandwidthStatsResponse
+	GetDeadLetters(
+		bitmask []byte,
+		limit int,
+	) (*protobufs.DeadLettersResponse, error)
+	SetDeadLetterMaxSize(bitmask []byte, maxSize int)
+	GetBlossomSubParams() (*protobufs.BlossomSubParamsResponse, error)
+	UpdateBlossomSubParams(
+		req *protobufs.UpdateBlossomSubParamsRequest,
+	) (*protobufs.BlossomSubParamsResponse, error)
+	StartTraceLog(
+		req *protobufs.StartTraceLogRequest,
+	) (*protobufs.TraceLogStatusResponse, error)
+	StopTraceLog() (*protobufs.TraceLogStatusResponse, error)
 	Bootstrap(ctx context.Context) error
 	DiscoverPeers(ctx context.Context) error
+	RunPeerDiscovery(
+		ctx context.Context,
+		bootstrap, discover bool,
+	) *protobufs.PeerDiscoveryResponse
+	ReloadPeers(p2pConfig *config.P2PConfig) error
 	GetNetwork() uint
 }
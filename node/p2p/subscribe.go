@@ -0,0 +1,230 @@
+package p2p
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	blossomsub "source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub"
+)
+
+const (
+	// Attempts to subscribe to each constituent bit of a bitmask before the
+	// subscription is rolled back.
+	subscribeBitAttempts = 3
+	// Delay before the first retry of a bit, doubled on every later one.
+	subscribeBitBackoff = 100 * time.Millisecond
+)
+
+// SubscribeError is returned by Subscribe when a constituent bit of the
+// bitmask could not be subscribed to. The subscriptions made to the other bits
+// are rolled back, so none of the bitmask remains subscribed.
+type SubscribeError struct {
+	Bitmask []byte
+	// The bits that were subscribed to before the failure, and are now rolled
+	// back.
+	Joined [][]byte
+	// The bit that failed, and the error of its last attempt.
+	Failed []byte
+	Err    error
+}
+
+func (e *SubscribeError) Error() string {
+	return fmt.Sprintf(
+		"subscribe to bitmask %s: bit %s failed after %d of %d bits: %v",
+		hex.EncodeToString(e.Bitmask),
+		hex.EncodeToString(e.Failed),
+		len(e.Joined),
+		len(e.Joined)+1,
+		e.Err,
+	)
+}
+
+func (e *SubscribeError) Unwrap() error {
+	return e.Err
+}
+
+// subscribeBit subscribes to one bit of a bitmask, retrying with backoff
+// unless the bit was closed.
+func (b *BlossomSub) subscribeBit(
+	bit *blossomsub.Bitmask,
+) (*blossomsub.Subscription, error) {
+	var err error
+	for attempt := 0; attempt < subscribeBitAttempts; attempt++ {
+		if attempt > 0 {
+			b.clock.Sleep(subscribeBitBackoff << (attempt - 1))
+		}
+
+		var sub *blossomsub.Subscription
+		sub, err = bit.Subscribe()
+		if err == nil {
+			return sub, nil
+		}
+		if errors.Is(err, blossomsub.ErrBitmaskClosed) {
+			break
+		}
+
+		b.logger.Debug(
+			"bit subscription failed",
+			zap.Binary("bit", bit.Bitmask()),
+			zap.Int("attempt", attempt+1),
+			zap.Error(err),
+		)
+	}
+
+	return nil, err
+}
+
+// subscribeBits subscribes to every bit of a joined bitmask, or to none of
+// them. On failure the subscriptions already made are cancelled and the bits
+// this call joined are closed again.
+func (b *BlossomSub) subscribeBits(
+	bitmask []byte,
+	bits []*blossomsub.Bitmask,
+) ([]*blossomsub.Subscription, error) {
+	subs := []*blossomsub.Subscription{}
+	for _, bit := range bits {
+		sub, err := b.subscribeBit(bit)
+		if err == nil {
+			subs = append(subs, sub)
+			continue
+		}
+
+		joined := [][]byte{}
+		for _, sub := range subs {
+			joined = append(joined, sub.Bitmask())
+			sub.Cancel()
+		}
+		b.subscriptionsMx.Lock()
+		defer b.subscriptionsMx.Unlock()
+		for _, bit := range bits {
+			if _, ok := b.bitmaskMap[string(bit.Bitmask())]; ok {
+				continue
+			}
+			if err := bit.Close(); err != nil {
+				b.logger.Debug(
+					"could not close bit after failed subscription",
+					zap.Binary("bit", bit.Bitmask()),
+					zap.Error(err),
+				)
+			}
+		}
+
+		return nil, &SubscribeError{
+			Bitmask: bitmask,
+			Joined:  joined,
+			Failed:  bit.Bitmask(),
+			Err:     err,
+		}
+	}
+
+	b.subscriptionsMx.Lock()
+	defer b.subscriptionsMx.Unlock()
+	for _, bit := range bits {
+		if _, ok := b.bitmaskMap[string(bit.Bitmask())]; !ok {
+			b.bitmaskMap[string(bit.Bitmask())] = bit
+		}
+	}
+
+	return subs, nil
+}
+
+// subscription is the Subscription returned by BlossomSub.Subscribe. Its
+// readers stop once it is cancelled, and the bits no other subscription reads
+// from are closed.
+type subscription struct {
+	b       *BlossomSub
+	bitmask []byte
+	bits    []*blossomsub.Bitmask
+	subs    []*blossomsub.Subscription
+	ctx     context.Context
+	cancel  context.CancelFunc
+	readers sync.WaitGroup
+	done    chan struct{}
+	once    sync.Once
+}
+
+var _ Subscription = (*subscription)(nil)
+
+func (s *subscription) Bitmask() []byte {
+	return s.bitmask
+}
+
+// Cancel does not wait for the readers, so it may be called from the handler.
+func (s *subscription) Cancel() {
+	s.once.Do(func() {
+		s.cancel()
+		for _, sub := range s.subs {
+			sub.Cancel()
+		}
+		s.b.removeSubscription(s)
+	})
+}
+
+func (s *subscription) Done() <-chan struct{} {
+	return s.done
+}
+
+func (b *BlossomSub) addSubscription(s *subscription) {
+	b.subscriptionsMx.Lock()
+	defer b.subscriptionsMx.Unlock()
+
+	if b.subscriptions == nil {
+		b.subscriptions = map[string][]*subscription{}
+	}
+	key := string(s.bitmask)
+	b.subscriptions[key] = append(b.subscriptions[key], s)
+}
+
+// removeSubscription forgets a cancelled subscription and closes the bits it
+// was the last reader of.
+func (b *BlossomSub) removeSubscription(s *subscription) {
+	b.subscriptionsMx.Lock()
+	defer b.subscriptionsMx.Unlock()
+
+	key := string(s.bitmask)
+	remaining := slices.DeleteFunc(
+		b.subscriptions[key],
+		func(other *subscription) bool { return other == s },
+	)
+	if len(remaining) == 0 {
+		delete(b.subscriptions, key)
+	} else {
+		b.subscriptions[key] = remaining
+	}
+
+	inUse := map[string]struct{}{}
+	for _, others := range b.subscriptions {
+		for _, other := range others {
+			for _, bit := range other.bits {
+				inUse[string(bit.Bitmask())] = struct{}{}
+			}
+		}
+	}
+
+	for _, bit := range s.bits {
+		if _, ok := inUse[string(bit.Bitmask())]; ok {
+			continue
+		}
+		delete(b.bitmaskMap, string(bit.Bitmask()))
+		if err := bit.Close(); err != nil {
+			b.logger.Debug(
+				"could not close bit after unsubscribing",
+				zap.Binary("bit", bit.Bitmask()),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+func (b *BlossomSub) subscriptionsTo(bitmask []byte) []*subscription {
+	b.subscriptionsMx.Lock()
+	defer b.subscriptionsMx.Unlock()
+
+	return slices.Clone(b.subscriptions[string(bitmask)])
+}
@@ -0,0 +1,137 @@
+package p2p
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	blossomsub "source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+)
+
+func newTestSubscribeBlossomSub(
+	t *testing.T,
+	ctx context.Context,
+	clk clock.Clock,
+) *BlossomSub {
+	h, err := libp2p.New(libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"))
+	require.NoError(t, err)
+	t.Cleanup(func() { h.Close() })
+
+	ps, err := blossomsub.NewBlossomSub(ctx, h)
+	require.NoError(t, err)
+	return &BlossomSub{
+		ps:         ps,
+		logger:     zap.NewNop(),
+		clock:      clk,
+		bitmaskMap: map[string]*blossomsub.Bitmask{},
+	}
+}
+
+type subscribeResult struct {
+	subs []*blossomsub.Subscription
+	err  error
+}
+
+func subscribeBits(
+	b *BlossomSub,
+	bitmask []byte,
+	bits []*blossomsub.Bitmask,
+) <-chan subscribeResult {
+	done := make(chan subscribeResult, 1)
+	go func() {
+		subs, err := b.subscribeBits(bitmask, bits)
+		done <- subscribeResult{subs: subs, err: err}
+	}()
+	return done
+}
+
+func awaitSubscribe(t *testing.T, done <-chan subscribeResult) subscribeResult {
+	select {
+	case r := <-done:
+		return r
+	case <-time.After(10 * time.Second):
+		t.Fatal("subscribing did not return")
+		return subscribeResult{}
+	}
+}
+
+func TestSubscribeBitsRollback(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	b := newTestSubscribeBlossomSub(
+		t,
+		ctx,
+		clock.NewFakeClock(time.Unix(1700000000, 0)),
+	)
+
+	// The highest bit is already in use by another subscription.
+	existing, err := b.ps.Join([]byte{0x00, 0x04})
+	require.NoError(t, err)
+	b.bitmaskMap[string(existing[0].Bitmask())] = existing[0]
+
+	bitmask := []byte{0x00, 0x07}
+	bits, err := b.ps.Join(bitmask)
+	require.NoError(t, err)
+	require.Len(t, bits, 3)
+	require.Same(t, existing[0], bits[0])
+
+	// A closed bit fails at once, without waiting to retry.
+	require.NoError(t, bits[2].Close())
+	r := awaitSubscribe(t, subscribeBits(b, bitmask, bits))
+	require.Nil(t, r.subs)
+	var subscribeErr *SubscribeError
+	require.ErrorAs(t, r.err, &subscribeErr)
+	require.ErrorIs(t, r.err, blossomsub.ErrBitmaskClosed)
+	require.Equal(t, bitmask, subscribeErr.Bitmask)
+	require.Equal(
+		t,
+		[][]byte{bits[0].Bitmask(), bits[1].Bitmask()},
+		subscribeErr.Joined,
+	)
+	require.Equal(t, bits[2].Bitmask(), subscribeErr.Failed)
+
+	// The subscriptions made are cancelled, and the bits joined for them are
+	// closed, but the bit in use stays open.
+	require.Empty(t, b.ps.GetBitmasks())
+	_, err = b.ps.Join(bits[1].Bitmask())
+	require.NoError(t, err)
+	_, err = b.ps.Join(bits[0].Bitmask())
+	require.Error(t, err)
+}
+
+func TestSubscribeBitsBackoff(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	clk := clock.NewFakeClock(time.Unix(1700000000, 0))
+	b := newTestSubscribeBlossomSub(t, ctx, clk)
+
+	bitmask := []byte{0x00, 0x01}
+	bits, err := b.ps.Join(bitmask)
+	require.NoError(t, err)
+
+	// Once the pubsub stops, every attempt fails, and is retried after a
+	// backoff doubling each time.
+	cancel()
+	done := subscribeBits(b, bitmask, bits)
+	clk.BlockUntil(1)
+	clk.Advance(subscribeBitBackoff)
+	clk.BlockUntil(1)
+	clk.Advance(subscribeBitBackoff)
+	select {
+	case <-done:
+		t.Fatal("retried before the backoff")
+	case <-time.After(100 * time.Millisecond):
+	}
+	clk.Advance(subscribeBitBackoff)
+
+	r := awaitSubscribe(t, done)
+	var subscribeErr *SubscribeError
+	require.ErrorAs(t, r.err, &subscribeErr)
+	require.ErrorIs(t, r.err, context.Canceled)
+	require.Empty(t, subscribeErr.Joined)
+	require.NotErrorIs(t, r.err, blossomsub.ErrBitmaskClosed)
+}
@@ -0,0 +1,177 @@
+package p2p
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	blossomsub "source.quilibrium.com/quilibrium/monorepo/go-libp2p-blossomsub"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p/internal"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+// traceLogState is the trace log in progress, started from the config or by
+// the operator.
+type traceLogState struct {
+	mx sync.Mutex
+	// Receives the trace events of the router.
+	tracer *internal.SwitchTracer
+	// Directory the trace logs started by the operator are written to.
+	dir string
+	// Configured rotation and sampling, used for the values a request leaves
+	// unset.
+	maxSize    int64
+	maxAge     time.Duration
+	maxBackups int
+	sampleRate float64
+	// Set while a trace log is in progress.
+	json   *blossomsub.JSONTracer
+	status *protobufs.TraceLogStatusResponse
+}
+
+func newTraceLogState(p2pConfig *config.P2PConfig) traceLogState {
+	return traceLogState{
+		tracer:     &internal.SwitchTracer{},
+		dir:        p2pConfig.TraceLogDir,
+		maxSize:    p2pConfig.TraceLogMaxSize,
+		maxAge:     p2pConfig.TraceLogMaxAge,
+		maxBackups: p2pConfig.TraceLogMaxBackups,
+		sampleRate: p2pConfig.TraceLogSampleRate,
+	}
+}
+
+// path resolves the file name of a trace log requested by the operator to a
+// path in the trace log directory. Anything else is refused, as is a name that
+// exists as something other than a regular file, so that the request cannot
+// truncate files elsewhere on the node.
+func (s *traceLogState) path(name string) (string, error) {
+	if s.dir == "" {
+		return "", errors.New("trace log directory not configured")
+	}
+	if name == "" {
+		return "", errors.New("trace log path required")
+	}
+	if !filepath.IsLocal(name) || filepath.Base(name) != name {
+		return "", errors.Errorf(
+			"trace log path %q not in trace log directory",
+			name,
+		)
+	}
+
+	path := filepath.Join(s.dir, name)
+	info, err := os.Lstat(path)
+	if err != nil && !os.IsNotExist(err) {
+		return "", errors.Wrap(err, "path")
+	}
+	if err == nil && !info.Mode().IsRegular() {
+		return "", errors.Errorf("trace log path %q not a regular file", name)
+	}
+	return path, nil
+}
+
+// StartTraceLog starts writing the trace events of the router as JSON to the
+// named file in the trace log directory, truncating it, and stops the trace
+// log in progress if any.
+func (b *BlossomSub) StartTraceLog(
+	req *protobufs.StartTraceLogRequest,
+) (*protobufs.TraceLogStatusResponse, error) {
+	if b.traceLog.tracer == nil {
+		return nil, errors.Wrap(errRouterUnavailable, "start trace log")
+	}
+	path, err := b.traceLog.path(req.Path)
+	if err != nil {
+		return nil, errors.Wrap(err, "start trace log")
+	}
+
+	return b.startTraceLog(path, req)
+}
+
+// startTraceLog starts the trace log at path, which is not checked against the
+// trace log directory, with the rotation and sampling of req.
+func (b *BlossomSub) startTraceLog(
+	path string,
+	req *protobufs.StartTraceLogRequest,
+) (*protobufs.TraceLogStatusResponse, error) {
+	b.traceLog.mx.Lock()
+	defer b.traceLog.mx.Unlock()
+
+	status := &protobufs.TraceLogStatusResponse{
+		Enabled:    true,
+		Path:       path,
+		MaxSize:    b.traceLog.maxSize,
+		MaxAge:     int64(b.traceLog.maxAge / time.Second),
+		MaxBackups: int32(b.traceLog.maxBackups),
+		SampleRate: b.traceLog.sampleRate,
+	}
+	if req.MaxSize != 0 {
+		status.MaxSize = req.MaxSize
+	}
+	if req.MaxAge != 0 {
+		status.MaxAge = req.MaxAge
+	}
+	if req.MaxBackups != 0 {
+		status.MaxBackups = req.MaxBackups
+	}
+	if req.SampleRate != 0 {
+		status.SampleRate = req.SampleRate
+	}
+
+	file, err := internal.OpenRotatingFile(
+		b.logger.Named("trace-log"),
+		b.clock,
+		status.Path,
+		status.MaxSize,
+		time.Duration(status.MaxAge)*time.Second,
+		int(status.MaxBackups),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "start trace log")
+	}
+
+	json := blossomsub.NewWriterJSONTracer(file)
+	b.traceLog.tracer.Set(internal.NewSampledTracer(json, status.SampleRate))
+	if b.traceLog.json != nil {
+		b.traceLog.json.Close()
+	}
+	b.traceLog.json = json
+	b.traceLog.status = status
+
+	b.logger.Info(
+		"started trace log",
+		zap.String("path", status.Path),
+		zap.Int64("max_size", status.MaxSize),
+		zap.Int64("max_age_seconds", status.MaxAge),
+		zap.Int32("max_backups", status.MaxBackups),
+		zap.Float64("sample_rate", status.SampleRate),
+	)
+	return status, nil
+}
+
+// StopTraceLog stops the trace log in progress, if any, flushing and closing
+// its file.
+func (b *BlossomSub) StopTraceLog() (*protobufs.TraceLogStatusResponse, error) {
+	if b.traceLog.tracer == nil {
+		return nil, errors.Wrap(errRouterUnavailable, "stop trace log")
+	}
+
+	b.traceLog.mx.Lock()
+	defer b.traceLog.mx.Unlock()
+
+	if b.traceLog.json == nil {
+		return &protobufs.TraceLogStatusResponse{}, nil
+	}
+
+	b.traceLog.tracer.Set(nil)
+	b.traceLog.json.Close()
+	b.logger.Info(
+		"stopped trace log",
+		zap.String("path", b.traceLog.status.Path),
+	)
+	b.traceLog.json = nil
+	b.traceLog.status = nil
+	return &protobufs.TraceLogStatusResponse{}, nil
+}
@@ -0,0 +1,80 @@
+package p2p
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+func newTestTraceLogBlossomSub(t *testing.T, dir string) *BlossomSub {
+	return &BlossomSub{
+		logger: zap.NewNop(),
+		clock:  clock.NewFakeClock(time.Unix(1700000000, 0)),
+		traceLog: newTraceLogState(&config.P2PConfig{
+			TraceLogDir:        dir,
+			TraceLogMaxSize:    defaultTraceLogMaxSize,
+			TraceLogMaxAge:     defaultTraceLogMaxAge,
+			TraceLogMaxBackups: defaultTraceLogMaxBackups,
+			TraceLogSampleRate: defaultTraceLogSampleRate,
+		}),
+	}
+}
+
+func TestStartTraceLog(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, "trace")
+	require.NoError(t, os.Mkdir(dir, 0755))
+	b := newTestTraceLogBlossomSub(t, dir)
+
+	status, err := b.StartTraceLog(&protobufs.StartTraceLogRequest{
+		Path: "trace.json",
+	})
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(dir, "trace.json"), status.Path)
+	require.FileExists(t, filepath.Join(dir, "trace.json"))
+	_, err = b.StopTraceLog()
+	require.NoError(t, err)
+
+	// Nothing outside the trace log directory is opened, and neither is a
+	// link inside it.
+	victim := filepath.Join(root, "victim")
+	require.NoError(t, os.WriteFile(victim, []byte("keep"), 0644))
+	require.NoError(t, os.Symlink(victim, filepath.Join(dir, "link.json")))
+	for _, path := range []string{
+		"",
+		victim,
+		"../victim",
+		"sub/trace.json",
+		"link.json",
+	} {
+		_, err := b.StartTraceLog(&protobufs.StartTraceLogRequest{Path: path})
+		require.Error(t, err, path)
+	}
+	data, err := os.ReadFile(victim)
+	require.NoError(t, err)
+	require.Equal(t, "keep", string(data))
+}
+
+func TestStartTraceLogWithoutDir(t *testing.T) {
+	b := newTestTraceLogBlossomSub(t, "")
+
+	// Without a trace log directory only the configured file is traced.
+	_, err := b.StartTraceLog(&protobufs.StartTraceLogRequest{
+		Path: "trace.json",
+	})
+	require.ErrorContains(t, err, "trace log directory not configured")
+
+	path := filepath.Join(t.TempDir(), "trace.json")
+	status, err := b.startTraceLog(path, &protobufs.StartTraceLogRequest{})
+	require.NoError(t, err)
+	require.Equal(t, path, status.Path)
+	_, err = b.StopTraceLog()
+	require.NoError(t, err)
+}
@@ -0,0 +1,145 @@
+package p2p
+
+import (
+	"strings"
+
+	"github.com/libp2p/go-libp2p"
+	libp2pconfig "github.com/libp2p/go-libp2p/config"
+	libp2pquic "github.com/libp2p/go-libp2p/p2p/transport/quic"
+	"github.com/libp2p/go-libp2p/p2p/transport/tcp"
+	"github.com/libp2p/go-libp2p/p2p/transport/websocket"
+	libp2pwebtransport "github.com/libp2p/go-libp2p/p2p/transport/webtransport"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+)
+
+// Names accepted in P2PConfig.Transports.
+const (
+	TransportTCP          = "tcp"
+	TransportQUIC         = "quic"
+	TransportWebsocket    = "websocket"
+	TransportWebtransport = "webtransport"
+)
+
+type transportSpec struct {
+	option libp2pconfig.Option
+	// Whether the transport listens on and dials the address.
+	serves func(addr ma.Multiaddr) bool
+	// Whether the transport can carry a private network, which QUIC and
+	// everything else over UDP cannot.
+	privateNetwork bool
+}
+
+func hasProtocol(addr ma.Multiaddr, code int) bool {
+	_, err := addr.ValueForProtocol(code)
+	return err == nil
+}
+
+func isWebsocket(addr ma.Multiaddr) bool {
+	return hasProtocol(addr, ma.P_WS) || hasProtocol(addr, ma.P_WSS)
+}
+
+var transportSpecs = map[string]transportSpec{
+	TransportTCP: {
+		option: libp2p.Transport(tcp.NewTCPTransport),
+		serves: func(addr ma.Multiaddr) bool {
+			return hasProtocol(addr, ma.P_TCP) && !isWebsocket(addr)
+		},
+		privateNetwork: true,
+	},
+	TransportQUIC: {
+		option: libp2p.Transport(libp2pquic.NewTransport),
+		serves: func(addr ma.Multiaddr) bool {
+			return hasProtocol(addr, ma.P_QUIC_V1) &&
+				!hasProtocol(addr, ma.P_WEBTRANSPORT)
+		},
+	},
+	TransportWebsocket: {
+		option:         libp2p.Transport(websocket.New),
+		serves:         isWebsocket,
+		privateNetwork: true,
+	},
+	TransportWebtransport: {
+		option: libp2p.Transport(libp2pwebtransport.New),
+		serves: func(addr ma.Multiaddr) bool {
+			return hasProtocol(addr, ma.P_WEBTRANSPORT)
+		},
+	},
+}
+
+// enabledTransports returns the normalized transport names configured in
+// P2PConfig.Transports, or nil when unset. Private networks default to TCP and
+// websocket, the transports that support them.
+func enabledTransports(p2pConfig *config.P2PConfig) ([]string, error) {
+	if len(p2pConfig.Transports) == 0 {
+		if p2pConfig.NetworkPSK != "" {
+			return []string{TransportTCP, TransportWebsocket}, nil
+		}
+		return nil, nil
+	}
+
+	names := []string{}
+	seen := map[string]struct{}{}
+	for _, name := range p2pConfig.Transports {
+		name = strings.ToLower(strings.TrimSpace(name))
+		t, ok := transportSpecs[name]
+		if !ok {
+			return nil, errors.Errorf("unknown transport %q", name)
+		}
+		if p2pConfig.NetworkPSK != "" && !t.privateNetwork {
+			return nil, errors.Errorf(
+				"transport %s does not support private networks",
+				name,
+			)
+		}
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		names = append(names, name)
+	}
+
+	return names, nil
+}
+
+// transportOptions restricts the host to the transports enabled in
+// P2PConfig.Transports, rather than every transport libp2p supports. The
+// listen multiaddr must be served by one of them. Without a transport list,
+// libp2p picks its defaults.
+func transportOptions(
+	p2pConfig *config.P2PConfig,
+) ([]libp2pconfig.Option, error) {
+	names, err := enabledTransports(p2pConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "transport options")
+	}
+	if names == nil {
+		return nil, nil
+	}
+
+	listen, err := ma.NewMultiaddr(p2pConfig.ListenMultiaddr)
+	if err != nil {
+		return nil, errors.Wrap(err, "transport options")
+	}
+
+	opts := []libp2pconfig.Option{}
+	listening := false
+	for _, name := range names {
+		t := transportSpecs[name]
+		opts = append(opts, t.option)
+		listening = listening || t.serves(listen)
+	}
+	if !listening {
+		return nil, errors.Wrap(
+			errors.Errorf(
+				"listen multiaddr %s is not served by transports %s",
+				listen,
+				strings.Join(names, ", "),
+			),
+			"transport options",
+		)
+	}
+
+	return opts, nil
+}
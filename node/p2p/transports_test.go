@@ -0,0 +1,66 @@
+package p2p_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/clock"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p"
+)
+
+func TestNewBlossomSubRejectsMismatchedTransports(t *testing.T) {
+	privKey, _, err := crypto.GenerateEd448Key(rand.Reader)
+	require.NoError(t, err)
+	raw, err := privKey.Raw()
+	require.NoError(t, err)
+
+	psk := hex.EncodeToString(bytes.Repeat([]byte{0x01}, 32))
+	for name, cfg := range map[string]*config.P2PConfig{
+		"unknown transport": {
+			Network:         1,
+			ListenMultiaddr: "/ip4/127.0.0.1/tcp/0",
+			Transports:      []string{"tcp", "carrier-pigeon"},
+		},
+		"listener not served": {
+			Network:         1,
+			ListenMultiaddr: "/ip4/127.0.0.1/udp/0/quic-v1",
+			Transports:      []string{"tcp"},
+		},
+		"quic on private network": {
+			Network:         1,
+			NetworkPSK:      psk,
+			ListenMultiaddr: "/ip4/127.0.0.1/tcp/0",
+			Transports:      []string{"tcp", "quic"},
+		},
+		"proxy without tcp": {
+			Network:         1,
+			ListenMultiaddr: "/ip4/127.0.0.1/udp/0/quic-v1",
+			ProxyAddr:       "127.0.0.1:9050",
+			Transports:      []string{"quic"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cfg.PeerPrivKey = hex.EncodeToString(raw)
+			_, err := p2p.NewBlossomSub(cfg, zap.NewNop(), clock.NewRealClock())
+			require.True(t, errors.Is(err, p2p.ErrInvalidP2PConfig), err)
+		})
+	}
+}
+
+func TestNewBlossomSubKeepsErrorCause(t *testing.T) {
+	_, err := p2p.NewBlossomSub(&config.P2PConfig{
+		Network:         1,
+		PeerPrivKey:     "not hex",
+		ListenMultiaddr: "/ip4/127.0.0.1/tcp/0",
+	}, zap.NewNop(), clock.NewRealClock())
+	require.True(t, errors.Is(err, p2p.ErrInvalidP2PConfig), err)
+	var cause hex.InvalidByteError
+	require.True(t, errors.As(err, &cause), err)
+}
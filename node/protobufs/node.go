@@ -2,6 +2,7 @@ package protobufs
 
 import (
 	"encoding/binary"
+	"sort"
 
 	"github.com/iden3/go-iden3-crypto/poseidon"
 	pcrypto "github.com/libp2p/go-libp2p/core/crypto"
@@ -19,6 +20,27 @@ func (t *TokenRequest) Priority() uint64 {
 	return 0
 }
 
+// FaucetPayload returns the payload the signature of a faucet request covers,
+// which binds the claim to the network and the frame it is made at.
+func FaucetPayload(network uint8, frameNumber uint64) []byte {
+	payload := append([]byte("faucet"), network)
+	return binary.BigEndian.AppendUint64(payload, frameNumber)
+}
+
+// ExpiredAt returns whether the request can no longer be applied in the frame.
+func (t *TokenRequest) ExpiredAt(frameNumber uint64) bool {
+	return t.ExpiryFrame != 0 && frameNumber > t.ExpiryFrame
+}
+
+// AppendExpiryFrame appends the expiry frame of a request to the payload its
+// signature covers, leaving the payload of requests without one unchanged.
+func AppendExpiryFrame(payload []byte, expiryFrame uint64) []byte {
+	if expiryFrame == 0 {
+		return payload
+	}
+	return binary.BigEndian.AppendUint64(payload, expiryFrame)
+}
+
 func (t *MintCoinRequest) RingAndParallelism(
 	ringCalc func(addr []byte) int,
 ) (int, uint32, error) {
@@ -58,3 +80,18 @@ func (t *MintCoinRequest) RingAndParallelism(
 
 	return -1, 0, errors.New("invalid")
 }
+
+// MetadataEntriesFromMap converts operator annotations into entries sorted by
+// key, so that responses and manifests are stable across calls.
+func MetadataEntriesFromMap(metadata map[string]string) []*MetadataEntry {
+	entries := make([]*MetadataEntry, 0, len(metadata))
+	for k, v := range metadata {
+		entries = append(entries, &MetadataEntry{Key: k, Value: v})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Key < entries[j].Key
+	})
+
+	return entries
+}
@@ -184,6 +184,40 @@ const (
 	NodeService_SendMessage_FullMethodName               = "/quilibrium.node.node.pb.NodeService/SendMessage"
 	NodeService_GetTokensByAccount_FullMethodName        = "/quilibrium.node.node.pb.NodeService/GetTokensByAccount"
 	NodeService_GetPreCoinProofsByAccount_FullMethodName = "/quilibrium.node.node.pb.NodeService/GetPreCoinProofsByAccount"
+	NodeService_SetMetadata_FullMethodName               = "/quilibrium.node.node.pb.NodeService/SetMetadata"
+	NodeService_GetMetadata_FullMethodName               = "/quilibrium.node.node.pb.NodeService/GetMetadata"
+	NodeService_ListMetadata_FullMethodName              = "/quilibrium.node.node.pb.NodeService/ListMetadata"
+	NodeService_ConnectPeer_FullMethodName               = "/quilibrium.node.node.pb.NodeService/ConnectPeer"
+	NodeService_DisconnectPeer_FullMethodName            = "/quilibrium.node.node.pb.NodeService/DisconnectPeer"
+	NodeService_ProtectPeer_FullMethodName               = "/quilibrium.node.node.pb.NodeService/ProtectPeer"
+	NodeService_GetPeerConnection_FullMethodName         = "/quilibrium.node.node.pb.NodeService/GetPeerConnection"
+	NodeService_GetInclusionProof_FullMethodName         = "/quilibrium.node.node.pb.NodeService/GetInclusionProof"
+	NodeService_GetBlossomSubParams_FullMethodName       = "/quilibrium.node.node.pb.NodeService/GetBlossomSubParams"
+	NodeService_UpdateBlossomSubParams_FullMethodName    = "/quilibrium.node.node.pb.NodeService/UpdateBlossomSubParams"
+	NodeService_StartTraceLog_FullMethodName             = "/quilibrium.node.node.pb.NodeService/StartTraceLog"
+	NodeService_StopTraceLog_FullMethodName              = "/quilibrium.node.node.pb.NodeService/StopTraceLog"
+	NodeService_ScheduleMaintenance_FullMethodName       = "/quilibrium.node.node.pb.NodeService/ScheduleMaintenance"
+	NodeService_GetMaintenanceStatus_FullMethodName      = "/quilibrium.node.node.pb.NodeService/GetMaintenanceStatus"
+	NodeService_GetSyncClients_FullMethodName            = "/quilibrium.node.node.pb.NodeService/GetSyncClients"
+	NodeService_GetServingLatency_FullMethodName         = "/quilibrium.node.node.pb.NodeService/GetServingLatency"
+	NodeService_StreamFrames_FullMethodName              = "/quilibrium.node.node.pb.NodeService/StreamFrames"
+	NodeService_GetScoredPeers_FullMethodName            = "/quilibrium.node.node.pb.NodeService/GetScoredPeers"
+	NodeService_OverrideGraylist_FullMethodName          = "/quilibrium.node.node.pb.NodeService/OverrideGraylist"
+	NodeService_GetPeerHealth_FullMethodName             = "/quilibrium.node.node.pb.NodeService/GetPeerHealth"
+	NodeService_BanPeer_FullMethodName                   = "/quilibrium.node.node.pb.NodeService/BanPeer"
+	NodeService_UnbanPeer_FullMethodName                 = "/quilibrium.node.node.pb.NodeService/UnbanPeer"
+	NodeService_ListBans_FullMethodName                  = "/quilibrium.node.node.pb.NodeService/ListBans"
+	NodeService_GetNATStatus_FullMethodName              = "/quilibrium.node.node.pb.NodeService/GetNATStatus"
+	NodeService_GetReachability_FullMethodName           = "/quilibrium.node.node.pb.NodeService/GetReachability"
+	NodeService_GetEvents_FullMethodName                 = "/quilibrium.node.node.pb.NodeService/GetEvents"
+	NodeService_GetProverStats_FullMethodName            = "/quilibrium.node.node.pb.NodeService/GetProverStats"
+	NodeService_GetProverTrieDiff_FullMethodName         = "/quilibrium.node.node.pb.NodeService/GetProverTrieDiff"
+	NodeService_VerifyStateRoot_FullMethodName           = "/quilibrium.node.node.pb.NodeService/VerifyStateRoot"
+	NodeService_SubmitFrame_FullMethodName               = "/quilibrium.node.node.pb.NodeService/SubmitFrame"
+	NodeService_GetTransactionBlooms_FullMethodName      = "/quilibrium.node.node.pb.NodeService/GetTransactionBlooms"
+	NodeService_RunPeerDiscovery_FullMethodName          = "/quilibrium.node.node.pb.NodeService/RunPeerDiscovery"
+	NodeService_GetBandwidthStats_FullMethodName         = "/quilibrium.node.node.pb.NodeService/GetBandwidthStats"
+	NodeService_GetDeadLetters_FullMethodName            = "/quilibrium.node.node.pb.NodeService/GetDeadLetters"
 )
 
 // NodeServiceClient is the client API for NodeService service.
@@ -200,6 +234,40 @@ type NodeServiceClient interface {
 	SendMessage(ctx context.Context, in *TokenRequest, opts ...grpc.CallOption) (*SendMessageResponse, error)
 	GetTokensByAccount(ctx context.Context, in *GetTokensByAccountRequest, opts ...grpc.CallOption) (*TokensByAccountResponse, error)
 	GetPreCoinProofsByAccount(ctx context.Context, in *GetPreCoinProofsByAccountRequest, opts ...grpc.CallOption) (*PreCoinProofsByAccountResponse, error)
+	SetMetadata(ctx context.Context, in *SetMetadataRequest, opts ...grpc.CallOption) (*MetadataResponse, error)
+	GetMetadata(ctx context.Context, in *GetMetadataRequest, opts ...grpc.CallOption) (*MetadataResponse, error)
+	ListMetadata(ctx context.Context, in *ListMetadataRequest, opts ...grpc.CallOption) (*MetadataResponse, error)
+	ConnectPeer(ctx context.Context, in *ConnectPeerRequest, opts ...grpc.CallOption) (*PeerConnectionResponse, error)
+	DisconnectPeer(ctx context.Context, in *DisconnectPeerRequest, opts ...grpc.CallOption) (*PeerConnectionResponse, error)
+	ProtectPeer(ctx context.Context, in *ProtectPeerRequest, opts ...grpc.CallOption) (*PeerConnectionResponse, error)
+	GetPeerConnection(ctx context.Context, in *GetPeerConnectionRequest, opts ...grpc.CallOption) (*PeerConnectionResponse, error)
+	GetInclusionProof(ctx context.Context, in *GetInclusionProofRequest, opts ...grpc.CallOption) (NodeService_GetInclusionProofClient, error)
+	GetBlossomSubParams(ctx context.Context, in *GetBlossomSubParamsRequest, opts ...grpc.CallOption) (*BlossomSubParamsResponse, error)
+	UpdateBlossomSubParams(ctx context.Context, in *UpdateBlossomSubParamsRequest, opts ...grpc.CallOption) (*BlossomSubParamsResponse, error)
+	StartTraceLog(ctx context.Context, in *StartTraceLogRequest, opts ...grpc.CallOption) (*TraceLogStatusResponse, error)
+	StopTraceLog(ctx context.Context, in *StopTraceLogRequest, opts ...grpc.CallOption) (*TraceLogStatusResponse, error)
+	ScheduleMaintenance(ctx context.Context, in *ScheduleMaintenanceRequest, opts ...grpc.CallOption) (*MaintenanceStatusResponse, error)
+	GetMaintenanceStatus(ctx context.Context, in *GetMaintenanceStatusRequest, opts ...grpc.CallOption) (*MaintenanceStatusResponse, error)
+	GetSyncClients(ctx context.Context, in *GetSyncClientsRequest, opts ...grpc.CallOption) (*SyncClientsResponse, error)
+	GetServingLatency(ctx context.Context, in *GetServingLatencyRequest, opts ...grpc.CallOption) (*ServingLatencyResponse, error)
+	StreamFrames(ctx context.Context, in *StreamFramesRequest, opts ...grpc.CallOption) (NodeService_StreamFramesClient, error)
+	GetScoredPeers(ctx context.Context, in *GetScoredPeersRequest, opts ...grpc.CallOption) (*ScoredPeersResponse, error)
+	OverrideGraylist(ctx context.Context, in *OverrideGraylistRequest, opts ...grpc.CallOption) (*ScoredPeer, error)
+	GetPeerHealth(ctx context.Context, in *GetPeerHealthRequest, opts ...grpc.CallOption) (*PeerHealthResponse, error)
+	BanPeer(ctx context.Context, in *BanPeerRequest, opts ...grpc.CallOption) (*PeerBan, error)
+	UnbanPeer(ctx context.Context, in *UnbanPeerRequest, opts ...grpc.CallOption) (*PeerBansResponse, error)
+	ListBans(ctx context.Context, in *ListBansRequest, opts ...grpc.CallOption) (*PeerBansResponse, error)
+	GetNATStatus(ctx context.Context, in *GetNATStatusRequest, opts ...grpc.CallOption) (*NATStatusResponse, error)
+	GetReachability(ctx context.Context, in *GetReachabilityRequest, opts ...grpc.CallOption) (*ReachabilityResponse, error)
+	GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*EventsResponse, error)
+	GetProverStats(ctx context.Context, in *GetProverStatsRequest, opts ...grpc.CallOption) (*ProverStats, error)
+	GetProverTrieDiff(ctx context.Context, in *GetProverTrieDiffRequest, opts ...grpc.CallOption) (*ProverTrieDiffResponse, error)
+	VerifyStateRoot(ctx context.Context, in *VerifyStateRootRequest, opts ...grpc.CallOption) (*VerifyStateRootResponse, error)
+	SubmitFrame(ctx context.Context, in *SubmitFrameRequest, opts ...grpc.CallOption) (*SubmitFrameResponse, error)
+	GetTransactionBlooms(ctx context.Context, in *GetTransactionBloomsRequest, opts ...grpc.CallOption) (*TransactionBloomsResponse, error)
+	RunPeerDiscovery(ctx context.Context, in *RunPeerDiscoveryRequest, opts ...grpc.CallOption) (*PeerDiscoveryResponse, error)
+	GetBandwidthStats(ctx context.Context, in *GetBandwidthStatsRequest, opts ...grpc.CallOption) (*BandwidthStatsResponse, error)
+	GetDeadLetters(ctx context.Context, in *GetDeadLettersRequest, opts ...grpc.CallOption) (*DeadLettersResponse, error)
 }
 
 type nodeServiceClient struct {
@@ -300,6 +368,358 @@ func (c *nodeServiceClient) GetPreCoinProofsByAccount(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *nodeServiceClient) SetMetadata(ctx context.Context, in *SetMetadataRequest, opts ...grpc.CallOption) (*MetadataResponse, error) {
+	out := new(MetadataResponse)
+	err := c.cc.Invoke(ctx, NodeService_SetMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) GetMetadata(ctx context.Context, in *GetMetadataRequest, opts ...grpc.CallOption) (*MetadataResponse, error) {
+	out := new(MetadataResponse)
+	err := c.cc.Invoke(ctx, NodeService_GetMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) ListMetadata(ctx context.Context, in *ListMetadataRequest, opts ...grpc.CallOption) (*MetadataResponse, error) {
+	out := new(MetadataResponse)
+	err := c.cc.Invoke(ctx, NodeService_ListMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) ConnectPeer(ctx context.Context, in *ConnectPeerRequest, opts ...grpc.CallOption) (*PeerConnectionResponse, error) {
+	out := new(PeerConnectionResponse)
+	err := c.cc.Invoke(ctx, NodeService_ConnectPeer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) DisconnectPeer(ctx context.Context, in *DisconnectPeerRequest, opts ...grpc.CallOption) (*PeerConnectionResponse, error) {
+	out := new(PeerConnectionResponse)
+	err := c.cc.Invoke(ctx, NodeService_DisconnectPeer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) ProtectPeer(ctx context.Context, in *ProtectPeerRequest, opts ...grpc.CallOption) (*PeerConnectionResponse, error) {
+	out := new(PeerConnectionResponse)
+	err := c.cc.Invoke(ctx, NodeService_ProtectPeer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) GetPeerConnection(ctx context.Context, in *GetPeerConnectionRequest, opts ...grpc.CallOption) (*PeerConnectionResponse, error) {
+	out := new(PeerConnectionResponse)
+	err := c.cc.Invoke(ctx, NodeService_GetPeerConnection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) GetInclusionProof(ctx context.Context, in *GetInclusionProofRequest, opts ...grpc.CallOption) (NodeService_GetInclusionProofClient, error) {
+	stream, err := c.cc.NewStream(ctx, &NodeService_ServiceDesc.Streams[0], NodeService_GetInclusionProof_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nodeServiceGetInclusionProofClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NodeService_GetInclusionProofClient interface {
+	Recv() (*InclusionProofChunk, error)
+	grpc.ClientStream
+}
+
+type nodeServiceGetInclusionProofClient struct {
+	grpc.ClientStream
+}
+
+func (x *nodeServiceGetInclusionProofClient) Recv() (*InclusionProofChunk, error) {
+	m := new(InclusionProofChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *nodeServiceClient) GetBlossomSubParams(ctx context.Context, in *GetBlossomSubParamsRequest, opts ...grpc.CallOption) (*BlossomSubParamsResponse, error) {
+	out := new(BlossomSubParamsResponse)
+	err := c.cc.Invoke(ctx, NodeService_GetBlossomSubParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) UpdateBlossomSubParams(ctx context.Context, in *UpdateBlossomSubParamsRequest, opts ...grpc.CallOption) (*BlossomSubParamsResponse, error) {
+	out := new(BlossomSubParamsResponse)
+	err := c.cc.Invoke(ctx, NodeService_UpdateBlossomSubParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) StartTraceLog(ctx context.Context, in *StartTraceLogRequest, opts ...grpc.CallOption) (*TraceLogStatusResponse, error) {
+	out := new(TraceLogStatusResponse)
+	err := c.cc.Invoke(ctx, NodeService_StartTraceLog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) StopTraceLog(ctx context.Context, in *StopTraceLogRequest, opts ...grpc.CallOption) (*TraceLogStatusResponse, error) {
+	out := new(TraceLogStatusResponse)
+	err := c.cc.Invoke(ctx, NodeService_StopTraceLog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) ScheduleMaintenance(ctx context.Context, in *ScheduleMaintenanceRequest, opts ...grpc.CallOption) (*MaintenanceStatusResponse, error) {
+	out := new(MaintenanceStatusResponse)
+	err := c.cc.Invoke(ctx, NodeService_ScheduleMaintenance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) GetMaintenanceStatus(ctx context.Context, in *GetMaintenanceStatusRequest, opts ...grpc.CallOption) (*MaintenanceStatusResponse, error) {
+	out := new(MaintenanceStatusResponse)
+	err := c.cc.Invoke(ctx, NodeService_GetMaintenanceStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) GetSyncClients(ctx context.Context, in *GetSyncClientsRequest, opts ...grpc.CallOption) (*SyncClientsResponse, error) {
+	out := new(SyncClientsResponse)
+	err := c.cc.Invoke(ctx, NodeService_GetSyncClients_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) GetServingLatency(ctx context.Context, in *GetServingLatencyRequest, opts ...grpc.CallOption) (*ServingLatencyResponse, error) {
+	out := new(ServingLatencyResponse)
+	err := c.cc.Invoke(ctx, NodeService_GetServingLatency_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) StreamFrames(ctx context.Context, in *StreamFramesRequest, opts ...grpc.CallOption) (NodeService_StreamFramesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &NodeService_ServiceDesc.Streams[1], NodeService_StreamFrames_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nodeServiceStreamFramesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NodeService_StreamFramesClient interface {
+	Recv() (*FrameNotification, error)
+	grpc.ClientStream
+}
+
+type nodeServiceStreamFramesClient struct {
+	grpc.ClientStream
+}
+
+func (x *nodeServiceStreamFramesClient) Recv() (*FrameNotification, error) {
+	m := new(FrameNotification)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *nodeServiceClient) GetScoredPeers(ctx context.Context, in *GetScoredPeersRequest, opts ...grpc.CallOption) (*ScoredPeersResponse, error) {
+	out := new(ScoredPeersResponse)
+	err := c.cc.Invoke(ctx, NodeService_GetScoredPeers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) OverrideGraylist(ctx context.Context, in *OverrideGraylistRequest, opts ...grpc.CallOption) (*ScoredPeer, error) {
+	out := new(ScoredPeer)
+	err := c.cc.Invoke(ctx, NodeService_OverrideGraylist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) GetPeerHealth(ctx context.Context, in *GetPeerHealthRequest, opts ...grpc.CallOption) (*PeerHealthResponse, error) {
+	out := new(PeerHealthResponse)
+	err := c.cc.Invoke(ctx, NodeService_GetPeerHealth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) BanPeer(ctx context.Context, in *BanPeerRequest, opts ...grpc.CallOption) (*PeerBan, error) {
+	out := new(PeerBan)
+	err := c.cc.Invoke(ctx, NodeService_BanPeer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) UnbanPeer(ctx context.Context, in *UnbanPeerRequest, opts ...grpc.CallOption) (*PeerBansResponse, error) {
+	out := new(PeerBansResponse)
+	err := c.cc.Invoke(ctx, NodeService_UnbanPeer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) ListBans(ctx context.Context, in *ListBansRequest, opts ...grpc.CallOption) (*PeerBansResponse, error) {
+	out := new(PeerBansResponse)
+	err := c.cc.Invoke(ctx, NodeService_ListBans_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) GetNATStatus(ctx context.Context, in *GetNATStatusRequest, opts ...grpc.CallOption) (*NATStatusResponse, error) {
+	out := new(NATStatusResponse)
+	err := c.cc.Invoke(ctx, NodeService_GetNATStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) GetReachability(ctx context.Context, in *GetReachabilityRequest, opts ...grpc.CallOption) (*ReachabilityResponse, error) {
+	out := new(ReachabilityResponse)
+	err := c.cc.Invoke(ctx, NodeService_GetReachability_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*EventsResponse, error) {
+	out := new(EventsResponse)
+	err := c.cc.Invoke(ctx, NodeService_GetEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) GetProverStats(ctx context.Context, in *GetProverStatsRequest, opts ...grpc.CallOption) (*ProverStats, error) {
+	out := new(ProverStats)
+	err := c.cc.Invoke(ctx, NodeService_GetProverStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) GetProverTrieDiff(ctx context.Context, in *GetProverTrieDiffRequest, opts ...grpc.CallOption) (*ProverTrieDiffResponse, error) {
+	out := new(ProverTrieDiffResponse)
+	err := c.cc.Invoke(ctx, NodeService_GetProverTrieDiff_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) VerifyStateRoot(ctx context.Context, in *VerifyStateRootRequest, opts ...grpc.CallOption) (*VerifyStateRootResponse, error) {
+	out := new(VerifyStateRootResponse)
+	err := c.cc.Invoke(ctx, NodeService_VerifyStateRoot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) SubmitFrame(ctx context.Context, in *SubmitFrameRequest, opts ...grpc.CallOption) (*SubmitFrameResponse, error) {
+	out := new(SubmitFrameResponse)
+	err := c.cc.Invoke(ctx, NodeService_SubmitFrame_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) GetTransactionBlooms(ctx context.Context, in *GetTransactionBloomsRequest, opts ...grpc.CallOption) (*TransactionBloomsResponse, error) {
+	out := new(TransactionBloomsResponse)
+	err := c.cc.Invoke(ctx, NodeService_GetTransactionBlooms_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) RunPeerDiscovery(ctx context.Context, in *RunPeerDiscoveryRequest, opts ...grpc.CallOption) (*PeerDiscoveryResponse, error) {
+	out := new(PeerDiscoveryResponse)
+	err := c.cc.Invoke(ctx, NodeService_RunPeerDiscovery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) GetBandwidthStats(ctx context.Context, in *GetBandwidthStatsRequest, opts ...grpc.CallOption) (*BandwidthStatsResponse, error) {
+	out := new(BandwidthStatsResponse)
+	err := c.cc.Invoke(ctx, NodeService_GetBandwidthStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) GetDeadLetters(ctx context.Context, in *GetDeadLettersRequest, opts ...grpc.CallOption) (*DeadLettersResponse, error) {
+	out := new(DeadLettersResponse)
+	err := c.cc.Invoke(ctx, NodeService_GetDeadLetters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NodeServiceServer is the server API for NodeService service.
 // All implementations must embed UnimplementedNodeServiceServer
 // for forward compatibility
@@ -314,232 +734,986 @@ type NodeServiceServer interface {
 	SendMessage(context.Context, *TokenRequest) (*SendMessageResponse, error)
 	GetTokensByAccount(context.Context, *GetTokensByAccountRequest) (*TokensByAccountResponse, error)
 	GetPreCoinProofsByAccount(context.Context, *GetPreCoinProofsByAccountRequest) (*PreCoinProofsByAccountResponse, error)
+	SetMetadata(context.Context, *SetMetadataRequest) (*MetadataResponse, error)
+	GetMetadata(context.Context, *GetMetadataRequest) (*MetadataResponse, error)
+	ListMetadata(context.Context, *ListMetadataRequest) (*MetadataResponse, error)
+	ConnectPeer(context.Context, *ConnectPeerRequest) (*PeerConnectionResponse, error)
+	DisconnectPeer(context.Context, *DisconnectPeerRequest) (*PeerConnectionResponse, error)
+	ProtectPeer(context.Context, *ProtectPeerRequest) (*PeerConnectionResponse, error)
+	GetPeerConnection(context.Context, *GetPeerConnectionRequest) (*PeerConnectionResponse, error)
+	GetInclusionProof(*GetInclusionProofRequest, NodeService_GetInclusionProofServer) error
+	GetBlossomSubParams(context.Context, *GetBlossomSubParamsRequest) (*BlossomSubParamsResponse, error)
+	UpdateBlossomSubParams(context.Context, *UpdateBlossomSubParamsRequest) (*BlossomSubParamsResponse, error)
+	StartTraceLog(context.Context, *StartTraceLogRequest) (*TraceLogStatusResponse, error)
+	StopTraceLog(context.Context, *StopTraceLogRequest) (*TraceLogStatusResponse, error)
+	ScheduleMaintenance(context.Context, *ScheduleMaintenanceRequest) (*MaintenanceStatusResponse, error)
+	GetMaintenanceStatus(context.Context, *GetMaintenanceStatusRequest) (*MaintenanceStatusResponse, error)
+	GetSyncClients(context.Context, *GetSyncClientsRequest) (*SyncClientsResponse, error)
+	GetServingLatency(context.Context, *GetServingLatencyRequest) (*ServingLatencyResponse, error)
+	StreamFrames(*StreamFramesRequest, NodeService_StreamFramesServer) error
+	GetScoredPeers(context.Context, *GetScoredPeersRequest) (*ScoredPeersResponse, error)
+	OverrideGraylist(context.Context, *OverrideGraylistRequest) (*ScoredPeer, error)
+	GetPeerHealth(context.Context, *GetPeerHealthRequest) (*PeerHealthResponse, error)
+	BanPeer(context.Context, *BanPeerRequest) (*PeerBan, error)
+	UnbanPeer(context.Context, *UnbanPeerRequest) (*PeerBansResponse, error)
+	ListBans(context.Context, *ListBansRequest) (*PeerBansResponse, error)
+	GetNATStatus(context.Context, *GetNATStatusRequest) (*NATStatusResponse, error)
+	GetReachability(context.Context, *GetReachabilityRequest) (*ReachabilityResponse, error)
+	GetEvents(context.Context, *GetEventsRequest) (*EventsResponse, error)
+	GetProverStats(context.Context, *GetProverStatsRequest) (*ProverStats, error)
+	GetProverTrieDiff(context.Context, *GetProverTrieDiffRequest) (*ProverTrieDiffResponse, error)
+	VerifyStateRoot(context.Context, *VerifyStateRootRequest) (*VerifyStateRootResponse, error)
+	SubmitFrame(context.Context, *SubmitFrameRequest) (*SubmitFrameResponse, error)
+	GetTransactionBlooms(context.Context, *GetTransactionBloomsRequest) (*TransactionBloomsResponse, error)
+	RunPeerDiscovery(context.Context, *RunPeerDiscoveryRequest) (*PeerDiscoveryResponse, error)
+	GetBandwidthStats(context.Context, *GetBandwidthStatsRequest) (*BandwidthStatsResponse, error)
+	GetDeadLetters(context.Context, *GetDeadLettersRequest) (*DeadLettersResponse, error)
 	mustEmbedUnimplementedNodeServiceServer()
 }
 
-// UnimplementedNodeServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedNodeServiceServer struct {
+// UnimplementedNodeServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedNodeServiceServer struct {
+}
+
+func (UnimplementedNodeServiceServer) GetFrames(context.Context, *GetFramesRequest) (*FramesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFrames not implemented")
+}
+func (UnimplementedNodeServiceServer) GetFrameInfo(context.Context, *GetFrameInfoRequest) (*FrameInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFrameInfo not implemented")
+}
+func (UnimplementedNodeServiceServer) GetPeerInfo(context.Context, *GetPeerInfoRequest) (*PeerInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeerInfo not implemented")
+}
+func (UnimplementedNodeServiceServer) GetNodeInfo(context.Context, *GetNodeInfoRequest) (*NodeInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNodeInfo not implemented")
+}
+func (UnimplementedNodeServiceServer) GetNetworkInfo(context.Context, *GetNetworkInfoRequest) (*NetworkInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetworkInfo not implemented")
+}
+func (UnimplementedNodeServiceServer) GetTokenInfo(context.Context, *GetTokenInfoRequest) (*TokenInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTokenInfo not implemented")
+}
+func (UnimplementedNodeServiceServer) GetPeerManifests(context.Context, *GetPeerManifestsRequest) (*PeerManifestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeerManifests not implemented")
+}
+func (UnimplementedNodeServiceServer) SendMessage(context.Context, *TokenRequest) (*SendMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendMessage not implemented")
+}
+func (UnimplementedNodeServiceServer) GetTokensByAccount(context.Context, *GetTokensByAccountRequest) (*TokensByAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTokensByAccount not implemented")
+}
+func (UnimplementedNodeServiceServer) GetPreCoinProofsByAccount(context.Context, *GetPreCoinProofsByAccountRequest) (*PreCoinProofsByAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPreCoinProofsByAccount not implemented")
+}
+func (UnimplementedNodeServiceServer) SetMetadata(context.Context, *SetMetadataRequest) (*MetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMetadata not implemented")
+}
+func (UnimplementedNodeServiceServer) GetMetadata(context.Context, *GetMetadataRequest) (*MetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetadata not implemented")
+}
+func (UnimplementedNodeServiceServer) ListMetadata(context.Context, *ListMetadataRequest) (*MetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMetadata not implemented")
+}
+func (UnimplementedNodeServiceServer) ConnectPeer(context.Context, *ConnectPeerRequest) (*PeerConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectPeer not implemented")
+}
+func (UnimplementedNodeServiceServer) DisconnectPeer(context.Context, *DisconnectPeerRequest) (*PeerConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisconnectPeer not implemented")
+}
+func (UnimplementedNodeServiceServer) ProtectPeer(context.Context, *ProtectPeerRequest) (*PeerConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProtectPeer not implemented")
+}
+func (UnimplementedNodeServiceServer) GetPeerConnection(context.Context, *GetPeerConnectionRequest) (*PeerConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeerConnection not implemented")
+}
+func (UnimplementedNodeServiceServer) GetInclusionProof(*GetInclusionProofRequest, NodeService_GetInclusionProofServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetInclusionProof not implemented")
+}
+func (UnimplementedNodeServiceServer) GetBlossomSubParams(context.Context, *GetBlossomSubParamsRequest) (*BlossomSubParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlossomSubParams not implemented")
+}
+func (UnimplementedNodeServiceServer) UpdateBlossomSubParams(context.Context, *UpdateBlossomSubParamsRequest) (*BlossomSubParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBlossomSubParams not implemented")
+}
+func (UnimplementedNodeServiceServer) StartTraceLog(context.Context, *StartTraceLogRequest) (*TraceLogStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartTraceLog not implemented")
+}
+func (UnimplementedNodeServiceServer) StopTraceLog(context.Context, *StopTraceLogRequest) (*TraceLogStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopTraceLog not implemented")
+}
+func (UnimplementedNodeServiceServer) ScheduleMaintenance(context.Context, *ScheduleMaintenanceRequest) (*MaintenanceStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMaintenance not implemented")
+}
+func (UnimplementedNodeServiceServer) GetMaintenanceStatus(context.Context, *GetMaintenanceStatusRequest) (*MaintenanceStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMaintenanceStatus not implemented")
+}
+func (UnimplementedNodeServiceServer) GetSyncClients(context.Context, *GetSyncClientsRequest) (*SyncClientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSyncClients not implemented")
+}
+func (UnimplementedNodeServiceServer) GetServingLatency(context.Context, *GetServingLatencyRequest) (*ServingLatencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServingLatency not implemented")
+}
+func (UnimplementedNodeServiceServer) StreamFrames(*StreamFramesRequest, NodeService_StreamFramesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamFrames not implemented")
+}
+func (UnimplementedNodeServiceServer) GetScoredPeers(context.Context, *GetScoredPeersRequest) (*ScoredPeersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScoredPeers not implemented")
+}
+func (UnimplementedNodeServiceServer) OverrideGraylist(context.Context, *OverrideGraylistRequest) (*ScoredPeer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OverrideGraylist not implemented")
+}
+func (UnimplementedNodeServiceServer) GetPeerHealth(context.Context, *GetPeerHealthRequest) (*PeerHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeerHealth not implemented")
+}
+func (UnimplementedNodeServiceServer) BanPeer(context.Context, *BanPeerRequest) (*PeerBan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanPeer not implemented")
+}
+func (UnimplementedNodeServiceServer) UnbanPeer(context.Context, *UnbanPeerRequest) (*PeerBansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbanPeer not implemented")
+}
+func (UnimplementedNodeServiceServer) ListBans(context.Context, *ListBansRequest) (*PeerBansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBans not implemented")
+}
+func (UnimplementedNodeServiceServer) GetNATStatus(context.Context, *GetNATStatusRequest) (*NATStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNATStatus not implemented")
+}
+func (UnimplementedNodeServiceServer) GetReachability(context.Context, *GetReachabilityRequest) (*ReachabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReachability not implemented")
+}
+func (UnimplementedNodeServiceServer) GetEvents(context.Context, *GetEventsRequest) (*EventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEvents not implemented")
+}
+func (UnimplementedNodeServiceServer) GetProverStats(context.Context, *GetProverStatsRequest) (*ProverStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProverStats not implemented")
+}
+func (UnimplementedNodeServiceServer) GetProverTrieDiff(context.Context, *GetProverTrieDiffRequest) (*ProverTrieDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProverTrieDiff not implemented")
+}
+func (UnimplementedNodeServiceServer) VerifyStateRoot(context.Context, *VerifyStateRootRequest) (*VerifyStateRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyStateRoot not implemented")
+}
+func (UnimplementedNodeServiceServer) SubmitFrame(context.Context, *SubmitFrameRequest) (*SubmitFrameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitFrame not implemented")
+}
+func (UnimplementedNodeServiceServer) GetTransactionBlooms(context.Context, *GetTransactionBloomsRequest) (*TransactionBloomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionBlooms not implemented")
+}
+func (UnimplementedNodeServiceServer) RunPeerDiscovery(context.Context, *RunPeerDiscoveryRequest) (*PeerDiscoveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunPeerDiscovery not implemented")
+}
+func (UnimplementedNodeServiceServer) GetBandwidthStats(context.Context, *GetBandwidthStatsRequest) (*BandwidthStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBandwidthStats not implemented")
+}
+func (UnimplementedNodeServiceServer) GetDeadLetters(context.Context, *GetDeadLettersRequest) (*DeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeadLetters not implemented")
+}
+func (UnimplementedNodeServiceServer) mustEmbedUnimplementedNodeServiceServer() {}
+
+// UnsafeNodeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NodeServiceServer will
+// result in compilation errors.
+type UnsafeNodeServiceServer interface {
+	mustEmbedUnimplementedNodeServiceServer()
+}
+
+func RegisterNodeServiceServer(s grpc.ServiceRegistrar, srv NodeServiceServer) {
+	s.RegisterService(&NodeService_ServiceDesc, srv)
+}
+
+func _NodeService_GetFrames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFramesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).GetFrames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_GetFrames_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).GetFrames(ctx, req.(*GetFramesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_GetFrameInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFrameInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).GetFrameInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_GetFrameInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).GetFrameInfo(ctx, req.(*GetFrameInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_GetPeerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPeerInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).GetPeerInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_GetPeerInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).GetPeerInfo(ctx, req.(*GetPeerInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_GetNodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodeInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).GetNodeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_GetNodeInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).GetNodeInfo(ctx, req.(*GetNodeInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_GetNetworkInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNetworkInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).GetNetworkInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_GetNetworkInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).GetNetworkInfo(ctx, req.(*GetNetworkInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_GetTokenInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTokenInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).GetTokenInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_GetTokenInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).GetTokenInfo(ctx, req.(*GetTokenInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_GetPeerManifests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPeerManifestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).GetPeerManifests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_GetPeerManifests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).GetPeerManifests(ctx, req.(*GetPeerManifestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_SendMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).SendMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_SendMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).SendMessage(ctx, req.(*TokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_GetTokensByAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTokensByAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).GetTokensByAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_GetTokensByAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).GetTokensByAccount(ctx, req.(*GetTokensByAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_GetPreCoinProofsByAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPreCoinProofsByAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).GetPreCoinProofsByAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_GetPreCoinProofsByAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).GetPreCoinProofsByAccount(ctx, req.(*GetPreCoinProofsByAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_SetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).SetMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_SetMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).SetMetadata(ctx, req.(*SetMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_GetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).GetMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_GetMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).GetMetadata(ctx, req.(*GetMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_ListMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).ListMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_ListMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).ListMetadata(ctx, req.(*ListMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_ConnectPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).ConnectPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_ConnectPeer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).ConnectPeer(ctx, req.(*ConnectPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_DisconnectPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisconnectPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).DisconnectPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_DisconnectPeer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).DisconnectPeer(ctx, req.(*DisconnectPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_ProtectPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProtectPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).ProtectPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_ProtectPeer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).ProtectPeer(ctx, req.(*ProtectPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_GetPeerConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPeerConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).GetPeerConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_GetPeerConnection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).GetPeerConnection(ctx, req.(*GetPeerConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_GetInclusionProof_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetInclusionProofRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NodeServiceServer).GetInclusionProof(m, &nodeServiceGetInclusionProofServer{stream})
+}
+
+type NodeService_GetInclusionProofServer interface {
+	Send(*InclusionProofChunk) error
+	grpc.ServerStream
+}
+
+type nodeServiceGetInclusionProofServer struct {
+	grpc.ServerStream
+}
+
+func (x *nodeServiceGetInclusionProofServer) Send(m *InclusionProofChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _NodeService_GetBlossomSubParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlossomSubParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).GetBlossomSubParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_GetBlossomSubParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).GetBlossomSubParams(ctx, req.(*GetBlossomSubParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_UpdateBlossomSubParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBlossomSubParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).UpdateBlossomSubParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_UpdateBlossomSubParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).UpdateBlossomSubParams(ctx, req.(*UpdateBlossomSubParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_StartTraceLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartTraceLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).StartTraceLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_StartTraceLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).StartTraceLog(ctx, req.(*StartTraceLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_StopTraceLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopTraceLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).StopTraceLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_StopTraceLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).StopTraceLog(ctx, req.(*StopTraceLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_ScheduleMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleMaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).ScheduleMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_ScheduleMaintenance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).ScheduleMaintenance(ctx, req.(*ScheduleMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_GetMaintenanceStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMaintenanceStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).GetMaintenanceStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_GetMaintenanceStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).GetMaintenanceStatus(ctx, req.(*GetMaintenanceStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_GetSyncClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSyncClientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).GetSyncClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_GetSyncClients_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).GetSyncClients(ctx, req.(*GetSyncClientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeService_GetServingLatency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServingLatencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).GetServingLatency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_GetServingLatency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).GetServingLatency(ctx, req.(*GetServingLatencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (UnimplementedNodeServiceServer) GetFrames(context.Context, *GetFramesRequest) (*FramesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFrames not implemented")
-}
-func (UnimplementedNodeServiceServer) GetFrameInfo(context.Context, *GetFrameInfoRequest) (*FrameInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFrameInfo not implemented")
+func _NodeService_StreamFrames_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamFramesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NodeServiceServer).StreamFrames(m, &nodeServiceStreamFramesServer{stream})
 }
-func (UnimplementedNodeServiceServer) GetPeerInfo(context.Context, *GetPeerInfoRequest) (*PeerInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPeerInfo not implemented")
+
+type NodeService_StreamFramesServer interface {
+	Send(*FrameNotification) error
+	grpc.ServerStream
 }
-func (UnimplementedNodeServiceServer) GetNodeInfo(context.Context, *GetNodeInfoRequest) (*NodeInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetNodeInfo not implemented")
+
+type nodeServiceStreamFramesServer struct {
+	grpc.ServerStream
 }
-func (UnimplementedNodeServiceServer) GetNetworkInfo(context.Context, *GetNetworkInfoRequest) (*NetworkInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetNetworkInfo not implemented")
+
+func (x *nodeServiceStreamFramesServer) Send(m *FrameNotification) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedNodeServiceServer) GetTokenInfo(context.Context, *GetTokenInfoRequest) (*TokenInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTokenInfo not implemented")
+
+func _NodeService_GetScoredPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScoredPeersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).GetScoredPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_GetScoredPeers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).GetScoredPeers(ctx, req.(*GetScoredPeersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedNodeServiceServer) GetPeerManifests(context.Context, *GetPeerManifestsRequest) (*PeerManifestsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPeerManifests not implemented")
+
+func _NodeService_OverrideGraylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OverrideGraylistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).OverrideGraylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_OverrideGraylist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).OverrideGraylist(ctx, req.(*OverrideGraylistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedNodeServiceServer) SendMessage(context.Context, *TokenRequest) (*SendMessageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendMessage not implemented")
+
+func _NodeService_GetPeerHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPeerHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).GetPeerHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_GetPeerHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).GetPeerHealth(ctx, req.(*GetPeerHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedNodeServiceServer) GetTokensByAccount(context.Context, *GetTokensByAccountRequest) (*TokensByAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTokensByAccount not implemented")
+
+func _NodeService_BanPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).BanPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_BanPeer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).BanPeer(ctx, req.(*BanPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedNodeServiceServer) GetPreCoinProofsByAccount(context.Context, *GetPreCoinProofsByAccountRequest) (*PreCoinProofsByAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPreCoinProofsByAccount not implemented")
+
+func _NodeService_UnbanPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbanPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).UnbanPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_UnbanPeer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).UnbanPeer(ctx, req.(*UnbanPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedNodeServiceServer) mustEmbedUnimplementedNodeServiceServer() {}
 
-// UnsafeNodeServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to NodeServiceServer will
-// result in compilation errors.
-type UnsafeNodeServiceServer interface {
-	mustEmbedUnimplementedNodeServiceServer()
+func _NodeService_ListBans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).ListBans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_ListBans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).ListBans(ctx, req.(*ListBansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterNodeServiceServer(s grpc.ServiceRegistrar, srv NodeServiceServer) {
-	s.RegisterService(&NodeService_ServiceDesc, srv)
+func _NodeService_GetNATStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNATStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServiceServer).GetNATStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NodeService_GetNATStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServiceServer).GetNATStatus(ctx, req.(*GetNATStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _NodeService_GetFrames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFramesRequest)
+func _NodeService_GetReachability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReachabilityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NodeServiceServer).GetFrames(ctx, in)
+		return srv.(NodeServiceServer).GetReachability(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: NodeService_GetFrames_FullMethodName,
+		FullMethod: NodeService_GetReachability_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NodeServiceServer).GetFrames(ctx, req.(*GetFramesRequest))
+		return srv.(NodeServiceServer).GetReachability(ctx, req.(*GetReachabilityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NodeService_GetFrameInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFrameInfoRequest)
+func _NodeService_GetEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NodeServiceServer).GetFrameInfo(ctx, in)
+		return srv.(NodeServiceServer).GetEvents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: NodeService_GetFrameInfo_FullMethodName,
+		FullMethod: NodeService_GetEvents_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NodeServiceServer).GetFrameInfo(ctx, req.(*GetFrameInfoRequest))
+		return srv.(NodeServiceServer).GetEvents(ctx, req.(*GetEventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NodeService_GetPeerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPeerInfoRequest)
+func _NodeService_GetProverStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProverStatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NodeServiceServer).GetPeerInfo(ctx, in)
+		return srv.(NodeServiceServer).GetProverStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: NodeService_GetPeerInfo_FullMethodName,
+		FullMethod: NodeService_GetProverStats_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NodeServiceServer).GetPeerInfo(ctx, req.(*GetPeerInfoRequest))
+		return srv.(NodeServiceServer).GetProverStats(ctx, req.(*GetProverStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NodeService_GetNodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetNodeInfoRequest)
+func _NodeService_GetProverTrieDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProverTrieDiffRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NodeServiceServer).GetNodeInfo(ctx, in)
+		return srv.(NodeServiceServer).GetProverTrieDiff(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: NodeService_GetNodeInfo_FullMethodName,
+		FullMethod: NodeService_GetProverTrieDiff_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NodeServiceServer).GetNodeInfo(ctx, req.(*GetNodeInfoRequest))
+		return srv.(NodeServiceServer).GetProverTrieDiff(ctx, req.(*GetProverTrieDiffRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NodeService_GetNetworkInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetNetworkInfoRequest)
+func _NodeService_VerifyStateRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyStateRootRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NodeServiceServer).GetNetworkInfo(ctx, in)
+		return srv.(NodeServiceServer).VerifyStateRoot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: NodeService_GetNetworkInfo_FullMethodName,
+		FullMethod: NodeService_VerifyStateRoot_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NodeServiceServer).GetNetworkInfo(ctx, req.(*GetNetworkInfoRequest))
+		return srv.(NodeServiceServer).VerifyStateRoot(ctx, req.(*VerifyStateRootRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NodeService_GetTokenInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTokenInfoRequest)
+func _NodeService_SubmitFrame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitFrameRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NodeServiceServer).GetTokenInfo(ctx, in)
+		return srv.(NodeServiceServer).SubmitFrame(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: NodeService_GetTokenInfo_FullMethodName,
+		FullMethod: NodeService_SubmitFrame_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NodeServiceServer).GetTokenInfo(ctx, req.(*GetTokenInfoRequest))
+		return srv.(NodeServiceServer).SubmitFrame(ctx, req.(*SubmitFrameRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NodeService_GetPeerManifests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPeerManifestsRequest)
+func _NodeService_GetTransactionBlooms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionBloomsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NodeServiceServer).GetPeerManifests(ctx, in)
+		return srv.(NodeServiceServer).GetTransactionBlooms(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: NodeService_GetPeerManifests_FullMethodName,
+		FullMethod: NodeService_GetTransactionBlooms_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NodeServiceServer).GetPeerManifests(ctx, req.(*GetPeerManifestsRequest))
+		return srv.(NodeServiceServer).GetTransactionBlooms(ctx, req.(*GetTransactionBloomsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NodeService_SendMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TokenRequest)
+func _NodeService_RunPeerDiscovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunPeerDiscoveryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NodeServiceServer).SendMessage(ctx, in)
+		return srv.(NodeServiceServer).RunPeerDiscovery(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: NodeService_SendMessage_FullMethodName,
+		FullMethod: NodeService_RunPeerDiscovery_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NodeServiceServer).SendMessage(ctx, req.(*TokenRequest))
+		return srv.(NodeServiceServer).RunPeerDiscovery(ctx, req.(*RunPeerDiscoveryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NodeService_GetTokensByAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTokensByAccountRequest)
+func _NodeService_GetBandwidthStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBandwidthStatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NodeServiceServer).GetTokensByAccount(ctx, in)
+		return srv.(NodeServiceServer).GetBandwidthStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: NodeService_GetTokensByAccount_FullMethodName,
+		FullMethod: NodeService_GetBandwidthStats_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NodeServiceServer).GetTokensByAccount(ctx, req.(*GetTokensByAccountRequest))
+		return srv.(NodeServiceServer).GetBandwidthStats(ctx, req.(*GetBandwidthStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _NodeService_GetPreCoinProofsByAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPreCoinProofsByAccountRequest)
+func _NodeService_GetDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeadLettersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NodeServiceServer).GetPreCoinProofsByAccount(ctx, in)
+		return srv.(NodeServiceServer).GetDeadLetters(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: NodeService_GetPreCoinProofsByAccount_FullMethodName,
+		FullMethod: NodeService_GetDeadLetters_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NodeServiceServer).GetPreCoinProofsByAccount(ctx, req.(*GetPreCoinProofsByAccountRequest))
+		return srv.(NodeServiceServer).GetDeadLetters(ctx, req.(*GetDeadLettersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -591,8 +1765,147 @@ var NodeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPreCoinProofsByAccount",
 			Handler:    _NodeService_GetPreCoinProofsByAccount_Handler,
 		},
+		{
+			MethodName: "SetMetadata",
+			Handler:    _NodeService_SetMetadata_Handler,
+		},
+		{
+			MethodName: "GetMetadata",
+			Handler:    _NodeService_GetMetadata_Handler,
+		},
+		{
+			MethodName: "ListMetadata",
+			Handler:    _NodeService_ListMetadata_Handler,
+		},
+		{
+			MethodName: "ConnectPeer",
+			Handler:    _NodeService_ConnectPeer_Handler,
+		},
+		{
+			MethodName: "DisconnectPeer",
+			Handler:    _NodeService_DisconnectPeer_Handler,
+		},
+		{
+			MethodName: "ProtectPeer",
+			Handler:    _NodeService_ProtectPeer_Handler,
+		},
+		{
+			MethodName: "GetPeerConnection",
+			Handler:    _NodeService_GetPeerConnection_Handler,
+		},
+		{
+			MethodName: "GetBlossomSubParams",
+			Handler:    _NodeService_GetBlossomSubParams_Handler,
+		},
+		{
+			MethodName: "UpdateBlossomSubParams",
+			Handler:    _NodeService_UpdateBlossomSubParams_Handler,
+		},
+		{
+			MethodName: "StartTraceLog",
+			Handler:    _NodeService_StartTraceLog_Handler,
+		},
+		{
+			MethodName: "StopTraceLog",
+			Handler:    _NodeService_StopTraceLog_Handler,
+		},
+		{
+			MethodName: "ScheduleMaintenance",
+			Handler:    _NodeService_ScheduleMaintenance_Handler,
+		},
+		{
+			MethodName: "GetMaintenanceStatus",
+			Handler:    _NodeService_GetMaintenanceStatus_Handler,
+		},
+		{
+			MethodName: "GetSyncClients",
+			Handler:    _NodeService_GetSyncClients_Handler,
+		},
+		{
+			MethodName: "GetServingLatency",
+			Handler:    _NodeService_GetServingLatency_Handler,
+		},
+		{
+			MethodName: "GetScoredPeers",
+			Handler:    _NodeService_GetScoredPeers_Handler,
+		},
+		{
+			MethodName: "OverrideGraylist",
+			Handler:    _NodeService_OverrideGraylist_Handler,
+		},
+		{
+			MethodName: "GetPeerHealth",
+			Handler:    _NodeService_GetPeerHealth_Handler,
+		},
+		{
+			MethodName: "BanPeer",
+			Handler:    _NodeService_BanPeer_Handler,
+		},
+		{
+			MethodName: "UnbanPeer",
+			Handler:    _NodeService_UnbanPeer_Handler,
+		},
+		{
+			MethodName: "ListBans",
+			Handler:    _NodeService_ListBans_Handler,
+		},
+		{
+			MethodName: "GetNATStatus",
+			Handler:    _NodeService_GetNATStatus_Handler,
+		},
+		{
+			MethodName: "GetReachability",
+			Handler:    _NodeService_GetReachability_Handler,
+		},
+		{
+			MethodName: "GetEvents",
+			Handler:    _NodeService_GetEvents_Handler,
+		},
+		{
+			MethodName: "GetProverStats",
+			Handler:    _NodeService_GetProverStats_Handler,
+		},
+		{
+			MethodName: "GetProverTrieDiff",
+			Handler:    _NodeService_GetProverTrieDiff_Handler,
+		},
+		{
+			MethodName: "VerifyStateRoot",
+			Handler:    _NodeService_VerifyStateRoot_Handler,
+		},
+		{
+			MethodName: "SubmitFrame",
+			Handler:    _NodeService_SubmitFrame_Handler,
+		},
+		{
+			MethodName: "GetTransactionBlooms",
+			Handler:    _NodeService_GetTransactionBlooms_Handler,
+		},
+		{
+			MethodName: "RunPeerDiscovery",
+			Handler:    _NodeService_RunPeerDiscovery_Handler,
+		},
+		{
+			MethodName: "GetBandwidthStats",
+			Handler:    _NodeService_GetBandwidthStats_Handler,
+		},
+		{
+			MethodName: "GetDeadLetters",
+			Handler:    _NodeService_GetDeadLetters_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetInclusionProof",
+			Handler:       _NodeService_GetInclusionProof_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamFrames",
+			Handler:       _NodeService_StreamFrames_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "node.proto",
 }
 
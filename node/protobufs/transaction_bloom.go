@@ -0,0 +1,87 @@
+package protobufs
+
+import (
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/sha3"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// TransactionBloomBits is the minimum size in bits of a frame's
+	// transaction bloom.
+	TransactionBloomBits = 2048
+	// TransactionBloomBitsPerTransaction sizes the bloom to its transaction
+	// count, which with seven bits set per transaction keeps the false
+	// positive rate under one percent however many a frame holds.
+	TransactionBloomBitsPerTransaction = 10
+	// TransactionBloomHashes is the number of bits set per transaction.
+	TransactionBloomHashes = 7
+)
+
+// TransactionHash is the SHA3-256 hash of the deterministic encoding of the
+// request, as returned by SendMessage and tracked by the frame transaction
+// blooms.
+func TransactionHash(request *TokenRequest) ([]byte, error) {
+	payload, err := proto.MarshalOptions{Deterministic: true}.Marshal(request)
+	if err != nil {
+		return nil, errors.Wrap(err, "transaction hash")
+	}
+
+	hash := sha3.Sum256(payload)
+	return hash[:], nil
+}
+
+// transactionBloomBits are the positions in a bloom of size bits of the hash,
+// taken from its leading 32 bit words.
+func transactionBloomBits(hash []byte, size uint32) []uint32 {
+	bits := make([]uint32, 0, TransactionBloomHashes)
+	for i := 0; i < TransactionBloomHashes && (i+1)*4 <= len(hash); i++ {
+		word := binary.BigEndian.Uint32(hash[i*4 : (i+1)*4])
+		bits = append(bits, word%size)
+	}
+	return bits
+}
+
+// NewTransactionBloom returns the bloom filter of the transaction hashes of
+// the frame, sized to their count.
+func NewTransactionBloom(
+	frameNumber uint64,
+	hashes [][]byte,
+) *TransactionBloom {
+	size := max(
+		uint32(len(hashes))*TransactionBloomBitsPerTransaction,
+		TransactionBloomBits,
+	)
+	// Whole bytes.
+	size = (size + 7) / 8 * 8
+
+	bloom := make([]byte, size/8)
+	for _, hash := range hashes {
+		for _, bit := range transactionBloomBits(hash, size) {
+			bloom[bit/8] |= 1 << (bit % 8)
+		}
+	}
+	return &TransactionBloom{
+		FrameNumber: frameNumber,
+		Bloom:       bloom,
+		Bits:        size,
+	}
+}
+
+// MayContain reports whether the transaction hash may be in the bloom. False
+// positives are possible, false negatives are not.
+func (t *TransactionBloom) MayContain(hash []byte) bool {
+	if t.Bits == 0 || uint64(len(t.Bloom))*8 != uint64(t.Bits) ||
+		len(hash) < TransactionBloomHashes*4 {
+		return false
+	}
+
+	for _, bit := range transactionBloomBits(hash, t.Bits) {
+		if t.Bloom[bit/8]&(1<<(bit%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,204 @@
+package rpc
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/mr-tron/base58"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// BytesEncodingHeader selects how byte fields (peer IDs, addresses,
+// selectors) are rendered in JSON responses from the REST gateway. Accepted
+// values are "hex" and "base58"; anything else keeps the default base64.
+const BytesEncodingHeader = "X-Bytes-Encoding"
+
+const (
+	mimeJSONHex    = "application/vnd.quilibrium.hex+json"
+	mimeJSONBase58 = "application/vnd.quilibrium.base58+json"
+)
+
+// bytesEncodingMarshaler behaves as the gateway's default JSON marshaler but
+// re-encodes byte fields of the outgoing message with the given encoder.
+type bytesEncodingMarshaler struct {
+	runtime.JSONPb
+	encode func([]byte) string
+}
+
+func newBytesEncodingMarshaler(
+	encode func([]byte) string,
+) *bytesEncodingMarshaler {
+	return &bytesEncodingMarshaler{
+		JSONPb: runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				EmitUnpopulated: true,
+			},
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				DiscardUnknown: true,
+			},
+		},
+		encode: encode,
+	}
+}
+
+func (m *bytesEncodingMarshaler) Marshal(v interface{}) ([]byte, error) {
+	// The gateway wraps each message of a server stream as {"result": ...}.
+	if chunk, ok := v.(map[string]interface{}); ok && len(chunk) == 1 {
+		if msg, ok := chunk["result"].(proto.Message); ok {
+			out, err := m.marshalMessage(msg)
+			if err != nil {
+				return nil, err
+			}
+
+			return json.Marshal(map[string]json.RawMessage{"result": out})
+		}
+	}
+
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return m.JSONPb.Marshal(v)
+	}
+
+	return m.marshalMessage(msg)
+}
+
+func (m *bytesEncodingMarshaler) marshalMessage(
+	msg proto.Message,
+) ([]byte, error) {
+	out, err := m.JSONPb.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(out))
+	dec.UseNumber()
+	var node interface{}
+	if err := dec.Decode(&node); err != nil {
+		return nil, errors.Wrap(err, "marshal")
+	}
+
+	if err := m.reencode(msg.ProtoReflect().Descriptor(), node); err != nil {
+		return nil, errors.Wrap(err, "marshal")
+	}
+
+	return json.Marshal(node)
+}
+
+// reencode walks the protojson output of a message alongside its descriptor,
+// replacing the base64 strings emitted for bytes fields.
+func (m *bytesEncodingMarshaler) reencode(
+	md protoreflect.MessageDescriptor,
+	node interface{},
+) error {
+	if strings.HasPrefix(string(md.FullName()), "google.protobuf.") {
+		return nil
+	}
+
+	obj, ok := node.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		value, ok := obj[fd.JSONName()]
+		if !ok || value == nil {
+			continue
+		}
+
+		if fd.IsMap() {
+			entries, _ := value.(map[string]interface{})
+			for k, v := range entries {
+				updated, err := m.reencodeValue(fd.MapValue(), v)
+				if err != nil {
+					return err
+				}
+				entries[k] = updated
+			}
+			continue
+		}
+
+		if fd.IsList() {
+			items, _ := value.([]interface{})
+			for j, v := range items {
+				updated, err := m.reencodeValue(fd, v)
+				if err != nil {
+					return err
+				}
+				items[j] = updated
+			}
+			continue
+		}
+
+		updated, err := m.reencodeValue(fd, value)
+		if err != nil {
+			return err
+		}
+		obj[fd.JSONName()] = updated
+	}
+
+	return nil
+}
+
+func (m *bytesEncodingMarshaler) reencodeValue(
+	fd protoreflect.FieldDescriptor,
+	value interface{},
+) (interface{}, error) {
+	switch fd.Kind() {
+	case protoreflect.BytesKind:
+		s, ok := value.(string)
+		if !ok {
+			return value, nil
+		}
+
+		raw, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return nil, err
+		}
+
+		return m.encode(raw), nil
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return value, m.reencode(fd.Message(), value)
+	default:
+		return value, nil
+	}
+}
+
+// bytesEncodingOptions registers the alternate byte encodings with the
+// gateway, selectable through the Accept header.
+func bytesEncodingOptions() []runtime.ServeMuxOption {
+	return []runtime.ServeMuxOption{
+		runtime.WithMarshalerOption(
+			mimeJSONHex,
+			newBytesEncodingMarshaler(hex.EncodeToString),
+		),
+		runtime.WithMarshalerOption(
+			mimeJSONBase58,
+			newBytesEncodingMarshaler(base58.Encode),
+		),
+	}
+}
+
+// withBytesEncodingHeader maps BytesEncodingHeader onto the Accept header so
+// operators can pick an encoding without knowing the vendor media types.
+func withBytesEncodingHeader(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch strings.ToLower(r.Header.Get(BytesEncodingHeader)) {
+		case "hex":
+			r.Header.Set("Accept", mimeJSONHex)
+		case "base58":
+			r.Header.Set("Accept", mimeJSONBase58)
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,245 @@
+package rpc
+
+import (
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// testBytesMessage describes a message with bytes in every position the
+// marshaler handles:
+//
+//	message Inner {
+//	  bytes data = 1;
+//	  repeated bytes items = 2;
+//	}
+//	message Outer {
+//	  bytes data = 1;
+//	  Inner inner = 2;
+//	  repeated Inner inners = 3;
+//	  map<string, bytes> values = 4;
+//	  map<string, Inner> nested = 5;
+//	  google.protobuf.BytesValue wrapped = 6;
+//	}
+func testBytesMessage(t *testing.T) (outer, inner protoreflect.MessageDescriptor) {
+	field := func(
+		name string,
+		number int32,
+		label descriptorpb.FieldDescriptorProto_Label,
+		kind descriptorpb.FieldDescriptorProto_Type,
+		typeName string,
+	) *descriptorpb.FieldDescriptorProto {
+		f := &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			JsonName: proto.String(name),
+			Number:   proto.Int32(number),
+			Label:    label.Enum(),
+			Type:     kind.Enum(),
+		}
+		if typeName != "" {
+			f.TypeName = proto.String(typeName)
+		}
+		return f
+	}
+	optional := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+	repeated := descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+	bytesType := descriptorpb.FieldDescriptorProto_TYPE_BYTES
+	stringType := descriptorpb.FieldDescriptorProto_TYPE_STRING
+	messageType := descriptorpb.FieldDescriptorProto_TYPE_MESSAGE
+	mapEntry := func(
+		name string,
+		value *descriptorpb.FieldDescriptorProto,
+	) *descriptorpb.DescriptorProto {
+		return &descriptorpb.DescriptorProto{
+			Name: proto.String(name),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				field("key", 1, optional, stringType, ""),
+				value,
+			},
+			Options: &descriptorpb.MessageOptions{MapEntry: proto.Bool(true)},
+		}
+	}
+
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:       proto.String("bytes_encoding_test.proto"),
+		Package:    proto.String("test"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"google/protobuf/wrappers.proto"},
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("Inner"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("data", 1, optional, bytesType, ""),
+					field("items", 2, repeated, bytesType, ""),
+				},
+			},
+			{
+				Name: proto.String("Outer"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("data", 1, optional, bytesType, ""),
+					field("inner", 2, optional, messageType, ".test.Inner"),
+					field("inners", 3, repeated, messageType, ".test.Inner"),
+					field(
+						"values", 4, repeated, messageType, ".test.Outer.ValuesEntry",
+					),
+					field(
+						"nested", 5, repeated, messageType, ".test.Outer.NestedEntry",
+					),
+					field(
+						"wrapped", 6, optional, messageType, ".google.protobuf.BytesValue",
+					),
+				},
+				NestedType: []*descriptorpb.DescriptorProto{
+					mapEntry(
+						"ValuesEntry",
+						field("value", 2, optional, bytesType, ""),
+					),
+					mapEntry(
+						"NestedEntry",
+						field("value", 2, optional, messageType, ".test.Inner"),
+					),
+				},
+			},
+		},
+	}, protoregistry.GlobalFiles)
+	require.NoError(t, err)
+
+	return file.Messages().ByName("Outer"), file.Messages().ByName("Inner")
+}
+
+func newTestBytesMessage(t *testing.T) proto.Message {
+	outerDesc, innerDesc := testBytesMessage(t)
+	newInner := func(data []byte) *dynamicpb.Message {
+		inner := dynamicpb.NewMessage(innerDesc)
+		inner.Set(innerDesc.Fields().ByName("data"), protoreflect.ValueOfBytes(data))
+		items := inner.Mutable(innerDesc.Fields().ByName("items")).List()
+		items.Append(protoreflect.ValueOfBytes([]byte{0x0a}))
+		items.Append(protoreflect.ValueOfBytes([]byte{0x0b}))
+		return inner
+	}
+
+	fields := outerDesc.Fields()
+	outer := dynamicpb.NewMessage(outerDesc)
+	outer.Set(fields.ByName("data"), protoreflect.ValueOfBytes([]byte{0x01, 0x02}))
+	outer.Set(
+		fields.ByName("inner"),
+		protoreflect.ValueOfMessage(newInner([]byte{0x03})),
+	)
+	inners := outer.Mutable(fields.ByName("inners")).List()
+	inners.Append(protoreflect.ValueOfMessage(newInner([]byte{0x04})))
+	values := outer.Mutable(fields.ByName("values")).Map()
+	values.Set(
+		protoreflect.ValueOfString("a").MapKey(),
+		protoreflect.ValueOfBytes([]byte{0x05}),
+	)
+	nested := outer.Mutable(fields.ByName("nested")).Map()
+	nested.Set(
+		protoreflect.ValueOfString("b").MapKey(),
+		protoreflect.ValueOfMessage(newInner([]byte{0x06})),
+	)
+	outer.Set(
+		fields.ByName("wrapped"),
+		protoreflect.ValueOfMessage(
+			wrapperspb.Bytes([]byte{0xff}).ProtoReflect(),
+		),
+	)
+	return outer
+}
+
+func TestBytesEncodingMarshaler(t *testing.T) {
+	msg := newTestBytesMessage(t)
+
+	// Bytes fields are re-encoded at any depth, in lists and map values, but
+	// well-known types keep their own JSON form.
+	out, err := newBytesEncodingMarshaler(hex.EncodeToString).Marshal(msg)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"data": "0102",
+		"inner": {"data": "03", "items": ["0a", "0b"]},
+		"inners": [{"data": "04", "items": ["0a", "0b"]}],
+		"values": {"a": "05"},
+		"nested": {"b": {"data": "06", "items": ["0a", "0b"]}},
+		"wrapped": "/w=="
+	}`, string(out))
+
+	out, err = newBytesEncodingMarshaler(base58.Encode).Marshal(msg)
+	require.NoError(t, err)
+	require.Contains(t, string(out), `"data":"`+base58.Encode([]byte{1, 2})+`"`)
+
+	// Messages of server streams are re-encoded within the gateway's chunks,
+	// and errors are left as they are.
+	m := newBytesEncodingMarshaler(hex.EncodeToString)
+	out, err = m.Marshal(map[string]interface{}{"result": msg})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"result": {
+		"data": "0102",
+		"inner": {"data": "03", "items": ["0a", "0b"]},
+		"inners": [{"data": "04", "items": ["0a", "0b"]}],
+		"values": {"a": "05"},
+		"nested": {"b": {"data": "06", "items": ["0a", "0b"]}},
+		"wrapped": "/w=="
+	}}`, string(out))
+
+	out, err = m.Marshal(map[string]proto.Message{
+		"error": status.New(codes.Internal, "failed").Proto(),
+	})
+	require.NoError(t, err)
+	require.JSONEq(
+		t,
+		`{"error": {"code": 13, "message": "failed", "details": []}}`,
+		string(out),
+	)
+}
+
+func TestBytesEncodingHeader(t *testing.T) {
+	mux := runtime.NewServeMux(bytesEncodingOptions()...)
+	msg := newTestBytesMessage(t)
+
+	for _, test := range []struct {
+		header string
+		accept string
+		data   string
+	}{
+		{header: "hex", data: "0102"},
+		{header: "HEX", data: "0102"},
+		{header: "base58", data: base58.Encode([]byte{0x01, 0x02})},
+		{header: "", data: "AQI="},
+		{header: "base32", data: "AQI="},
+		{accept: mimeJSONHex, data: "0102"},
+		{accept: mimeJSONBase58, data: base58.Encode([]byte{0x01, 0x02})},
+	} {
+		var data string
+		handler := withBytesEncodingHeader(http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				_, marshaler := runtime.MarshalerForRequest(mux, r)
+				out, err := marshaler.Marshal(msg)
+				require.NoError(t, err)
+				data = string(out)
+			},
+		))
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		if test.header != "" {
+			req.Header.Set(BytesEncodingHeader, test.header)
+		}
+		if test.accept != "" {
+			req.Header.Set("Accept", test.accept)
+		}
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+		require.Contains(t, data, `"data":"`+test.data+`"`, test)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/binary"
 	"encoding/hex"
+	"net"
 	"os"
 	"runtime"
 	"syscall"
@@ -135,13 +136,6 @@ func NewDataWorkerIPCServer(
 }
 
 func (r *DataWorkerIPCServer) Start() error {
-	s := qgrpc.NewServer(
-		grpc.MaxRecvMsgSize(600*1024*1024),
-		grpc.MaxSendMsgSize(600*1024*1024),
-	)
-	protobufs.RegisterDataIPCServiceServer(s, r)
-	reflection.Register(s)
-
 	mg, err := multiaddr.NewMultiaddr(r.listenAddrGRPC)
 	if err != nil {
 		return errors.Wrap(err, "start")
@@ -152,13 +146,27 @@ func (r *DataWorkerIPCServer) Start() error {
 		return errors.Wrap(err, "start")
 	}
 
+	return r.Serve(mn.NetListener(lis))
+}
+
+// Serve serves on a listener bound ahead of the worker, by socket activation
+// or by the process launching it.
+func (r *DataWorkerIPCServer) Serve(lis net.Listener) error {
+	s := qgrpc.NewServer(
+		grpc.MaxRecvMsgSize(600*1024*1024),
+		grpc.MaxSendMsgSize(600*1024*1024),
+	)
+	protobufs.RegisterDataIPCServiceServer(s, r)
+	reflection.Register(s)
+
 	go r.monitorParent()
 
 	r.logger.Info(
 		"data worker listening",
 		zap.String("address", r.listenAddrGRPC),
+		zap.String("listener", lis.Addr().String()),
 	)
-	if err := s.Serve(mn.NetListener(lis)); err != nil {
+	if err := s.Serve(lis); err != nil {
 		r.logger.Error("terminating server", zap.Error(err))
 		panic(err)
 	}
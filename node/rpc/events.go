@@ -0,0 +1,94 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"source.quilibrium.com/quilibrium/monorepo/node/execution/intrinsics/token/application"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+// Maximum number of frames a single GetEvents request scans.
+const maxEventFrames = 1000
+
+// GetEvents implements protobufs.NodeServiceServer.
+func (r *RPCServer) GetEvents(
+	ctx context.Context,
+	req *protobufs.GetEventsRequest,
+) (*protobufs.EventsResponse, error) {
+	if req.ToFrameNumber <= req.FromFrameNumber {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			"to frame number must be above from frame number",
+		)
+	}
+	if req.ToFrameNumber-req.FromFrameNumber > maxEventFrames {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"at most %d frames per request",
+			maxEventFrames,
+		)
+	}
+
+	iter, err := r.clockStore.RangeDataClockFrames(
+		p2p.GetBloomFilter(application.TOKEN_ADDRESS, 256, 3),
+		req.FromFrameNumber,
+		req.ToFrameNumber,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "get events")
+	}
+	defer iter.Close()
+
+	resp := &protobufs.EventsResponse{}
+	for iter.First(); iter.Valid(); iter.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, errors.Wrap(err, "get events")
+		}
+
+		frame, err := iter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "get events")
+		}
+
+		events, err := application.GetEventsFromClockFrame(frame)
+		if err != nil {
+			return nil, errors.Wrap(err, "get events")
+		}
+
+		for _, event := range events {
+			if !eventMatches(event, req.Type, req.Topic) {
+				continue
+			}
+			resp.Events = append(resp.Events, &protobufs.FrameEvent{
+				FrameNumber: frame.FrameNumber,
+				Event:       event,
+			})
+		}
+	}
+
+	return resp, nil
+}
+
+func eventMatches(
+	event *protobufs.ExecutionEvent,
+	typ string,
+	topic []byte,
+) bool {
+	if typ != "" && event.Type != typ {
+		return false
+	}
+	if len(topic) == 0 {
+		return true
+	}
+	for _, t := range event.Topics {
+		if bytes.Equal(t, topic) {
+			return true
+		}
+	}
+	return false
+}
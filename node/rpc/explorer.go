@@ -0,0 +1,166 @@
+package rpc
+
+import (
+	_ "embed"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"net/http"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+// ExplorerPath is the path under the REST listener at which the embedded
+// explorer UI is served, when enabled.
+const ExplorerPath = "/explorer/"
+
+// Number of most recent data frames listed by the explorer.
+const explorerRecentFrames = 16
+
+//go:embed explorer.html
+var explorerPage []byte
+
+type explorerNode struct {
+	PeerID            string `json:"peerId"`
+	Version           string `json:"version"`
+	MaxFrame          uint64 `json:"maxFrame"`
+	NetworkHeadFrame  uint64 `json:"networkHeadFrame"`
+	StartupPhase      string `json:"startupPhase"`
+	StartupPhaseSince int64  `json:"startupPhaseSince"`
+	ProverRing        int32  `json:"proverRing"`
+	Seniority         string `json:"seniority"`
+	Workers           uint32 `json:"workers"`
+	NetworkPeers      int    `json:"networkPeers"`
+}
+
+type explorerFrame struct {
+	FrameNumber uint64 `json:"frameNumber"`
+	Timestamp   int64  `json:"timestamp"`
+	Difficulty  uint32 `json:"difficulty"`
+	Prover      string `json:"prover"`
+}
+
+type explorerPeer struct {
+	PeerID     string   `json:"peerId"`
+	MaxFrame   uint64   `json:"maxFrame"`
+	Version    string   `json:"version"`
+	Timestamp  int64    `json:"timestamp"`
+	Multiaddrs []string `json:"multiaddrs"`
+}
+
+type explorerStatus struct {
+	Node   explorerNode    `json:"node"`
+	Frames []explorerFrame `json:"frames"`
+	Peers  []explorerPeer  `json:"peers"`
+}
+
+// explorerHandler serves the explorer page and the status it polls.
+func (r *RPCServer) explorerHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(ExplorerPath, func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = w.Write(explorerPage)
+	})
+	mux.HandleFunc(
+		ExplorerPath+"status",
+		func(w http.ResponseWriter, req *http.Request) {
+			status, err := r.explorerStatus(req)
+			if err != nil {
+				r.logger.Debug("could not get explorer status", zap.Error(err))
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(status)
+		},
+	)
+	return mux
+}
+
+func (r *RPCServer) explorerStatus(req *http.Request) (*explorerStatus, error) {
+	if len(r.executionEngines) == 0 {
+		return nil, errors.Wrap(
+			errors.New("no execution engines"),
+			"explorer status",
+		)
+	}
+
+	info, err := r.GetNodeInfo(req.Context(), &protobufs.GetNodeInfoRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	status := &explorerStatus{
+		Node: explorerNode{
+			PeerID:            info.PeerId,
+			Version:           explorerVersion(info.Version),
+			MaxFrame:          info.MaxFrame,
+			NetworkHeadFrame:  info.NetworkHeadFrame,
+			StartupPhase:      info.StartupPhase,
+			StartupPhaseSince: info.StartupPhaseSince,
+			ProverRing:        info.ProverRing,
+			Seniority:         new(big.Int).SetBytes(info.PeerSeniority).String(),
+			Workers:           info.Workers,
+			NetworkPeers:      r.pubSub.GetNetworkPeersCount(),
+		},
+		Frames: []explorerFrame{},
+		Peers:  []explorerPeer{},
+	}
+
+	head := r.executionEngines[0].GetFrame()
+	if head != nil && head.FrameNumber > 0 {
+		from := uint64(1)
+		if head.FrameNumber > explorerRecentFrames {
+			from = head.FrameNumber - explorerRecentFrames + 1
+		}
+		frames, err := r.GetFrames(req.Context(), &protobufs.GetFramesRequest{
+			Filter:          head.Filter,
+			FromFrameNumber: from,
+			ToFrameNumber:   head.FrameNumber + 1,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for i := len(frames.TruncatedClockFrames) - 1; i >= 0; i-- {
+			frame := frames.TruncatedClockFrames[i]
+			prover := ""
+			if sig := frame.GetPublicKeySignatureEd448(); sig != nil &&
+				sig.PublicKey != nil {
+				prover = hex.EncodeToString(sig.PublicKey.KeyValue)
+			}
+			status.Frames = append(status.Frames, explorerFrame{
+				FrameNumber: frame.FrameNumber,
+				Timestamp:   frame.Timestamp,
+				Difficulty:  frame.Difficulty,
+				Prover:      prover,
+			})
+		}
+	}
+
+	if peers := r.executionEngines[0].GetPeerInfo(); peers != nil {
+		for _, p := range peers.PeerInfo {
+			status.Peers = append(status.Peers, explorerPeer{
+				PeerID:     peer.ID(p.PeerId).String(),
+				MaxFrame:   p.MaxFrame,
+				Version:    explorerVersion(p.Version),
+				Timestamp:  p.Timestamp,
+				Multiaddrs: p.Multiaddrs,
+			})
+		}
+	}
+
+	return status, nil
+}
+
+func explorerVersion(version []byte) string {
+	if len(version) < 3 {
+		return ""
+	}
+	return config.FormatVersion(version)
+}
@@ -0,0 +1,96 @@
+package rpc
+
+import (
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+// Maximum number of frames StreamFrames sends per store read.
+const frameStreamBatch = 100
+
+// StreamFrames implements protobufs.NodeServiceServer. It notifies every data
+// frame from the requested one onwards as it becomes the head, together with
+// the finalized frame number, until the client goes away.
+func (r *RPCServer) StreamFrames(
+	req *protobufs.StreamFramesRequest,
+	stream protobufs.NodeService_StreamFramesServer,
+) error {
+	if len(r.executionEngines) == 0 {
+		return status.Error(codes.Unavailable, "no execution engine running")
+	}
+
+	engine := r.executionEngines[0]
+	headChanged, unsubscribe := engine.SubscribeHead()
+	defer unsubscribe()
+
+	head := engine.GetFrame()
+	if head == nil {
+		return status.Error(codes.Unavailable, "no data frame available")
+	}
+
+	next := req.FromFrameNumber
+	if next == 0 {
+		next = head.FrameNumber
+	}
+
+	for {
+		head = engine.GetFrame()
+		if head != nil && head.FrameNumber >= next {
+			var err error
+			next, err = r.sendFrameNotifications(stream, head, next)
+			if err != nil {
+				return errors.Wrap(err, "stream frames")
+			}
+
+			// Keep catching up without waiting for the head to move.
+			if head.FrameNumber >= next {
+				continue
+			}
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-headChanged:
+		}
+	}
+}
+
+// sendFrameNotifications sends the frames from next up to the head, at most
+// frameStreamBatch of them, and returns the frame number to continue from.
+func (r *RPCServer) sendFrameNotifications(
+	stream protobufs.NodeService_StreamFramesServer,
+	head *protobufs.ClockFrame,
+	next uint64,
+) (uint64, error) {
+	finalized := r.executionEngines[0].GetFinalizedFrameNumber()
+	to := min(head.FrameNumber+1, next+frameStreamBatch)
+
+	iter, err := r.clockStore.RangeDataClockFrames(head.Filter, next, to)
+	if err != nil {
+		return next, err
+	}
+	defer iter.Close()
+
+	for iter.First(); iter.Valid(); iter.Next() {
+		frame, err := iter.TruncatedValue()
+		if err != nil {
+			return next, err
+		}
+
+		if err := stream.Send(&protobufs.FrameNotification{
+			TruncatedClockFrame:  frame,
+			HeadFrameNumber:      head.FrameNumber,
+			FinalizedFrameNumber: finalized,
+			Final:                frame.FrameNumber <= finalized,
+		}); err != nil {
+			return next, err
+		}
+		next = frame.FrameNumber + 1
+	}
+
+	// Skip over gaps in the store rather than waiting on them forever.
+	return max(next, to), nil
+}
@@ -0,0 +1,115 @@
+package rpc
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/sha3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+const (
+	// These mirror how execution outputs are committed to when proving data
+	// frames.
+	inclusionProofScheme          = "kzg-bls48581"
+	inclusionProofExpandedLength  = 1024
+	inclusionProofPolynomialSize  = 16
+	inclusionCommitmentLength     = 74
+	inclusionProofFrameInputStart = 516
+
+	inclusionProofChunkSize = 1024 * 1024
+)
+
+// GetInclusionProof implements protobufs.NodeServiceServer. It streams a
+// single inclusion commitment of a data frame with its proof, so that the
+// output can be verified without the rest of the frame.
+func (r *RPCServer) GetInclusionProof(
+	req *protobufs.GetInclusionProofRequest,
+	stream protobufs.NodeService_GetInclusionProofServer,
+) error {
+	if bytes.Equal(req.Filter, make([]byte, 32)) {
+		return status.Error(
+			codes.InvalidArgument,
+			"master frames carry no inclusion proofs",
+		)
+	}
+
+	frame, _, err := r.clockStore.GetDataClockFrame(
+		req.Filter,
+		req.FrameNumber,
+		false,
+	)
+	if err != nil {
+		return errors.Wrap(err, "get inclusion proof")
+	}
+
+	if int(req.AggregateIndex) >= len(frame.AggregateProofs) {
+		return status.Error(codes.NotFound, "aggregate proof not found")
+	}
+	aggregate := frame.AggregateProofs[req.AggregateIndex]
+
+	if int(req.Position) >= len(aggregate.InclusionCommitments) {
+		return status.Error(codes.NotFound, "inclusion commitment not found")
+	}
+	commitment := aggregate.InclusionCommitments[req.Position]
+
+	var aggregateCommitment []byte
+	start := inclusionProofFrameInputStart +
+		int(req.AggregateIndex)*inclusionCommitmentLength
+	if len(frame.Input) >= start+inclusionCommitmentLength {
+		aggregateCommitment = frame.Input[start : start+inclusionCommitmentLength]
+	}
+
+	expand := make([]byte, inclusionProofExpandedLength)
+	digest := sha3.NewShake256()
+	if _, err := digest.Write(commitment.Data); err != nil {
+		return errors.Wrap(err, "get inclusion proof")
+	}
+	if _, err := digest.Read(expand); err != nil {
+		return errors.Wrap(err, "get inclusion proof")
+	}
+
+	if err := stream.Send(&protobufs.InclusionProofChunk{
+		Chunk: &protobufs.InclusionProofChunk_Parameters{
+			Parameters: &protobufs.InclusionProofParameters{
+				Filter:              req.Filter,
+				FrameNumber:         frame.FrameNumber,
+				AggregateIndex:      req.AggregateIndex,
+				Position:            req.Position,
+				TypeUrl:             commitment.TypeUrl,
+				Commitment:          commitment.Commitment,
+				AggregateCommitment: aggregateCommitment,
+				DataLength:          uint64(len(commitment.Data)),
+				Scheme:              inclusionProofScheme,
+				ExpandedLength:      inclusionProofExpandedLength,
+				PolynomialSize:      inclusionProofPolynomialSize,
+				EvaluationIndex:     uint64(expand[0] % inclusionProofPolynomialSize),
+			},
+		},
+	}); err != nil {
+		return errors.Wrap(err, "get inclusion proof")
+	}
+
+	for offset := 0; offset < len(commitment.Data); offset += inclusionProofChunkSize {
+		end := min(offset+inclusionProofChunkSize, len(commitment.Data))
+		if err := stream.Send(&protobufs.InclusionProofChunk{
+			Chunk: &protobufs.InclusionProofChunk_Data{
+				Data: commitment.Data[offset:end],
+			},
+		}); err != nil {
+			return errors.Wrap(err, "get inclusion proof")
+		}
+	}
+
+	if err := stream.Send(&protobufs.InclusionProofChunk{
+		Chunk: &protobufs.InclusionProofChunk_Proof{
+			Proof: aggregate.Proof,
+		},
+	}); err != nil {
+		return errors.Wrap(err, "get inclusion proof")
+	}
+
+	return nil
+}
@@ -0,0 +1,193 @@
+package rpc
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/sha3"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"source.quilibrium.com/quilibrium/monorepo/node/crypto"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+	"source.quilibrium.com/quilibrium/monorepo/node/tries"
+)
+
+type inclusionProofStream struct {
+	grpc.ServerStream
+	chunks []*protobufs.InclusionProofChunk
+}
+
+func (s *inclusionProofStream) Send(chunk *protobufs.InclusionProofChunk) error {
+	s.chunks = append(s.chunks, chunk)
+	return nil
+}
+
+func expandInclusionData(t *testing.T, data []byte) []byte {
+	expand := make([]byte, inclusionProofExpandedLength)
+	digest := sha3.NewShake256()
+	_, err := digest.Write(data)
+	require.NoError(t, err)
+	_, err = digest.Read(expand)
+	require.NoError(t, err)
+	return expand
+}
+
+func TestGetInclusionProof(t *testing.T) {
+	logger := zap.NewNop()
+	prover := crypto.NewKZGInclusionProver(logger)
+	clockStore := store.NewPebbleClockStore(store.NewInMemKVDB(), logger)
+	filter := bytes.Repeat([]byte{0x01}, 32)
+
+	// One aggregate for each case: no data, data filling whole chunks, and data
+	// ending in a partial chunk.
+	outputs := [][]byte{
+		{},
+		bytes.Repeat([]byte{0x02}, 2*inclusionProofChunkSize),
+		bytes.Repeat([]byte{0x03}, inclusionProofChunkSize+1),
+	}
+	frame := &protobufs.ClockFrame{
+		Filter:      filter,
+		FrameNumber: 1,
+		Input:       make([]byte, inclusionProofFrameInputStart),
+		Output:      bytes.Repeat([]byte{0x04}, 516),
+	}
+	for i, data := range outputs {
+		expand := expandInclusionData(t, data)
+		commitment, err := prover.CommitRaw(expand, inclusionProofPolynomialSize)
+		require.NoError(t, err)
+		proof, err := prover.ProveRaw(
+			expand,
+			int(expand[0]%inclusionProofPolynomialSize),
+			inclusionProofPolynomialSize,
+		)
+		require.NoError(t, err)
+
+		frame.Input = append(
+			frame.Input,
+			bytes.Repeat([]byte{byte(i + 1)}, inclusionCommitmentLength)...,
+		)
+		frame.AggregateProofs = append(
+			frame.AggregateProofs,
+			&protobufs.InclusionAggregateProof{
+				Filter:      filter,
+				FrameNumber: 1,
+				InclusionCommitments: []*protobufs.InclusionCommitment{{
+					Filter:      filter,
+					FrameNumber: 1,
+					TypeUrl:     protobufs.MessageType,
+					Commitment:  commitment,
+					Data:        data,
+				}},
+				Proof: proof,
+			},
+		)
+	}
+
+	selector, err := frame.GetSelector()
+	require.NoError(t, err)
+	txn, err := clockStore.NewTransaction(false)
+	require.NoError(t, err)
+	require.NoError(t, clockStore.StageDataClockFrame(
+		selector.FillBytes(make([]byte, 32)),
+		frame,
+		txn,
+	))
+	require.NoError(t, clockStore.CommitDataClockFrame(
+		filter,
+		1,
+		selector.FillBytes(make([]byte, 32)),
+		[]*tries.RollingFrecencyCritbitTrie{{}},
+		txn,
+		false,
+	))
+	require.NoError(t, txn.Commit())
+
+	r := &RPCServer{logger: logger, clockStore: clockStore}
+	for i, data := range outputs {
+		stream := &inclusionProofStream{}
+		require.NoError(t, r.GetInclusionProof(&protobufs.GetInclusionProofRequest{
+			Filter:         filter,
+			FrameNumber:    1,
+			AggregateIndex: uint32(i),
+		}, stream))
+
+		// The parameters come first, then the data in chunks, then the proof.
+		require.GreaterOrEqual(t, len(stream.chunks), 2)
+		params := stream.chunks[0].GetParameters()
+		require.NotNil(t, params)
+		require.Equal(t, uint64(len(data)), params.DataLength)
+		start := inclusionProofFrameInputStart + i*inclusionCommitmentLength
+		require.Equal(
+			t,
+			frame.Input[start:start+inclusionCommitmentLength],
+			params.AggregateCommitment,
+		)
+
+		reassembled := []byte{}
+		for _, chunk := range stream.chunks[1 : len(stream.chunks)-1] {
+			require.NotNil(t, chunk.GetData())
+			require.LessOrEqual(t, len(chunk.GetData()), inclusionProofChunkSize)
+			reassembled = append(reassembled, chunk.GetData()...)
+		}
+		require.Len(
+			t,
+			stream.chunks,
+			2+(len(data)+inclusionProofChunkSize-1)/inclusionProofChunkSize,
+		)
+		require.Equal(t, data, reassembled)
+		proof := stream.chunks[len(stream.chunks)-1].GetProof()
+		require.True(t, bytes.Equal(frame.AggregateProofs[i].Proof, proof))
+
+		// The proof opens the commitment of the expanded data as described.
+		expand := expandInclusionData(t, reassembled)
+		require.Equal(
+			t,
+			uint64(expand[0]%inclusionProofPolynomialSize),
+			params.EvaluationIndex,
+		)
+		require.Equal(t, uint64(len(expand)), params.ExpandedLength)
+		valid, err := prover.VerifyRaw(
+			expand,
+			params.Commitment,
+			int(params.EvaluationIndex),
+			proof,
+			params.PolynomialSize,
+		)
+		require.NoError(t, err)
+		require.True(t, valid)
+	}
+
+	// Master frames carry no proofs, and missing outputs are not found.
+	for _, test := range []struct {
+		req  *protobufs.GetInclusionProofRequest
+		code codes.Code
+	}{
+		{
+			req:  &protobufs.GetInclusionProofRequest{Filter: make([]byte, 32)},
+			code: codes.InvalidArgument,
+		},
+		{
+			req: &protobufs.GetInclusionProofRequest{
+				Filter:         filter,
+				FrameNumber:    1,
+				AggregateIndex: uint32(len(outputs)),
+			},
+			code: codes.NotFound,
+		},
+		{
+			req: &protobufs.GetInclusionProofRequest{
+				Filter:      filter,
+				FrameNumber: 1,
+				Position:    1,
+			},
+			code: codes.NotFound,
+		},
+	} {
+		err := r.GetInclusionProof(test.req, &inclusionProofStream{})
+		require.Equal(t, test.code, status.Code(err), test.req)
+	}
+}
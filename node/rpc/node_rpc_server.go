@@ -16,19 +16,25 @@ import (
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"source.quilibrium.com/quilibrium/monorepo/node/config"
+	"source.quilibrium.com/quilibrium/monorepo/node/consensus"
 	"source.quilibrium.com/quilibrium/monorepo/node/consensus/master"
 	"source.quilibrium.com/quilibrium/monorepo/node/execution"
 	"source.quilibrium.com/quilibrium/monorepo/node/execution/intrinsics/token/application"
 	qgrpc "source.quilibrium.com/quilibrium/monorepo/node/internal/grpc"
+	"source.quilibrium.com/quilibrium/monorepo/node/internal/startup"
 	"source.quilibrium.com/quilibrium/monorepo/node/keys"
+	"source.quilibrium.com/quilibrium/monorepo/node/maintenance"
 	"source.quilibrium.com/quilibrium/monorepo/node/p2p"
 	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
 	"source.quilibrium.com/quilibrium/monorepo/node/store"
+	"source.quilibrium.com/quilibrium/monorepo/node/tries"
 )
 
 type RPCServer struct {
@@ -39,10 +45,13 @@ type RPCServer struct {
 	dataProofStore   store.DataProofStore
 	clockStore       store.ClockStore
 	coinStore        store.CoinStore
+	metadataStore    store.MetadataStore
 	keyManager       keys.KeyManager
 	pubSub           p2p.PubSub
 	masterClock      *master.MasterClockConsensusEngine
 	executionEngines []execution.ExecutionEngine
+	maintenance      *maintenance.Scheduler
+	enableExplorer   bool
 }
 
 // GetFrameInfo implements protobufs.NodeServiceServer.
@@ -72,8 +81,11 @@ func (r *RPCServer) GetFrameInfo(
 			return nil, errors.Wrap(err, "get frame info")
 		}
 
+		finalized := r.executionEngines[0].GetFinalizedFrameNumber()
 		return &protobufs.FrameInfoResponse{
-			ClockFrame: frame,
+			ClockFrame:           frame,
+			Final:                frame.FrameNumber <= finalized,
+			FinalizedFrameNumber: finalized,
 		}, nil
 	} else {
 		return nil, errors.Wrap(errors.New("not found"), "get frame info")
@@ -138,6 +150,7 @@ func (r *RPCServer) GetFrames(
 
 		return &protobufs.FramesResponse{
 			TruncatedClockFrames: frames,
+			FinalizedFrameNumber: r.executionEngines[0].GetFinalizedFrameNumber(),
 		}, nil
 	}
 }
@@ -175,6 +188,7 @@ func (r *RPCServer) GetNodeInfo(
 	if seniority == nil {
 		seniority = big.NewInt(0)
 	}
+	phase, phaseSince := startup.Current()
 	return &protobufs.NodeInfoResponse{
 		PeerId:    peerID.String(),
 		MaxFrame:  frame,
@@ -182,9 +196,12 @@ func (r *RPCServer) GetNodeInfo(
 		Version: append(
 			append([]byte{}, config.GetVersion()...), config.GetPatchNumber(),
 		),
-		PeerSeniority: seniority.FillBytes(make([]byte, 32)),
-		ProverRing:    int32(ring),
-		Workers:       r.executionEngines[0].GetWorkerCount(),
+		PeerSeniority:     seniority.FillBytes(make([]byte, 32)),
+		ProverRing:        int32(ring),
+		Workers:           r.executionEngines[0].GetWorkerCount(),
+		StartupPhase:      phase.String(),
+		StartupPhaseSince: phaseSince.UnixMilli(),
+		NetworkHeadFrame:  r.executionEngines[0].GetNetworkHeadEstimate(),
 	}, nil
 }
 
@@ -221,6 +238,13 @@ func (r *RPCServer) SendMessage(
 ) (*protobufs.SendMessageResponse, error) {
 	req.Timestamp = time.Now().UnixMilli()
 
+	if err := application.PreTransition(req); err != nil {
+		if errors.Is(err, application.ErrTransitionRejected) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, errors.Wrap(err, "publish message")
+	}
+
 	any := &anypb.Any{}
 	if err := any.MarshalFrom(req); err != nil {
 		return nil, errors.Wrap(err, "publish message")
@@ -244,6 +268,11 @@ func (r *RPCServer) SendMessage(
 		return nil, errors.Wrap(err, "publish message")
 	}
 
+	transactionHash, err := protobufs.TransactionHash(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "publish message")
+	}
+
 	intrinsicFilter := p2p.GetBloomFilter(application.TOKEN_ADDRESS, 256, 3)
 
 	msg := &protobufs.Message{
@@ -255,7 +284,10 @@ func (r *RPCServer) SendMessage(
 	if err != nil {
 		return nil, errors.Wrap(err, "publish message")
 	}
-	return &protobufs.SendMessageResponse{}, r.pubSub.PublishToBitmask(
+	return &protobufs.SendMessageResponse{
+		TransactionHash: transactionHash,
+	}, r.pubSub.PublishToBitmaskAs(
+		p2p.PublishRoleRPC,
 		append([]byte{0x00}, intrinsicFilter...),
 		data,
 	)
@@ -372,6 +404,473 @@ func (r *RPCServer) GetPeerManifests(
 	return r.masterClock.GetPeerManifests(), nil
 }
 
+func (r *RPCServer) SetMetadata(
+	ctx context.Context,
+	req *protobufs.SetMetadataRequest,
+) (*protobufs.MetadataResponse, error) {
+	if req.Key == "" {
+		return nil, errors.Wrap(errors.New("key is required"), "set metadata")
+	}
+
+	if req.Value == "" {
+		if err := r.metadataStore.DeleteMetadata(req.Key); err != nil {
+			return nil, errors.Wrap(err, "set metadata")
+		}
+
+		return &protobufs.MetadataResponse{}, nil
+	}
+
+	if err := r.metadataStore.PutMetadata(req.Key, req.Value); err != nil {
+		return nil, errors.Wrap(err, "set metadata")
+	}
+
+	return &protobufs.MetadataResponse{
+		Entries: []*protobufs.MetadataEntry{
+			{Key: req.Key, Value: req.Value},
+		},
+	}, nil
+}
+
+func (r *RPCServer) GetMetadata(
+	ctx context.Context,
+	req *protobufs.GetMetadataRequest,
+) (*protobufs.MetadataResponse, error) {
+	value, err := r.metadataStore.GetMetadata(req.Key)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return &protobufs.MetadataResponse{}, nil
+		}
+
+		return nil, errors.Wrap(err, "get metadata")
+	}
+
+	return &protobufs.MetadataResponse{
+		Entries: []*protobufs.MetadataEntry{
+			{Key: req.Key, Value: value},
+		},
+	}, nil
+}
+
+func (r *RPCServer) ListMetadata(
+	ctx context.Context,
+	req *protobufs.ListMetadataRequest,
+) (*protobufs.MetadataResponse, error) {
+	entries, err := r.metadataStore.ListMetadata()
+	if err != nil {
+		return nil, errors.Wrap(err, "list metadata")
+	}
+
+	return &protobufs.MetadataResponse{
+		Entries: protobufs.MetadataEntriesFromMap(entries),
+	}, nil
+}
+
+func (r *RPCServer) ConnectPeer(
+	ctx context.Context,
+	req *protobufs.ConnectPeerRequest,
+) (*protobufs.PeerConnectionResponse, error) {
+	peerId, err := r.pubSub.ConnectPeer(ctx, req.Multiaddr)
+	if err != nil {
+		return nil, errors.Wrap(err, "connect peer")
+	}
+
+	r.logger.Info("operator connected peer", zap.String("multiaddr", req.Multiaddr))
+	return r.pubSub.GetPeerConnection(peerId), nil
+}
+
+func (r *RPCServer) DisconnectPeer(
+	ctx context.Context,
+	req *protobufs.DisconnectPeerRequest,
+) (*protobufs.PeerConnectionResponse, error) {
+	if _, err := peer.IDFromBytes(req.PeerId); err != nil {
+		return nil, errors.Wrap(err, "disconnect peer")
+	}
+
+	if err := r.pubSub.DisconnectPeer(req.PeerId); err != nil {
+		return nil, errors.Wrap(err, "disconnect peer")
+	}
+
+	r.logger.Info(
+		"operator disconnected peer",
+		zap.String("peer_id", peer.ID(req.PeerId).String()),
+	)
+	return r.pubSub.GetPeerConnection(req.PeerId), nil
+}
+
+func (r *RPCServer) ProtectPeer(
+	ctx context.Context,
+	req *protobufs.ProtectPeerRequest,
+) (*protobufs.PeerConnectionResponse, error) {
+	if _, err := peer.IDFromBytes(req.PeerId); err != nil {
+		return nil, errors.Wrap(err, "protect peer")
+	}
+
+	r.pubSub.ProtectPeer(req.PeerId, req.Protect)
+	r.logger.Info(
+		"operator changed peer protection",
+		zap.String("peer_id", peer.ID(req.PeerId).String()),
+		zap.Bool("protect", req.Protect),
+	)
+	return r.pubSub.GetPeerConnection(req.PeerId), nil
+}
+
+func (r *RPCServer) RunPeerDiscovery(
+	ctx context.Context,
+	req *protobufs.RunPeerDiscoveryRequest,
+) (*protobufs.PeerDiscoveryResponse, error) {
+	if !req.Bootstrap && !req.Discover {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			"neither bootstrap nor discover requested",
+		)
+	}
+
+	r.logger.Info(
+		"operator requested peer discovery",
+		zap.Bool("bootstrap", req.Bootstrap),
+		zap.Bool("discover", req.Discover),
+	)
+	return r.pubSub.RunPeerDiscovery(ctx, req.Bootstrap, req.Discover), nil
+}
+
+func (r *RPCServer) GetPeerConnection(
+	ctx context.Context,
+	req *protobufs.GetPeerConnectionRequest,
+) (*protobufs.PeerConnectionResponse, error) {
+	if _, err := peer.IDFromBytes(req.PeerId); err != nil {
+		return nil, errors.Wrap(err, "get peer connection")
+	}
+
+	return r.pubSub.GetPeerConnection(req.PeerId), nil
+}
+
+func (r *RPCServer) GetScoredPeers(
+	ctx context.Context,
+	req *protobufs.GetScoredPeersRequest,
+) (*protobufs.ScoredPeersResponse, error) {
+	return r.pubSub.GetScoredPeers()
+}
+
+func (r *RPCServer) OverrideGraylist(
+	ctx context.Context,
+	req *protobufs.OverrideGraylistRequest,
+) (*protobufs.ScoredPeer, error) {
+	if _, err := peer.IDFromBytes(req.PeerId); err != nil {
+		return nil, errors.Wrap(err, "override graylist")
+	}
+
+	duration := time.Duration(req.DurationSeconds) * time.Second
+	resp := r.pubSub.OverrideGraylist(req.PeerId, duration)
+	r.logger.Info(
+		"operator changed graylist override",
+		zap.String("peer_id", peer.ID(req.PeerId).String()),
+		zap.Duration("duration", duration),
+	)
+	return resp, nil
+}
+
+func (r *RPCServer) GetPeerHealth(
+	ctx context.Context,
+	req *protobufs.GetPeerHealthRequest,
+) (*protobufs.PeerHealthResponse, error) {
+	return r.pubSub.GetPeerHealth(), nil
+}
+
+func (r *RPCServer) BanPeer(
+	ctx context.Context,
+	req *protobufs.BanPeerRequest,
+) (*protobufs.PeerBan, error) {
+	if _, err := peer.IDFromBytes(req.PeerId); err != nil {
+		return nil, errors.Wrap(err, "ban peer")
+	}
+
+	ttl := time.Duration(req.TtlSeconds) * time.Second
+	resp, err := r.pubSub.BanPeer(req.PeerId, ttl)
+	if err != nil {
+		return nil, errors.Wrap(err, "ban peer")
+	}
+
+	r.logger.Info(
+		"operator banned peer",
+		zap.String("peer_id", peer.ID(req.PeerId).String()),
+		zap.Duration("ttl", ttl),
+	)
+	return resp, nil
+}
+
+func (r *RPCServer) UnbanPeer(
+	ctx context.Context,
+	req *protobufs.UnbanPeerRequest,
+) (*protobufs.PeerBansResponse, error) {
+	if _, err := peer.IDFromBytes(req.PeerId); err != nil {
+		return nil, errors.Wrap(err, "unban peer")
+	}
+
+	resp, err := r.pubSub.UnbanPeer(req.PeerId)
+	if err != nil {
+		return nil, errors.Wrap(err, "unban peer")
+	}
+
+	r.logger.Info(
+		"operator unbanned peer",
+		zap.String("peer_id", peer.ID(req.PeerId).String()),
+	)
+	return resp, nil
+}
+
+func (r *RPCServer) ListBans(
+	ctx context.Context,
+	req *protobufs.ListBansRequest,
+) (*protobufs.PeerBansResponse, error) {
+	return r.pubSub.ListBans()
+}
+
+func (r *RPCServer) GetNATStatus(
+	ctx context.Context,
+	req *protobufs.GetNATStatusRequest,
+) (*protobufs.NATStatusResponse, error) {
+	return r.pubSub.GetNATStatus(), nil
+}
+
+func (r *RPCServer) GetReachability(
+	ctx context.Context,
+	req *protobufs.GetReachabilityRequest,
+) (*protobufs.ReachabilityResponse, error) {
+	return r.pubSub.GetReachability(), nil
+}
+
+func (r *RPCServer) GetBandwidthStats(
+	ctx context.Context,
+	req *protobufs.GetBandwidthStatsRequest,
+) (*protobufs.BandwidthStatsResponse, error) {
+	return r.pubSub.GetBandwidthStats(int(req.PeerLimit)), nil
+}
+
+func (r *RPCServer) GetDeadLetters(
+	ctx context.Context,
+	req *protobufs.GetDeadLettersRequest,
+) (*protobufs.DeadLettersResponse, error) {
+	resp, err := r.pubSub.GetDeadLetters(req.Bitmask, int(req.Limit))
+	if errors.Is(err, p2p.ErrDeadLettersDisabled) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return resp, errors.Wrap(err, "get dead letters")
+}
+
+func (r *RPCServer) GetBlossomSubParams(
+	ctx context.Context,
+	req *protobufs.GetBlossomSubParamsRequest,
+) (*protobufs.BlossomSubParamsResponse, error) {
+	resp, err := r.pubSub.GetBlossomSubParams()
+	return resp, errors.Wrap(err, "get blossomsub params")
+}
+
+func (r *RPCServer) UpdateBlossomSubParams(
+	ctx context.Context,
+	req *protobufs.UpdateBlossomSubParamsRequest,
+) (*protobufs.BlossomSubParamsResponse, error) {
+	resp, err := r.pubSub.UpdateBlossomSubParams(req)
+	return resp, errors.Wrap(err, "update blossomsub params")
+}
+
+func (r *RPCServer) StartTraceLog(
+	ctx context.Context,
+	req *protobufs.StartTraceLogRequest,
+) (*protobufs.TraceLogStatusResponse, error) {
+	resp, err := r.pubSub.StartTraceLog(req)
+	return resp, errors.Wrap(err, "start trace log")
+}
+
+func (r *RPCServer) StopTraceLog(
+	ctx context.Context,
+	req *protobufs.StopTraceLogRequest,
+) (*protobufs.TraceLogStatusResponse, error) {
+	resp, err := r.pubSub.StopTraceLog()
+	return resp, errors.Wrap(err, "stop trace log")
+}
+
+// GetSyncClients implements protobufs.NodeServiceServer.
+func (r *RPCServer) GetSyncClients(
+	ctx context.Context,
+	req *protobufs.GetSyncClientsRequest,
+) (*protobufs.SyncClientsResponse, error) {
+	if len(r.executionEngines) == 0 {
+		return nil, errors.Wrap(
+			errors.New("no execution engines"),
+			"get sync clients",
+		)
+	}
+
+	return &protobufs.SyncClientsResponse{
+		Clients: r.executionEngines[0].GetSyncClients(int(req.Limit)),
+	}, nil
+}
+
+// GetServingLatency implements protobufs.NodeServiceServer.
+func (r *RPCServer) GetServingLatency(
+	ctx context.Context,
+	req *protobufs.GetServingLatencyRequest,
+) (*protobufs.ServingLatencyResponse, error) {
+	if len(r.executionEngines) == 0 {
+		return nil, errors.Wrap(
+			errors.New("no execution engines"),
+			"get serving latency",
+		)
+	}
+
+	return r.executionEngines[0].GetServingLatency(), nil
+}
+
+// GetProverStats implements protobufs.NodeServiceServer.
+func (r *RPCServer) GetProverStats(
+	ctx context.Context,
+	req *protobufs.GetProverStatsRequest,
+) (*protobufs.ProverStats, error) {
+	if len(r.executionEngines) == 0 {
+		return nil, errors.Wrap(
+			errors.New("no execution engines"),
+			"get prover stats",
+		)
+	}
+
+	return r.executionEngines[0].GetProverStats(), nil
+}
+
+// GetProverTrieDiff implements protobufs.NodeServiceServer.
+func (r *RPCServer) GetProverTrieDiff(
+	ctx context.Context,
+	req *protobufs.GetProverTrieDiffRequest,
+) (*protobufs.ProverTrieDiffResponse, error) {
+	filter := req.Filter
+	if len(filter) == 0 {
+		filter = p2p.GetBloomFilter(application.TOKEN_ADDRESS, 256, 3)
+	}
+
+	fromTries, err := r.proverTriesAtFrame(filter, req.FromFrameNumber)
+	if err != nil {
+		return nil, errors.Wrap(err, "get prover trie diff")
+	}
+
+	toTries, err := r.proverTriesAtFrame(filter, req.ToFrameNumber)
+	if err != nil {
+		return nil, errors.Wrap(err, "get prover trie diff")
+	}
+
+	return &protobufs.ProverTrieDiffResponse{
+		Rings: tries.DiffRings(fromTries, toTries),
+	}, nil
+}
+
+// proverTriesAtFrame returns the prover tries stored for a data frame, failing
+// if the frame is unknown.
+func (r *RPCServer) proverTriesAtFrame(
+	filter []byte,
+	frameNumber uint64,
+) ([]*tries.RollingFrecencyCritbitTrie, error) {
+	if _, _, err := r.clockStore.GetDataClockFrame(
+		filter,
+		frameNumber,
+		true,
+	); err != nil {
+		return nil, errors.Wrapf(err, "frame %d", frameNumber)
+	}
+
+	return r.clockStore.GetProverTriesForFrame(filter, frameNumber)
+}
+
+// VerifyStateRoot implements protobufs.NodeServiceServer.
+func (r *RPCServer) VerifyStateRoot(
+	ctx context.Context,
+	req *protobufs.VerifyStateRootRequest,
+) (*protobufs.VerifyStateRootResponse, error) {
+	if len(r.executionEngines) == 0 {
+		return nil, errors.Wrap(
+			errors.New("no execution engines"),
+			"verify state root",
+		)
+	}
+
+	resp, err := r.executionEngines[0].VerifyStateRoot(req.Depth)
+	if err != nil {
+		return nil, errors.Wrap(err, "verify state root")
+	}
+
+	return resp, nil
+}
+
+// SubmitFrame implements protobufs.NodeServiceServer.
+func (r *RPCServer) SubmitFrame(
+	ctx context.Context,
+	req *protobufs.SubmitFrameRequest,
+) (*protobufs.SubmitFrameResponse, error) {
+	if len(r.executionEngines) == 0 {
+		return nil, errors.Wrap(
+			errors.New("no execution engines"),
+			"submit frame",
+		)
+	}
+
+	err := r.executionEngines[0].SubmitFrame(req.Frame)
+	switch {
+	case errors.Is(err, consensus.ErrFrameSubmissionUnauthorized):
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, consensus.ErrFrameSubmissionRejected):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		return nil, errors.Wrap(err, "submit frame")
+	}
+
+	return &protobufs.SubmitFrameResponse{
+		FrameNumber: req.Frame.FrameNumber,
+	}, nil
+}
+
+// ScheduleMaintenance implements protobufs.NodeServiceServer.
+func (r *RPCServer) ScheduleMaintenance(
+	ctx context.Context,
+	req *protobufs.ScheduleMaintenanceRequest,
+) (*protobufs.MaintenanceStatusResponse, error) {
+	plan := maintenance.Plan{
+		Compact:   req.Compact,
+		BackupDir: req.BackupDir,
+	}
+	if req.StartTimestamp != 0 {
+		plan.Start = time.UnixMilli(req.StartTimestamp)
+	}
+
+	if err := r.maintenance.Schedule(plan); err != nil {
+		return nil, errors.Wrap(err, "schedule maintenance")
+	}
+
+	return maintenanceStatusResponse(r.maintenance.Status()), nil
+}
+
+// GetMaintenanceStatus implements protobufs.NodeServiceServer.
+func (r *RPCServer) GetMaintenanceStatus(
+	ctx context.Context,
+	req *protobufs.GetMaintenanceStatusRequest,
+) (*protobufs.MaintenanceStatusResponse, error) {
+	return maintenanceStatusResponse(r.maintenance.Status()), nil
+}
+
+func maintenanceStatusResponse(
+	status maintenance.Status,
+) *protobufs.MaintenanceStatusResponse {
+	resp := &protobufs.MaintenanceStatusResponse{
+		Phase: string(status.Phase),
+	}
+	if !status.Scheduled.IsZero() {
+		resp.ScheduledTimestamp = status.Scheduled.UnixMilli()
+	}
+	if !status.LastCompleted.IsZero() {
+		resp.LastCompletedTimestamp = status.LastCompleted.UnixMilli()
+	}
+	if status.LastError != nil {
+		resp.LastError = status.LastError.Error()
+	}
+	return resp
+}
+
 func NewRPCServer(
 	listenAddrGRPC string,
 	listenAddrHTTP string,
@@ -379,10 +878,13 @@ func NewRPCServer(
 	dataProofStore store.DataProofStore,
 	clockStore store.ClockStore,
 	coinStore store.CoinStore,
+	metadataStore store.MetadataStore,
 	keyManager keys.KeyManager,
 	pubSub p2p.PubSub,
 	masterClock *master.MasterClockConsensusEngine,
 	executionEngines []execution.ExecutionEngine,
+	maintenance *maintenance.Scheduler,
+	enableExplorer bool,
 ) (*RPCServer, error) {
 	return &RPCServer{
 		listenAddrGRPC:   listenAddrGRPC,
@@ -391,10 +893,13 @@ func NewRPCServer(
 		dataProofStore:   dataProofStore,
 		clockStore:       clockStore,
 		coinStore:        coinStore,
+		metadataStore:    metadataStore,
 		keyManager:       keyManager,
 		pubSub:           pubSub,
 		masterClock:      masterClock,
 		executionEngines: executionEngines,
+		maintenance:      maintenance,
+		enableExplorer:   enableExplorer,
 	}, nil
 }
 
@@ -439,7 +944,7 @@ func (r *RPCServer) Start() error {
 		}
 
 		go func() {
-			mux := runtime.NewServeMux()
+			mux := runtime.NewServeMux(bytesEncodingOptions()...)
 			opts := qgrpc.ClientOptions(
 				grpc.WithTransportCredentials(insecure.NewCredentials()),
 				grpc.WithDefaultCallOptions(
@@ -457,7 +962,15 @@ func (r *RPCServer) Start() error {
 				panic(err)
 			}
 
-			if err := http.ListenAndServe(ma.String(), mux); err != nil {
+			var handler http.Handler = withBytesEncodingHeader(mux)
+			if r.enableExplorer {
+				root := http.NewServeMux()
+				root.Handle(ExplorerPath, r.explorerHandler())
+				root.Handle("/", handler)
+				handler = root
+			}
+
+			if err := http.ListenAndServe(ma.String(), handler); err != nil {
 				panic(err)
 			}
 		}()
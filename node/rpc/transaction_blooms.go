@@ -0,0 +1,55 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"source.quilibrium.com/quilibrium/monorepo/node/execution/intrinsics/token/application"
+	"source.quilibrium.com/quilibrium/monorepo/node/p2p"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+)
+
+// Maximum number of frames a single GetTransactionBlooms request covers.
+const maxTransactionBloomFrames = 1000
+
+// GetTransactionBlooms implements protobufs.NodeServiceServer.
+func (r *RPCServer) GetTransactionBlooms(
+	ctx context.Context,
+	req *protobufs.GetTransactionBloomsRequest,
+) (*protobufs.TransactionBloomsResponse, error) {
+	if req.ToFrameNumber <= req.FromFrameNumber {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			"to frame number must be above from frame number",
+		)
+	}
+	if req.ToFrameNumber-req.FromFrameNumber > maxTransactionBloomFrames {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"at most %d frames per request",
+			maxTransactionBloomFrames,
+		)
+	}
+
+	blooms, err := r.clockStore.RangeTransactionBlooms(
+		p2p.GetBloomFilter(application.TOKEN_ADDRESS, 256, 3),
+		req.FromFrameNumber,
+		req.ToFrameNumber,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "get transaction blooms")
+	}
+
+	resp := &protobufs.TransactionBloomsResponse{}
+	for _, bloom := range blooms {
+		if len(req.TransactionHash) != 0 &&
+			!bloom.MayContain(req.TransactionHash) {
+			continue
+		}
+		resp.Blooms = append(resp.Blooms, bloom)
+	}
+
+	return resp, nil
+}
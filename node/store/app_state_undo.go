@@ -0,0 +1,573 @@
+package store
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"io"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+)
+
+// AppStateUndoDepth is the number of processed frames whose application state
+// changes can be rolled back.
+const AppStateUndoDepth = 128
+
+// appStateUndo holds what a frame overwrote: the prior value of every key it
+// wrote, and the prior seniority of every peer whose seniority it changed.
+// The seniority map is a single value covering every peer, so it is recorded
+// per peer rather than whole.
+type appStateUndo struct {
+	Keys      [][]byte
+	Values    [][]byte
+	Existed   []bool
+	Seniority map[string]uint64
+	Unranked  []string
+}
+
+func appStateUndoKey(frameNumber uint64) []byte {
+	key := []byte{COIN, APP_STATE_UNDO}
+	key = binary.BigEndian.AppendUint64(key, frameNumber)
+	return key
+}
+
+// AppStateJournal wraps the transaction a frame is processed in and records
+// what each write overwrites, so RollbackAppState can undo the frame later.
+// Prior values are read from the committed state, since frame transactions are
+// not indexed.
+type AppStateJournal struct {
+	Transaction
+	db           KVDB
+	seniorityKey []byte
+	frameNumber  uint64
+	seen         map[string]struct{}
+	committed    map[string]uint64
+	undo         appStateUndo
+}
+
+var _ Transaction = (*AppStateJournal)(nil)
+
+func (p *PebbleCoinStore) JournalAppState(
+	txn Transaction,
+	filter []byte,
+	frameNumber uint64,
+) *AppStateJournal {
+	return &AppStateJournal{
+		Transaction:  txn,
+		db:           p.db,
+		seniorityKey: clockDataSeniorityKey(filter),
+		frameNumber:  frameNumber,
+		seen:         map[string]struct{}{},
+	}
+}
+
+func (j *AppStateJournal) Set(key []byte, value []byte) error {
+	if bytes.Equal(key, j.seniorityKey) {
+		if err := j.recordSeniority(value); err != nil {
+			return errors.Wrap(err, "set")
+		}
+	} else if err := j.record(key); err != nil {
+		return errors.Wrap(err, "set")
+	}
+
+	return j.Transaction.Set(key, value)
+}
+
+func (j *AppStateJournal) Delete(key []byte) error {
+	if bytes.Equal(key, j.seniorityKey) {
+		if err := j.recordSeniority(nil); err != nil {
+			return errors.Wrap(err, "delete")
+		}
+	} else if err := j.record(key); err != nil {
+		return errors.Wrap(err, "delete")
+	}
+
+	return j.Transaction.Delete(key)
+}
+
+// DeleteRange is refused, as the keys it removes cannot be recorded.
+func (j *AppStateJournal) DeleteRange(lowerBound []byte, upperBound []byte) error {
+	return errors.New("delete range is not journaled")
+}
+
+// Finish adds the frame's undo record to the transaction and drops the record
+// that has fallen out of AppStateUndoDepth.
+func (j *AppStateJournal) Finish() error {
+	value, err := encodeAppStateUndo(&j.undo)
+	if err != nil {
+		return errors.Wrap(err, "finish")
+	}
+
+	if err := j.Transaction.Set(
+		appStateUndoKey(j.frameNumber),
+		value,
+	); err != nil {
+		return errors.Wrap(err, "finish")
+	}
+
+	if j.frameNumber >= AppStateUndoDepth {
+		if err := j.Transaction.Delete(
+			appStateUndoKey(j.frameNumber - AppStateUndoDepth),
+		); err != nil {
+			return errors.Wrap(err, "finish")
+		}
+	}
+
+	return nil
+}
+
+func (j *AppStateJournal) record(key []byte) error {
+	if _, ok := j.seen[string(key)]; ok {
+		return nil
+	}
+
+	value, closer, err := j.db.Get(key)
+	existed := true
+	if err != nil {
+		if !errors.Is(err, pebble.ErrNotFound) {
+			return err
+		}
+		existed = false
+	} else {
+		value = append([]byte{}, value...)
+		closer.Close()
+	}
+
+	j.seen[string(key)] = struct{}{}
+	j.undo.Keys = append(j.undo.Keys, append([]byte{}, key...))
+	j.undo.Values = append(j.undo.Values, value)
+	j.undo.Existed = append(j.undo.Existed, existed)
+	return nil
+}
+
+// recordSeniority replaces the seniority part of the record with the peers
+// whose seniority differs between the committed map and value. A frame may
+// write the map more than once, the last write is what it commits.
+func (j *AppStateJournal) recordSeniority(value []byte) error {
+	if j.committed == nil {
+		committed, closer, err := j.db.Get(j.seniorityKey)
+		if err != nil {
+			if !errors.Is(err, pebble.ErrNotFound) {
+				return err
+			}
+			j.committed = map[string]uint64{}
+		} else {
+			j.committed, err = decodeSeniorityMap(committed)
+			closer.Close()
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	next := map[string]uint64{}
+	if value != nil {
+		var err error
+		if next, err = decodeSeniorityMap(value); err != nil {
+			return err
+		}
+	}
+
+	j.undo.Seniority = map[string]uint64{}
+	j.undo.Unranked = nil
+	for peer, seniority := range j.committed {
+		if s, ok := next[peer]; !ok || s != seniority {
+			j.undo.Seniority[peer] = seniority
+		}
+	}
+	for peer := range next {
+		if _, ok := j.committed[peer]; !ok {
+			j.undo.Unranked = append(j.undo.Unranked, peer)
+		}
+	}
+
+	return nil
+}
+
+// RollbackAppState undoes every processed frame after frameNumber, so the
+// application state is as it was when frameNumber was processed. Nothing is
+// changed if a frame in that span has no undo record.
+func (p *PebbleCoinStore) RollbackAppState(
+	filter []byte,
+	frameNumber uint64,
+) error {
+	latest, err := p.GetLatestFrameProcessed()
+	if err != nil {
+		return errors.Wrap(err, "rollback app state")
+	}
+
+	if latest <= frameNumber {
+		return nil
+	}
+
+	undos := make([]*appStateUndo, 0, latest-frameNumber)
+	for f := latest; f > frameNumber; f-- {
+		value, closer, err := p.db.Get(appStateUndoKey(f))
+		if err != nil {
+			if errors.Is(err, pebble.ErrNotFound) {
+				return errors.Wrapf(
+					ErrNotFound,
+					"rollback app state: no undo record for frame %d",
+					f,
+				)
+			}
+
+			return errors.Wrap(err, "rollback app state")
+		}
+
+		undo, err := decodeAppStateUndo(value)
+		closer.Close()
+		if err != nil {
+			return errors.Wrap(err, "rollback app state")
+		}
+
+		undos = append(undos, undo)
+	}
+
+	seniorityKey := clockDataSeniorityKey(filter)
+	seniorityMap := map[string]uint64{}
+	value, closer, err := p.db.Get(seniorityKey)
+	if err != nil {
+		if !errors.Is(err, pebble.ErrNotFound) {
+			return errors.Wrap(err, "rollback app state")
+		}
+	} else {
+		seniorityMap, err = decodeSeniorityMap(value)
+		closer.Close()
+		if err != nil {
+			return errors.Wrap(err, "rollback app state")
+		}
+	}
+
+	// Undo records are applied newest first, so where several frames wrote a
+	// key the value from before the oldest of them is the one kept.
+	txn := p.db.NewBatch(false)
+	seniorityChanged := false
+	for i, undo := range undos {
+		for k, key := range undo.Keys {
+			if undo.Existed[k] {
+				err = txn.Set(key, undo.Values[k])
+			} else {
+				err = txn.Delete(key)
+			}
+			if err != nil {
+				txn.Abort()
+				return errors.Wrap(err, "rollback app state")
+			}
+		}
+
+		for _, peer := range undo.Unranked {
+			delete(seniorityMap, peer)
+			seniorityChanged = true
+		}
+		for peer, seniority := range undo.Seniority {
+			seniorityMap[peer] = seniority
+			seniorityChanged = true
+		}
+
+		if err := txn.Delete(appStateUndoKey(latest - uint64(i))); err != nil {
+			txn.Abort()
+			return errors.Wrap(err, "rollback app state")
+		}
+	}
+
+	if seniorityChanged {
+		value, err = encodeSeniorityMap(seniorityMap)
+		if err != nil {
+			txn.Abort()
+			return errors.Wrap(err, "rollback app state")
+		}
+
+		if err := txn.Set(seniorityKey, value); err != nil {
+			txn.Abort()
+			return errors.Wrap(err, "rollback app state")
+		}
+	}
+
+	return errors.Wrap(txn.Commit(), "rollback app state")
+}
+
+func encodeAppStateUndo(undo *appStateUndo) ([]byte, error) {
+	b := new(bytes.Buffer)
+	if err := gob.NewEncoder(b).Encode(undo); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+func decodeAppStateUndo(value []byte) (*appStateUndo, error) {
+	undo := &appStateUndo{}
+	if err := gob.NewDecoder(bytes.NewReader(value)).Decode(undo); err != nil {
+		return nil, err
+	}
+
+	return undo, nil
+}
+
+type appStateValue struct {
+	value   []byte
+	existed bool
+}
+
+// AppStateView is a read only KVDB over the application state as it was after
+// a processed frame, laying the undo records of the frames since over the
+// committed state. It starts at the latest processed frame and is moved back a
+// frame at a time with Revert, so at most AppStateUndoDepth frames.
+type AppStateView struct {
+	db          KVDB
+	frameNumber uint64
+	overlay     map[string]appStateValue
+	seniority   map[string]uint64
+}
+
+var _ KVDB = (*AppStateView)(nil)
+
+func (p *PebbleCoinStore) NewAppStateView(filter []byte) (*AppStateView, error) {
+	frameNumber, err := p.GetLatestFrameProcessed()
+	if err != nil {
+		return nil, errors.Wrap(err, "new app state view")
+	}
+
+	seniority := map[string]uint64{}
+	value, closer, err := p.db.Get(clockDataSeniorityKey(filter))
+	if err != nil {
+		if !errors.Is(err, pebble.ErrNotFound) {
+			return nil, errors.Wrap(err, "new app state view")
+		}
+	} else {
+		seniority, err = decodeSeniorityMap(value)
+		closer.Close()
+		if err != nil {
+			return nil, errors.Wrap(err, "new app state view")
+		}
+	}
+
+	return &AppStateView{
+		db:          p.db,
+		frameNumber: frameNumber,
+		overlay:     map[string]appStateValue{},
+		seniority:   seniority,
+	}, nil
+}
+
+// FrameNumber returns the frame the view is of the state after.
+func (v *AppStateView) FrameNumber() uint64 {
+	return v.frameNumber
+}
+
+// PeerSeniority returns a copy of the seniority map after the view's frame.
+func (v *AppStateView) PeerSeniority() map[string]uint64 {
+	seniority := make(map[string]uint64, len(v.seniority))
+	for peer, s := range v.seniority {
+		seniority[peer] = s
+	}
+
+	return seniority
+}
+
+// Revert moves the view back to before its frame, returning the values the
+// frame left in the keys it wrote, nil where it deleted the key. The seniority
+// map and the latest frame processed are not among them.
+func (v *AppStateView) Revert() (map[string][]byte, error) {
+	value, closer, err := v.db.Get(appStateUndoKey(v.frameNumber))
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, errors.Wrapf(
+				ErrNotFound,
+				"revert: no undo record for frame %d",
+				v.frameNumber,
+			)
+		}
+
+		return nil, errors.Wrap(err, "revert")
+	}
+
+	undo, err := decodeAppStateUndo(value)
+	closer.Close()
+	if err != nil {
+		return nil, errors.Wrap(err, "revert")
+	}
+
+	written := map[string][]byte{}
+	for k, key := range undo.Keys {
+		if !bytes.Equal(key, latestExecutionKey()) {
+			var after []byte
+			value, closer, err := v.Get(key)
+			if err == nil {
+				after = append([]byte{}, value...)
+				closer.Close()
+			} else if !errors.Is(err, pebble.ErrNotFound) {
+				return nil, errors.Wrap(err, "revert")
+			}
+			written[string(key)] = after
+		}
+
+		v.overlay[string(key)] = appStateValue{
+			value:   undo.Values[k],
+			existed: undo.Existed[k],
+		}
+	}
+
+	for _, peer := range undo.Unranked {
+		delete(v.seniority, peer)
+	}
+	for peer, seniority := range undo.Seniority {
+		v.seniority[peer] = seniority
+	}
+
+	v.frameNumber--
+	return written, nil
+}
+
+// Capture returns a transaction reading from the view that records its
+// writes instead of applying them.
+func (v *AppStateView) Capture() *AppStateCapture {
+	return &AppStateCapture{view: v, writes: map[string][]byte{}}
+}
+
+func (v *AppStateView) Get(key []byte) ([]byte, io.Closer, error) {
+	if value, ok := v.overlay[string(key)]; ok {
+		if !value.existed {
+			return nil, nil, pebble.ErrNotFound
+		}
+
+		return value.value, io.NopCloser(nil), nil
+	}
+
+	return v.db.Get(key)
+}
+
+// NewIter iterates over a copy of the range, as the overlay has to be merged
+// into it.
+func (v *AppStateView) NewIter(
+	lowerBound []byte,
+	upperBound []byte,
+) (Iterator, error) {
+	iter, err := v.db.NewIter(lowerBound, upperBound)
+	if err != nil {
+		return nil, errors.Wrap(err, "new iter")
+	}
+
+	merged := NewInMemKVDB()
+	for iter.First(); iter.Valid(); iter.Next() {
+		key := append([]byte{}, iter.Key()...)
+		if err := merged.Set(key, append([]byte{}, iter.Value()...)); err != nil {
+			iter.Close()
+			return nil, errors.Wrap(err, "new iter")
+		}
+	}
+	if err := iter.Close(); err != nil {
+		return nil, errors.Wrap(err, "new iter")
+	}
+
+	for key, value := range v.overlay {
+		if bytes.Compare([]byte(key), lowerBound) < 0 ||
+			bytes.Compare([]byte(key), upperBound) >= 0 {
+			continue
+		}
+
+		if value.existed {
+			err = merged.Set([]byte(key), value.value)
+		} else {
+			err = merged.Delete([]byte(key))
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "new iter")
+		}
+	}
+
+	return merged.NewIter(lowerBound, upperBound)
+}
+
+func (v *AppStateView) NewBatch(indexed bool) Transaction {
+	return v.Capture()
+}
+
+func (v *AppStateView) Set(key, value []byte) error {
+	return errors.Wrap(ErrReadOnlyView, "set")
+}
+
+func (v *AppStateView) Delete(key []byte) error {
+	return errors.Wrap(ErrReadOnlyView, "delete")
+}
+
+func (v *AppStateView) DeleteRange(start, end []byte) error {
+	return errors.Wrap(ErrReadOnlyView, "delete range")
+}
+
+func (v *AppStateView) Compact(start, end []byte, parallelize bool) error {
+	return errors.Wrap(ErrReadOnlyView, "compact")
+}
+
+func (v *AppStateView) CompactAll() error {
+	return errors.Wrap(ErrReadOnlyView, "compact all")
+}
+
+func (v *AppStateView) Checkpoint(dir string) error {
+	return errors.Wrap(ErrReadOnlyView, "checkpoint")
+}
+
+// Close does nothing, the view does not own the underlying store.
+func (v *AppStateView) Close() error {
+	return nil
+}
+
+// AppStateCapture is a transaction over an AppStateView that records its
+// writes, so that they can be compared with what a frame wrote.
+type AppStateCapture struct {
+	view   *AppStateView
+	writes map[string][]byte
+}
+
+var _ Transaction = (*AppStateCapture)(nil)
+
+// Writes returns the values written by key, nil where the key was deleted.
+func (c *AppStateCapture) Writes() map[string][]byte {
+	return c.writes
+}
+
+func (c *AppStateCapture) Get(key []byte) ([]byte, io.Closer, error) {
+	if value, ok := c.writes[string(key)]; ok {
+		if value == nil {
+			return nil, nil, pebble.ErrNotFound
+		}
+
+		return value, io.NopCloser(nil), nil
+	}
+
+	return c.view.Get(key)
+}
+
+func (c *AppStateCapture) Set(key []byte, value []byte) error {
+	c.writes[string(key)] = append([]byte{}, value...)
+	return nil
+}
+
+func (c *AppStateCapture) Delete(key []byte) error {
+	c.writes[string(key)] = nil
+	return nil
+}
+
+func (c *AppStateCapture) Commit() error {
+	return errors.Wrap(ErrReadOnlyView, "commit")
+}
+
+func (c *AppStateCapture) Abort() error {
+	return nil
+}
+
+func (c *AppStateCapture) NewIter(
+	lowerBound []byte,
+	upperBound []byte,
+) (Iterator, error) {
+	return c.view.NewIter(lowerBound, upperBound)
+}
+
+func (c *AppStateCapture) DeleteRange(
+	lowerBound []byte,
+	upperBound []byte,
+) error {
+	return errors.Wrap(ErrReadOnlyView, "delete range")
+}
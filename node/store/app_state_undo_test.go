@@ -0,0 +1,142 @@
+package store_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+// processTestFrame writes through a journal as frame processing does.
+func processTestFrame(
+	t *testing.T,
+	coinStore *store.PebbleCoinStore,
+	clockStore *store.PebbleClockStore,
+	filter []byte,
+	frameNumber uint64,
+	write func(txn store.Transaction),
+	seniority map[string]uint64,
+) {
+	txn, _ := coinStore.NewTransaction(false)
+	journal := coinStore.JournalAppState(txn, filter, frameNumber)
+	write(journal)
+	assert.NoError(t, clockStore.PutPeerSeniorityMap(journal, filter, seniority))
+	assert.NoError(t, coinStore.SetLatestFrameProcessed(journal, frameNumber))
+	assert.NoError(t, journal.Finish())
+	assert.NoError(t, txn.Commit())
+}
+
+func TestRollbackAppState(t *testing.T) {
+	filter := bytes.Repeat([]byte{0x01}, 32)
+	db := store.NewInMemKVDB()
+	coinStore := store.NewPebbleCoinStore(db, zap.NewNop())
+	clockStore := store.NewPebbleClockStore(db, zap.NewNop())
+	kept := bytes.Repeat([]byte{0x01}, 32)
+	spent := bytes.Repeat([]byte{0x02}, 32)
+	minted := bytes.Repeat([]byte{0x03}, 32)
+	owner := bytes.Repeat([]byte{0xcc}, 32)
+
+	processTestFrame(t, coinStore, clockStore, filter, 1, func(txn store.Transaction) {
+		assert.NoError(t, coinStore.PutCoin(txn, 1, kept, testCoin(0xaa, []byte{0x01})))
+		assert.NoError(t, coinStore.PutCoin(txn, 1, spent, testCoin(0xbb, []byte{0x02})))
+	}, map[string]uint64{"a": 1})
+	processTestFrame(t, coinStore, clockStore, filter, 2, func(txn store.Transaction) {
+		assert.NoError(t, coinStore.DeleteCoin(txn, spent, testCoin(0xbb, []byte{0x02})))
+		assert.NoError(t, coinStore.PutCoin(txn, 2, minted, testCoin(0xbb, []byte{0x03})))
+		assert.NoError(t, coinStore.PutFaucetClaim(txn, owner, 2))
+	}, map[string]uint64{"a": 2, "b": 2})
+	processTestFrame(t, coinStore, clockStore, filter, 3, func(txn store.Transaction) {
+		assert.NoError(t, coinStore.DeleteCoin(txn, minted, testCoin(0xbb, []byte{0x03})))
+	}, map[string]uint64{"b": 3})
+
+	// Rolling back to the latest frame processed changes nothing.
+	assert.NoError(t, coinStore.RollbackAppState(filter, 3))
+	latest, err := coinStore.GetLatestFrameProcessed()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), latest)
+
+	assert.NoError(t, coinStore.RollbackAppState(filter, 1))
+	latest, err = coinStore.GetLatestFrameProcessed()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), latest)
+
+	_, err = coinStore.GetCoinByAddress(nil, spent)
+	assert.NoError(t, err)
+	_, err = coinStore.GetCoinByAddress(nil, minted)
+	assert.ErrorIs(t, err, store.ErrNotFound)
+	_, coins, err := coinStore.GetCoinsForFrame(1)
+	assert.NoError(t, err)
+	assert.Len(t, coins, 2)
+	_, coins, err = coinStore.GetCoinsForFrame(2)
+	assert.NoError(t, err)
+	assert.Empty(t, coins)
+	_, err = coinStore.GetFaucetClaimFrame(owner)
+	assert.ErrorIs(t, err, store.ErrNotFound)
+
+	seniority, err := clockStore.GetPeerSeniorityMap(filter)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]uint64{"a": 1}, seniority)
+
+	// The rolled back frames can be processed and rolled back again.
+	processTestFrame(t, coinStore, clockStore, filter, 2, func(txn store.Transaction) {
+		assert.NoError(t, coinStore.DeleteCoin(txn, kept, testCoin(0xaa, []byte{0x01})))
+	}, map[string]uint64{"a": 5})
+	assert.NoError(t, coinStore.RollbackAppState(filter, 1))
+	_, err = coinStore.GetCoinByAddress(nil, kept)
+	assert.NoError(t, err)
+}
+
+func TestRollbackAppStateWithoutUndoRecord(t *testing.T) {
+	filter := bytes.Repeat([]byte{0x01}, 32)
+	db := store.NewInMemKVDB()
+	coinStore := store.NewPebbleCoinStore(db, zap.NewNop())
+	clockStore := store.NewPebbleClockStore(db, zap.NewNop())
+	address := bytes.Repeat([]byte{0x01}, 32)
+
+	// Frame 1 was processed before undo records were kept.
+	txn, _ := coinStore.NewTransaction(false)
+	assert.NoError(t, coinStore.SetLatestFrameProcessed(txn, 1))
+	assert.NoError(t, txn.Commit())
+	processTestFrame(t, coinStore, clockStore, filter, 2, func(txn store.Transaction) {
+		assert.NoError(t, coinStore.PutCoin(txn, 2, address, testCoin(0xaa, []byte{0x01})))
+	}, map[string]uint64{"a": 1})
+
+	assert.ErrorIs(t, coinStore.RollbackAppState(filter, 0), store.ErrNotFound)
+	latest, err := coinStore.GetLatestFrameProcessed()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), latest)
+	_, err = coinStore.GetCoinByAddress(nil, address)
+	assert.NoError(t, err)
+
+	assert.NoError(t, coinStore.RollbackAppState(filter, 1))
+	_, err = coinStore.GetCoinByAddress(nil, address)
+	assert.ErrorIs(t, err, store.ErrNotFound)
+}
+
+func TestAppStateJournalDepth(t *testing.T) {
+	filter := bytes.Repeat([]byte{0x01}, 32)
+	db := store.NewInMemKVDB()
+	coinStore := store.NewPebbleCoinStore(db, zap.NewNop())
+	clockStore := store.NewPebbleClockStore(db, zap.NewNop())
+
+	for n := uint64(1); n <= store.AppStateUndoDepth+1; n++ {
+		processTestFrame(t, coinStore, clockStore, filter, n, func(
+			txn store.Transaction,
+		) {
+		}, map[string]uint64{"a": n})
+	}
+
+	// Only the last AppStateUndoDepth frames can be rolled back.
+	assert.ErrorIs(t, coinStore.RollbackAppState(filter, 0), store.ErrNotFound)
+	assert.NoError(t, coinStore.RollbackAppState(filter, 1))
+	seniority, err := clockStore.GetPeerSeniorityMap(filter)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]uint64{"a": 1}, seniority)
+
+	txn, _ := coinStore.NewTransaction(false)
+	journal := coinStore.JournalAppState(txn, filter, 2)
+	assert.Error(t, journal.DeleteRange([]byte{0x00}, []byte{0xff}))
+	txn.Abort()
+}
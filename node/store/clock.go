@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"encoding/gob"
 	"math/big"
+	"slices"
 	"sort"
 
 	"github.com/cockroachdb/pebble"
@@ -98,11 +99,52 @@ type ClockStore interface {
 		frame *protobufs.ClockFrame,
 		tries []*tries.RollingFrecencyCritbitTrie,
 	) error
+	GetProverTriesForFrame(
+		filter []byte,
+		frameNumber uint64,
+	) ([]*tries.RollingFrecencyCritbitTrie, error)
 	DeleteDataClockFrameRange(
 		filter []byte,
 		minFrameNumber uint64,
 		maxFrameNumber uint64,
 	) error
+	PutPendingDataClockFrame(
+		filter []byte,
+		frameNumber uint64,
+		selector []byte,
+		txn Transaction,
+	) error
+	GetPendingDataClockFrames(
+		filter []byte,
+	) ([]*protobufs.ClockFrame, error)
+	DeletePendingDataClockFrames(
+		filter []byte,
+		fromFrameNumber uint64,
+		toFrameNumber uint64,
+	) error
+	PutProverStats(stats *protobufs.ProverStats) error
+	GetProverStats(proverAddress []byte) (*protobufs.ProverStats, error)
+	PutTransactionBloom(
+		txn Transaction,
+		filter []byte,
+		bloom *protobufs.TransactionBloom,
+	) error
+	RangeTransactionBlooms(
+		filter []byte,
+		fromFrameNumber uint64,
+		toFrameNumber uint64,
+	) ([]*protobufs.TransactionBloom, error)
+	PutDeferredDataClockFrame(
+		filter []byte,
+		frameNumber uint64,
+		peerId []byte,
+	) error
+	GetDeferredDataClockFrames(filter []byte) (map[uint64][]byte, error)
+	DeleteDeferredDataClockFrames(
+		filter []byte,
+		fromFrameNumber uint64,
+		toFrameNumber uint64,
+	) error
 }
 
 type PebbleClockStore struct {
@@ -298,6 +340,10 @@ const CLOCK_DATA_FRAME_FRECENCY_DATA = 0x03
 const CLOCK_DATA_FRAME_DISTANCE_DATA = 0x04
 const CLOCK_COMPACTION_DATA = 0x05
 const CLOCK_DATA_FRAME_SENIORITY_DATA = 0x06
+const CLOCK_DATA_FRAME_PENDING_DATA = 0x07
+const CLOCK_DATA_FRAME_PROVER_STATS_DATA = 0x09
+const CLOCK_DATA_FRAME_TRANSACTION_BLOOM_DATA = 0x0A
+const CLOCK_DATA_FRAME_DEFERRED_DATA = 0x0B
 const CLOCK_MASTER_FRAME_INDEX_EARLIEST = 0x10 | CLOCK_MASTER_FRAME_DATA
 const CLOCK_MASTER_FRAME_INDEX_LATEST = 0x20 | CLOCK_MASTER_FRAME_DATA
 const CLOCK_MASTER_FRAME_INDEX_PARENT = 0x30 | CLOCK_MASTER_FRAME_DATA
@@ -453,6 +499,40 @@ func clockDataSeniorityKey(
 	return key
 }
 
+// Pending frames are keyed by filter first so that all pending branches of a
+// given filter can be walked in frame number order with a single iterator.
+func clockDataPendingKey(
+	filter []byte,
+	frameNumber uint64,
+	selector []byte,
+) []byte {
+	key := []byte{CLOCK_FRAME, CLOCK_DATA_FRAME_PENDING_DATA}
+	key = append(key, filter...)
+	key = binary.BigEndian.AppendUint64(key, frameNumber)
+	key = append(key, rightAlign(selector, 32)...)
+	return key
+}
+
+func clockDataProverStatsKey(proverAddress []byte) []byte {
+	key := []byte{CLOCK_FRAME, CLOCK_DATA_FRAME_PROVER_STATS_DATA}
+	key = append(key, proverAddress...)
+	return key
+}
+
+func clockDataTransactionBloomKey(filter []byte, frameNumber uint64) []byte {
+	key := []byte{CLOCK_FRAME, CLOCK_DATA_FRAME_TRANSACTION_BLOOM_DATA}
+	key = append(key, filter...)
+	key = binary.BigEndian.AppendUint64(key, frameNumber)
+	return key
+}
+
+func clockDataDeferredKey(filter []byte, frameNumber uint64) []byte {
+	key := []byte{CLOCK_FRAME, CLOCK_DATA_FRAME_DEFERRED_DATA}
+	key = append(key, filter...)
+	key = binary.BigEndian.AppendUint64(key, frameNumber)
+	return key
+}
+
 func (p *PebbleClockStore) NewTransaction(indexed bool) (Transaction, error) {
 	return p.db.NewBatch(indexed), nil
 }
@@ -668,23 +748,9 @@ func (p *PebbleClockStore) GetDataClockFrame(
 			)
 		}
 
-		proverTries := []*tries.RollingFrecencyCritbitTrie{}
-		i := uint16(0)
-		for {
-			proverTrie := &tries.RollingFrecencyCritbitTrie{}
-			trieData, closer, err := p.db.Get(clockProverTrieKey(filter, i, frameNumber))
-			if err != nil {
-				break
-			}
-
-			if err := proverTrie.Deserialize(trieData); err != nil {
-				closer.Close()
-				return nil, nil, errors.Wrap(err, "get latest data clock frame")
-			}
-
-			closer.Close()
-			i++
-			proverTries = append(proverTries, proverTrie)
+		proverTries, err := p.GetProverTriesForFrame(filter, frameNumber)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "get latest data clock frame")
 		}
 
 		return frame, proverTries, nil
@@ -1093,6 +1159,260 @@ func (p *PebbleClockStore) DeleteDataClockFrameRange(
 	return errors.Wrap(err, "delete data clock frame range")
 }
 
+// PutPendingDataClockFrame implements ClockStore. The frame itself must be
+// staged separately, this only records that the staged frame is an unverified
+// branch the time reel should revisit after a restart.
+func (p *PebbleClockStore) PutPendingDataClockFrame(
+	filter []byte,
+	frameNumber uint64,
+	selector []byte,
+	txn Transaction,
+) error {
+	return errors.Wrap(
+		txn.Set(clockDataPendingKey(filter, frameNumber, selector), []byte{}),
+		"put pending data clock frame",
+	)
+}
+
+// GetPendingDataClockFrames implements ClockStore. Pending entries whose staged
+// frame no longer exists are skipped.
+func (p *PebbleClockStore) GetPendingDataClockFrames(
+	filter []byte,
+) ([]*protobufs.ClockFrame, error) {
+	iter, err := p.db.NewIter(
+		clockDataPendingKey(filter, 0, bytes.Repeat([]byte{0x00}, 32)),
+		clockDataPendingKey(
+			filter,
+			0xffffffffffffffff,
+			bytes.Repeat([]byte{0xff}, 32),
+		),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "get pending data clock frames")
+	}
+
+	type pendingKey struct {
+		frameNumber uint64
+		selector    []byte
+	}
+
+	keys := []pendingKey{}
+	for iter.First(); iter.Valid(); iter.Next() {
+		key := iter.Key()
+		if len(key) != len(filter)+42 {
+			continue
+		}
+
+		selector := make([]byte, 32)
+		copy(selector, key[len(key)-32:])
+		keys = append(keys, pendingKey{
+			frameNumber: binary.BigEndian.Uint64(
+				key[2+len(filter) : 10+len(filter)],
+			),
+			selector: selector,
+		})
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, errors.Wrap(err, "get pending data clock frames")
+	}
+
+	frames := []*protobufs.ClockFrame{}
+	for _, k := range keys {
+		frame, err := p.GetStagedDataClockFrame(
+			filter,
+			k.frameNumber,
+			k.selector,
+			false,
+		)
+		if err != nil {
+			if errors.Is(err, ErrNotFound) {
+				continue
+			}
+
+			return nil, errors.Wrap(err, "get pending data clock frames")
+		}
+
+		frames = append(frames, frame)
+	}
+
+	return frames, nil
+}
+
+// DeletePendingDataClockFrames implements ClockStore. Only the pending markers
+// are removed, staged frames are left for compaction and pruning to handle.
+func (p *PebbleClockStore) DeletePendingDataClockFrames(
+	filter []byte,
+	fromFrameNumber uint64,
+	toFrameNumber uint64,
+) error {
+	return errors.Wrap(
+		p.db.DeleteRange(
+			clockDataPendingKey(
+				filter,
+				fromFrameNumber,
+				bytes.Repeat([]byte{0x00}, 32),
+			),
+			clockDataPendingKey(
+				filter,
+				toFrameNumber,
+				bytes.Repeat([]byte{0x00}, 32),
+			),
+		),
+		"delete pending data clock frames",
+	)
+}
+
+// PutDeferredDataClockFrame implements ClockStore. It records that the proof
+// of a synced frame, served by the given peer, has yet to be verified, so that
+// the verification survives a restart.
+func (p *PebbleClockStore) PutDeferredDataClockFrame(
+	filter []byte,
+	frameNumber uint64,
+	peerId []byte,
+) error {
+	return errors.Wrap(
+		p.db.Set(clockDataDeferredKey(filter, frameNumber), peerId),
+		"put deferred data clock frame",
+	)
+}
+
+// GetDeferredDataClockFrames implements ClockStore. It returns the peers that
+// served the frames awaiting proof verification, by frame number.
+func (p *PebbleClockStore) GetDeferredDataClockFrames(
+	filter []byte,
+) (map[uint64][]byte, error) {
+	iter, err := p.db.NewIter(
+		clockDataDeferredKey(filter, 0),
+		clockDataDeferredKey(filter, 0xffffffffffffffff),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "get deferred data clock frames")
+	}
+
+	deferred := map[uint64][]byte{}
+	for iter.First(); iter.Valid(); iter.Next() {
+		key := iter.Key()
+		if len(key) != len(filter)+10 {
+			continue
+		}
+
+		deferred[binary.BigEndian.Uint64(key[2+len(filter):])] =
+			slices.Clone(iter.Value())
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, errors.Wrap(err, "get deferred data clock frames")
+	}
+
+	return deferred, nil
+}
+
+// DeleteDeferredDataClockFrames implements ClockStore. It forgets the frames
+// from fromFrameNumber up to but excluding toFrameNumber.
+func (p *PebbleClockStore) DeleteDeferredDataClockFrames(
+	filter []byte,
+	fromFrameNumber uint64,
+	toFrameNumber uint64,
+) error {
+	return errors.Wrap(
+		p.db.DeleteRange(
+			clockDataDeferredKey(filter, fromFrameNumber),
+			clockDataDeferredKey(filter, toFrameNumber),
+		),
+		"delete deferred data clock frames",
+	)
+}
+
+// PutProverStats implements ClockStore. It replaces the proving counters kept
+// for the stats' prover address.
+func (p *PebbleClockStore) PutProverStats(
+	stats *protobufs.ProverStats,
+) error {
+	data, err := proto.Marshal(stats)
+	if err != nil {
+		return errors.Wrap(err, "put prover stats")
+	}
+
+	return errors.Wrap(
+		p.db.Set(clockDataProverStatsKey(stats.ProverAddress), data),
+		"put prover stats",
+	)
+}
+
+// GetProverStats implements ClockStore.
+func (p *PebbleClockStore) GetProverStats(
+	proverAddress []byte,
+) (*protobufs.ProverStats, error) {
+	data, closer, err := p.db.Get(clockDataProverStatsKey(proverAddress))
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, errors.Wrap(err, "get prover stats")
+	}
+	defer closer.Close()
+
+	stats := &protobufs.ProverStats{}
+	if err := proto.Unmarshal(data, stats); err != nil {
+		return nil, errors.Wrap(
+			errors.Wrap(err, ErrInvalidData.Error()),
+			"get prover stats",
+		)
+	}
+
+	return stats, nil
+}
+
+// PutTransactionBloom implements ClockStore.
+func (p *PebbleClockStore) PutTransactionBloom(
+	txn Transaction,
+	filter []byte,
+	bloom *protobufs.TransactionBloom,
+) error {
+	data, err := proto.Marshal(bloom)
+	if err != nil {
+		return errors.Wrap(err, "put transaction bloom")
+	}
+
+	return errors.Wrap(
+		txn.Set(
+			clockDataTransactionBloomKey(filter, bloom.FrameNumber),
+			data,
+		),
+		"put transaction bloom",
+	)
+}
+
+// RangeTransactionBlooms implements ClockStore. The range excludes
+// toFrameNumber.
+func (p *PebbleClockStore) RangeTransactionBlooms(
+	filter []byte,
+	fromFrameNumber uint64,
+	toFrameNumber uint64,
+) ([]*protobufs.TransactionBloom, error) {
+	iter, err := p.db.NewIter(
+		clockDataTransactionBloomKey(filter, fromFrameNumber),
+		clockDataTransactionBloomKey(filter, toFrameNumber),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "range transaction blooms")
+	}
+	defer iter.Close()
+
+	blooms := []*protobufs.TransactionBloom{}
+	for iter.First(); iter.Valid(); iter.Next() {
+		bloom := &protobufs.TransactionBloom{}
+		if err := proto.Unmarshal(iter.Value(), bloom); err != nil {
+			return nil, errors.Wrap(err, "range transaction blooms")
+		}
+		blooms = append(blooms, bloom)
+	}
+
+	return blooms, nil
+}
+
 func (p *PebbleClockStore) ResetMasterClockFrames(filter []byte) error {
 	if err := p.db.DeleteRange(
 		clockMasterFrameKey(filter, 0),
@@ -1560,11 +1880,8 @@ func (p *PebbleClockStore) GetPeerSeniorityMap(filter []byte) (
 		return nil, errors.Wrap(err, "get peer seniority map")
 	}
 	defer closer.Close()
-	var b bytes.Buffer
-	b.Write(value)
-	dec := gob.NewDecoder(&b)
-	var seniorityMap map[string]uint64
-	if err = dec.Decode(&seniorityMap); err != nil {
+	seniorityMap, err := decodeSeniorityMap(value)
+	if err != nil {
 		return nil, errors.Wrap(err, "get peer seniority map")
 	}
 
@@ -1576,19 +1893,37 @@ func (p *PebbleClockStore) PutPeerSeniorityMap(
 	filter []byte,
 	seniorityMap map[string]uint64,
 ) error {
-	b := new(bytes.Buffer)
-	enc := gob.NewEncoder(b)
-
-	if err := enc.Encode(&seniorityMap); err != nil {
+	value, err := encodeSeniorityMap(seniorityMap)
+	if err != nil {
 		return errors.Wrap(err, "put peer seniority map")
 	}
 
 	return errors.Wrap(
-		txn.Set(clockDataSeniorityKey(filter), b.Bytes()),
+		txn.Set(clockDataSeniorityKey(filter), value),
 		"put peer seniority map",
 	)
 }
 
+func decodeSeniorityMap(value []byte) (map[string]uint64, error) {
+	var seniorityMap map[string]uint64
+	dec := gob.NewDecoder(bytes.NewReader(value))
+	if err := dec.Decode(&seniorityMap); err != nil {
+		return nil, err
+	}
+
+	return seniorityMap, nil
+}
+
+func encodeSeniorityMap(seniorityMap map[string]uint64) ([]byte, error) {
+	b := new(bytes.Buffer)
+	enc := gob.NewEncoder(b)
+	if err := enc.Encode(&seniorityMap); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
 func (p *PebbleClockStore) SetProverTriesForFrame(
 	frame *protobufs.ClockFrame,
 	tries []*tries.RollingFrecencyCritbitTrie,
@@ -1630,3 +1965,34 @@ func (p *PebbleClockStore) SetProverTriesForFrame(
 
 	return nil
 }
+
+// GetProverTriesForFrame implements ClockStore. It returns the prover tries
+// of each ring as of the frame, which is empty for frames without stored
+// tries.
+func (p *PebbleClockStore) GetProverTriesForFrame(
+	filter []byte,
+	frameNumber uint64,
+) ([]*tries.RollingFrecencyCritbitTrie, error) {
+	proverTries := []*tries.RollingFrecencyCritbitTrie{}
+	i := uint16(0)
+	for {
+		proverTrie := &tries.RollingFrecencyCritbitTrie{}
+		trieData, closer, err := p.db.Get(
+			clockProverTrieKey(filter, i, frameNumber),
+		)
+		if err != nil {
+			break
+		}
+
+		if err := proverTrie.Deserialize(trieData); err != nil {
+			closer.Close()
+			return nil, errors.Wrap(err, "get prover tries for frame")
+		}
+
+		closer.Close()
+		i++
+		proverTries = append(proverTries, proverTrie)
+	}
+
+	return proverTries, nil
+}
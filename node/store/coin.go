@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"io"
+	"math/big"
 
 	"github.com/cockroachdb/pebble"
 	"github.com/pkg/errors"
@@ -16,6 +17,13 @@ import (
 type CoinStore interface {
 	NewTransaction(indexed bool) (Transaction, error)
 	GetCoinsForOwner(owner []byte) ([]uint64, [][]byte, []*protobufs.Coin, error)
+	GetCoinsForFrame(frameNumber uint64) ([][]byte, []*protobufs.Coin, error)
+	GetCoinsForDenomination(bucket uint8) (
+		[]uint64,
+		[][]byte,
+		[]*protobufs.Coin,
+		error,
+	)
 	GetPreCoinProofsForOwner(owner []byte) (
 		[]uint64,
 		[]*protobufs.PreCoinProof,
@@ -48,8 +56,18 @@ type CoinStore interface {
 	) error
 	GetLatestFrameProcessed() (uint64, error)
 	SetLatestFrameProcessed(txn Transaction, frameNumber uint64) error
+	GetFaucetClaimFrame(owner []byte) (uint64, error)
+	PutFaucetClaim(txn Transaction, owner []byte, frameNumber uint64) error
 	SetMigrationVersion(genesisSeedHex string) error
 	Migrate(filter []byte, genesisSeedHex string) error
+	BackfillCoinIndexes(batchSize int) error
+	JournalAppState(
+		txn Transaction,
+		filter []byte,
+		frameNumber uint64,
+	) *AppStateJournal
+	RollbackAppState(filter []byte, frameNumber uint64) error
+	NewAppStateView(filter []byte) (*AppStateView, error)
 }
 
 var _ CoinStore = (*PebbleCoinStore)(nil)
@@ -75,6 +93,11 @@ const (
 	COIN_BY_ADDRESS  = 0x00
 	COIN_BY_OWNER    = 0x01
 	MIGRATION        = 0x02
+	COIN_BY_FRAME    = 0x03
+	COIN_BY_BUCKET   = 0x04
+	COIN_BACKFILL    = 0x05
+	FAUCET_CLAIM     = 0x06
+	APP_STATE_UNDO   = 0x07
 	GENESIS          = 0xFE
 	LATEST_EXECUTION = 0xFF
 )
@@ -92,6 +115,40 @@ func coinByOwnerKey(owner []byte, address []byte) []byte {
 	return key
 }
 
+func coinByFrameKey(frameNumber uint64, address []byte) []byte {
+	key := []byte{COIN, COIN_BY_FRAME}
+	key = binary.BigEndian.AppendUint64(key, frameNumber)
+	key = append(key, address...)
+	return key
+}
+
+func coinByBucketKey(bucket uint8, address []byte) []byte {
+	key := []byte{COIN, COIN_BY_BUCKET, bucket}
+	key = append(key, address...)
+	return key
+}
+
+func coinBackfillKey() []byte {
+	return []byte{COIN, COIN_BACKFILL}
+}
+
+func faucetClaimKey(owner []byte) []byte {
+	key := []byte{COIN, FAUCET_CLAIM}
+	key = append(key, owner...)
+	return key
+}
+
+// DenominationBucket groups coin amounts by bit length, so each bucket spans
+// a power of two.
+func DenominationBucket(amount []byte) uint8 {
+	bitLen := new(big.Int).SetBytes(amount).BitLen()
+	if bitLen > 255 {
+		return 255
+	}
+
+	return uint8(bitLen)
+}
+
 func proofKey(address []byte) []byte {
 	key := []byte{PROOF, COIN_BY_ADDRESS}
 	key = append(key, address...)
@@ -159,6 +216,216 @@ func (p *PebbleCoinStore) GetCoinsForOwner(
 	return frameNumbers, addresses, coins, nil
 }
 
+func (p *PebbleCoinStore) GetCoinsForFrame(
+	frameNumber uint64,
+) ([][]byte, []*protobufs.Coin, error) {
+	if !p.coinIndexesReady() {
+		_, addresses, coins, err := p.scanCoins(
+			func(f uint64, _ *protobufs.Coin) bool { return f == frameNumber },
+		)
+		return addresses, coins, errors.Wrap(err, "get coins for frame")
+	}
+
+	iter, err := p.db.NewIter(
+		coinByFrameKey(frameNumber, bytes.Repeat([]byte{0x00}, 32)),
+		coinByFrameKey(frameNumber, bytes.Repeat([]byte{0xff}, 32)),
+	)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "get coins for frame")
+	}
+
+	defer iter.Close()
+	addresses := [][]byte{}
+	coins := []*protobufs.Coin{}
+	for iter.First(); iter.Valid(); iter.Next() {
+		addr := make([]byte, 32)
+		copy(addr[:], iter.Key()[10:])
+		coin, err := p.GetCoinByAddress(nil, addr)
+		if err != nil {
+			if errors.Is(err, ErrNotFound) {
+				continue
+			}
+			return nil, nil, errors.Wrap(err, "get coins for frame")
+		}
+		addresses = append(addresses, addr)
+		coins = append(coins, coin)
+	}
+
+	return addresses, coins, nil
+}
+
+func (p *PebbleCoinStore) GetCoinsForDenomination(bucket uint8) (
+	[]uint64,
+	[][]byte,
+	[]*protobufs.Coin,
+	error,
+) {
+	if !p.coinIndexesReady() {
+		frameNumbers, addresses, coins, err := p.scanCoins(
+			func(_ uint64, c *protobufs.Coin) bool {
+				return DenominationBucket(c.Amount) == bucket
+			},
+		)
+		return frameNumbers, addresses, coins, errors.Wrap(
+			err,
+			"get coins for denomination",
+		)
+	}
+
+	iter, err := p.db.NewIter(
+		coinByBucketKey(bucket, bytes.Repeat([]byte{0x00}, 32)),
+		coinByBucketKey(bucket, bytes.Repeat([]byte{0xff}, 32)),
+	)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "get coins for denomination")
+	}
+
+	defer iter.Close()
+	frameNumbers := []uint64{}
+	addresses := [][]byte{}
+	coins := []*protobufs.Coin{}
+	for iter.First(); iter.Valid(); iter.Next() {
+		addr := make([]byte, 32)
+		copy(addr[:], iter.Key()[3:])
+		coin, err := p.GetCoinByAddress(nil, addr)
+		if err != nil {
+			if errors.Is(err, ErrNotFound) {
+				continue
+			}
+			return nil, nil, nil, errors.Wrap(err, "get coins for denomination")
+		}
+		frameNumbers = append(frameNumbers, binary.BigEndian.Uint64(iter.Value()))
+		addresses = append(addresses, addr)
+		coins = append(coins, coin)
+	}
+
+	return frameNumbers, addresses, coins, nil
+}
+
+// scanCoins walks every coin, used to answer index queries until the
+// backfill has completed.
+func (p *PebbleCoinStore) scanCoins(
+	match func(frameNumber uint64, coin *protobufs.Coin) bool,
+) ([]uint64, [][]byte, []*protobufs.Coin, error) {
+	iter, err := p.db.NewIter(
+		coinKey(bytes.Repeat([]byte{0x00}, 32)),
+		coinKey(bytes.Repeat([]byte{0xff}, 32)),
+	)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	defer iter.Close()
+	frameNumbers := []uint64{}
+	addresses := [][]byte{}
+	coins := []*protobufs.Coin{}
+	for iter.First(); iter.Valid(); iter.Next() {
+		coinBytes := iter.Value()
+		frameNumber := binary.BigEndian.Uint64(coinBytes[:8])
+		coin := &protobufs.Coin{}
+		if err := proto.Unmarshal(coinBytes[8:], coin); err != nil {
+			return nil, nil, nil, err
+		}
+		if !match(frameNumber, coin) {
+			continue
+		}
+		addr := make([]byte, 32)
+		copy(addr[:], iter.Key()[2:])
+		frameNumbers = append(frameNumbers, frameNumber)
+		addresses = append(addresses, addr)
+		coins = append(coins, coin)
+	}
+
+	return frameNumbers, addresses, coins, nil
+}
+
+func (p *PebbleCoinStore) coinIndexesReady() bool {
+	status, closer, err := p.db.Get(coinBackfillKey())
+	if err != nil {
+		return false
+	}
+	defer closer.Close()
+
+	return len(status) == 1 && status[0] == 0x01
+}
+
+// BackfillCoinIndexes builds the frame and denomination indexes for coins
+// stored before those indexes existed. Progress is committed every batchSize
+// coins, so it can run alongside normal processing and resumes where it left
+// off after a restart.
+func (p *PebbleCoinStore) BackfillCoinIndexes(batchSize int) error {
+	if p.coinIndexesReady() {
+		return nil
+	}
+
+	start := coinKey(bytes.Repeat([]byte{0x00}, 32))
+	end := coinKey(bytes.Repeat([]byte{0xff}, 32))
+	cursor, closer, err := p.db.Get(coinBackfillKey())
+	if err != nil && !errors.Is(err, pebble.ErrNotFound) {
+		return errors.Wrap(err, "backfill coin indexes")
+	}
+	if err == nil {
+		if len(cursor) == 32 {
+			start = append(coinKey(cursor), 0x00)
+		}
+		closer.Close()
+	}
+
+	total := 0
+	for {
+		iter, err := p.db.NewIter(start, end)
+		if err != nil {
+			return errors.Wrap(err, "backfill coin indexes")
+		}
+
+		txn := p.db.NewBatch(false)
+		count := 0
+		var last []byte
+		for iter.First(); iter.Valid() && count < batchSize; iter.Next() {
+			coinBytes := iter.Value()
+			frameNumber := binary.BigEndian.Uint64(coinBytes[:8])
+			coin := &protobufs.Coin{}
+			if err := proto.Unmarshal(coinBytes[8:], coin); err != nil {
+				iter.Close()
+				txn.Abort()
+				return errors.Wrap(err, "backfill coin indexes")
+			}
+
+			last = make([]byte, 32)
+			copy(last, iter.Key()[2:])
+			if err := p.putCoinIndexes(txn, frameNumber, last, coin); err != nil {
+				iter.Close()
+				txn.Abort()
+				return errors.Wrap(err, "backfill coin indexes")
+			}
+			count++
+		}
+		done := !iter.Valid()
+		iter.Close()
+
+		status := last
+		if done {
+			status = []byte{0x01}
+		}
+		if err := txn.Set(coinBackfillKey(), status); err != nil {
+			txn.Abort()
+			return errors.Wrap(err, "backfill coin indexes")
+		}
+		if err := txn.Commit(); err != nil {
+			return errors.Wrap(err, "backfill coin indexes")
+		}
+
+		total += count
+		if done {
+			p.logger.Info("coin index backfill complete", zap.Int("coins", total))
+			return nil
+		}
+
+		p.logger.Debug("coin index backfill progress", zap.Int("coins", total))
+		start = append(coinKey(last), 0x00)
+	}
+}
+
 func (p *PebbleCoinStore) GetPreCoinProofsForOwner(owner []byte) (
 	[]uint64,
 	[]*protobufs.PreCoinProof,
@@ -294,15 +561,58 @@ func (p *PebbleCoinStore) PutCoin(
 		return errors.Wrap(err, "put coin")
 	}
 
+	if err = p.putCoinIndexes(txn, frameNumber, address, coin); err != nil {
+		return errors.Wrap(err, "put coin")
+	}
+
 	return nil
 }
 
+func (p *PebbleCoinStore) putCoinIndexes(
+	txn Transaction,
+	frameNumber uint64,
+	address []byte,
+	coin *protobufs.Coin,
+) error {
+	err := txn.Set(coinByFrameKey(frameNumber, address), []byte{})
+	if err != nil {
+		return err
+	}
+
+	return txn.Set(
+		coinByBucketKey(DenominationBucket(coin.Amount), address),
+		binary.BigEndian.AppendUint64([]byte{}, frameNumber),
+	)
+}
+
 func (p *PebbleCoinStore) DeleteCoin(
 	txn Transaction,
 	address []byte,
 	coin *protobufs.Coin,
 ) error {
-	err := txn.Delete(coinKey(address))
+	// Frames are processed in unindexed batches, which cannot be read from, so
+	// the frame index entry is found through the committed coin. A coin put and
+	// deleted in the same batch leaves an entry behind, which lookups skip.
+	coinBytes, closer, err := p.db.Get(coinKey(address))
+	if err != nil && !errors.Is(err, pebble.ErrNotFound) {
+		return errors.Wrap(err, "delete coin")
+	}
+	if err == nil {
+		frameNumber := binary.BigEndian.Uint64(coinBytes[:8])
+		closer.Close()
+		if err = txn.Delete(coinByFrameKey(frameNumber, address)); err != nil {
+			return errors.Wrap(err, "delete coin")
+		}
+	}
+
+	err = txn.Delete(
+		coinByBucketKey(DenominationBucket(coin.Amount), address),
+	)
+	if err != nil {
+		return errors.Wrap(err, "delete coin")
+	}
+
+	err = txn.Delete(coinKey(address))
 	if err != nil {
 		return errors.Wrap(err, "delete coin")
 	}
@@ -407,6 +717,41 @@ func (p *PebbleCoinStore) SetLatestFrameProcessed(
 	return nil
 }
 
+// GetFaucetClaimFrame returns the frame at which the owner last claimed
+// faucet funds, or ErrNotFound if it never did.
+func (p *PebbleCoinStore) GetFaucetClaimFrame(owner []byte) (uint64, error) {
+	v, closer, err := p.db.Get(faucetClaimKey(owner))
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return 0, ErrNotFound
+		}
+
+		return 0, errors.Wrap(err, "get faucet claim frame")
+	}
+	defer closer.Close()
+
+	if len(v) != 8 {
+		return 0, errors.Wrap(ErrInvalidData, "get faucet claim frame")
+	}
+
+	return binary.BigEndian.Uint64(v), nil
+}
+
+func (p *PebbleCoinStore) PutFaucetClaim(
+	txn Transaction,
+	owner []byte,
+	frameNumber uint64,
+) error {
+	if err := txn.Set(
+		faucetClaimKey(owner),
+		binary.BigEndian.AppendUint64([]byte{}, frameNumber),
+	); err != nil {
+		return errors.Wrap(err, "put faucet claim")
+	}
+
+	return nil
+}
+
 func (p *PebbleCoinStore) SetMigrationVersion(
 	genesisSeedHex string,
 ) error {
@@ -430,6 +775,11 @@ func (p *PebbleCoinStore) SetMigrationVersion(
 		panic(err)
 	}
 
+	err = txn.Set(coinBackfillKey(), []byte{0x01})
+	if err != nil {
+		panic(err)
+	}
+
 	return txn.Commit()
 }
 
@@ -486,6 +836,13 @@ func (p *PebbleCoinStore) internalMigrate(
 	if err != nil {
 		panic(err)
 	}
+	err = p.db.DeleteRange(
+		[]byte{COIN, COIN_BY_FRAME},
+		[]byte{COIN, COIN_BACKFILL + 1},
+	)
+	if err != nil {
+		panic(err)
+	}
 	if err := p.db.Delete(clockDataEarliestIndex(filter)); err != nil {
 		panic(err)
 	}
@@ -508,6 +865,11 @@ func (p *PebbleCoinStore) internalMigrate(
 		panic(err)
 	}
 
+	err = txn.Set(coinBackfillKey(), []byte{0x01})
+	if err != nil {
+		panic(err)
+	}
+
 	return txn.Commit()
 }
 
@@ -0,0 +1,102 @@
+package store_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+func testCoin(owner byte, amount []byte) *protobufs.Coin {
+	return &protobufs.Coin{
+		Amount: amount,
+		Owner: &protobufs.AccountRef{
+			Account: &protobufs.AccountRef_ImplicitAccount{
+				ImplicitAccount: &protobufs.ImplicitAccount{
+					Address: bytes.Repeat([]byte{owner}, 32),
+				},
+			},
+		},
+	}
+}
+
+func TestCoinIndexes(t *testing.T) {
+	db := store.NewInMemKVDB()
+	s := store.NewPebbleCoinStore(db, zap.NewNop())
+
+	assert.NoError(t, s.SetMigrationVersion("00"))
+
+	txn, _ := s.NewTransaction(false)
+	assert.NoError(t, s.PutCoin(
+		txn, 5, bytes.Repeat([]byte{0x01}, 32), testCoin(0xaa, []byte{0x01, 0x00}),
+	))
+	assert.NoError(t, s.PutCoin(
+		txn, 5, bytes.Repeat([]byte{0x02}, 32), testCoin(0xbb, []byte{0x80}),
+	))
+	assert.NoError(t, s.PutCoin(
+		txn, 6, bytes.Repeat([]byte{0x03}, 32), testCoin(0xaa, []byte{0xff}),
+	))
+	assert.NoError(t, txn.Commit())
+
+	addrs, coins, err := s.GetCoinsForFrame(5)
+	assert.NoError(t, err)
+	assert.Len(t, coins, 2)
+	assert.Equal(t, bytes.Repeat([]byte{0x01}, 32), addrs[0])
+
+	frames, addrs, _, err := s.GetCoinsForDenomination(8)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{5, 6}, frames)
+	assert.Equal(t, bytes.Repeat([]byte{0x03}, 32), addrs[1])
+
+	txn, _ = s.NewTransaction(false)
+	assert.NoError(t, s.DeleteCoin(
+		txn, bytes.Repeat([]byte{0x02}, 32), testCoin(0xbb, []byte{0x80}),
+	))
+	assert.NoError(t, txn.Commit())
+
+	_, coins, err = s.GetCoinsForFrame(5)
+	assert.NoError(t, err)
+	assert.Len(t, coins, 1)
+	_, _, coins, err = s.GetCoinsForDenomination(8)
+	assert.NoError(t, err)
+	assert.Len(t, coins, 1)
+}
+
+func TestBackfillCoinIndexes(t *testing.T) {
+	db := store.NewInMemKVDB()
+	s := store.NewPebbleCoinStore(db, zap.NewNop())
+
+	txn, _ := s.NewTransaction(false)
+	for i := byte(1); i <= 5; i++ {
+		assert.NoError(t, s.PutCoin(
+			txn, uint64(i%2), bytes.Repeat([]byte{i}, 32), testCoin(i, []byte{i}),
+		))
+	}
+	assert.NoError(t, txn.Commit())
+
+	// simulate coins written before the indexes existed
+	assert.NoError(t, db.DeleteRange(
+		[]byte{store.COIN, store.COIN_BY_FRAME},
+		[]byte{store.COIN, store.COIN_BACKFILL + 1},
+	))
+
+	// unindexed queries fall back to scanning
+	_, coins, err := s.GetCoinsForFrame(1)
+	assert.NoError(t, err)
+	assert.Len(t, coins, 3)
+
+	assert.NoError(t, s.BackfillCoinIndexes(2))
+
+	_, coins, err = s.GetCoinsForFrame(1)
+	assert.NoError(t, err)
+	assert.Len(t, coins, 3)
+	_, coins, err = s.GetCoinsForFrame(0)
+	assert.NoError(t, err)
+	assert.Len(t, coins, 2)
+	_, _, coins, err = s.GetCoinsForDenomination(3)
+	assert.NoError(t, err)
+	assert.Len(t, coins, 2)
+}
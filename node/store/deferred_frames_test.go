@@ -0,0 +1,38 @@
+package store_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+func TestDeferredDataClockFrames(t *testing.T) {
+	s := store.NewPebbleClockStore(store.NewInMemKVDB(), zap.NewNop())
+	filter := bytes.Repeat([]byte{0x01}, 32)
+	other := bytes.Repeat([]byte{0x02}, 32)
+
+	for _, frameNumber := range []uint64{4, 5, 9} {
+		assert.NoError(t, s.PutDeferredDataClockFrame(
+			filter,
+			frameNumber,
+			[]byte{byte(frameNumber)},
+		))
+	}
+	assert.NoError(t, s.PutDeferredDataClockFrame(other, 5, []byte("other")))
+
+	deferred, err := s.GetDeferredDataClockFrames(filter)
+	assert.NoError(t, err)
+	assert.Equal(t, map[uint64][]byte{4: {4}, 5: {5}, 9: {9}}, deferred)
+
+	assert.NoError(t, s.DeleteDeferredDataClockFrames(filter, 5, 9))
+	deferred, err = s.GetDeferredDataClockFrames(filter)
+	assert.NoError(t, err)
+	assert.Equal(t, map[uint64][]byte{4: {4}, 9: {9}}, deferred)
+
+	deferred, err = s.GetDeferredDataClockFrames(other)
+	assert.NoError(t, err)
+	assert.Equal(t, map[uint64][]byte{5: []byte("other")}, deferred)
+}
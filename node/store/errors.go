@@ -5,4 +5,6 @@ import "errors"
 var (
 	ErrNotFound    = errors.New("item not found")
 	ErrInvalidData = errors.New("invalid data")
+	// Returned by writes to an AppStateView.
+	ErrReadOnlyView = errors.New("read only view")
 )
@@ -431,4 +431,8 @@ func (d *InMemKVDB) CompactAll() error {
 	return nil
 }
 
+func (d *InMemKVDB) Checkpoint(dir string) error {
+	return errors.New("checkpoint not supported")
+}
+
 var _ KVDB = (*InMemKVDB)(nil)
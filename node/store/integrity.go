@@ -0,0 +1,180 @@
+package store
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// UncleanShutdownMarker is the name of the file placed in the store directory
+// while the node runs. Its presence at startup means the previous run did not
+// shut down cleanly.
+const UncleanShutdownMarker = "UNCLEAN_SHUTDOWN"
+
+// UncleanShutdown reports whether the unclean shutdown marker is present in
+// the store directory.
+func UncleanShutdown(path string) (bool, error) {
+	_, err := os.Stat(filepath.Join(path, UncleanShutdownMarker))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+
+		return false, errors.Wrap(err, "unclean shutdown")
+	}
+
+	return true, nil
+}
+
+// MarkRunning places the unclean shutdown marker in the store directory. It
+// is left in place until MarkStopped, so any exit in between, including one
+// that cuts a shutdown short, counts as unclean.
+func MarkRunning(path string) error {
+	if err := os.MkdirAll(path, fs.FileMode(0700)); err != nil {
+		return errors.Wrap(err, "mark running")
+	}
+
+	if err := os.WriteFile(
+		filepath.Join(path, UncleanShutdownMarker),
+		[]byte(fmt.Sprintf("%d\n", os.Getpid())),
+		fs.FileMode(0600),
+	); err != nil {
+		return errors.Wrap(err, "mark running")
+	}
+
+	return nil
+}
+
+// MarkStopped removes the unclean shutdown marker after a clean shutdown. It
+// must only be called once the store is known to be consistent, as the marker
+// is what has the next start check it.
+func MarkStopped(path string) error {
+	err := os.Remove(filepath.Join(path, UncleanShutdownMarker))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return errors.Wrap(err, "mark stopped")
+	}
+
+	return nil
+}
+
+// RepairDataClockFrames scans up to depth of the most recent committed data
+// clock frames for the filter, checking that each frame and its prover tries
+// load and that each frame links to its predecessor by selector. If an
+// inconsistency is found, the frames from the first inconsistent frame up to
+// the head are deleted and the head is rolled back to the last consistent
+// frame. The state executed from the rolled back frames is undone first with
+// unwind, if given, and nothing is deleted if that fails. The rolled back
+// frames are synced again from peers. Returns the resulting head frame number,
+// or an error if no consistent frame is found within depth.
+func (p *PebbleClockStore) RepairDataClockFrames(
+	filter []byte,
+	depth uint64,
+	unwind func(frameNumber uint64) error,
+) (uint64, error) {
+	// The head frame itself may be what is damaged, so read the index rather
+	// than loading the frame.
+	idxValue, closer, err := p.db.Get(clockDataLatestIndex(filter))
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return 0, nil
+		}
+
+		return 0, errors.Wrap(err, "repair data clock frames")
+	}
+	headNumber := binary.BigEndian.Uint64(idxValue)
+	closer.Close()
+
+	earliest, err := p.GetEarliestDataClockFrame(filter)
+	if err != nil {
+		return 0, errors.Wrap(err, "repair data clock frames")
+	}
+
+	start := earliest.FrameNumber
+	if headNumber > depth && headNumber-depth > start {
+		start = headNumber - depth
+	}
+
+	consistent := start
+	var parentSelector []byte
+	for n := start; n <= headNumber; n++ {
+		frame, proverTries, err := p.GetDataClockFrame(filter, n, false)
+		reason := ""
+		switch {
+		case err != nil:
+			reason = err.Error()
+		case frame.FrameNumber != n:
+			reason = "frame number mismatch"
+		case len(frame.Output) < 516:
+			reason = "truncated output"
+		case len(proverTries) == 0:
+			reason = "missing prover tries"
+		case parentSelector != nil &&
+			!bytes.Equal(frame.ParentSelector, parentSelector):
+			reason = "parent selector mismatch"
+		}
+
+		if reason == "" {
+			selector, err := frame.GetSelector()
+			if err != nil {
+				reason = err.Error()
+			} else {
+				parentSelector = selector.FillBytes(make([]byte, 32))
+			}
+		}
+
+		if reason == "" {
+			consistent = n
+			continue
+		}
+
+		if n == start {
+			return 0, errors.Wrap(
+				errors.Errorf(
+					"no consistent frame within %d frames of head %d",
+					depth,
+					headNumber,
+				),
+				"repair data clock frames",
+			)
+		}
+
+		p.logger.Warn(
+			"rolling back inconsistent data clock frames",
+			zap.Uint64("frame_number", n),
+			zap.Uint64("head_frame_number", headNumber),
+			zap.String("reason", reason),
+		)
+
+		if unwind != nil {
+			if err := unwind(consistent); err != nil {
+				return 0, errors.Wrap(err, "repair data clock frames")
+			}
+		}
+
+		if err := p.DeleteDataClockFrameRange(
+			filter,
+			n,
+			headNumber+1,
+		); err != nil {
+			return 0, errors.Wrap(err, "repair data clock frames")
+		}
+
+		if err := p.SetLatestDataClockFrameNumber(
+			filter,
+			consistent,
+		); err != nil {
+			return 0, errors.Wrap(err, "repair data clock frames")
+		}
+
+		return consistent, nil
+	}
+
+	return consistent, nil
+}
@@ -0,0 +1,119 @@
+package store_test
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+	"source.quilibrium.com/quilibrium/monorepo/node/tries"
+)
+
+func commitTestFrames(
+	t *testing.T,
+	s *store.PebbleClockStore,
+	filter []byte,
+	count uint64,
+	corrupt uint64,
+) {
+	parent := make([]byte, 32)
+	for n := uint64(0); n < count; n++ {
+		frame := &protobufs.ClockFrame{
+			Filter:         filter,
+			FrameNumber:    n,
+			ParentSelector: parent,
+			Input:          make([]byte, 516),
+			Output:         bytes.Repeat([]byte{byte(n + 1)}, 516),
+		}
+		if n == corrupt {
+			frame.ParentSelector = bytes.Repeat([]byte{0xff}, 32)
+		}
+
+		selector, err := frame.GetSelector()
+		assert.NoError(t, err)
+		parent = selector.FillBytes(make([]byte, 32))
+
+		trie := &tries.RollingFrecencyCritbitTrie{}
+		trie.Add(bytes.Repeat([]byte{0x01}, 32), n)
+
+		txn, err := s.NewTransaction(false)
+		assert.NoError(t, err)
+		assert.NoError(t, s.StageDataClockFrame(parent, frame, txn))
+		assert.NoError(t, s.CommitDataClockFrame(
+			filter,
+			n,
+			parent,
+			[]*tries.RollingFrecencyCritbitTrie{trie},
+			txn,
+			false,
+		))
+		assert.NoError(t, txn.Commit())
+	}
+}
+
+func TestRepairDataClockFrames(t *testing.T) {
+	filter := bytes.Repeat([]byte{0x01}, 32)
+
+	var unwound []uint64
+	unwind := func(frameNumber uint64) error {
+		unwound = append(unwound, frameNumber)
+		return nil
+	}
+
+	s := store.NewPebbleClockStore(store.NewInMemKVDB(), zap.NewNop())
+	commitTestFrames(t, s, filter, 10, 100)
+	head, err := s.RepairDataClockFrames(filter, 5, unwind)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(9), head)
+	assert.Empty(t, unwound)
+
+	s = store.NewPebbleClockStore(store.NewInMemKVDB(), zap.NewNop())
+	commitTestFrames(t, s, filter, 10, 7)
+
+	// Frames are kept when the executed state cannot be rolled back.
+	_, err = s.RepairDataClockFrames(filter, 5, func(uint64) error {
+		return store.ErrNotFound
+	})
+	assert.ErrorIs(t, err, store.ErrNotFound)
+	_, _, err = s.GetDataClockFrame(filter, 7, true)
+	assert.NoError(t, err)
+
+	head, err = s.RepairDataClockFrames(filter, 5, unwind)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(6), head)
+	assert.Equal(t, []uint64{6}, unwound)
+
+	latest, _, err := s.GetLatestDataClockFrame(filter)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(6), latest.FrameNumber)
+	_, _, err = s.GetDataClockFrame(filter, 7, true)
+	assert.ErrorIs(t, err, store.ErrNotFound)
+}
+
+func TestUncleanShutdownMarker(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "store")
+
+	unclean, err := store.UncleanShutdown(path)
+	assert.NoError(t, err)
+	assert.False(t, unclean)
+
+	assert.NoError(t, store.MarkRunning(path))
+	unclean, err = store.UncleanShutdown(path)
+	assert.NoError(t, err)
+	assert.True(t, unclean)
+
+	// Starting again over an unclean shutdown keeps it marked.
+	assert.NoError(t, store.MarkRunning(path))
+	unclean, err = store.UncleanShutdown(path)
+	assert.NoError(t, err)
+	assert.True(t, unclean)
+
+	assert.NoError(t, store.MarkStopped(path))
+	assert.NoError(t, store.MarkStopped(path))
+	unclean, err = store.UncleanShutdown(path)
+	assert.NoError(t, err)
+	assert.False(t, unclean)
+}
@@ -12,6 +12,7 @@ type KVDB interface {
 	NewIter(lowerBound []byte, upperBound []byte) (Iterator, error)
 	Compact(start, end []byte, parallelize bool) error
 	CompactAll() error
+	Checkpoint(dir string) error
 	Close() error
 	DeleteRange(start, end []byte) error
 }
@@ -0,0 +1,99 @@
+package store
+
+import (
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// MetadataStore holds node-local operator annotations, such as machine
+// labels, region, or maintenance notes. Entries are never gossiped on their
+// own; they are only surfaced through the node RPC and, when enabled, peer
+// manifests.
+type MetadataStore interface {
+	PutMetadata(key string, value string) error
+	GetMetadata(key string) (string, error)
+	DeleteMetadata(key string) error
+	ListMetadata() (map[string]string, error)
+}
+
+var _ MetadataStore = (*PebbleMetadataStore)(nil)
+
+type PebbleMetadataStore struct {
+	db     KVDB
+	logger *zap.Logger
+}
+
+func NewPebbleMetadataStore(
+	db KVDB,
+	logger *zap.Logger,
+) *PebbleMetadataStore {
+	return &PebbleMetadataStore{
+		db,
+		logger,
+	}
+}
+
+const (
+	METADATA           = 0x09
+	METADATA_BY_KEY    = 0x00
+	MAX_METADATA_KEY   = 128
+	MAX_METADATA_VALUE = 1024
+)
+
+func metadataKey(key string) []byte {
+	k := []byte{METADATA, METADATA_BY_KEY}
+	k = append(k, []byte(key)...)
+	return k
+}
+
+func (p *PebbleMetadataStore) PutMetadata(key string, value string) error {
+	if len(key) == 0 || len(key) > MAX_METADATA_KEY {
+		return errors.Wrap(ErrInvalidData, "put metadata")
+	}
+
+	if len(value) > MAX_METADATA_VALUE {
+		return errors.Wrap(ErrInvalidData, "put metadata")
+	}
+
+	return errors.Wrap(
+		p.db.Set(metadataKey(key), []byte(value)),
+		"put metadata",
+	)
+}
+
+func (p *PebbleMetadataStore) GetMetadata(key string) (string, error) {
+	value, closer, err := p.db.Get(metadataKey(key))
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return "", ErrNotFound
+		}
+
+		return "", errors.Wrap(err, "get metadata")
+	}
+	defer closer.Close()
+
+	return string(value), nil
+}
+
+func (p *PebbleMetadataStore) DeleteMetadata(key string) error {
+	return errors.Wrap(p.db.Delete(metadataKey(key)), "delete metadata")
+}
+
+func (p *PebbleMetadataStore) ListMetadata() (map[string]string, error) {
+	iter, err := p.db.NewIter(
+		[]byte{METADATA, METADATA_BY_KEY},
+		[]byte{METADATA, METADATA_BY_KEY + 1},
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "list metadata")
+	}
+	defer iter.Close()
+
+	entries := map[string]string{}
+	for iter.First(); iter.Valid(); iter.Next() {
+		entries[string(iter.Key()[2:])] = string(iter.Value())
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,39 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+func TestMetadataStore(t *testing.T) {
+	db := store.NewInMemKVDB()
+	s := store.NewPebbleMetadataStore(db, zap.NewNop())
+
+	// neighbouring prefixes must not leak into the listing
+	assert.NoError(t, db.Set([]byte{store.COIN, 0x00, 0x01}, []byte{0x01}))
+	assert.NoError(t, db.Set([]byte{store.METADATA + 1}, []byte{0x01}))
+
+	assert.NoError(t, s.PutMetadata("region", "eu-west"))
+	assert.NoError(t, s.PutMetadata("rack", "r12"))
+	assert.NoError(t, s.PutMetadata("region", "us-east"))
+	assert.Error(t, s.PutMetadata("", "empty"))
+
+	value, err := s.GetMetadata("region")
+	assert.NoError(t, err)
+	assert.Equal(t, "us-east", value)
+
+	_, err = s.GetMetadata("missing")
+	assert.ErrorIs(t, err, store.ErrNotFound)
+
+	entries, err := s.ListMetadata()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"region": "us-east", "rack": "r12"}, entries)
+
+	assert.NoError(t, s.DeleteMetadata("rack"))
+	entries, err = s.ListMetadata()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"region": "us-east"}, entries)
+}
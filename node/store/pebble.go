@@ -13,12 +13,23 @@ type PebbleDB struct {
 }
 
 func NewPebbleDB(config *config.DBConfig) *PebbleDB {
-	db, err := pebble.Open(config.Path, &pebble.Options{})
+	db, err := OpenPebbleDB(config.Path)
 	if err != nil {
 		panic(err)
 	}
 
-	return &PebbleDB{db}
+	return db
+}
+
+// OpenPebbleDB opens the pebble database at the path, returning an error
+// instead of panicking when it cannot be opened.
+func OpenPebbleDB(path string) (*PebbleDB, error) {
+	db, err := pebble.Open(path, &pebble.Options{})
+	if err != nil {
+		return nil, errors.Wrap(err, "open pebble db")
+	}
+
+	return &PebbleDB{db}, nil
 }
 
 func (p *PebbleDB) Get(key []byte) ([]byte, io.Closer, error) {
@@ -91,6 +102,15 @@ func (p *PebbleDB) CompactAll() error {
 	return nil
 }
 
+// Checkpoint writes a consistent copy of the database into dir, which must
+// not exist yet.
+func (p *PebbleDB) Checkpoint(dir string) error {
+	return errors.Wrap(
+		p.db.Checkpoint(dir, pebble.WithFlushedWAL()),
+		"checkpoint",
+	)
+}
+
 var _ KVDB = (*PebbleDB)(nil)
 
 type Transaction interface {
@@ -0,0 +1,110 @@
+package store
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// PeerScoreStore keeps the application specific peer scores of the p2p layer
+// across restarts.
+type PeerScoreStore interface {
+	PutPeerScores(scores map[string]int64, savedAt time.Time) error
+	ListPeerScores() (map[string]PeerScore, error)
+}
+
+// PeerScore is a persisted peer score and when it was saved.
+type PeerScore struct {
+	Score   int64
+	SavedAt time.Time
+}
+
+var _ PeerScoreStore = (*PebblePeerScoreStore)(nil)
+
+type PebblePeerScoreStore struct {
+	db     KVDB
+	logger *zap.Logger
+}
+
+func NewPebblePeerScoreStore(
+	db KVDB,
+	logger *zap.Logger,
+) *PebblePeerScoreStore {
+	return &PebblePeerScoreStore{
+		db,
+		logger,
+	}
+}
+
+const (
+	PEER_SCORE         = 0x0A
+	PEER_SCORE_BY_PEER = 0x00
+)
+
+func peerScoreKey(peerId string) []byte {
+	k := []byte{PEER_SCORE, PEER_SCORE_BY_PEER}
+	k = append(k, []byte(peerId)...)
+	return k
+}
+
+// PutPeerScores replaces the stored scores with the given ones. Zero scores
+// are not stored.
+func (p *PebblePeerScoreStore) PutPeerScores(
+	scores map[string]int64,
+	savedAt time.Time,
+) error {
+	txn := p.db.NewBatch(false)
+	if err := txn.DeleteRange(
+		[]byte{PEER_SCORE, PEER_SCORE_BY_PEER},
+		[]byte{PEER_SCORE, PEER_SCORE_BY_PEER + 1},
+	); err != nil {
+		txn.Abort()
+		return errors.Wrap(err, "put peer scores")
+	}
+
+	for peerId, score := range scores {
+		if score == 0 {
+			continue
+		}
+
+		value := binary.BigEndian.AppendUint64(nil, uint64(score))
+		value = binary.BigEndian.AppendUint64(value, uint64(savedAt.UnixNano()))
+		if err := txn.Set(peerScoreKey(peerId), value); err != nil {
+			txn.Abort()
+			return errors.Wrap(err, "put peer scores")
+		}
+	}
+
+	return errors.Wrap(txn.Commit(), "put peer scores")
+}
+
+func (p *PebblePeerScoreStore) ListPeerScores() (map[string]PeerScore, error) {
+	iter, err := p.db.NewIter(
+		[]byte{PEER_SCORE, PEER_SCORE_BY_PEER},
+		[]byte{PEER_SCORE, PEER_SCORE_BY_PEER + 1},
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "list peer scores")
+	}
+	defer iter.Close()
+
+	scores := map[string]PeerScore{}
+	for iter.First(); iter.Valid(); iter.Next() {
+		value := iter.Value()
+		if len(value) != 16 {
+			return nil, errors.Wrap(ErrInvalidData, "list peer scores")
+		}
+
+		scores[string(iter.Key()[2:])] = PeerScore{
+			Score: int64(binary.BigEndian.Uint64(value[:8])),
+			SavedAt: time.Unix(
+				0,
+				int64(binary.BigEndian.Uint64(value[8:])),
+			),
+		}
+	}
+
+	return scores, nil
+}
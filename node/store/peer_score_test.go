@@ -0,0 +1,39 @@
+package store_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+func TestPeerScoreStore(t *testing.T) {
+	db := store.NewInMemKVDB()
+	s := store.NewPebblePeerScoreStore(db, zap.NewNop())
+
+	// neighbouring prefixes must not leak into the listing
+	assert.NoError(t, db.Set([]byte{store.METADATA, 0x00, 0x01}, []byte{0x01}))
+	assert.NoError(t, db.Set([]byte{store.PEER_SCORE + 1}, []byte{0x01}))
+
+	savedAt := time.Unix(1700000000, 0)
+	assert.NoError(t, s.PutPeerScores(
+		map[string]int64{"a": 10, "b": -250, "c": 0},
+		savedAt,
+	))
+
+	scores, err := s.ListPeerScores()
+	assert.NoError(t, err)
+	assert.Len(t, scores, 2)
+	assert.Equal(t, int64(10), scores["a"].Score)
+	assert.Equal(t, int64(-250), scores["b"].Score)
+	assert.True(t, savedAt.Equal(scores["b"].SavedAt))
+
+	// a later snapshot replaces the earlier one
+	assert.NoError(t, s.PutPeerScores(map[string]int64{"b": -100}, savedAt))
+	scores, err = s.ListPeerScores()
+	assert.NoError(t, err)
+	assert.Len(t, scores, 1)
+	assert.Equal(t, int64(-100), scores["b"].Score)
+}
@@ -0,0 +1,86 @@
+package store_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
+	"source.quilibrium.com/quilibrium/monorepo/node/store"
+)
+
+func TestTransactionBlooms(t *testing.T) {
+	db := store.NewInMemKVDB()
+	s := store.NewPebbleClockStore(db, zap.NewNop())
+	filter := bytes.Repeat([]byte{0x01}, 32)
+	other := bytes.Repeat([]byte{0x02}, 32)
+
+	hash, err := protobufs.TransactionHash(&protobufs.TokenRequest{Timestamp: 1})
+	assert.NoError(t, err)
+	missing, err := protobufs.TransactionHash(&protobufs.TokenRequest{Timestamp: 2})
+	assert.NoError(t, err)
+
+	txn, err := s.NewTransaction(false)
+	assert.NoError(t, err)
+	for _, frameNumber := range []uint64{4, 5, 9} {
+		assert.NoError(t, s.PutTransactionBloom(
+			txn,
+			filter,
+			protobufs.NewTransactionBloom(frameNumber, [][]byte{hash}),
+		))
+	}
+	assert.NoError(t, s.PutTransactionBloom(
+		txn,
+		other,
+		protobufs.NewTransactionBloom(6, [][]byte{hash}),
+	))
+	assert.NoError(t, txn.Commit())
+
+	blooms, err := s.RangeTransactionBlooms(filter, 5, 9)
+	assert.NoError(t, err)
+	assert.Len(t, blooms, 1)
+	assert.Equal(t, uint64(5), blooms[0].FrameNumber)
+	assert.Equal(t, uint32(protobufs.TransactionBloomBits), blooms[0].Bits)
+	assert.True(t, blooms[0].MayContain(hash))
+	assert.False(t, blooms[0].MayContain(missing))
+}
+
+func TestTransactionBloomSize(t *testing.T) {
+	hashes := make([][]byte, 5000)
+	for i := range hashes {
+		hash, err := protobufs.TransactionHash(
+			&protobufs.TokenRequest{Timestamp: int64(i)},
+		)
+		assert.NoError(t, err)
+		hashes[i] = hash
+	}
+
+	bloom := protobufs.NewTransactionBloom(1, hashes)
+	assert.Equal(
+		t,
+		uint32(len(hashes)*protobufs.TransactionBloomBitsPerTransaction),
+		bloom.Bits,
+	)
+	assert.Len(t, bloom.Bloom, int(bloom.Bits/8))
+	for _, hash := range hashes {
+		assert.True(t, bloom.MayContain(hash))
+	}
+
+	// The false positive rate stays low however many requests the frame has.
+	falsePositives := 0
+	for i := 0; i < 10000; i++ {
+		hash, err := protobufs.TransactionHash(
+			&protobufs.TokenRequest{Timestamp: int64(len(hashes) + i)},
+		)
+		assert.NoError(t, err)
+		if bloom.MayContain(hash) {
+			falsePositives++
+		}
+	}
+	assert.Less(t, falsePositives, 200)
+
+	// A bloom whose size does not match its bits matches nothing.
+	bloom.Bits += 8
+	assert.False(t, bloom.MayContain(hashes[0]))
+}
@@ -7,6 +7,7 @@ import (
 	"sync"
 
 	"github.com/pkg/errors"
+	"source.quilibrium.com/quilibrium/monorepo/node/protobufs"
 )
 
 type RollingFrecencyCritbitTrie struct {
@@ -193,3 +194,108 @@ func (t *RollingFrecencyCritbitTrie) Remove(address []byte) {
 	}
 	t.Trie.Delete(address)
 }
+
+// ValueChange is an entry present in both tries compared by Diff, whose value
+// differs between them.
+type ValueChange struct {
+	From Value
+	To   Value
+}
+
+// Diff compares the trie against a later one, returning the entries only in
+// the later trie, the entries only in this one, and the entries in both whose
+// frames or count differ. Each list is in key order.
+func (t *RollingFrecencyCritbitTrie) Diff(
+	to *RollingFrecencyCritbitTrie,
+) (added []Value, removed []Value, changed []ValueChange) {
+	from := map[string]Value{}
+	for _, v := range t.values() {
+		from[string(v.Key)] = v
+	}
+
+	for _, v := range to.values() {
+		prev, ok := from[string(v.Key)]
+		if !ok {
+			added = append(added, v)
+			continue
+		}
+
+		delete(from, string(v.Key))
+		if prev.EarliestFrame != v.EarliestFrame ||
+			prev.LatestFrame != v.LatestFrame ||
+			prev.Count != v.Count {
+			changed = append(changed, ValueChange{From: prev, To: v})
+		}
+	}
+
+	for _, v := range from {
+		removed = append(removed, v)
+	}
+	sort.Slice(removed, func(i, j int) bool {
+		return bytes.Compare(removed[i].Key, removed[j].Key) < 0
+	})
+
+	return added, removed, changed
+}
+
+// DiffRings compares the prover tries of each ring against those of a later
+// set, with a ring missing from either side compared as empty.
+func DiffRings(
+	from []*RollingFrecencyCritbitTrie,
+	to []*RollingFrecencyCritbitTrie,
+) []*protobufs.ProverRingDiff {
+	diffs := []*protobufs.ProverRingDiff{}
+	for ring := 0; ring < max(len(from), len(to)); ring++ {
+		fromTrie := &RollingFrecencyCritbitTrie{}
+		if ring < len(from) {
+			fromTrie = from[ring]
+		}
+		toTrie := &RollingFrecencyCritbitTrie{}
+		if ring < len(to) {
+			toTrie = to[ring]
+		}
+
+		added, removed, changed := fromTrie.Diff(toTrie)
+		diff := &protobufs.ProverRingDiff{Ring: uint32(ring)}
+		for _, v := range added {
+			diff.Added = append(diff.Added, proverTrieEntry(v))
+		}
+		for _, v := range removed {
+			diff.Removed = append(diff.Removed, proverTrieEntry(v))
+		}
+		for _, c := range changed {
+			diff.Changed = append(diff.Changed, &protobufs.ProverTrieChange{
+				From: proverTrieEntry(c.From),
+				To:   proverTrieEntry(c.To),
+			})
+		}
+		diffs = append(diffs, diff)
+	}
+
+	return diffs
+}
+
+func proverTrieEntry(v Value) *protobufs.ProverTrieEntry {
+	return &protobufs.ProverTrieEntry{
+		ProverAddress: v.Key,
+		EarliestFrame: v.EarliestFrame,
+		LatestFrame:   v.LatestFrame,
+		Count:         v.Count,
+	}
+}
+
+func (t *RollingFrecencyCritbitTrie) values() []Value {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if t.Trie == nil {
+		return nil
+	}
+
+	values := []Value{}
+	t.Trie.Walk(func(k []byte, v interface{}) bool {
+		values = append(values, v.(Value))
+		return false
+	})
+
+	return values
+}
@@ -62,3 +62,43 @@ func TestCritbitReinit(t *testing.T) {
 	near = tree.FindNearestAndApproximateNeighbors(make([]byte, 32))
 	assert.Equal(t, 0, len(near))
 }
+
+func TestRollingFrecencyCritbitTrieDiff(t *testing.T) {
+	key := func(b byte) []byte {
+		k := make([]byte, 32)
+		k[0] = b
+		return k
+	}
+
+	from := &tries.RollingFrecencyCritbitTrie{}
+	from.Add(key(1), 10)
+	from.Add(key(2), 10)
+	from.Add(key(3), 10)
+
+	to := &tries.RollingFrecencyCritbitTrie{}
+	to.Add(key(2), 10)
+	to.Add(key(3), 10)
+	to.Add(key(3), 20)
+	to.Add(key(5), 20)
+	to.Add(key(4), 20)
+
+	added, removed, changed := from.Diff(to)
+	assert.Equal(t, []tries.Value{
+		{Key: key(4), EarliestFrame: 20, LatestFrame: 20},
+		{Key: key(5), EarliestFrame: 20, LatestFrame: 20},
+	}, added)
+	assert.Equal(t, []tries.Value{
+		{Key: key(1), EarliestFrame: 10, LatestFrame: 10},
+	}, removed)
+	assert.Equal(t, []tries.ValueChange{
+		{
+			From: tries.Value{Key: key(3), EarliestFrame: 10, LatestFrame: 10},
+			To:   tries.Value{Key: key(3), EarliestFrame: 10, LatestFrame: 20},
+		},
+	}, changed)
+
+	added, removed, changed = to.Diff(to)
+	assert.Empty(t, added)
+	assert.Empty(t, removed)
+	assert.Empty(t, changed)
+}
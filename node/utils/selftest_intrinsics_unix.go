@@ -15,3 +15,15 @@ func GetDiskSpace(dir string) uint64 {
 
 	return stat.Bavail * uint64(stat.Bsize)
 }
+
+// GetFreeDiskSpace returns the bytes available to unprivileged users on the
+// volume holding dir.
+func GetFreeDiskSpace(dir string) (uint64, error) {
+	var stat unix.Statfs_t
+
+	if err := unix.Statfs(dir, &stat); err != nil {
+		return 0, err
+	}
+
+	return stat.Bavail * uint64(stat.Bsize), nil
+}
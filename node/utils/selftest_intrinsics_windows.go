@@ -18,3 +18,19 @@ func GetDiskSpace(dir string) uint64 {
 
 	return totalNumberOfBytes
 }
+
+// GetFreeDiskSpace returns the bytes available to the calling user on the
+// volume holding dir.
+func GetFreeDiskSpace(dir string) (uint64, error) {
+	var freeBytesAvailable uint64
+	var totalNumberOfBytes uint64
+	var totalNumberOfFreeBytes uint64
+
+	err := windows.GetDiskFreeSpaceEx(windows.StringToUTF16Ptr(dir),
+		&freeBytesAvailable, &totalNumberOfBytes, &totalNumberOfFreeBytes)
+	if err != nil {
+		return 0, err
+	}
+
+	return freeBytesAvailable, nil
+}